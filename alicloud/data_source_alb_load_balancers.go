@@ -0,0 +1,296 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &albLoadBalancersDataSource{}
+	_ datasource.DataSourceWithConfigure = &albLoadBalancersDataSource{}
+)
+
+func NewAlbLoadBalancersDataSource() datasource.DataSource {
+	return &albLoadBalancersDataSource{}
+}
+
+type albLoadBalancersDataSource struct {
+	client *alicloudAlbClient.Client
+}
+
+type albLoadBalancersDataSourceModel struct {
+	ClientConfig  *clientConfigWithZone     `tfsdk:"client_config"`
+	Name          types.String              `tfsdk:"name"`
+	VpcId         types.String              `tfsdk:"vpc_id"`
+	Tags          types.Map                 `tfsdk:"tags"`
+	LoadBalancers []*albLoadBalancersDetail `tfsdk:"load_balancers"`
+}
+
+type albLoadBalancersDetail struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DnsName     types.String `tfsdk:"dns_name"`
+	VpcId       types.String `tfsdk:"vpc_id"`
+	AddressType types.String `tfsdk:"address_type"`
+	Edition     types.String `tfsdk:"edition"`
+	Status      types.String `tfsdk:"status"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+func (d *albLoadBalancersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_load_balancers"
+}
+
+func (d *albLoadBalancersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the Application Load Balancers in desired region or user account.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the ALBs.",
+				Optional:    true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the VPC of the ALBs.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the ALB instances, only the ALBs matching all the given " +
+					"tags are returned. The tag value of an ALB delimited by '/' is matched if any of the " +
+					"values is matched.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"load_balancers": schema.ListNestedAttribute{
+				Description: "A list of ALBs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the ALB.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the ALB.",
+							Computed:    true,
+						},
+						"dns_name": schema.StringAttribute{
+							Description: "The domain name of the ALB.",
+							Computed:    true,
+						},
+						"vpc_id": schema.StringAttribute{
+							Description: "The ID of the VPC of the ALB.",
+							Computed:    true,
+						},
+						"address_type": schema.StringAttribute{
+							Description: "The network type of the ALB, Internet or Intranet.",
+							Computed:    true,
+						},
+						"edition": schema.StringAttribute{
+							Description: "The edition of the ALB.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the ALB.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "The tags of the ALB.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the ALBs. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"zone": schema.StringAttribute{
+						Description: "The zone of the ALBs.",
+						Optional:    true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"ALBs. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"ALBs. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *albLoadBalancersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).albClient
+}
+
+func (d *albLoadBalancersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *albLoadBalancersDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfigWithZone{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig.getClientConfig())
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudAlbClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud ALB API Client",
+				"An unexpected error occurred when creating the AliCloud ALB API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud ALB Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &albLoadBalancersDataSourceModel{
+		Name:          plan.Name,
+		VpcId:         plan.VpcId,
+		Tags:          plan.Tags,
+		LoadBalancers: []*albLoadBalancersDetail{},
+	}
+
+	inputTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		convertTagsDiags := plan.Tags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var nextToken *string
+	for {
+		var listLoadBalancersResponse *alicloudAlbClient.ListLoadBalancersResponse
+		listLoadBalancers := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			// The tags are not passed to AliCloud API, as the tag values
+			// delimited by '/' are matched after listing the ALBs.
+			listLoadBalancersRequest := &alicloudAlbClient.ListLoadBalancersRequest{
+				MaxResults: tea.Int32(100),
+				NextToken:  nextToken,
+			}
+			if !plan.Name.IsNull() {
+				listLoadBalancersRequest.LoadBalancerNames = []*string{tea.String(plan.Name.ValueString())}
+			}
+			if !plan.VpcId.IsNull() {
+				listLoadBalancersRequest.VpcIds = []*string{tea.String(plan.VpcId.ValueString())}
+			}
+			if !plan.ClientConfig.Zone.IsNull() && plan.ClientConfig.Zone.ValueString() != "" {
+				listLoadBalancersRequest.ZoneId = tea.String(plan.ClientConfig.Zone.ValueString())
+			}
+
+			var err error
+			listLoadBalancersResponse, err = d.client.ListLoadBalancersWithOptions(listLoadBalancersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listLoadBalancers); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Load Balancers.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, loadBalancer := range listLoadBalancersResponse.Body.LoadBalancers {
+			tags := make(map[string]attr.Value)
+			albTags := make(map[string]string)
+			for _, tag := range loadBalancer.Tags {
+				tags[tea.StringValue(tag.Key)] = types.StringValue(tea.StringValue(tag.Value))
+				albTags[tea.StringValue(tag.Key)] = tea.StringValue(tag.Value)
+			}
+
+			if !isAlbTagsMatched(albTags, inputTags) {
+				continue
+			}
+
+			state.LoadBalancers = append(state.LoadBalancers, &albLoadBalancersDetail{
+				Id:          types.StringValue(tea.StringValue(loadBalancer.LoadBalancerId)),
+				Name:        types.StringValue(tea.StringValue(loadBalancer.LoadBalancerName)),
+				DnsName:     types.StringValue(tea.StringValue(loadBalancer.DNSName)),
+				VpcId:       types.StringValue(tea.StringValue(loadBalancer.VpcId)),
+				AddressType: types.StringValue(tea.StringValue(loadBalancer.AddressType)),
+				Edition:     types.StringValue(tea.StringValue(loadBalancer.LoadBalancerEdition)),
+				Status:      types.StringValue(tea.StringValue(loadBalancer.LoadBalancerStatus)),
+				Tags:        types.MapValueMust(types.StringType, tags),
+			})
+		}
+
+		nextToken = listLoadBalancersResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to match all the input tags with the tags of the ALB. Same as
+// st-alicloud_slb_load_balancers, '/' is assumed as the delimiter of the tag
+// value of the ALB, and the tag is matched if any of the values is matched.
+func isAlbTagsMatched(albTags map[string]string, inputTags map[string]string) bool {
+	for inputTagKey, inputTagValue := range inputTags {
+		value, ok := albTags[inputTagKey]
+		if !ok {
+			return false
+		}
+
+		matched := false
+		for _, t := range strings.Split(value, "/") {
+			if t == inputTagValue {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
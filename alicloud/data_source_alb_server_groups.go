@@ -0,0 +1,357 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &albServerGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &albServerGroupsDataSource{}
+)
+
+func NewAlbServerGroupsDataSource() datasource.DataSource {
+	return &albServerGroupsDataSource{}
+}
+
+type albServerGroupsDataSource struct {
+	client *alicloudAlbClient.Client
+}
+
+type albServerGroupsDataSourceModel struct {
+	ClientConfig *clientConfig            `tfsdk:"client_config"`
+	Name         types.String             `tfsdk:"name"`
+	VpcId        types.String             `tfsdk:"vpc_id"`
+	Tags         types.Map                `tfsdk:"tags"`
+	ServerGroups []*albServerGroupsDetail `tfsdk:"server_groups"`
+}
+
+type albServerGroupsDetail struct {
+	Id          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	Type        types.String                `tfsdk:"type"`
+	Protocol    types.String                `tfsdk:"protocol"`
+	Scheduler   types.String                `tfsdk:"scheduler"`
+	VpcId       types.String                `tfsdk:"vpc_id"`
+	Status      types.String                `tfsdk:"status"`
+	ServerCount types.Int64                 `tfsdk:"server_count"`
+	HealthCheck *albServerGroupsHealthCheck `tfsdk:"health_check"`
+	Tags        types.Map                   `tfsdk:"tags"`
+}
+
+type albServerGroupsHealthCheck struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Protocol           types.String `tfsdk:"protocol"`
+	Port               types.Int64  `tfsdk:"port"`
+	Host               types.String `tfsdk:"host"`
+	Path               types.String `tfsdk:"path"`
+	Method             types.String `tfsdk:"method"`
+	Codes              types.List   `tfsdk:"codes"`
+	Interval           types.Int64  `tfsdk:"interval"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	HealthyThreshold   types.Int64  `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold types.Int64  `tfsdk:"unhealthy_threshold"`
+}
+
+func (d *albServerGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_server_groups"
+}
+
+func (d *albServerGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the server groups of Application Load Balancers in desired region or user account.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the server groups.",
+				Optional:    true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the VPC of the server groups.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the server groups, only the server groups matching all the " +
+					"given tags are returned. The tag value of a server group delimited by '/' is matched if any " +
+					"of the values is matched.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"server_groups": schema.ListNestedAttribute{
+				Description: "A list of server groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the server group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the server group.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the server group, Instance, Ip or Fc.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The backend protocol of the server group.",
+							Computed:    true,
+						},
+						"scheduler": schema.StringAttribute{
+							Description: "The scheduling algorithm of the server group.",
+							Computed:    true,
+						},
+						"vpc_id": schema.StringAttribute{
+							Description: "The ID of the VPC of the server group.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the server group.",
+							Computed:    true,
+						},
+						"server_count": schema.Int64Attribute{
+							Description: "The number of backend servers attached to the server group.",
+							Computed:    true,
+						},
+						"health_check": schema.SingleNestedAttribute{
+							Description: "The health check config of the server group.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Description: "Whether the health check is enabled.",
+									Computed:    true,
+								},
+								"protocol": schema.StringAttribute{
+									Description: "The protocol of the health check.",
+									Computed:    true,
+								},
+								"port": schema.Int64Attribute{
+									Description: "The port of the health check, 0 means the port of the backend servers.",
+									Computed:    true,
+								},
+								"host": schema.StringAttribute{
+									Description: "The domain name of the health check.",
+									Computed:    true,
+								},
+								"path": schema.StringAttribute{
+									Description: "The path of the health check.",
+									Computed:    true,
+								},
+								"method": schema.StringAttribute{
+									Description: "The HTTP method of the health check.",
+									Computed:    true,
+								},
+								"codes": schema.ListAttribute{
+									Description: "The status codes of the healthy backend servers.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+								"interval": schema.Int64Attribute{
+									Description: "The interval of the health check in seconds.",
+									Computed:    true,
+								},
+								"timeout": schema.Int64Attribute{
+									Description: "The timeout of the health check in seconds.",
+									Computed:    true,
+								},
+								"healthy_threshold": schema.Int64Attribute{
+									Description: "The number of consecutive successful health checks to mark a backend server healthy.",
+									Computed:    true,
+								},
+								"unhealthy_threshold": schema.Int64Attribute{
+									Description: "The number of consecutive failed health checks to mark a backend server unhealthy.",
+									Computed:    true,
+								},
+							},
+						},
+						"tags": schema.MapAttribute{
+							Description: "The tags of the server group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the server groups. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"server groups. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"server groups. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *albServerGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).albClient
+}
+
+func (d *albServerGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *albServerGroupsDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudAlbClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud ALB API Client",
+				"An unexpected error occurred when creating the AliCloud ALB API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud ALB Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &albServerGroupsDataSourceModel{
+		Name:         plan.Name,
+		VpcId:        plan.VpcId,
+		Tags:         plan.Tags,
+		ServerGroups: []*albServerGroupsDetail{},
+	}
+
+	inputTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		convertTagsDiags := plan.Tags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var nextToken *string
+	for {
+		var listServerGroupsResponse *alicloudAlbClient.ListServerGroupsResponse
+		listServerGroups := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			// Same as st-alicloud_alb_load_balancers, the tags are matched
+			// after listing the server groups.
+			listServerGroupsRequest := &alicloudAlbClient.ListServerGroupsRequest{
+				MaxResults: tea.Int32(100),
+				NextToken:  nextToken,
+			}
+			if !plan.Name.IsNull() {
+				listServerGroupsRequest.ServerGroupNames = []*string{tea.String(plan.Name.ValueString())}
+			}
+			if !plan.VpcId.IsNull() {
+				listServerGroupsRequest.VpcId = tea.String(plan.VpcId.ValueString())
+			}
+
+			var err error
+			listServerGroupsResponse, err = d.client.ListServerGroupsWithOptions(listServerGroupsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listServerGroups); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Server Groups.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, serverGroup := range listServerGroupsResponse.Body.ServerGroups {
+			tags := make(map[string]attr.Value)
+			serverGroupTags := make(map[string]string)
+			for _, tag := range serverGroup.Tags {
+				tags[tea.StringValue(tag.Key)] = types.StringValue(tea.StringValue(tag.Value))
+				serverGroupTags[tea.StringValue(tag.Key)] = tea.StringValue(tag.Value)
+			}
+
+			if !isAlbTagsMatched(serverGroupTags, inputTags) {
+				continue
+			}
+
+			healthCheck := &albServerGroupsHealthCheck{
+				Enabled: types.BoolValue(false),
+				Codes:   types.ListValueMust(types.StringType, []attr.Value{}),
+			}
+			if config := serverGroup.HealthCheckConfig; config != nil {
+				healthCheck = &albServerGroupsHealthCheck{
+					Enabled:            types.BoolValue(tea.BoolValue(config.HealthCheckEnabled)),
+					Protocol:           types.StringValue(tea.StringValue(config.HealthCheckProtocol)),
+					Port:               types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectPort))),
+					Host:               types.StringValue(tea.StringValue(config.HealthCheckHost)),
+					Path:               types.StringValue(tea.StringValue(config.HealthCheckPath)),
+					Method:             types.StringValue(tea.StringValue(config.HealthCheckMethod)),
+					Codes:              types.ListValueMust(types.StringType, convertStringPointersToAttrValues(config.HealthCheckCodes)),
+					Interval:           types.Int64Value(int64(tea.Int32Value(config.HealthCheckInterval))),
+					Timeout:            types.Int64Value(int64(tea.Int32Value(config.HealthCheckTimeout))),
+					HealthyThreshold:   types.Int64Value(int64(tea.Int32Value(config.HealthyThreshold))),
+					UnhealthyThreshold: types.Int64Value(int64(tea.Int32Value(config.UnhealthyThreshold))),
+				}
+			}
+
+			state.ServerGroups = append(state.ServerGroups, &albServerGroupsDetail{
+				Id:          types.StringValue(tea.StringValue(serverGroup.ServerGroupId)),
+				Name:        types.StringValue(tea.StringValue(serverGroup.ServerGroupName)),
+				Type:        types.StringValue(tea.StringValue(serverGroup.ServerGroupType)),
+				Protocol:    types.StringValue(tea.StringValue(serverGroup.Protocol)),
+				Scheduler:   types.StringValue(tea.StringValue(serverGroup.Scheduler)),
+				VpcId:       types.StringValue(tea.StringValue(serverGroup.VpcId)),
+				Status:      types.StringValue(tea.StringValue(serverGroup.ServerGroupStatus)),
+				ServerCount: types.Int64Value(int64(tea.Int32Value(serverGroup.ServerCount))),
+				HealthCheck: healthCheck,
+				Tags:        types.MapValueMust(types.StringType, tags),
+			})
+		}
+
+		nextToken = listServerGroupsResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,420 @@
+package alicloud
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDnsClient "github.com/alibabacloud-go/alidns-20150109/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource                     = &aliDnsRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &aliDnsRecordsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &aliDnsRecordsDataSource{}
+)
+
+func NewAliDnsRecordsDataSource() datasource.DataSource {
+	return &aliDnsRecordsDataSource{}
+}
+
+type aliDnsRecordsDataSource struct {
+	client *alicloudDnsClient.Client
+}
+
+type aliDnsRecordsDataSourceModel struct {
+	ClientConfig *clientConfig         `tfsdk:"client_config"`
+	DomainName   types.String          `tfsdk:"domain_name"`
+	DomainTags   types.Map             `tfsdk:"domain_tags"`
+	RRRegex      types.String          `tfsdk:"rr_regex"`
+	Type         types.String          `tfsdk:"type"`
+	Value        types.String          `tfsdk:"value"`
+	Line         types.String          `tfsdk:"line"`
+	Ids          types.List            `tfsdk:"ids"`
+	Records      []*aliDnsRecordDetail `tfsdk:"records"`
+}
+
+type aliDnsRecordDetail struct {
+	RecordId   types.String `tfsdk:"record_id"`
+	DomainName types.String `tfsdk:"domain_name"`
+	RR         types.String `tfsdk:"rr"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	Ttl        types.Int64  `tfsdk:"ttl"`
+	Line       types.String `tfsdk:"line"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Weight     types.Int64  `tfsdk:"weight"`
+	Status     types.String `tfsdk:"status"`
+	Locked     types.Bool   `tfsdk:"locked"`
+	Remark     types.String `tfsdk:"remark"`
+}
+
+func (d *aliDnsRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alidns_records"
+}
+
+func (d *aliDnsRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the DNS records of the AliDNS domains.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The domain name of the records.",
+				Optional:    true,
+			},
+			"domain_tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the domains, the records of all the domains matching " +
+					"all the given tags are returned.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rr_regex": schema.StringAttribute{
+				Description: "The regular expression to match the host records (RR) of the records.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the records, e.g. A, AAAA, CNAME, MX, TXT.",
+				Optional:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the records.",
+				Optional:    true,
+			},
+			"line": schema.StringAttribute{
+				Description: "The resolution line of the records.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "A list of the matched record IDs.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "A list of the matched records.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"record_id": schema.StringAttribute{
+							Description: "The ID of the record.",
+							Computed:    true,
+						},
+						"domain_name": schema.StringAttribute{
+							Description: "The domain name of the record.",
+							Computed:    true,
+						},
+						"rr": schema.StringAttribute{
+							Description: "The host record (RR) of the record.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the record.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the record.",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "The TTL of the record in seconds.",
+							Computed:    true,
+						},
+						"line": schema.StringAttribute{
+							Description: "The resolution line of the record.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "The priority of the MX record.",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the record in the weighted round-robin.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the record, ENABLE or DISABLE.",
+							Computed:    true,
+						},
+						"locked": schema.BoolAttribute{
+							Description: "Whether the record is locked.",
+							Computed:    true,
+						},
+						"remark": schema.StringAttribute{
+							Description: "The remark of the record.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the AliDNS. Default to " +
+							"use region configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"DNS records. Default to use access key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"DNS records. Default to use secret key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *aliDnsRecordsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("domain_name"),
+			path.MatchRoot("domain_tags"),
+		),
+	}
+}
+
+func (d *aliDnsRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).dnsClient
+}
+
+func (d *aliDnsRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *aliDnsRecordsDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudDnsClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud DNS API Client",
+				"An unexpected error occurred when creating the AliCloud DNS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud DNS Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var rrRegex *regexp.Regexp
+	if !plan.RRRegex.IsNull() {
+		var err error
+		if rrRegex, err = regexp.Compile(plan.RRRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rr_regex"),
+				"Invalid Regular Expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	domainNames := []string{plan.DomainName.ValueString()}
+	if plan.DomainName.IsNull() {
+		inputTags := make(map[string]string)
+		convertTagsDiags := plan.DomainTags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		if domainNames, err = d.listTaggedDomains(inputTags); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Tagged Domains.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &aliDnsRecordsDataSourceModel{
+		DomainName: plan.DomainName,
+		DomainTags: plan.DomainTags,
+		RRRegex:    plan.RRRegex,
+		Type:       plan.Type,
+		Value:      plan.Value,
+		Line:       plan.Line,
+		Records:    []*aliDnsRecordDetail{},
+	}
+
+	recordIds := []*string{}
+	for _, domainName := range domainNames {
+		records, err := d.describeDomainRecords(plan, domainName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Domain Records.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, record := range records {
+			// The regular expression is not supported by the API, filter the
+			// records after listing them.
+			if rrRegex != nil && !rrRegex.MatchString(tea.StringValue(record.RR)) {
+				continue
+			}
+			if !plan.Value.IsNull() && plan.Value.ValueString() != tea.StringValue(record.Value) {
+				continue
+			}
+
+			recordIds = append(recordIds, record.RecordId)
+			state.Records = append(state.Records, &aliDnsRecordDetail{
+				RecordId:   types.StringValue(tea.StringValue(record.RecordId)),
+				DomainName: types.StringValue(tea.StringValue(record.DomainName)),
+				RR:         types.StringValue(tea.StringValue(record.RR)),
+				Type:       types.StringValue(tea.StringValue(record.Type)),
+				Value:      types.StringValue(tea.StringValue(record.Value)),
+				Ttl:        types.Int64Value(tea.Int64Value(record.TTL)),
+				Line:       types.StringValue(tea.StringValue(record.Line)),
+				Priority:   types.Int64Value(tea.Int64Value(record.Priority)),
+				Weight:     types.Int64Value(int64(tea.Int32Value(record.Weight))),
+				Status:     types.StringValue(tea.StringValue(record.Status)),
+				Locked:     types.BoolValue(tea.BoolValue(record.Locked)),
+				Remark:     types.StringValue(tea.StringValue(record.Remark)),
+			})
+		}
+	}
+	state.Ids = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(recordIds))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to list the records of the domain with the type and the line
+// filters supported by the API.
+func (d *aliDnsRecordsDataSource) describeDomainRecords(plan *aliDnsRecordsDataSourceModel, domainName string) ([]*alicloudDnsClient.DescribeDomainRecordsResponseBodyDomainRecordsRecord, error) {
+	var records []*alicloudDnsClient.DescribeDomainRecordsResponseBodyDomainRecordsRecord
+	describeDomainRecords := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		records = nil
+		pageNumber := int64(1)
+		for {
+			describeDomainRecordsRequest := &alicloudDnsClient.DescribeDomainRecordsRequest{
+				DomainName: tea.String(domainName),
+				PageNumber: tea.Int64(pageNumber),
+				PageSize:   tea.Int64(500),
+			}
+			if !plan.Type.IsNull() {
+				describeDomainRecordsRequest.Type = tea.String(plan.Type.ValueString())
+			}
+			if !plan.Line.IsNull() {
+				describeDomainRecordsRequest.Line = tea.String(plan.Line.ValueString())
+			}
+
+			describeDomainRecordsResponse, err := d.client.DescribeDomainRecordsWithOptions(describeDomainRecordsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			body := describeDomainRecordsResponse.Body
+			if body.DomainRecords == nil || len(body.DomainRecords.Record) == 0 {
+				return nil
+			}
+			records = append(records, body.DomainRecords.Record...)
+			if pageNumber*500 >= tea.Int64Value(body.TotalCount) {
+				return nil
+			}
+			pageNumber++
+		}
+	}
+
+	err := retryAPICall(describeDomainRecords)
+	return records, err
+}
+
+// Function to list the names of the domains matching all the tags.
+func (d *aliDnsRecordsDataSource) listTaggedDomains(tags map[string]string) ([]string, error) {
+	var domainNames []string
+	listTagResources := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		domainNames = nil
+		matchedTags := map[string]int{}
+		var nextToken *string
+		for {
+			listTagResourcesRequest := &alicloudDnsClient.ListTagResourcesRequest{
+				ResourceType: tea.String("DOMAIN"),
+				NextToken:    nextToken,
+			}
+			for key, value := range tags {
+				listTagResourcesRequest.Tag = append(listTagResourcesRequest.Tag, &alicloudDnsClient.ListTagResourcesRequestTag{
+					Key:   tea.String(key),
+					Value: tea.String(value),
+				})
+			}
+
+			listTagResourcesResponse, err := d.client.ListTagResourcesWithOptions(listTagResourcesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			// The tag resources are returned per tag, a domain matches when
+			// all the tags are returned for it.
+			for _, tagResource := range listTagResourcesResponse.Body.TagResources {
+				if value, ok := tags[tea.StringValue(tagResource.TagKey)]; ok && value == tea.StringValue(tagResource.TagValue) {
+					matchedTags[tea.StringValue(tagResource.ResourceId)]++
+				}
+			}
+
+			nextToken = listTagResourcesResponse.Body.NextToken
+			if tea.StringValue(nextToken) == "" {
+				break
+			}
+		}
+
+		for domainName, count := range matchedTags {
+			if count == len(tags) {
+				domainNames = append(domainNames, domainName)
+			}
+		}
+		sort.Strings(domainNames)
+		return nil
+	}
+
+	err := retryAPICall(listTagResources)
+	return domainNames, err
+}
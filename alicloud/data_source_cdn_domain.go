@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -163,9 +162,7 @@ func (d *cdnDomainDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeCdnDomain, reconnectBackoff)
+	err = retryAPICall(describeCdnDomain)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Describe CDN Domain",
@@ -0,0 +1,313 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &cdnDomainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &cdnDomainsDataSource{}
+)
+
+func NewCdnDomainsDataSource() datasource.DataSource {
+	return &cdnDomainsDataSource{}
+}
+
+type cdnDomainsDataSource struct {
+	client *alicloudCdnClient.Client
+}
+
+type cdnDomainsDataSourceModel struct {
+	ClientConfig *clientConfig       `tfsdk:"client_config"`
+	Status       types.String        `tfsdk:"status"`
+	Cname        types.String        `tfsdk:"cname"`
+	Tags         types.Map           `tfsdk:"tags"`
+	Domains      []*cdnDomainsDetail `tfsdk:"domains"`
+}
+
+type cdnDomainsDetail struct {
+	DomainName      types.String        `tfsdk:"domain_name"`
+	Cname           types.String        `tfsdk:"cname"`
+	Status          types.String        `tfsdk:"status"`
+	CdnType         types.String        `tfsdk:"cdn_type"`
+	ResourceGroupId types.String        `tfsdk:"resource_group_id"`
+	HttpsEnabled    types.Bool          `tfsdk:"https_enabled"`
+	Sources         []*cdnDomainsSource `tfsdk:"sources"`
+}
+
+type cdnDomainsSource struct {
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+}
+
+func (d *cdnDomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_domains"
+}
+
+func (d *cdnDomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the CDN domains of the current Alibaba Cloud user.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "The status of the CDN domains. Valid values: online, offline, configuring, " +
+					"configure_failed, checking, check_failed, stopping, deleting.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("online", "offline", "configuring", "configure_failed",
+						"checking", "check_failed", "stopping", "deleting"),
+				},
+			},
+			"cname": schema.StringAttribute{
+				Description: "The CNAME of the CDN domains.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the CDN domains, only the domains matching all " +
+					"the given tags are returned.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"domains": schema.ListNestedAttribute{
+				Description: "A list of CDN domains.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain_name": schema.StringAttribute{
+							Description: "The accelerated domain name.",
+							Computed:    true,
+						},
+						"cname": schema.StringAttribute{
+							Description: "The CNAME of the CDN domain.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the CDN domain.",
+							Computed:    true,
+						},
+						"cdn_type": schema.StringAttribute{
+							Description: "The business type of the CDN domain.",
+							Computed:    true,
+						},
+						"resource_group_id": schema.StringAttribute{
+							Description: "The ID of the resource group of the CDN domain.",
+							Computed:    true,
+						},
+						"https_enabled": schema.BoolAttribute{
+							Description: "Whether HTTPS is enabled for the CDN domain.",
+							Computed:    true,
+						},
+						"sources": schema.ListNestedAttribute{
+							Description: "The origin servers of the CDN domain.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "The type of the origin server.",
+										Computed:    true,
+									},
+									"content": schema.StringAttribute{
+										Description: "The address of the origin server.",
+										Computed:    true,
+									},
+									"port": schema.Int64Attribute{
+										Description: "The port of the origin server.",
+										Computed:    true,
+									},
+									"priority": schema.Int64Attribute{
+										Description: "The priority of the origin server.",
+										Computed:    true,
+									},
+									"weight": schema.Int64Attribute{
+										Description: "The weight of the origin server.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the CDN domains. Default to " +
+							"use region configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"CDN domains. Default to use access key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"CDN domains. Default to use secret key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cdnDomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).cdnClient
+}
+
+func (d *cdnDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *cdnDomainsDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudCdnClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud CDN API Client",
+				"An unexpected error occurred when creating the AliCloud CDN API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud CDN Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &cdnDomainsDataSourceModel{
+		Status:  plan.Status,
+		Cname:   plan.Cname,
+		Tags:    plan.Tags,
+		Domains: []*cdnDomainsDetail{},
+	}
+
+	inputTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		convertTagsDiags := plan.Tags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var pageNumber int32 = 1
+	for {
+		var describeUserDomainsResponse *alicloudCdnClient.DescribeUserDomainsResponse
+		describeUserDomains := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeUserDomainsRequest := &alicloudCdnClient.DescribeUserDomainsRequest{
+				PageSize:   tea.Int32(500),
+				PageNumber: tea.Int32(pageNumber),
+			}
+			if !plan.Status.IsNull() {
+				describeUserDomainsRequest.DomainStatus = tea.String(plan.Status.ValueString())
+			}
+			for key, value := range inputTags {
+				describeUserDomainsRequest.Tag = append(describeUserDomainsRequest.Tag, &alicloudCdnClient.DescribeUserDomainsRequestTag{
+					Key:   tea.String(key),
+					Value: tea.String(value),
+				})
+			}
+
+			var err error
+			describeUserDomainsResponse, err = d.client.DescribeUserDomainsWithOptions(describeUserDomainsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeUserDomains); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe CDN Domains.",
+				err.Error(),
+			)
+			return
+		}
+
+		if describeUserDomainsResponse.Body.Domains == nil {
+			break
+		}
+
+		pageData := describeUserDomainsResponse.Body.Domains.PageData
+		for _, domain := range pageData {
+			// The CNAME is not supported by the API, filter it after listing
+			// the domains.
+			if !plan.Cname.IsNull() && plan.Cname.ValueString() != tea.StringValue(domain.Cname) {
+				continue
+			}
+
+			sources := []*cdnDomainsSource{}
+			if domain.Sources != nil {
+				for _, source := range domain.Sources.Source {
+					priority, _ := strconv.ParseInt(tea.StringValue(source.Priority), 10, 64)
+					weight, _ := strconv.ParseInt(tea.StringValue(source.Weight), 10, 64)
+					sources = append(sources, &cdnDomainsSource{
+						Type:     types.StringValue(tea.StringValue(source.Type)),
+						Content:  types.StringValue(tea.StringValue(source.Content)),
+						Port:     types.Int64Value(int64(tea.Int32Value(source.Port))),
+						Priority: types.Int64Value(priority),
+						Weight:   types.Int64Value(weight),
+					})
+				}
+			}
+
+			state.Domains = append(state.Domains, &cdnDomainsDetail{
+				DomainName:      types.StringValue(tea.StringValue(domain.DomainName)),
+				Cname:           types.StringValue(tea.StringValue(domain.Cname)),
+				Status:          types.StringValue(tea.StringValue(domain.DomainStatus)),
+				CdnType:         types.StringValue(tea.StringValue(domain.CdnType)),
+				ResourceGroupId: types.StringValue(tea.StringValue(domain.ResourceGroupId)),
+				HttpsEnabled:    types.BoolValue(tea.StringValue(domain.SslProtocol) == "on"),
+				Sources:         sources,
+			})
+		}
+
+		if len(pageData) == 0 || int64(pageNumber)*500 >= tea.Int64Value(describeUserDomainsResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
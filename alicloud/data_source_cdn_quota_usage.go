@@ -0,0 +1,516 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
+	alicloudDcdnClient "github.com/alibabacloud-go/dcdn-20180115/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	cdnUsageDefaultWindowMinutes = 60
+	// The interval in seconds of the usage data, the finest granularity
+	// supported by the CDN and DCDN monitoring APIs for a window up to 3 days.
+	cdnUsageInterval = "300"
+)
+
+var (
+	_ datasource.DataSource              = &cdnQuotaUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &cdnQuotaUsageDataSource{}
+)
+
+func NewCdnQuotaUsageDataSource() datasource.DataSource {
+	return &cdnQuotaUsageDataSource{}
+}
+
+type cdnQuotaUsageDataSource struct {
+	cdnClient  *alicloudCdnClient.Client
+	dcdnClient *alicloudDcdnClient.Client
+}
+
+type cdnQuotaUsageDataSourceModel struct {
+	ClientConfig  *clientConfig         `tfsdk:"client_config"`
+	Product       types.String          `tfsdk:"product"`
+	DomainNames   types.List            `tfsdk:"domain_names"`
+	WindowMinutes types.Int64           `tfsdk:"window_minutes"`
+	RefreshQuota  *cdnQuotaUsageRefresh `tfsdk:"refresh_quota"`
+	Usage         *cdnQuotaUsageTraffic `tfsdk:"usage"`
+}
+
+type cdnQuotaUsageRefresh struct {
+	UrlQuota      types.Int64 `tfsdk:"url_quota"`
+	UrlRemain     types.Int64 `tfsdk:"url_remain"`
+	DirQuota      types.Int64 `tfsdk:"dir_quota"`
+	DirRemain     types.Int64 `tfsdk:"dir_remain"`
+	RegexQuota    types.Int64 `tfsdk:"regex_quota"`
+	RegexRemain   types.Int64 `tfsdk:"regex_remain"`
+	PreloadQuota  types.Int64 `tfsdk:"preload_quota"`
+	PreloadRemain types.Int64 `tfsdk:"preload_remain"`
+}
+
+type cdnQuotaUsageTraffic struct {
+	PeakBps       types.Float64 `tfsdk:"peak_bps"`
+	AverageBps    types.Float64 `tfsdk:"average_bps"`
+	PeakQps       types.Float64 `tfsdk:"peak_qps"`
+	TotalRequests types.Float64 `tfsdk:"total_requests"`
+}
+
+func (d *cdnQuotaUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_quota_usage"
+}
+
+func (d *cdnQuotaUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	quotaAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the recent usage and the remaining refresh and prefetch quota " +
+			"of CDN or DCDN.",
+		Attributes: map[string]schema.Attribute{
+			"product": schema.StringAttribute{
+				Description: "The product to query. Valid values: cdn, dcdn. Default to cdn.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cdn", "dcdn"),
+				},
+			},
+			"domain_names": schema.ListAttribute{
+				Description: "The accelerated domain names to query the usage. Default to query the usage " +
+					"of all the domain names in the account.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"window_minutes": schema.Int64Attribute{
+				Description: "The recent window in minutes to query the usage. Default to 60.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(5, 4320),
+				},
+			},
+			"refresh_quota": schema.SingleNestedAttribute{
+				Description: "The daily refresh and prefetch quota of the account.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"url_quota":      quotaAttribute("The maximum number of URLs to refresh per day."),
+					"url_remain":     quotaAttribute("The remaining number of URLs to refresh today."),
+					"dir_quota":      quotaAttribute("The maximum number of directories to refresh per day."),
+					"dir_remain":     quotaAttribute("The remaining number of directories to refresh today."),
+					"regex_quota":    quotaAttribute("The maximum number of regular expressions to refresh per day."),
+					"regex_remain":   quotaAttribute("The remaining number of regular expressions to refresh today."),
+					"preload_quota":  quotaAttribute("The maximum number of URLs to prefetch per day."),
+					"preload_remain": quotaAttribute("The remaining number of URLs to prefetch today."),
+				},
+			},
+			"usage": schema.SingleNestedAttribute{
+				Description: "The usage of the domain names over the window.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"peak_bps": schema.Float64Attribute{
+						Description: "The peak bandwidth in bit/s.",
+						Computed:    true,
+					},
+					"average_bps": schema.Float64Attribute{
+						Description: "The average bandwidth in bit/s.",
+						Computed:    true,
+					},
+					"peak_qps": schema.Float64Attribute{
+						Description: "The peak number of queries per second.",
+						Computed:    true,
+					},
+					"total_requests": schema.Float64Attribute{
+						Description: "The total number of requests.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the CDN or DCDN endpoint. Default to " +
+							"use region configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to query " +
+							"CDN or DCDN usage and quota. Default to use access key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to query " +
+							"CDN or DCDN usage and quota. Default to use secret key configured in " +
+							"the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cdnQuotaUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.cdnClient = req.ProviderData.(alicloudClients).cdnClient
+	d.dcdnClient = req.ProviderData.(alicloudClients).dcdnClient
+}
+
+func (d *cdnQuotaUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *cdnQuotaUsageDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.cdnClient.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.cdnClient, err = alicloudCdnClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud CDN API Client",
+				"An unexpected error occurred when creating the AliCloud CDN API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud CDN Client Error: "+err.Error(),
+			)
+			return
+		}
+
+		dcdnClientConfig := *clientCredentialsConfig
+		dcdnClientConfig.Endpoint = tea.String("dcdn.aliyuncs.com")
+		d.dcdnClient, err = alicloudDcdnClient.NewClient(&dcdnClientConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud DCDN API Client",
+				"An unexpected error occurred when creating the AliCloud DCDN API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud DCDN Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	windowMinutes := int64(cdnUsageDefaultWindowMinutes)
+	if !plan.WindowMinutes.IsNull() {
+		windowMinutes = plan.WindowMinutes.ValueInt64()
+	}
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-time.Duration(windowMinutes) * time.Minute)
+
+	state := &cdnQuotaUsageDataSourceModel{
+		Product:       plan.Product,
+		DomainNames:   plan.DomainNames,
+		WindowMinutes: plan.WindowMinutes,
+	}
+
+	domainNames := strings.Join(convertListValueToStrings(plan.DomainNames), ",")
+	var bpsValues, qpsValues, requestValues []float64
+	var err error
+	if plan.Product.ValueString() == "dcdn" {
+		state.RefreshQuota, err = d.describeDcdnRefreshQuota()
+		if err == nil {
+			bpsValues, err = d.describeDcdnBpsData(domainNames, startTime, endTime)
+		}
+		if err == nil {
+			qpsValues, requestValues, err = d.describeDcdnQpsData(domainNames, startTime, endTime)
+		}
+	} else {
+		state.RefreshQuota, err = d.describeCdnRefreshQuota()
+		if err == nil {
+			bpsValues, err = d.describeCdnBpsData(domainNames, startTime, endTime)
+		}
+		if err == nil {
+			qpsValues, requestValues, err = d.describeCdnQpsData(domainNames, startTime, endTime)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Quota and Usage.",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Usage = &cdnQuotaUsageTraffic{
+		PeakBps:       types.Float64Value(0),
+		AverageBps:    types.Float64Value(0),
+		PeakQps:       types.Float64Value(0),
+		TotalRequests: types.Float64Value(0),
+	}
+	for _, bps := range bpsValues {
+		if bps > state.Usage.PeakBps.ValueFloat64() {
+			state.Usage.PeakBps = types.Float64Value(bps)
+		}
+		state.Usage.AverageBps = types.Float64Value(state.Usage.AverageBps.ValueFloat64() + bps)
+	}
+	if len(bpsValues) > 0 {
+		state.Usage.AverageBps = types.Float64Value(state.Usage.AverageBps.ValueFloat64() / float64(len(bpsValues)))
+	}
+	for _, qps := range qpsValues {
+		if qps > state.Usage.PeakQps.ValueFloat64() {
+			state.Usage.PeakQps = types.Float64Value(qps)
+		}
+	}
+	for _, requests := range requestValues {
+		state.Usage.TotalRequests = types.Float64Value(state.Usage.TotalRequests.ValueFloat64() + requests)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to read the refresh and prefetch quota of CDN.
+func (d *cdnQuotaUsageDataSource) describeCdnRefreshQuota() (*cdnQuotaUsageRefresh, error) {
+	var describeRefreshQuotaResponse *alicloudCdnClient.DescribeRefreshQuotaResponse
+	describeRefreshQuota := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		describeRefreshQuotaResponse, err = d.cdnClient.DescribeRefreshQuotaWithOptions(&alicloudCdnClient.DescribeRefreshQuotaRequest{}, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeRefreshQuota); err != nil {
+		return nil, err
+	}
+
+	body := describeRefreshQuotaResponse.Body
+	return &cdnQuotaUsageRefresh{
+		UrlQuota:      parseCdnInt64(body.UrlQuota),
+		UrlRemain:     parseCdnInt64(body.UrlRemain),
+		DirQuota:      parseCdnInt64(body.DirQuota),
+		DirRemain:     parseCdnInt64(body.DirRemain),
+		RegexQuota:    parseCdnInt64(body.RegexQuota),
+		RegexRemain:   parseCdnInt64(body.RegexRemain),
+		PreloadQuota:  parseCdnInt64(body.PreloadQuota),
+		PreloadRemain: parseCdnInt64(body.PreloadRemain),
+	}, nil
+}
+
+// Function to read the refresh and prefetch quota of DCDN.
+func (d *cdnQuotaUsageDataSource) describeDcdnRefreshQuota() (*cdnQuotaUsageRefresh, error) {
+	var describeDcdnRefreshQuotaResponse *alicloudDcdnClient.DescribeDcdnRefreshQuotaResponse
+	describeDcdnRefreshQuota := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		describeDcdnRefreshQuotaResponse, err = d.dcdnClient.DescribeDcdnRefreshQuotaWithOptions(&alicloudDcdnClient.DescribeDcdnRefreshQuotaRequest{}, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDcdnRefreshQuota); err != nil {
+		return nil, err
+	}
+
+	body := describeDcdnRefreshQuotaResponse.Body
+	return &cdnQuotaUsageRefresh{
+		UrlQuota:      parseCdnInt64(body.UrlQuota),
+		UrlRemain:     parseCdnInt64(body.UrlRemain),
+		DirQuota:      parseCdnInt64(body.DirQuota),
+		DirRemain:     parseCdnInt64(body.DirRemain),
+		RegexQuota:    parseCdnInt64(body.RegexQuota),
+		RegexRemain:   parseCdnInt64(body.RegexRemain),
+		PreloadQuota:  parseCdnInt64(body.PreloadQuota),
+		PreloadRemain: parseCdnInt64(body.PreloadRemain),
+	}, nil
+}
+
+// Function to read the bandwidth of every interval of the CDN domains.
+func (d *cdnQuotaUsageDataSource) describeCdnBpsData(domainNames string, startTime, endTime time.Time) ([]float64, error) {
+	var describeDomainBpsDataResponse *alicloudCdnClient.DescribeDomainBpsDataResponse
+	describeDomainBpsData := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDomainBpsDataRequest := &alicloudCdnClient.DescribeDomainBpsDataRequest{
+			StartTime: tea.String(startTime.Format(time.RFC3339)),
+			EndTime:   tea.String(endTime.Format(time.RFC3339)),
+			Interval:  tea.String(cdnUsageInterval),
+		}
+		if domainNames != "" {
+			describeDomainBpsDataRequest.DomainName = tea.String(domainNames)
+		}
+
+		var err error
+		describeDomainBpsDataResponse, err = d.cdnClient.DescribeDomainBpsDataWithOptions(describeDomainBpsDataRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDomainBpsData); err != nil {
+		return nil, err
+	}
+
+	bpsValues := []float64{}
+	if data := describeDomainBpsDataResponse.Body.BpsDataPerInterval; data != nil {
+		for _, dataModule := range data.DataModule {
+			bpsValues = append(bpsValues, parseCdnFloat64(dataModule.Value))
+		}
+	}
+	return bpsValues, nil
+}
+
+// Function to read the QPS and the number of requests of every interval of
+// the CDN domains.
+func (d *cdnQuotaUsageDataSource) describeCdnQpsData(domainNames string, startTime, endTime time.Time) ([]float64, []float64, error) {
+	var describeDomainQpsDataResponse *alicloudCdnClient.DescribeDomainQpsDataResponse
+	describeDomainQpsData := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDomainQpsDataRequest := &alicloudCdnClient.DescribeDomainQpsDataRequest{
+			StartTime: tea.String(startTime.Format(time.RFC3339)),
+			EndTime:   tea.String(endTime.Format(time.RFC3339)),
+			Interval:  tea.String(cdnUsageInterval),
+		}
+		if domainNames != "" {
+			describeDomainQpsDataRequest.DomainName = tea.String(domainNames)
+		}
+
+		var err error
+		describeDomainQpsDataResponse, err = d.cdnClient.DescribeDomainQpsDataWithOptions(describeDomainQpsDataRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDomainQpsData); err != nil {
+		return nil, nil, err
+	}
+
+	qpsValues, requestValues := []float64{}, []float64{}
+	if data := describeDomainQpsDataResponse.Body.QpsDataInterval; data != nil {
+		for _, dataModule := range data.DataModule {
+			qpsValues = append(qpsValues, parseCdnFloat64(dataModule.Value))
+			requestValues = append(requestValues, parseCdnFloat64(dataModule.AccValue))
+		}
+	}
+	return qpsValues, requestValues, nil
+}
+
+// Function to read the bandwidth of every interval of the DCDN domains.
+func (d *cdnQuotaUsageDataSource) describeDcdnBpsData(domainNames string, startTime, endTime time.Time) ([]float64, error) {
+	var describeDcdnDomainBpsDataResponse *alicloudDcdnClient.DescribeDcdnDomainBpsDataResponse
+	describeDcdnDomainBpsData := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDcdnDomainBpsDataRequest := &alicloudDcdnClient.DescribeDcdnDomainBpsDataRequest{
+			StartTime: tea.String(startTime.Format(time.RFC3339)),
+			EndTime:   tea.String(endTime.Format(time.RFC3339)),
+			Interval:  tea.String(cdnUsageInterval),
+		}
+		if domainNames != "" {
+			describeDcdnDomainBpsDataRequest.DomainName = tea.String(domainNames)
+		}
+
+		var err error
+		describeDcdnDomainBpsDataResponse, err = d.dcdnClient.DescribeDcdnDomainBpsDataWithOptions(describeDcdnDomainBpsDataRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDcdnDomainBpsData); err != nil {
+		return nil, err
+	}
+
+	bpsValues := []float64{}
+	if data := describeDcdnDomainBpsDataResponse.Body.BpsDataPerInterval; data != nil {
+		for _, dataModule := range data.DataModule {
+			bpsValues = append(bpsValues, float64(tea.Float32Value(dataModule.Bps)))
+		}
+	}
+	return bpsValues, nil
+}
+
+// Function to read the QPS and the number of requests of every interval of
+// the DCDN domains.
+func (d *cdnQuotaUsageDataSource) describeDcdnQpsData(domainNames string, startTime, endTime time.Time) ([]float64, []float64, error) {
+	var describeDcdnDomainQpsDataResponse *alicloudDcdnClient.DescribeDcdnDomainQpsDataResponse
+	describeDcdnDomainQpsData := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDcdnDomainQpsDataRequest := &alicloudDcdnClient.DescribeDcdnDomainQpsDataRequest{
+			StartTime: tea.String(startTime.Format(time.RFC3339)),
+			EndTime:   tea.String(endTime.Format(time.RFC3339)),
+			Interval:  tea.String(cdnUsageInterval),
+		}
+		if domainNames != "" {
+			describeDcdnDomainQpsDataRequest.DomainName = tea.String(domainNames)
+		}
+
+		var err error
+		describeDcdnDomainQpsDataResponse, err = d.dcdnClient.DescribeDcdnDomainQpsDataWithOptions(describeDcdnDomainQpsDataRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDcdnDomainQpsData); err != nil {
+		return nil, nil, err
+	}
+
+	qpsValues, requestValues := []float64{}, []float64{}
+	if data := describeDcdnDomainQpsDataResponse.Body.QpsDataPerInterval; data != nil {
+		for _, dataModule := range data.DataModule {
+			qpsValues = append(qpsValues, float64(tea.Float32Value(dataModule.Qps)))
+			requestValues = append(requestValues, float64(tea.Float32Value(dataModule.Requests)))
+		}
+	}
+	return qpsValues, requestValues, nil
+}
+
+// CDN API returns the numbers in string, an empty string is returned when
+// there is no data in the interval.
+func parseCdnInt64(value *string) types.Int64 {
+	number, _ := strconv.ParseInt(tea.StringValue(value), 10, 64)
+	return types.Int64Value(number)
+}
+
+func parseCdnFloat64(value *string) float64 {
+	number, _ := strconv.ParseFloat(tea.StringValue(value), 64)
+	return number
+}
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -10,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v4/client"
+	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v5/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
 )
@@ -154,9 +153,7 @@ func (d *csUserKubeconfigDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeUserKubeconfig, reconnectBackoff)
+	err = retryAPICall(describeUserKubeconfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Describe Container Service User Kubeconfig",
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -154,9 +153,7 @@ func (d *ddoscooDomainResourcesDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeWebRules, reconnectBackoff)
+	err = retryAPICall(describeWebRules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Describe Antiddos Web Rule.",
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"regexp"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -298,10 +297,7 @@ func (d *ddoscooInstancesDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-
-	err := backoff.Retry(readInstances, reconnectBackoff)
+	err := retryAPICall(readInstances)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to read Anti-DDoS Instances",
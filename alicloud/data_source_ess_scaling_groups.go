@@ -0,0 +1,393 @@
+package alicloud
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &essScalingGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &essScalingGroupsDataSource{}
+)
+
+func NewEssScalingGroupsDataSource() datasource.DataSource {
+	return &essScalingGroupsDataSource{}
+}
+
+type essScalingGroupsDataSource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScalingGroupsDataSourceModel struct {
+	ClientConfig  *clientConfig             `tfsdk:"client_config"`
+	NameRegex     types.String              `tfsdk:"name_regex"`
+	VpcId         types.String              `tfsdk:"vpc_id"`
+	Tags          types.Map                 `tfsdk:"tags"`
+	ScalingGroups []*essScalingGroupsDetail `tfsdk:"scaling_groups"`
+}
+
+type essScalingGroupsDetail struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	LifecycleState     types.String `tfsdk:"lifecycle_state"`
+	VpcId              types.String `tfsdk:"vpc_id"`
+	VSwitchIds         types.List   `tfsdk:"vswitch_ids"`
+	MinSize            types.Int64  `tfsdk:"min_size"`
+	MaxSize            types.Int64  `tfsdk:"max_size"`
+	DesiredCapacity    types.Int64  `tfsdk:"desired_capacity"`
+	TotalInstanceCount types.Int64  `tfsdk:"total_instance_count"`
+	ActiveCapacity     types.Int64  `tfsdk:"active_capacity"`
+	PendingCapacity    types.Int64  `tfsdk:"pending_capacity"`
+	RemovingCapacity   types.Int64  `tfsdk:"removing_capacity"`
+	StandbyCapacity    types.Int64  `tfsdk:"standby_capacity"`
+	ProtectedCapacity  types.Int64  `tfsdk:"protected_capacity"`
+	LoadBalancerIds    types.List   `tfsdk:"load_balancer_ids"`
+	VServerGroupIds    types.List   `tfsdk:"vserver_group_ids"`
+	AlbServerGroupIds  types.List   `tfsdk:"alb_server_group_ids"`
+	NlbServerGroupIds  types.List   `tfsdk:"nlb_server_group_ids"`
+	Tags               types.Map    `tfsdk:"tags"`
+}
+
+func (d *essScalingGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scaling_groups"
+}
+
+func (d *essScalingGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the auto scaling groups (ESS) in desired region, with the instance counts, capacity limits and the attached load balancers and server groups.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "A regex to filter the scaling groups by name.",
+				Optional:    true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the VPC of the scaling groups.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the scaling groups, only the scaling groups matching all the given tags are returned.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"scaling_groups": schema.ListNestedAttribute{
+				Description: "A list of scaling groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the scaling group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the scaling group.",
+							Computed:    true,
+						},
+						"lifecycle_state": schema.StringAttribute{
+							Description: "The state of the scaling group, e.g. Active, Inactive.",
+							Computed:    true,
+						},
+						"vpc_id": schema.StringAttribute{
+							Description: "The ID of the VPC of the scaling group.",
+							Computed:    true,
+						},
+						"vswitch_ids": schema.ListAttribute{
+							Description: "The IDs of the VSwitches of the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"min_size": schema.Int64Attribute{
+							Description: "The minimum number of instances of the scaling group.",
+							Computed:    true,
+						},
+						"max_size": schema.Int64Attribute{
+							Description: "The maximum number of instances of the scaling group.",
+							Computed:    true,
+						},
+						"desired_capacity": schema.Int64Attribute{
+							Description: "The desired number of instances of the scaling group, null if not enabled.",
+							Computed:    true,
+						},
+						"total_instance_count": schema.Int64Attribute{
+							Description: "The total number of instances in the scaling group.",
+							Computed:    true,
+						},
+						"active_capacity": schema.Int64Attribute{
+							Description: "The number of instances in service.",
+							Computed:    true,
+						},
+						"pending_capacity": schema.Int64Attribute{
+							Description: "The number of instances being added into the scaling group.",
+							Computed:    true,
+						},
+						"removing_capacity": schema.Int64Attribute{
+							Description: "The number of instances being removed from the scaling group.",
+							Computed:    true,
+						},
+						"standby_capacity": schema.Int64Attribute{
+							Description: "The number of instances in standby.",
+							Computed:    true,
+						},
+						"protected_capacity": schema.Int64Attribute{
+							Description: "The number of instances protected from scale in.",
+							Computed:    true,
+						},
+						"load_balancer_ids": schema.ListAttribute{
+							Description: "The IDs of the load balancers (CLB) attached with the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"vserver_group_ids": schema.ListAttribute{
+							Description: "The IDs of the CLB vServer groups attached with the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"alb_server_group_ids": schema.ListAttribute{
+							Description: "The IDs of the ALB server groups attached with the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"nlb_server_group_ids": schema.ListAttribute{
+							Description: "The IDs of the NLB server groups attached with the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "The tags of the scaling group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the scaling groups. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"scaling groups. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"scaling groups. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *essScalingGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).essClient
+}
+
+func (d *essScalingGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *essScalingGroupsDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudEssClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud ESS API Client",
+				"An unexpected error occurred when creating the AliCloud ESS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud ESS Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if !plan.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(plan.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &essScalingGroupsDataSourceModel{
+		NameRegex:     plan.NameRegex,
+		VpcId:         plan.VpcId,
+		Tags:          plan.Tags,
+		ScalingGroups: []*essScalingGroupsDetail{},
+	}
+
+	inputTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		convertTagsDiags := plan.Tags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	pageNumber := int32(1)
+	pageSize := int32(50)
+	for {
+		var describeScalingGroupsResponse *alicloudEssClient.DescribeScalingGroupsResponse
+		describeScalingGroups := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeScalingGroupsRequest := &alicloudEssClient.DescribeScalingGroupsRequest{
+				RegionId:   d.client.RegionId,
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+			for key, value := range inputTags {
+				describeScalingGroupsRequest.Tags = append(describeScalingGroupsRequest.Tags, &alicloudEssClient.DescribeScalingGroupsRequestTags{
+					Key:   tea.String(key),
+					Value: tea.String(value),
+				})
+			}
+
+			var err error
+			describeScalingGroupsResponse, err = d.client.DescribeScalingGroupsWithOptions(describeScalingGroupsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeScalingGroups); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Scaling Groups.",
+				err.Error(),
+			)
+			return
+		}
+
+	scalingGroupLoop:
+		for _, scalingGroup := range describeScalingGroupsResponse.Body.ScalingGroups {
+			if nameRegex != nil && !nameRegex.MatchString(tea.StringValue(scalingGroup.ScalingGroupName)) {
+				continue
+			}
+			if !plan.VpcId.IsNull() && tea.StringValue(scalingGroup.VpcId) != plan.VpcId.ValueString() {
+				continue
+			}
+
+			tags := make(map[string]attr.Value)
+			scalingGroupTags := make(map[string]string)
+			for _, tag := range scalingGroup.Tags {
+				tags[tea.StringValue(tag.TagKey)] = types.StringValue(tea.StringValue(tag.TagValue))
+				scalingGroupTags[tea.StringValue(tag.TagKey)] = tea.StringValue(tag.TagValue)
+			}
+
+			// Match all the given tags, as the scaling groups matching any
+			// of the tags may be returned by AliCloud API.
+			for inputTagKey, inputTagValue := range inputTags {
+				if value, ok := scalingGroupTags[inputTagKey]; !ok || value != inputTagValue {
+					continue scalingGroupLoop
+				}
+			}
+
+			var vServerGroupIds []*string
+			for _, vServerGroup := range scalingGroup.VServerGroups {
+				for _, attribute := range vServerGroup.VServerGroupAttributes {
+					vServerGroupIds = append(vServerGroupIds, attribute.VServerGroupId)
+				}
+			}
+
+			// The ALB server groups attached with the generic
+			// AttachServerGroups API are only returned in ServerGroups.
+			var albServerGroupIds, nlbServerGroupIds []*string
+			albServerGroupIdsSeen := make(map[string]bool)
+			for _, albServerGroup := range scalingGroup.AlbServerGroups {
+				albServerGroupIds = append(albServerGroupIds, albServerGroup.AlbServerGroupId)
+				albServerGroupIdsSeen[tea.StringValue(albServerGroup.AlbServerGroupId)] = true
+			}
+			for _, serverGroup := range scalingGroup.ServerGroups {
+				switch tea.StringValue(serverGroup.Type) {
+				case "ALB":
+					if !albServerGroupIdsSeen[tea.StringValue(serverGroup.ServerGroupId)] {
+						albServerGroupIds = append(albServerGroupIds, serverGroup.ServerGroupId)
+						albServerGroupIdsSeen[tea.StringValue(serverGroup.ServerGroupId)] = true
+					}
+				case essNlbServerGroupType:
+					nlbServerGroupIds = append(nlbServerGroupIds, serverGroup.ServerGroupId)
+				}
+			}
+
+			desiredCapacity := types.Int64Null()
+			if tea.BoolValue(scalingGroup.EnableDesiredCapacity) {
+				desiredCapacity = types.Int64Value(int64(tea.Int32Value(scalingGroup.DesiredCapacity)))
+			}
+
+			state.ScalingGroups = append(state.ScalingGroups, &essScalingGroupsDetail{
+				Id:                 types.StringValue(tea.StringValue(scalingGroup.ScalingGroupId)),
+				Name:               types.StringValue(tea.StringValue(scalingGroup.ScalingGroupName)),
+				LifecycleState:     types.StringValue(tea.StringValue(scalingGroup.LifecycleState)),
+				VpcId:              types.StringValue(tea.StringValue(scalingGroup.VpcId)),
+				VSwitchIds:         types.ListValueMust(types.StringType, convertStringPointersToAttrValues(scalingGroup.VSwitchIds)),
+				MinSize:            types.Int64Value(int64(tea.Int32Value(scalingGroup.MinSize))),
+				MaxSize:            types.Int64Value(int64(tea.Int32Value(scalingGroup.MaxSize))),
+				DesiredCapacity:    desiredCapacity,
+				TotalInstanceCount: types.Int64Value(int64(tea.Int32Value(scalingGroup.TotalInstanceCount))),
+				ActiveCapacity:     types.Int64Value(int64(tea.Int32Value(scalingGroup.ActiveCapacity))),
+				PendingCapacity:    types.Int64Value(int64(tea.Int32Value(scalingGroup.PendingCapacity))),
+				RemovingCapacity:   types.Int64Value(int64(tea.Int32Value(scalingGroup.RemovingCapacity))),
+				StandbyCapacity:    types.Int64Value(int64(tea.Int32Value(scalingGroup.StandbyCapacity))),
+				ProtectedCapacity:  types.Int64Value(int64(tea.Int32Value(scalingGroup.ProtectedCapacity))),
+				LoadBalancerIds:    types.ListValueMust(types.StringType, convertStringPointersToAttrValues(scalingGroup.LoadBalancerIds)),
+				VServerGroupIds:    types.ListValueMust(types.StringType, convertStringPointersToAttrValues(vServerGroupIds)),
+				AlbServerGroupIds:  types.ListValueMust(types.StringType, convertStringPointersToAttrValues(albServerGroupIds)),
+				NlbServerGroupIds:  types.ListValueMust(types.StringType, convertStringPointersToAttrValues(nlbServerGroupIds)),
+				Tags:               types.MapValueMust(types.StringType, tags),
+			})
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeScalingGroupsResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
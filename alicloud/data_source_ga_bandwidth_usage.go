@@ -0,0 +1,425 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The CMS namespace of Global Accelerator.
+	gaCmsNamespace = "acs_global_acceleration"
+
+	gaDefaultInBandwidthMetric  = "InboundBandwidth"
+	gaDefaultOutBandwidthMetric = "OutboundBandwidth"
+	gaDefaultWindowMinutes      = 5
+	gaDefaultPeriod             = 60
+)
+
+var (
+	_ datasource.DataSource              = &gaBandwidthUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &gaBandwidthUsageDataSource{}
+)
+
+func NewGaBandwidthUsageDataSource() datasource.DataSource {
+	return &gaBandwidthUsageDataSource{}
+}
+
+type gaBandwidthUsageDataSource struct {
+	client *alicloudCmsClient.Client
+}
+
+type gaBandwidthUsageDataSourceModel struct {
+	ClientConfig       *clientConfig             `tfsdk:"client_config"`
+	AcceleratorId      types.String              `tfsdk:"accelerator_id"`
+	ListenerIds        types.List                `tfsdk:"listener_ids"`
+	EndpointGroupIds   types.List                `tfsdk:"endpoint_group_ids"`
+	WindowMinutes      types.Int64               `tfsdk:"window_minutes"`
+	Period             types.Int64               `tfsdk:"period"`
+	InBandwidthMetric  types.String              `tfsdk:"in_bandwidth_metric"`
+	OutBandwidthMetric types.String              `tfsdk:"out_bandwidth_metric"`
+	Listeners          []*gaBandwidthUsageDetail `tfsdk:"listeners"`
+	EndpointGroups     []*gaBandwidthUsageDetail `tfsdk:"endpoint_groups"`
+}
+
+type gaBandwidthUsageDetail struct {
+	Id                  types.String  `tfsdk:"id"`
+	InBandwidthAverage  types.Float64 `tfsdk:"in_bandwidth_average"`
+	InBandwidthMaximum  types.Float64 `tfsdk:"in_bandwidth_maximum"`
+	OutBandwidthAverage types.Float64 `tfsdk:"out_bandwidth_average"`
+	OutBandwidthMaximum types.Float64 `tfsdk:"out_bandwidth_maximum"`
+	Datapoints          types.Int64   `tfsdk:"datapoints"`
+}
+
+// The aggregated datapoints of a metric of a listener or endpoint group.
+type gaBandwidthStatistics struct {
+	sum        float64
+	maximum    float64
+	datapoints int64
+}
+
+func (d *gaBandwidthUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ga_bandwidth_usage"
+}
+
+func (d *gaBandwidthUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	usageAttributes := func(idDescription string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: idDescription,
+				Computed:    true,
+			},
+			"in_bandwidth_average": schema.Float64Attribute{
+				Description: "The average inbound bandwidth in bit/s over the window.",
+				Computed:    true,
+			},
+			"in_bandwidth_maximum": schema.Float64Attribute{
+				Description: "The maximum inbound bandwidth in bit/s over the window.",
+				Computed:    true,
+			},
+			"out_bandwidth_average": schema.Float64Attribute{
+				Description: "The average outbound bandwidth in bit/s over the window.",
+				Computed:    true,
+			},
+			"out_bandwidth_maximum": schema.Float64Attribute{
+				Description: "The maximum outbound bandwidth in bit/s over the window.",
+				Computed:    true,
+			},
+			"datapoints": schema.Int64Attribute{
+				Description: "The number of datapoints returned by CMS over the window, 0 means there is " +
+					"no traffic data in the window.",
+				Computed: true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the recent bandwidth usage of the listeners and endpoint groups " +
+			"of a Global Accelerator (GA) instance from the metrics of Cloud Monitor Service (CMS).",
+		Attributes: map[string]schema.Attribute{
+			"accelerator_id": schema.StringAttribute{
+				Description: "The ID of the GA instance.",
+				Required:    true,
+			},
+			"listener_ids": schema.ListAttribute{
+				Description: "The IDs of the listeners to query.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("endpoint_group_ids")),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"endpoint_group_ids": schema.ListAttribute{
+				Description: "The IDs of the endpoint groups to query.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"window_minutes": schema.Int64Attribute{
+				Description: fmt.Sprintf("The recent window in minutes to query. Default to %d.", gaDefaultWindowMinutes),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+			},
+			"period": schema.Int64Attribute{
+				Description: fmt.Sprintf("The aggregation period of the metrics in seconds. Default to %d.", gaDefaultPeriod),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"in_bandwidth_metric": schema.StringAttribute{
+				Description: fmt.Sprintf("The CMS metric of the inbound bandwidth in the %s namespace. Default to %s.",
+					gaCmsNamespace, gaDefaultInBandwidthMetric),
+				Optional: true,
+			},
+			"out_bandwidth_metric": schema.StringAttribute{
+				Description: fmt.Sprintf("The CMS metric of the outbound bandwidth in the %s namespace. Default to %s.",
+					gaCmsNamespace, gaDefaultOutBandwidthMetric),
+				Optional: true,
+			},
+			"listeners": schema.ListNestedAttribute{
+				Description: "The bandwidth usage of the listeners, in the same order as listener_ids.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: usageAttributes("The ID of the listener."),
+				},
+			},
+			"endpoint_groups": schema.ListNestedAttribute{
+				Description: "The bandwidth usage of the endpoint groups, in the same order as endpoint_group_ids.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: usageAttributes("The ID of the endpoint group."),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the CMS endpoint. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to query " +
+							"CMS metrics. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to query " +
+							"CMS metrics. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *gaBandwidthUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).cmsClient
+}
+
+func (d *gaBandwidthUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *gaBandwidthUsageDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		clientCredentialsConfig.Endpoint = tea.String(fmt.Sprintf("metrics.%s.aliyuncs.com", tea.StringValue(clientCredentialsConfig.RegionId)))
+		d.client, err = alicloudCmsClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud CMS API Client",
+				"An unexpected error occurred when creating the AliCloud CMS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud CMS Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	windowMinutes := int64(gaDefaultWindowMinutes)
+	if !plan.WindowMinutes.IsNull() {
+		windowMinutes = plan.WindowMinutes.ValueInt64()
+	}
+	period := int64(gaDefaultPeriod)
+	if !plan.Period.IsNull() {
+		period = plan.Period.ValueInt64()
+	}
+	inBandwidthMetric := gaDefaultInBandwidthMetric
+	if !plan.InBandwidthMetric.IsNull() {
+		inBandwidthMetric = plan.InBandwidthMetric.ValueString()
+	}
+	outBandwidthMetric := gaDefaultOutBandwidthMetric
+	if !plan.OutBandwidthMetric.IsNull() {
+		outBandwidthMetric = plan.OutBandwidthMetric.ValueString()
+	}
+
+	endTime := time.Now()
+	startTime := endTime.Add(-time.Duration(windowMinutes) * time.Minute)
+
+	state := &gaBandwidthUsageDataSourceModel{
+		AcceleratorId:      plan.AcceleratorId,
+		ListenerIds:        plan.ListenerIds,
+		EndpointGroupIds:   plan.EndpointGroupIds,
+		WindowMinutes:      plan.WindowMinutes,
+		Period:             plan.Period,
+		InBandwidthMetric:  plan.InBandwidthMetric,
+		OutBandwidthMetric: plan.OutBandwidthMetric,
+		Listeners:          []*gaBandwidthUsageDetail{},
+		EndpointGroups:     []*gaBandwidthUsageDetail{},
+	}
+
+	dimensionKeys := []struct {
+		key     string
+		ids     []string
+		details *[]*gaBandwidthUsageDetail
+	}{
+		{"listenerId", convertListValueToStrings(plan.ListenerIds), &state.Listeners},
+		{"endpointGroupId", convertListValueToStrings(plan.EndpointGroupIds), &state.EndpointGroups},
+	}
+
+	for _, dimensionKey := range dimensionKeys {
+		if len(dimensionKey.ids) == 0 {
+			continue
+		}
+
+		var dimensions []map[string]string
+		for _, id := range dimensionKey.ids {
+			dimensions = append(dimensions, map[string]string{
+				"instanceId":     plan.AcceleratorId.ValueString(),
+				dimensionKey.key: id,
+			})
+		}
+
+		inStatistics, err := d.describeMetricStatistics(inBandwidthMetric, dimensionKey.key, dimensions, period, startTime, endTime)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Metric List.",
+				err.Error(),
+			)
+			return
+		}
+		outStatistics, err := d.describeMetricStatistics(outBandwidthMetric, dimensionKey.key, dimensions, period, startTime, endTime)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Metric List.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, id := range dimensionKey.ids {
+			in, out := inStatistics[id], outStatistics[id]
+			detail := &gaBandwidthUsageDetail{
+				Id:                  types.StringValue(id),
+				InBandwidthAverage:  types.Float64Value(0),
+				InBandwidthMaximum:  types.Float64Value(0),
+				OutBandwidthAverage: types.Float64Value(0),
+				OutBandwidthMaximum: types.Float64Value(0),
+				Datapoints:          types.Int64Value(0),
+			}
+			if in != nil {
+				detail.InBandwidthAverage = types.Float64Value(in.sum / float64(in.datapoints))
+				detail.InBandwidthMaximum = types.Float64Value(in.maximum)
+				detail.Datapoints = types.Int64Value(in.datapoints)
+			}
+			if out != nil {
+				detail.OutBandwidthAverage = types.Float64Value(out.sum / float64(out.datapoints))
+				detail.OutBandwidthMaximum = types.Float64Value(out.maximum)
+				if out.datapoints > detail.Datapoints.ValueInt64() {
+					detail.Datapoints = types.Int64Value(out.datapoints)
+				}
+			}
+			*dimensionKey.details = append(*dimensionKey.details, detail)
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to query the datapoints of a metric and aggregate them by the
+// value of the dimension key, i.e. the listener or endpoint group ID.
+func (d *gaBandwidthUsageDataSource) describeMetricStatistics(metricName, dimensionKey string, dimensions []map[string]string, period int64, startTime, endTime time.Time) (map[string]*gaBandwidthStatistics, error) {
+	dimensionsJson, err := json.Marshal(dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	statistics := make(map[string]*gaBandwidthStatistics)
+	var nextToken *string
+	for {
+		var describeMetricListResponse *alicloudCmsClient.DescribeMetricListResponse
+		describeMetricList := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeMetricListRequest := &alicloudCmsClient.DescribeMetricListRequest{
+				Namespace:  tea.String(gaCmsNamespace),
+				MetricName: tea.String(metricName),
+				Dimensions: tea.String(string(dimensionsJson)),
+				Period:     tea.String(strconv.FormatInt(period, 10)),
+				StartTime:  tea.String(strconv.FormatInt(startTime.UnixMilli(), 10)),
+				EndTime:    tea.String(strconv.FormatInt(endTime.UnixMilli(), 10)),
+				Length:     tea.String("1000"),
+				NextToken:  nextToken,
+			}
+
+			var err error
+			describeMetricListResponse, err = d.client.DescribeMetricListWithOptions(describeMetricListRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			if !tea.BoolValue(describeMetricListResponse.Body.Success) {
+				return backoff.Permanent(fmt.Errorf("%s: %s",
+					tea.StringValue(describeMetricListResponse.Body.Code),
+					tea.StringValue(describeMetricListResponse.Body.Message)))
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeMetricList); err != nil {
+			return nil, err
+		}
+
+		var datapoints []map[string]interface{}
+		if body := tea.StringValue(describeMetricListResponse.Body.Datapoints); body != "" {
+			if err := json.Unmarshal([]byte(body), &datapoints); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, datapoint := range datapoints {
+			id, _ := datapoint[dimensionKey].(string)
+			average, hasAverage := datapoint["Average"].(float64)
+			maximum, hasMaximum := datapoint["Maximum"].(float64)
+			if id == "" || (!hasAverage && !hasMaximum) {
+				continue
+			}
+			if !hasAverage {
+				average = maximum
+			}
+			if !hasMaximum {
+				maximum = average
+			}
+
+			if _, ok := statistics[id]; !ok {
+				statistics[id] = &gaBandwidthStatistics{}
+			}
+			statistics[id].sum += average
+			statistics[id].datapoints++
+			if maximum > statistics[id].maximum {
+				statistics[id].maximum = maximum
+			}
+		}
+
+		nextToken = describeMetricListResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return statistics, nil
+}
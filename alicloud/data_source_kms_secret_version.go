@@ -0,0 +1,208 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &kmsSecretVersionDataSource{}
+	_ datasource.DataSourceWithConfigure = &kmsSecretVersionDataSource{}
+)
+
+func NewKmsSecretVersionDataSource() datasource.DataSource {
+	return &kmsSecretVersionDataSource{}
+}
+
+type kmsSecretVersionDataSource struct {
+	client *alicloudKmsClient.Client
+}
+
+type kmsSecretVersionDataSourceModel struct {
+	ClientConfig   *clientConfig `tfsdk:"client_config"`
+	SecretName     types.String  `tfsdk:"secret_name"`
+	VersionId      types.String  `tfsdk:"version_id"`
+	VersionStage   types.String  `tfsdk:"version_stage"`
+	SecretData     types.String  `tfsdk:"secret_data"`
+	SecretDataType types.String  `tfsdk:"secret_data_type"`
+	SecretType     types.String  `tfsdk:"secret_type"`
+	VersionStages  types.List    `tfsdk:"version_stages"`
+	CreateTime     types.String  `tfsdk:"create_time"`
+}
+
+func (d *kmsSecretVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_secret_version"
+}
+
+func (d *kmsSecretVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source reads the value of a version of a KMS secret, so that the credentials can be " +
+			"injected into the other resources without hardcoding them. The version with the ACSCurrent stage is " +
+			"read if neither version_id nor version_stage is specified.",
+		Attributes: map[string]schema.Attribute{
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the secret.",
+				Required:    true,
+			},
+			"version_id": schema.StringAttribute{
+				Description: "The ID of the version to read.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_stage")),
+				},
+			},
+			"version_stage": schema.StringAttribute{
+				Description: "The stage of the version to read, e.g. `ACSCurrent`, `ACSPrevious` or a custom stage.",
+				Optional:    true,
+			},
+			"secret_data": schema.StringAttribute{
+				Description: "The value of the secret version.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secret_data_type": schema.StringAttribute{
+				Description: "The type of the secret value, `text` or `binary`.",
+				Computed:    true,
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret, e.g. Generic, Rds, RAMCredentials or ECS.",
+				Computed:    true,
+			},
+			"version_stages": schema.ListAttribute{
+				Description: "The stages attached to the secret version.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "The time when the secret version was created.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the secret. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to get the secret " +
+							"value. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to get the secret " +
+							"value. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *kmsSecretVersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).kmsClient
+}
+
+func (d *kmsSecretVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *kmsSecretVersionDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudKmsClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud KMS API Client",
+				"An unexpected error occurred when creating the AliCloud KMS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud KMS Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var getSecretValueResponse *alicloudKmsClient.GetSecretValueResponse
+	getSecretValue := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getSecretValueRequest := &alicloudKmsClient.GetSecretValueRequest{
+			SecretName: tea.String(plan.SecretName.ValueString()),
+		}
+		if !plan.VersionId.IsNull() {
+			getSecretValueRequest.VersionId = tea.String(plan.VersionId.ValueString())
+		}
+		if !plan.VersionStage.IsNull() {
+			getSecretValueRequest.VersionStage = tea.String(plan.VersionStage.ValueString())
+		}
+
+		var err error
+		getSecretValueResponse, err = d.client.GetSecretValueWithOptions(getSecretValueRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getSecretValue); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Secret Value.",
+			err.Error(),
+		)
+		return
+	}
+
+	value := getSecretValueResponse.Body
+	versionStages := []*string{}
+	if value.VersionStages != nil {
+		versionStages = value.VersionStages.VersionStage
+	}
+	state := &kmsSecretVersionDataSourceModel{
+		SecretName:     plan.SecretName,
+		VersionId:      types.StringValue(tea.StringValue(value.VersionId)),
+		VersionStage:   plan.VersionStage,
+		SecretData:     types.StringValue(tea.StringValue(value.SecretData)),
+		SecretDataType: types.StringValue(tea.StringValue(value.SecretDataType)),
+		SecretType:     types.StringValue(tea.StringValue(value.SecretType)),
+		VersionStages:  types.ListValueMust(types.StringType, convertStringPointersToAttrValues(versionStages)),
+		CreateTime:     types.StringValue(tea.StringValue(value.CreateTime)),
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,255 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+var (
+	_ datasource.DataSource              = &ossBucketObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ossBucketObjectsDataSource{}
+)
+
+func NewOssBucketObjectsDataSource() datasource.DataSource {
+	return &ossBucketObjectsDataSource{}
+}
+
+type ossBucketObjectsDataSource struct {
+	client *alicloudOssClient.Client
+}
+
+type ossBucketObjectsDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	Bucket       types.String            `tfsdk:"bucket"`
+	Prefix       types.String            `tfsdk:"prefix"`
+	Suffix       types.String            `tfsdk:"suffix"`
+	MaxObjects   types.Int64             `tfsdk:"max_objects"`
+	Objects      []*ossBucketObjectModel `tfsdk:"objects"`
+}
+
+type ossBucketObjectModel struct {
+	Key          types.String `tfsdk:"key"`
+	Size         types.Int64  `tfsdk:"size"`
+	Etag         types.String `tfsdk:"etag"`
+	LastModified types.String `tfsdk:"last_modified"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+func (d *ossBucketObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oss_bucket_objects"
+}
+
+func (d *ossBucketObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the objects in an OSS bucket filtered by the prefix and the " +
+			"suffix of the keys, so that the dependent resources, e.g. the code revisions of the FC " +
+			"functions, can react to the changes of the objects by the ETags.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the OSS bucket.",
+				Required:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "The prefix of the object keys, e.g. releases/. Default to all the objects.",
+				Optional:    true,
+			},
+			"suffix": schema.StringAttribute{
+				Description: "The suffix of the object keys, e.g. .zip. Default to all the objects.",
+				Optional:    true,
+			},
+			"max_objects": schema.Int64Attribute{
+				Description: "The maximum number of the objects to return. Default to all the matched objects.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"objects": schema.ListNestedAttribute{
+				Description: "A list of the objects sorted by the keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The key of the object.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the object in bytes.",
+							Computed:    true,
+						},
+						"etag": schema.StringAttribute{
+							Description: "The ETag of the object, which is changed when the content is changed.",
+							Computed:    true,
+						},
+						"last_modified": schema.StringAttribute{
+							Description: "The time when the object was last modified in RFC3339 format.",
+							Computed:    true,
+						},
+						"storage_class": schema.StringAttribute{
+							Description: "The storage class of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the OSS bucket. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list the objects " +
+							"of the bucket. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list the objects " +
+							"of the bucket. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ossBucketObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).ossClient
+}
+
+func (d *ossBucketObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ossBucketObjectsDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	// The OSS client is not an OpenAPI client, it is reinitialized with the
+	// endpoint of the region and the keys of the provider by default.
+	region := plan.ClientConfig.Region.ValueString()
+	accessKey := plan.ClientConfig.AccessKey.ValueString()
+	secretKey := plan.ClientConfig.SecretKey.ValueString()
+	if region != "" || accessKey != "" || secretKey != "" {
+		endpoint := d.client.Config.Endpoint
+		if region != "" {
+			endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", region)
+		}
+		if accessKey == "" {
+			accessKey = d.client.Config.AccessKeyID
+		}
+		if secretKey == "" {
+			secretKey = d.client.Config.AccessKeySecret
+		}
+
+		var err error
+		d.client, err = alicloudOssClient.New(endpoint, accessKey, secretKey)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud OSS API Client",
+				"An unexpected error occurred when creating the AliCloud OSS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud OSS Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	bucket, err := d.client.Bucket(plan.Bucket.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to Get Bucket.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &ossBucketObjectsDataSourceModel{
+		Bucket:     plan.Bucket,
+		Prefix:     plan.Prefix,
+		Suffix:     plan.Suffix,
+		MaxObjects: plan.MaxObjects,
+		Objects:    []*ossBucketObjectModel{},
+	}
+
+	continuationToken := ""
+	for {
+		var listObjectsV2Result alicloudOssClient.ListObjectsResultV2
+		listObjectsV2 := func() error {
+			options := []alicloudOssClient.Option{
+				alicloudOssClient.Prefix(plan.Prefix.ValueString()),
+				alicloudOssClient.MaxKeys(1000),
+			}
+			if continuationToken != "" {
+				options = append(options, alicloudOssClient.ContinuationToken(continuationToken))
+			}
+
+			var err error
+			listObjectsV2Result, err = bucket.ListObjectsV2(options...)
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listObjectsV2); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Objects.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, object := range listObjectsV2Result.Objects {
+			if !strings.HasSuffix(object.Key, plan.Suffix.ValueString()) {
+				continue
+			}
+			state.Objects = append(state.Objects, &ossBucketObjectModel{
+				Key:          types.StringValue(object.Key),
+				Size:         types.Int64Value(object.Size),
+				Etag:         types.StringValue(strings.Trim(object.ETag, "\"")),
+				LastModified: types.StringValue(object.LastModified.UTC().Format(time.RFC3339)),
+				StorageClass: types.StringValue(object.StorageClass),
+			})
+			if !plan.MaxObjects.IsNull() && int64(len(state.Objects)) >= plan.MaxObjects.ValueInt64() {
+				break
+			}
+		}
+
+		if !listObjectsV2Result.IsTruncated ||
+			(!plan.MaxObjects.IsNull() && int64(len(state.Objects)) >= plan.MaxObjects.ValueInt64()) {
+			break
+		}
+		continuationToken = listObjectsV2Result.NextContinuationToken
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,177 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &ramConditionalPolicyDocumentDataSource{}
+)
+
+func NewRamConditionalPolicyDocumentDataSource() datasource.DataSource {
+	return &ramConditionalPolicyDocumentDataSource{}
+}
+
+type ramConditionalPolicyDocumentDataSource struct{}
+
+type ramConditionalPolicyDocumentDataSourceModel struct {
+	SourceIpAllowlist         types.List   `tfsdk:"source_ip_allowlist"`
+	SourceIpRestrictedActions types.List   `tfsdk:"source_ip_restricted_actions"`
+	RequireMfa                types.Bool   `tfsdk:"require_mfa"`
+	MfaRequiredActions        types.List   `tfsdk:"mfa_required_actions"`
+	Statements                types.String `tfsdk:"statements"`
+	PolicyDocument            types.String `tfsdk:"policy_document"`
+}
+
+// The statement of RAM policy, the fields are ordered as the policies
+// generated by AliCloud console.
+type ramPolicyStatement struct {
+	Effect    string                            `json:"Effect"`
+	Action    []string                          `json:"Action"`
+	Resource  []string                          `json:"Resource"`
+	Condition map[string]map[string]interface{} `json:"Condition"`
+}
+
+type ramPolicyDocument struct {
+	Version   string                `json:"Version"`
+	Statement []*ramPolicyStatement `json:"Statement"`
+}
+
+func (d *ramConditionalPolicyDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ram_conditional_policy_document"
+}
+
+func (d *ramConditionalPolicyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source generates the standard guard-rail statements of RAM policy, which deny " +
+			"the requests from the IP addresses out of the allowlist, or the requests without MFA. The policy " +
+			"document can be used to create a custom policy to be attached by st-alicloud_ram_policy.",
+		Attributes: map[string]schema.Attribute{
+			"source_ip_allowlist": schema.ListAttribute{
+				Description: "The IP addresses or CIDR blocks which are allowed to send the requests, the " +
+					"requests from the other IP addresses are denied.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_ip_restricted_actions": schema.ListAttribute{
+				Description: "The actions restricted by source_ip_allowlist. Default to all actions.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"require_mfa": schema.BoolAttribute{
+				Description: "Whether to deny the requests without MFA. Note that the requests signed by the " +
+					"access keys are never authenticated with MFA. Default to false.",
+				Optional: true,
+				Computed: true,
+			},
+			"mfa_required_actions": schema.ListAttribute{
+				Description: "The actions restricted by require_mfa. Default to all actions.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"statements": schema.StringAttribute{
+				Description: "The JSON array of the generated statements, which can be merged into another policy document.",
+				Computed:    true,
+			},
+			"policy_document": schema.StringAttribute{
+				Description: "The policy document in JSON which contains the generated statements.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ramConditionalPolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ramConditionalPolicyDocumentDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allActions := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*")})
+	state := &ramConditionalPolicyDocumentDataSourceModel{
+		SourceIpAllowlist:         plan.SourceIpAllowlist,
+		SourceIpRestrictedActions: plan.SourceIpRestrictedActions,
+		RequireMfa:                plan.RequireMfa,
+		MfaRequiredActions:        plan.MfaRequiredActions,
+	}
+	if state.SourceIpRestrictedActions.IsNull() {
+		state.SourceIpRestrictedActions = allActions
+	}
+	if state.RequireMfa.IsNull() {
+		state.RequireMfa = types.BoolValue(false)
+	}
+	if state.MfaRequiredActions.IsNull() {
+		state.MfaRequiredActions = allActions
+	}
+
+	statements := []*ramPolicyStatement{}
+	if sourceIps := convertListValueToStrings(state.SourceIpAllowlist); len(sourceIps) > 0 {
+		statements = append(statements, &ramPolicyStatement{
+			Effect:   "Deny",
+			Action:   convertListValueToStrings(state.SourceIpRestrictedActions),
+			Resource: []string{"*"},
+			Condition: map[string]map[string]interface{}{
+				"NotIpAddress": {
+					"acs:SourceIp": sourceIps,
+				},
+			},
+		})
+	}
+	if state.RequireMfa.ValueBool() {
+		statements = append(statements, &ramPolicyStatement{
+			Effect:   "Deny",
+			Action:   convertListValueToStrings(state.MfaRequiredActions),
+			Resource: []string{"*"},
+			Condition: map[string]map[string]interface{}{
+				"Bool": {
+					"acs:MFAPresent": "false",
+				},
+			},
+		})
+	}
+	if len(statements) == 0 {
+		resp.Diagnostics.AddError(
+			"[ERROR] No Guard Rail is Configured.",
+			"At least one of source_ip_allowlist or require_mfa must be configured to generate the policy statements.",
+		)
+		return
+	}
+
+	statementsJson, err := json.Marshal(statements)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to Marshal Policy Statements.",
+			err.Error(),
+		)
+		return
+	}
+	policyDocumentJson, err := json.Marshal(&ramPolicyDocument{
+		Version:   "1",
+		Statement: statements,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to Marshal Policy Document.",
+			err.Error(),
+		)
+		return
+	}
+	state.Statements = types.StringValue(string(statementsJson))
+	state.PolicyDocument = types.StringValue(string(policyDocumentJson))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
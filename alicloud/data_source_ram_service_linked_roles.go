@@ -0,0 +1,215 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudRamClient "github.com/alibabacloud-go/ram-20150501/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &ramServiceLinkedRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ramServiceLinkedRolesDataSource{}
+)
+
+func NewRamServiceLinkedRolesDataSource() datasource.DataSource {
+	return &ramServiceLinkedRolesDataSource{}
+}
+
+type ramServiceLinkedRolesDataSource struct {
+	client *alicloudRamClient.Client
+}
+
+type ramServiceLinkedRolesDataSourceModel struct {
+	ClientConfig     *clientConfig           `tfsdk:"client_config"`
+	RoleNames        types.List              `tfsdk:"role_names"`
+	Roles            []*ramServiceLinkedRole `tfsdk:"roles"`
+	MissingRoleNames types.List              `tfsdk:"missing_role_names"`
+}
+
+type ramServiceLinkedRole struct {
+	RoleName types.String `tfsdk:"role_name"`
+	Exists   types.Bool   `tfsdk:"exists"`
+	Arn      types.String `tfsdk:"arn"`
+}
+
+func (d *ramServiceLinkedRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ram_service_linked_roles"
+}
+
+func (d *ramServiceLinkedRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source reports whether the service-linked roles required by the cloud services, " +
+			"e.g. AliyunServiceRoleForAutoScaling of ESS, AliyunServiceRoleForAlb of ALB and " +
+			"AliyunServiceRoleForServiceMesh of ASM, exist in the account. It is used to verify with a " +
+			"postcondition that the roles exist before the first apply in a fresh account.",
+		Attributes: map[string]schema.Attribute{
+			"role_names": schema.ListAttribute{
+				Description: "The names of the service-linked roles to check.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "A list of the service-linked roles in the same order as role_names.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Description: "The name of the service-linked role.",
+							Computed:    true,
+						},
+						"exists": schema.BoolAttribute{
+							Description: "Whether the service-linked role exists in the account.",
+							Computed:    true,
+						},
+						"arn": schema.StringAttribute{
+							Description: "The ARN of the service-linked role, empty if the role does not exist.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"missing_role_names": schema.ListAttribute{
+				Description: "The names of the service-linked roles which do not exist in the account.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the RAM roles. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to get the RAM " +
+							"roles. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to get the RAM " +
+							"roles. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ramServiceLinkedRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).ramClient
+}
+
+func (d *ramServiceLinkedRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *ramServiceLinkedRolesDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudRamClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud RAM API Client",
+				"An unexpected error occurred when creating the AliCloud RAM API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud RAM Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &ramServiceLinkedRolesDataSourceModel{
+		RoleNames: plan.RoleNames,
+		Roles:     []*ramServiceLinkedRole{},
+	}
+	missingRoleNames := []string{}
+	for _, roleName := range convertListValueToStrings(plan.RoleNames) {
+		role, err := getRamRole(d.client, roleName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Get Role.",
+				err.Error(),
+			)
+			return
+		}
+
+		serviceLinkedRole := &ramServiceLinkedRole{
+			RoleName: types.StringValue(roleName),
+			Exists:   types.BoolValue(role != nil),
+			Arn:      types.StringValue(""),
+		}
+		if role != nil {
+			serviceLinkedRole.Arn = types.StringValue(tea.StringValue(role.Arn))
+		} else {
+			missingRoleNames = append(missingRoleNames, roleName)
+		}
+		state.Roles = append(state.Roles, serviceLinkedRole)
+	}
+	state.MissingRoleNames = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(missingRoleNames)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to get the RAM role by the name, returns nil if the role does not
+// exist.
+func getRamRole(client *alicloudRamClient.Client, roleName string) (*alicloudRamClient.GetRoleResponseBodyRole, error) {
+	var role *alicloudRamClient.GetRoleResponseBodyRole
+	getRole := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getRoleRequest := &alicloudRamClient.GetRoleRequest{
+			RoleName: tea.String(roleName),
+		}
+
+		getRoleResponse, err := client.GetRoleWithOptions(getRoleRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Code), "EntityNotExist") {
+				role = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		role = getRoleResponse.Body.Role
+		return nil
+	}
+
+	err := retryAPICall(getRole)
+	return role, err
+}
@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &resourceManagerTrustedServicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceManagerTrustedServicesDataSource{}
+)
+
+func NewResourceManagerTrustedServicesDataSource() datasource.DataSource {
+	return &resourceManagerTrustedServicesDataSource{}
+}
+
+type resourceManagerTrustedServicesDataSource struct {
+	client *alicloudResourceManagerClient.Client
+}
+
+type resourceManagerTrustedServicesDataSourceModel struct {
+	ClientConfig    *clientConfig                    `tfsdk:"client_config"`
+	AdminAccountId  types.String                     `tfsdk:"admin_account_id"`
+	TrustedServices []*resourceManagerTrustedService `tfsdk:"trusted_services"`
+}
+
+type resourceManagerTrustedService struct {
+	ServicePrincipal types.String `tfsdk:"service_principal"`
+	EnableTime       types.String `tfsdk:"enable_time"`
+}
+
+func (d *resourceManagerTrustedServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_trusted_services"
+}
+
+func (d *resourceManagerTrustedServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the trusted services enabled in the resource directory, " +
+			"which is used to verify that the trusted services, e.g. config.aliyuncs.com, " +
+			"actiontrail.aliyuncs.com and cloudsso.aliyuncs.com, are enabled before managing the resources " +
+			"at the resource directory level.",
+		Attributes: map[string]schema.Attribute{
+			"admin_account_id": schema.StringAttribute{
+				Description: "The ID of the management account or the delegated administrator account of the " +
+					"resource directory. Default to the current account.",
+				Optional: true,
+			},
+			"trusted_services": schema.ListNestedAttribute{
+				Description: "A list of enabled trusted services.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_principal": schema.StringAttribute{
+							Description: "The identifier of the trusted service, e.g. config.aliyuncs.com.",
+							Computed:    true,
+						},
+						"enable_time": schema.StringAttribute{
+							Description: "The time when the trusted service was enabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the resource directory. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list the trusted " +
+							"services. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list the trusted " +
+							"services. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourceManagerTrustedServicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+}
+
+func (d *resourceManagerTrustedServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *resourceManagerTrustedServicesDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		clientCredentialsConfig.Endpoint = tea.String("resourcemanager.aliyuncs.com")
+		d.client, err = alicloudResourceManagerClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud Resource Manager API Client",
+				"An unexpected error occurred when creating the AliCloud Resource Manager API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud Resource Manager Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &resourceManagerTrustedServicesDataSourceModel{
+		AdminAccountId:  plan.AdminAccountId,
+		TrustedServices: []*resourceManagerTrustedService{},
+	}
+
+	pageNumber := int32(1)
+	pageSize := int32(100)
+	for {
+		var listTrustedServiceStatusResponse *alicloudResourceManagerClient.ListTrustedServiceStatusResponse
+		listTrustedServiceStatus := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listTrustedServiceStatusRequest := &alicloudResourceManagerClient.ListTrustedServiceStatusRequest{
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+			if !plan.AdminAccountId.IsNull() {
+				listTrustedServiceStatusRequest.AdminAccountId = tea.String(plan.AdminAccountId.ValueString())
+			}
+
+			var err error
+			listTrustedServiceStatusResponse, err = d.client.ListTrustedServiceStatusWithOptions(listTrustedServiceStatusRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listTrustedServiceStatus); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Trusted Services.",
+				err.Error(),
+			)
+			return
+		}
+
+		body := listTrustedServiceStatusResponse.Body
+		if body.EnabledServicePrincipals != nil {
+			for _, service := range body.EnabledServicePrincipals.EnabledServicePrincipal {
+				state.TrustedServices = append(state.TrustedServices, &resourceManagerTrustedService{
+					ServicePrincipal: types.StringValue(tea.StringValue(service.ServicePrincipal)),
+					EnableTime:       types.StringValue(tea.StringValue(service.EnableTime)),
+				})
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
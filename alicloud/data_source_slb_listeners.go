@@ -0,0 +1,392 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &slbListenersDataSource{}
+	_ datasource.DataSourceWithConfigure = &slbListenersDataSource{}
+)
+
+func NewSlbListenersDataSource() datasource.DataSource {
+	return &slbListenersDataSource{}
+}
+
+type slbListenersDataSource struct {
+	client *alicloudSlbClient.Client
+}
+
+type slbListenersDataSourceModel struct {
+	ClientConfig     *clientConfig         `tfsdk:"client_config"`
+	LoadBalancerId   types.String          `tfsdk:"load_balancer_id"`
+	ListenerProtocol types.String          `tfsdk:"listener_protocol"`
+	Listeners        []*slbListenersDetail `tfsdk:"listeners"`
+}
+
+type slbListenersDetail struct {
+	Port                     types.Int64              `tfsdk:"port"`
+	Protocol                 types.String             `tfsdk:"protocol"`
+	BackendPort              types.Int64              `tfsdk:"backend_port"`
+	Status                   types.String             `tfsdk:"status"`
+	Scheduler                types.String             `tfsdk:"scheduler"`
+	Description              types.String             `tfsdk:"description"`
+	VServerGroupId           types.String             `tfsdk:"vserver_group_id"`
+	MasterSlaveServerGroupId types.String             `tfsdk:"master_slave_server_group_id"`
+	ServerCertificateId      types.String             `tfsdk:"server_certificate_id"`
+	CaCertificateId          types.String             `tfsdk:"ca_certificate_id"`
+	ForwardPort              types.Int64              `tfsdk:"forward_port"`
+	HealthCheck              *slbListenersHealthCheck `tfsdk:"health_check"`
+}
+
+type slbListenersHealthCheck struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Type               types.String `tfsdk:"type"`
+	ConnectPort        types.Int64  `tfsdk:"connect_port"`
+	Domain             types.String `tfsdk:"domain"`
+	Uri                types.String `tfsdk:"uri"`
+	HttpCode           types.String `tfsdk:"http_code"`
+	Interval           types.Int64  `tfsdk:"interval"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	HealthyThreshold   types.Int64  `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold types.Int64  `tfsdk:"unhealthy_threshold"`
+}
+
+func (d *slbListenersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slb_listeners"
+}
+
+func (d *slbListenersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the listeners of a Server Load Balancer.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Description: "The ID of the SLB.",
+				Required:    true,
+			},
+			"listener_protocol": schema.StringAttribute{
+				Description: "The protocol of the listeners. Valid values: tcp, udp, http, https. Default to " +
+					"return the listeners of all protocols.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp", "http", "https"),
+				},
+			},
+			"listeners": schema.ListNestedAttribute{
+				Description: "A list of listeners.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Description: "The frontend port of the listener.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The protocol of the listener.",
+							Computed:    true,
+						},
+						"backend_port": schema.Int64Attribute{
+							Description: "The backend port of the listener, which is 0 if the listener forwards " +
+								"to a vserver group.",
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the listener.",
+							Computed:    true,
+						},
+						"scheduler": schema.StringAttribute{
+							Description: "The scheduling algorithm of the listener.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the listener.",
+							Computed:    true,
+						},
+						"vserver_group_id": schema.StringAttribute{
+							Description: "The ID of the vserver group of the listener.",
+							Computed:    true,
+						},
+						"master_slave_server_group_id": schema.StringAttribute{
+							Description: "The ID of the primary/secondary server group of the TCP or UDP listener.",
+							Computed:    true,
+						},
+						"server_certificate_id": schema.StringAttribute{
+							Description: "The ID of the server certificate of the HTTPS listener.",
+							Computed:    true,
+						},
+						"ca_certificate_id": schema.StringAttribute{
+							Description: "The ID of the CA certificate of the HTTPS listener.",
+							Computed:    true,
+						},
+						"forward_port": schema.Int64Attribute{
+							Description: "The port which the HTTP listener redirects the requests to, which is 0 " +
+								"if the redirection is disabled.",
+							Computed: true,
+						},
+						"health_check": schema.SingleNestedAttribute{
+							Description: "The health check config of the listener.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Description: "Whether the health check is enabled.",
+									Computed:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "The protocol of the health check of the TCP listener, tcp or http.",
+									Computed:    true,
+								},
+								"connect_port": schema.Int64Attribute{
+									Description: "The port of the health check, 0 means the port of the backend servers.",
+									Computed:    true,
+								},
+								"domain": schema.StringAttribute{
+									Description: "The domain name of the health check.",
+									Computed:    true,
+								},
+								"uri": schema.StringAttribute{
+									Description: "The URI of the health check.",
+									Computed:    true,
+								},
+								"http_code": schema.StringAttribute{
+									Description: "The status codes of the healthy backend servers.",
+									Computed:    true,
+								},
+								"interval": schema.Int64Attribute{
+									Description: "The interval of the health check in seconds.",
+									Computed:    true,
+								},
+								"timeout": schema.Int64Attribute{
+									Description: "The timeout of the health check in seconds.",
+									Computed:    true,
+								},
+								"healthy_threshold": schema.Int64Attribute{
+									Description: "The number of consecutive successful health checks to mark a backend server healthy.",
+									Computed:    true,
+								},
+								"unhealthy_threshold": schema.Int64Attribute{
+									Description: "The number of consecutive failed health checks to mark a backend server unhealthy.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the SLB. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"SLB listeners. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"SLB listeners. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *slbListenersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).slbClient
+}
+
+func (d *slbListenersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *slbListenersDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudSlbClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud SLB API Client",
+				"An unexpected error occurred when creating the AliCloud SLB API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud SLB Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &slbListenersDataSourceModel{
+		LoadBalancerId:   plan.LoadBalancerId,
+		ListenerProtocol: plan.ListenerProtocol,
+		Listeners:        []*slbListenersDetail{},
+	}
+
+	var nextToken *string
+	for {
+		var describeLoadBalancerListenersResponse *alicloudSlbClient.DescribeLoadBalancerListenersResponse
+		describeLoadBalancerListeners := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeLoadBalancerListenersRequest := &alicloudSlbClient.DescribeLoadBalancerListenersRequest{
+				RegionId:       d.client.RegionId,
+				LoadBalancerId: []*string{tea.String(plan.LoadBalancerId.ValueString())},
+				MaxResults:     tea.Int32(100),
+				NextToken:      nextToken,
+			}
+			if !plan.ListenerProtocol.IsNull() {
+				describeLoadBalancerListenersRequest.ListenerProtocol = tea.String(plan.ListenerProtocol.ValueString())
+			}
+
+			var err error
+			describeLoadBalancerListenersResponse, err = d.client.DescribeLoadBalancerListenersWithOptions(describeLoadBalancerListenersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeLoadBalancerListeners); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Load Balancer Listeners.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, listener := range describeLoadBalancerListenersResponse.Body.Listeners {
+			state.Listeners = append(state.Listeners, newSlbListenersDetail(listener))
+		}
+
+		nextToken = describeLoadBalancerListenersResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Function to convert the listener of AliCloud API, the health check and the
+// other protocol specific attributes are read from the config of the
+// listener's protocol.
+func newSlbListenersDetail(listener *alicloudSlbClient.DescribeLoadBalancerListenersResponseBodyListeners) *slbListenersDetail {
+	detail := &slbListenersDetail{
+		Port:                     types.Int64Value(int64(tea.Int32Value(listener.ListenerPort))),
+		Protocol:                 types.StringValue(tea.StringValue(listener.ListenerProtocol)),
+		BackendPort:              types.Int64Value(int64(tea.Int32Value(listener.BackendServerPort))),
+		Status:                   types.StringValue(tea.StringValue(listener.Status)),
+		Scheduler:                types.StringValue(tea.StringValue(listener.Scheduler)),
+		Description:              types.StringValue(tea.StringValue(listener.Description)),
+		VServerGroupId:           types.StringValue(tea.StringValue(listener.VServerGroupId)),
+		MasterSlaveServerGroupId: types.StringValue(""),
+		ServerCertificateId:      types.StringValue(""),
+		CaCertificateId:          types.StringValue(""),
+		ForwardPort:              types.Int64Value(0),
+		HealthCheck: &slbListenersHealthCheck{
+			Enabled:            types.BoolValue(false),
+			Type:               types.StringValue(""),
+			ConnectPort:        types.Int64Value(0),
+			Domain:             types.StringValue(""),
+			Uri:                types.StringValue(""),
+			HttpCode:           types.StringValue(""),
+			Interval:           types.Int64Value(0),
+			Timeout:            types.Int64Value(0),
+			HealthyThreshold:   types.Int64Value(0),
+			UnhealthyThreshold: types.Int64Value(0),
+		},
+	}
+
+	healthCheck := detail.HealthCheck
+	switch {
+	case listener.TCPListenerConfig != nil:
+		config := listener.TCPListenerConfig
+		detail.MasterSlaveServerGroupId = types.StringValue(tea.StringValue(config.MasterSlaveServerGroupId))
+		healthCheck.Enabled = types.BoolValue(tea.StringValue(config.HealthCheck) == "on")
+		healthCheck.Type = types.StringValue(tea.StringValue(config.HealthCheckType))
+		healthCheck.ConnectPort = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectPort)))
+		healthCheck.Domain = types.StringValue(tea.StringValue(config.HealthCheckDomain))
+		healthCheck.Uri = types.StringValue(tea.StringValue(config.HealthCheckURI))
+		healthCheck.HttpCode = types.StringValue(tea.StringValue(config.HealthCheckHttpCode))
+		healthCheck.Interval = types.Int64Value(int64(tea.Int32Value(config.HealthCheckInterval)))
+		healthCheck.Timeout = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectTimeout)))
+		healthCheck.HealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.HealthyThreshold)))
+		healthCheck.UnhealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.UnhealthyThreshold)))
+	case listener.UDPListenerConfig != nil:
+		config := listener.UDPListenerConfig
+		detail.MasterSlaveServerGroupId = types.StringValue(tea.StringValue(config.MasterSlaveServerGroupId))
+		healthCheck.Enabled = types.BoolValue(tea.StringValue(config.HealthCheck) == "on")
+		healthCheck.Type = types.StringValue("udp")
+		healthCheck.ConnectPort = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectPort)))
+		healthCheck.Interval = types.Int64Value(int64(tea.Int32Value(config.HealthCheckInterval)))
+		healthCheck.Timeout = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectTimeout)))
+		healthCheck.HealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.HealthyThreshold)))
+		healthCheck.UnhealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.UnhealthyThreshold)))
+	case listener.HTTPListenerConfig != nil:
+		config := listener.HTTPListenerConfig
+		if tea.StringValue(config.ListenerForward) == "on" {
+			detail.ForwardPort = types.Int64Value(int64(tea.Int32Value(config.ForwardPort)))
+		}
+		healthCheck.Enabled = types.BoolValue(tea.StringValue(config.HealthCheck) == "on")
+		healthCheck.Type = types.StringValue("http")
+		healthCheck.ConnectPort = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectPort)))
+		healthCheck.Domain = types.StringValue(tea.StringValue(config.HealthCheckDomain))
+		healthCheck.Uri = types.StringValue(tea.StringValue(config.HealthCheckURI))
+		healthCheck.HttpCode = types.StringValue(tea.StringValue(config.HealthCheckHttpCode))
+		healthCheck.Interval = types.Int64Value(int64(tea.Int32Value(config.HealthCheckInterval)))
+		healthCheck.Timeout = types.Int64Value(int64(tea.Int32Value(config.HealthCheckTimeout)))
+		healthCheck.HealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.HealthyThreshold)))
+		healthCheck.UnhealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.UnhealthyThreshold)))
+	case listener.HTTPSListenerConfig != nil:
+		config := listener.HTTPSListenerConfig
+		detail.ServerCertificateId = types.StringValue(tea.StringValue(config.ServerCertificateId))
+		detail.CaCertificateId = types.StringValue(tea.StringValue(config.CACertificateId))
+		healthCheck.Enabled = types.BoolValue(tea.StringValue(config.HealthCheck) == "on")
+		healthCheck.Type = types.StringValue("http")
+		healthCheck.ConnectPort = types.Int64Value(int64(tea.Int32Value(config.HealthCheckConnectPort)))
+		healthCheck.Domain = types.StringValue(tea.StringValue(config.HealthCheckDomain))
+		healthCheck.Uri = types.StringValue(tea.StringValue(config.HealthCheckURI))
+		healthCheck.HttpCode = types.StringValue(tea.StringValue(config.HealthCheckHttpCode))
+		healthCheck.Interval = types.Int64Value(int64(tea.Int32Value(config.HealthCheckInterval)))
+		healthCheck.Timeout = types.Int64Value(int64(tea.Int32Value(config.HealthCheckTimeout)))
+		healthCheck.HealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.HealthyThreshold)))
+		healthCheck.UnhealthyThreshold = types.Int64Value(int64(tea.Int32Value(config.UnhealthyThreshold)))
+	}
+	return detail
+}
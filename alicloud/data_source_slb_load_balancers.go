@@ -2,15 +2,17 @@ package alicloud
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 
 	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +33,9 @@ type slbLoadBalancersDataSourceModel struct {
 	ClientConfig  *clientConfigWithZone     `tfsdk:"client_config"`
 	Name          types.String              `tfsdk:"name"`
 	Tags          types.Map                 `tfsdk:"tags"`
+	TagMatchMode  types.String              `tfsdk:"tag_match_mode"`
+	PageSize      types.Int64               `tfsdk:"page_size"`
+	MaxResults    types.Int64               `tfsdk:"max_results"`
 	LoadBalancers []*slbLoadBalancersDetail `tfsdk:"load_balancers"`
 }
 
@@ -55,10 +60,34 @@ func (d *slbLoadBalancersDataSource) Schema(ctx context.Context, req datasource.
 				Optional:    true,
 			},
 			"tags": schema.MapAttribute{
-				Description: "A map of tags assigned to the SLB instances.",
+				Description: "A map of tags assigned to the SLB instances. The tag value of an SLB delimited " +
+					"by '/' is matched if any of the values is matched.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"tag_match_mode": schema.StringAttribute{
+				Description: "The mode to match the tags. Valid values: all, any. The SLBs matching all the " +
+					"given tags are returned if all, or the SLBs matching any of the given tags are returned if " +
+					"any. Default to all.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "any"),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				Description: "The number of SLBs queried in each page. Valid values: 1 to 100. Default to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "The maximum number of SLBs to be returned. Default to return all the matched SLBs.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"load_balancers": schema.ListNestedAttribute{
 				Description: "A list of SLBs.",
 				Computed:    true,
@@ -160,10 +189,17 @@ func (d *slbLoadBalancersDataSource) Read(ctx context.Context, req datasource.Re
 
 	state := &slbLoadBalancersDataSourceModel{}
 	state.LoadBalancers = []*slbLoadBalancersDetail{}
+	state.PageSize = plan.PageSize
+	state.MaxResults = plan.MaxResults
+
+	pageSize := int32(100)
+	if !plan.PageSize.IsNull() {
+		pageSize = int32(plan.PageSize.ValueInt64())
+	}
 
 	describeLoadBalancersRequest := &alicloudSlbClient.DescribeLoadBalancersRequest{
 		RegionId: d.client.RegionId,
-		PageSize: tea.Int32(100),
+		PageSize: tea.Int32(pageSize),
 	}
 
 	if !(plan.Name.IsUnknown() && plan.Name.IsNull()) {
@@ -180,46 +216,40 @@ func (d *slbLoadBalancersDataSource) Read(ctx context.Context, req datasource.Re
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
 
-		// Construct the AliCloud tag struct.
-		slbTags := make([]*alicloudSlbClient.DescribeLoadBalancersResponseBodyLoadBalancersLoadBalancerTagsTag, 0)
-		for key, value := range inputTags {
-			if key == "app" {
-				slbTags = append(slbTags, &alicloudSlbClient.DescribeLoadBalancersResponseBodyLoadBalancersLoadBalancerTagsTag{
-					TagKey:   tea.String(key),
-					TagValue: tea.String(value),
-				})
-			}
-		}
-
-		// Convert the tag struct to JSON string that will be used for DescribeLoadBalancersWithOptions in AliCloud API client.
-		if len(slbTags) != 0 {
-			jsonTags, err := json.Marshal(slbTags)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"[ERROR] failed to marshal tag",
-					err.Error(),
-				)
-				return
-			}
-
-			describeLoadBalancersRequest.Tags = tea.String(string(jsonTags))
-		}
+	// The tags are not passed to AliCloud API, as the API matches any of the
+	// exact tag values, while the tag values delimited by '/' and the match
+	// mode are handled after listing the load balancers.
+	matchAllTags := true
+	if !plan.TagMatchMode.IsNull() {
+		state.TagMatchMode = plan.TagMatchMode
+		matchAllTags = plan.TagMatchMode.ValueString() == "all"
+	}
 
-		if !(plan.ClientConfig.Zone.IsUnknown() && plan.ClientConfig.Zone.IsNull() && plan.ClientConfig.Zone.String() == "") {
-			describeLoadBalancersRequest.MasterZoneId = tea.String(plan.ClientConfig.Zone.ValueString())
-		}
+	if !plan.ClientConfig.Zone.IsNull() && plan.ClientConfig.Zone.ValueString() != "" {
+		describeLoadBalancersRequest.MasterZoneId = tea.String(plan.ClientConfig.Zone.ValueString())
 	}
 	runtime := &util.RuntimeOptions{}
 
 	pageNumber := 0
 
+pageLoop:
 	for {
 		pageNumber++
 		describeLoadBalancersRequest.PageNumber = tea.Int32(int32(pageNumber))
 
-		describeLoadBalancersResponse, err := d.client.DescribeLoadBalancersWithOptions(describeLoadBalancersRequest, runtime)
-		if err != nil {
+		var describeLoadBalancersResponse *alicloudSlbClient.DescribeLoadBalancersResponse
+		describeLoadBalancers := func() error {
+			var err error
+			describeLoadBalancersResponse, err = d.client.DescribeLoadBalancersWithOptions(describeLoadBalancersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeLoadBalancers); err != nil {
 			resp.Diagnostics.AddError(
 				"[API ERROR] failed to query load balancers",
 				err.Error(),
@@ -227,62 +257,39 @@ func (d *slbLoadBalancersDataSource) Read(ctx context.Context, req datasource.Re
 			return
 		}
 
-	slbLoop:
 		for _, loadBalancer := range describeLoadBalancersResponse.Body.LoadBalancers.LoadBalancer {
-			if len(loadBalancer.Tags.Tag) < 1 {
+			// The SLBs without any tag are skipped.
+			if loadBalancer.Tags == nil || len(loadBalancer.Tags.Tag) < 1 {
 				continue
-			} else {
-				tags := make(map[string]attr.Value)
-
-				// Convert AliCloud tag format to map[string]string
-				slbTagQuried := make(map[string]string)
-				for _, tag := range loadBalancer.Tags.Tag {
-					slbTagQuried[*tag.TagKey] = *tag.TagValue
-					tags[*tag.TagKey] = types.StringValue(*tag.TagValue)
-				}
+			}
 
-				// Search whether the quried slb contains the input tags
-				for inputTagKey, inputTagValue := range inputTags {
-					// Check whether the load balance have the tag key, break and loop next load balance
-					// if key not found.
-					value, ok := slbTagQuried[inputTagKey]
-					if ok {
-						// '/' is assumed as string delimiter, split them to a list of string
-						// and compare with the input tag value, break if none of it are matched
-						if strings.Contains(value, "/") {
-							matched := false
-							tagList := strings.Split(value, "/")
-							for _, t := range tagList {
-								if t == inputTagValue {
-									matched = true
-								}
-							}
-							if !matched {
-								continue slbLoop
-							}
-							// Compare with the input tag value, break if not matched
-						} else if value != inputTagValue {
-							continue slbLoop
-						}
-					} else {
-						continue slbLoop
-					}
-				}
+			tags := make(map[string]attr.Value)
 
-				slbDetail := &slbLoadBalancersDetail{
-					Id:           types.StringValue(*loadBalancer.LoadBalancerId),
-					Name:         types.StringValue(*loadBalancer.LoadBalancerName),
-					MasterZoneId: types.StringValue(*loadBalancer.MasterZoneId),
-					SlaveZoneId:  types.StringValue(*loadBalancer.SlaveZoneId),
-					Tags:         types.MapValueMust(types.StringType, tags),
-				}
-				state.LoadBalancers = append(state.LoadBalancers, slbDetail)
+			// Convert AliCloud tag format to map[string]string
+			slbTagQuried := make(map[string]string)
+			for _, tag := range loadBalancer.Tags.Tag {
+				slbTagQuried[tea.StringValue(tag.TagKey)] = tea.StringValue(tag.TagValue)
+				tags[tea.StringValue(tag.TagKey)] = types.StringValue(tea.StringValue(tag.TagValue))
 			}
-		}
 
-		// Stop entering to second page if any result is found.
-		if len(state.LoadBalancers) > 0 {
-			break
+			if !isSlbTagsMatched(slbTagQuried, inputTags, matchAllTags) {
+				continue
+			}
+
+			slbDetail := &slbLoadBalancersDetail{
+				Id:           types.StringValue(tea.StringValue(loadBalancer.LoadBalancerId)),
+				Name:         types.StringValue(tea.StringValue(loadBalancer.LoadBalancerName)),
+				MasterZoneId: types.StringValue(tea.StringValue(loadBalancer.MasterZoneId)),
+				SlaveZoneId:  types.StringValue(tea.StringValue(loadBalancer.SlaveZoneId)),
+				Tags:         types.MapValueMust(types.StringType, tags),
+			}
+			state.LoadBalancers = append(state.LoadBalancers, slbDetail)
+
+			// Stop querying the next page once the maximum number of
+			// results is reached.
+			if !plan.MaxResults.IsNull() && int64(len(state.LoadBalancers)) >= plan.MaxResults.ValueInt64() {
+				break pageLoop
+			}
 		}
 
 		// If page number * page size is larger or equal to the total count, then that mean it's the last page.
@@ -297,3 +304,34 @@ func (d *slbLoadBalancersDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 }
+
+// Function to match the input tags with the tags of the SLB. '/' is assumed
+// as the delimiter of the tag value of the SLB, and the tag is matched if any
+// of the values is matched. All the input tags must be matched if matchAll is
+// true, otherwise any of the input tags is matched. The SLB is always matched
+// if no tag is given.
+func isSlbTagsMatched(slbTags map[string]string, inputTags map[string]string, matchAll bool) bool {
+	if len(inputTags) == 0 {
+		return true
+	}
+
+	for inputTagKey, inputTagValue := range inputTags {
+		matched := false
+		if value, ok := slbTags[inputTagKey]; ok {
+			for _, t := range strings.Split(value, "/") {
+				if t == inputTagValue {
+					matched = true
+					break
+				}
+			}
+		}
+
+		if matched && !matchAll {
+			return true
+		}
+		if !matched && matchAll {
+			return false
+		}
+	}
+	return matchAll
+}
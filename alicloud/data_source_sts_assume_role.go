@@ -0,0 +1,174 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudStsClient "github.com/alibabacloud-go/sts-20150401/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ datasource.DataSource              = &stsAssumeRoleDataSource{}
+	_ datasource.DataSourceWithConfigure = &stsAssumeRoleDataSource{}
+)
+
+func NewStsAssumeRoleDataSource() datasource.DataSource {
+	return &stsAssumeRoleDataSource{}
+}
+
+type stsAssumeRoleDataSource struct {
+	client *alicloudStsClient.Client
+}
+
+type stsAssumeRoleDataSourceModel struct {
+	RoleArn            types.String `tfsdk:"role_arn"`
+	RoleSessionName    types.String `tfsdk:"role_session_name"`
+	Policy             types.String `tfsdk:"policy"`
+	DurationSeconds    types.Int64  `tfsdk:"duration_seconds"`
+	ExternalId         types.String `tfsdk:"external_id"`
+	AccessKeyId        types.String `tfsdk:"access_key_id"`
+	AccessKeySecret    types.String `tfsdk:"access_key_secret"`
+	SecurityToken      types.String `tfsdk:"security_token"`
+	Expiration         types.String `tfsdk:"expiration"`
+	AssumedRoleUserArn types.String `tfsdk:"assumed_role_user_arn"`
+	AssumedRoleId      types.String `tfsdk:"assumed_role_id"`
+}
+
+func (d *stsAssumeRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sts_assume_role"
+}
+
+func (d *stsAssumeRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source assumes a RAM role and provides the temporary credentials of the role. " +
+			"The credentials are requested again on every read, and are stored in the state file.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Description: "The ARN of the RAM role to assume, e.g. acs:ram::123456789012****:role/example.",
+				Required:    true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Description: "The name of the role session, used to distinguish the callers of the role.",
+				Required:    true,
+			},
+			"policy": schema.StringAttribute{
+				Description: "The inline session policy in JSON. The permissions of the credentials are the " +
+					"intersection of the role policies and this policy.",
+				Optional: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Description: "The validity period of the credentials in seconds, between 900 and the " +
+					"maximum session duration of the role. Default to 3600.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(900),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The external ID required by the trust policy of the role.",
+				Optional:    true,
+			},
+			"access_key_id": schema.StringAttribute{
+				Description: "The access key ID of the temporary credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"access_key_secret": schema.StringAttribute{
+				Description: "The access key secret of the temporary credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"security_token": schema.StringAttribute{
+				Description: "The security token of the temporary credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration": schema.StringAttribute{
+				Description: "The time when the temporary credentials expire, in UTC.",
+				Computed:    true,
+			},
+			"assumed_role_user_arn": schema.StringAttribute{
+				Description: "The ARN of the assumed role session.",
+				Computed:    true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Description: "The ID of the assumed role session.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *stsAssumeRoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).stsClient
+}
+
+func (d *stsAssumeRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state *stsAssumeRoleDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assumeRoleRequest := &alicloudStsClient.AssumeRoleRequest{
+		RoleArn:         tea.String(state.RoleArn.ValueString()),
+		RoleSessionName: tea.String(state.RoleSessionName.ValueString()),
+	}
+	if !state.Policy.IsNull() {
+		assumeRoleRequest.Policy = tea.String(state.Policy.ValueString())
+	}
+	if !state.DurationSeconds.IsNull() {
+		assumeRoleRequest.DurationSeconds = tea.Int64(state.DurationSeconds.ValueInt64())
+	}
+	if !state.ExternalId.IsNull() {
+		assumeRoleRequest.ExternalId = tea.String(state.ExternalId.ValueString())
+	}
+
+	var assumeRoleResponse *alicloudStsClient.AssumeRoleResponse
+	assumeRole := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		assumeRoleResponse, err = d.client.AssumeRoleWithOptions(assumeRoleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(assumeRole); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Assume Role.",
+			err.Error(),
+		)
+		return
+	}
+
+	credentials := assumeRoleResponse.Body.Credentials
+	state.AccessKeyId = types.StringValue(tea.StringValue(credentials.AccessKeyId))
+	state.AccessKeySecret = types.StringValue(tea.StringValue(credentials.AccessKeySecret))
+	state.SecurityToken = types.StringValue(tea.StringValue(credentials.SecurityToken))
+	state.Expiration = types.StringValue(tea.StringValue(credentials.Expiration))
+
+	assumedRoleUser := assumeRoleResponse.Body.AssumedRoleUser
+	state.AssumedRoleUserArn = types.StringValue(tea.StringValue(assumedRoleUser.Arn))
+	state.AssumedRoleId = types.StringValue(tea.StringValue(assumedRoleUser.AssumedRoleId))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
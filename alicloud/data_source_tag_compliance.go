@@ -0,0 +1,243 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudTagClient "github.com/alibabacloud-go/tag-20180828/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// The maximum number of resource ARNs in a ListTagResources call.
+const tagResourceARNsMaxCount = 50
+
+var (
+	_ datasource.DataSource              = &tagComplianceDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagComplianceDataSource{}
+)
+
+func NewTagComplianceDataSource() datasource.DataSource {
+	return &tagComplianceDataSource{}
+}
+
+type tagComplianceDataSource struct {
+	client *alicloudTagClient.Client
+}
+
+type tagComplianceDataSourceModel struct {
+	ClientConfig          *clientConfig                   `tfsdk:"client_config"`
+	ResourceArns          types.List                      `tfsdk:"resource_arns"`
+	RequiredTagKeys       types.List                      `tfsdk:"required_tag_keys"`
+	Compliant             types.Bool                      `tfsdk:"compliant"`
+	NonCompliantResources []*tagNonCompliantResourceModel `tfsdk:"non_compliant_resources"`
+}
+
+type tagNonCompliantResourceModel struct {
+	ResourceArn    types.String `tfsdk:"resource_arn"`
+	MissingTagKeys types.List   `tfsdk:"missing_tag_keys"`
+}
+
+func (d *tagComplianceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_compliance"
+}
+
+func (d *tagComplianceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source checks that the resources carry all the required tag keys, e.g. the cost " +
+			"allocation tags, and returns the resources missing any of them, so that the tagging policy can be " +
+			"enforced with the preconditions or postconditions of the plan. A tag with an empty value is " +
+			"treated as missing.",
+		Attributes: map[string]schema.Attribute{
+			"resource_arns": schema.ListAttribute{
+				Description: "The ARNs of the resources to be checked, e.g. " +
+					"`acs:ecs:cn-hongkong:1234567890123456:instance/i-j6c0f3xxxxxxxxxxxxxx`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"required_tag_keys": schema.ListAttribute{
+				Description: "The tag keys that all the resources must carry, e.g. `CostCenter` and `Project`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"compliant": schema.BoolAttribute{
+				Description: "Whether all the resources carry all the required tag keys.",
+				Computed:    true,
+			},
+			"non_compliant_resources": schema.ListNestedAttribute{
+				Description: "A list of resources missing any of the required tag keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_arn": schema.StringAttribute{
+							Description: "The ARN of the resource.",
+							Computed:    true,
+						},
+						"missing_tag_keys": schema.ListAttribute{
+							Description: "The required tag keys missing from the resource.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the resources. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list the tags of the " +
+							"resources. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list the tags of the " +
+							"resources. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagComplianceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).tagClient
+}
+
+func (d *tagComplianceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *tagComplianceDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		clientCredentialsConfig.Endpoint = tea.String(fmt.Sprintf("tag.%s.aliyuncs.com", tea.StringValue(clientCredentialsConfig.RegionId)))
+		d.client, err = alicloudTagClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud Tag API Client",
+				"An unexpected error occurred when creating the AliCloud Tag API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud Tag Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resourceArns := convertListValueToStrings(plan.ResourceArns)
+	requiredTagKeys := convertListValueToStrings(plan.RequiredTagKeys)
+
+	// The tag keys with non-empty values of each resource.
+	resourceTagKeys := make(map[string][]string)
+	for start := 0; start < len(resourceArns); start += tagResourceARNsMaxCount {
+		end := start + tagResourceARNsMaxCount
+		if end > len(resourceArns) {
+			end = len(resourceArns)
+		}
+
+		var nextToken *string
+		for {
+			var listTagResourcesResponse *alicloudTagClient.ListTagResourcesResponse
+			listTagResources := func() error {
+				runtime := &util.RuntimeOptions{}
+
+				listTagResourcesRequest := &alicloudTagClient.ListTagResourcesRequest{
+					RegionId:    d.client.RegionId,
+					ResourceARN: tea.StringSlice(resourceArns[start:end]),
+					PageSize:    tea.Int32(1000),
+					NextToken:   nextToken,
+				}
+
+				var err error
+				listTagResourcesResponse, err = d.client.ListTagResourcesWithOptions(listTagResourcesRequest, runtime)
+				if err != nil {
+					return handleAPIError(err)
+				}
+				return nil
+			}
+
+			if err := retryAPICall(listTagResources); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to List Tag Resources.",
+					err.Error(),
+				)
+				return
+			}
+
+			for _, tagResource := range listTagResourcesResponse.Body.TagResources {
+				resourceArn := tea.StringValue(tagResource.ResourceARN)
+				for _, tag := range tagResource.Tags {
+					if tea.StringValue(tag.Value) != "" {
+						resourceTagKeys[resourceArn] = append(resourceTagKeys[resourceArn], tea.StringValue(tag.Key))
+					}
+				}
+			}
+
+			if tea.StringValue(listTagResourcesResponse.Body.NextToken) == "" {
+				break
+			}
+			nextToken = listTagResourcesResponse.Body.NextToken
+		}
+	}
+
+	state := &tagComplianceDataSourceModel{
+		ResourceArns:          plan.ResourceArns,
+		RequiredTagKeys:       plan.RequiredTagKeys,
+		NonCompliantResources: []*tagNonCompliantResourceModel{},
+	}
+	for _, resourceArn := range resourceArns {
+		missingTagKeys := convertStringsDifference(requiredTagKeys, resourceTagKeys[resourceArn])
+		if len(missingTagKeys) == 0 {
+			continue
+		}
+		state.NonCompliantResources = append(state.NonCompliantResources, &tagNonCompliantResourceModel{
+			ResourceArn:    types.StringValue(resourceArn),
+			MissingTagKeys: types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(missingTagKeys))),
+		})
+	}
+	state.Compliant = types.BoolValue(len(state.NonCompliantResources) == 0)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,342 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ datasource.DataSource              = &vpcNatGatewaysDataSource{}
+	_ datasource.DataSourceWithConfigure = &vpcNatGatewaysDataSource{}
+)
+
+func NewVpcNatGatewaysDataSource() datasource.DataSource {
+	return &vpcNatGatewaysDataSource{}
+}
+
+type vpcNatGatewaysDataSource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcNatGatewaysDataSourceModel struct {
+	ClientConfig *clientConfig           `tfsdk:"client_config"`
+	VpcId        types.String            `tfsdk:"vpc_id"`
+	Name         types.String            `tfsdk:"name"`
+	Tags         types.Map               `tfsdk:"tags"`
+	NatGateways  []*vpcNatGatewaysDetail `tfsdk:"nat_gateways"`
+}
+
+type vpcNatGatewaysDetail struct {
+	Id              types.String        `tfsdk:"id"`
+	Name            types.String        `tfsdk:"name"`
+	VpcId           types.String        `tfsdk:"vpc_id"`
+	NatType         types.String        `tfsdk:"nat_type"`
+	NetworkType     types.String        `tfsdk:"network_type"`
+	Spec            types.String        `tfsdk:"spec"`
+	Status          types.String        `tfsdk:"status"`
+	SnatTableIds    types.List          `tfsdk:"snat_table_ids"`
+	ForwardTableIds types.List          `tfsdk:"forward_table_ids"`
+	Eips            []*vpcNatGatewayEip `tfsdk:"eips"`
+	Tags            types.Map           `tfsdk:"tags"`
+}
+
+type vpcNatGatewayEip struct {
+	AllocationId     types.String `tfsdk:"allocation_id"`
+	IpAddress        types.String `tfsdk:"ip_address"`
+	SnatEntryEnabled types.Bool   `tfsdk:"snat_entry_enabled"`
+	UsingStatus      types.String `tfsdk:"using_status"`
+}
+
+func (d *vpcNatGatewaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_nat_gateways"
+}
+
+func (d *vpcNatGatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source provides the NAT gateways in desired region or VPC, with the SNAT table IDs and the EIPs bound to the NAT gateways.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the VPC of the NAT gateways.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the NAT gateways.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags assigned to the NAT gateways, only the NAT gateways matching all the given tags are returned.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"nat_gateways": schema.ListNestedAttribute{
+				Description: "A list of NAT gateways.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the NAT gateway.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the NAT gateway.",
+							Computed:    true,
+						},
+						"vpc_id": schema.StringAttribute{
+							Description: "The ID of the VPC of the NAT gateway.",
+							Computed:    true,
+						},
+						"nat_type": schema.StringAttribute{
+							Description: "The type of the NAT gateway, e.g. Enhanced.",
+							Computed:    true,
+						},
+						"network_type": schema.StringAttribute{
+							Description: "The network type of the NAT gateway, internet or intranet.",
+							Computed:    true,
+						},
+						"spec": schema.StringAttribute{
+							Description: "The specification of the NAT gateway.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the NAT gateway.",
+							Computed:    true,
+						},
+						"snat_table_ids": schema.ListAttribute{
+							Description: "The IDs of the SNAT tables of the NAT gateway.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"forward_table_ids": schema.ListAttribute{
+							Description: "The IDs of the DNAT (forward) tables of the NAT gateway.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"eips": schema.ListNestedAttribute{
+							Description: "The EIPs bound to the NAT gateway.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"allocation_id": schema.StringAttribute{
+										Description: "The ID of the EIP.",
+										Computed:    true,
+									},
+									"ip_address": schema.StringAttribute{
+										Description: "The IP address of the EIP.",
+										Computed:    true,
+									},
+									"snat_entry_enabled": schema.BoolAttribute{
+										Description: "Whether the EIP is used in the SNAT entries.",
+										Computed:    true,
+									},
+									"using_status": schema.StringAttribute{
+										Description: "The usage of the EIP, e.g. Idle, UsedBySnat, UsedByForward.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"tags": schema.MapAttribute{
+							Description: "The tags of the NAT gateway.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"client_config": schema.SingleNestedBlock{
+				Description: "Config to override default client created in Provider. " +
+					"This block will not be recorded in state file.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The region of the NAT gateways. Default to use region " +
+							"configured in the provider.",
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key that have permissions to list " +
+							"NAT gateways. Default to use access key configured in the provider.",
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key that have permissions to list " +
+							"NAT gateways. Default to use secret key configured in the provider.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vpcNatGatewaysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+func (d *vpcNatGatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan *vpcNatGatewaysDataSourceModel
+	getPlanDiags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClientConfig == nil {
+		plan.ClientConfig = &clientConfig{}
+	}
+
+	initClient, clientCredentialsConfig, initClientDiags := initNewClient(&d.client.Client, plan.ClientConfig)
+	if initClientDiags.HasError() {
+		resp.Diagnostics.Append(initClientDiags...)
+		return
+	}
+	if initClient {
+		var err error
+		d.client, err = alicloudVpcClient.NewClient(clientCredentialsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Reinitialize AliCloud VPC API Client",
+				"An unexpected error occurred when creating the AliCloud VPC API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud VPC Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := &vpcNatGatewaysDataSourceModel{
+		VpcId:       plan.VpcId,
+		Name:        plan.Name,
+		Tags:        plan.Tags,
+		NatGateways: []*vpcNatGatewaysDetail{},
+	}
+
+	inputTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		convertTagsDiags := plan.Tags.ElementsAs(ctx, &inputTags, false)
+		resp.Diagnostics.Append(convertTagsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	pageNumber := int32(1)
+	pageSize := int32(50)
+	for {
+		var describeNatGatewaysResponse *alicloudVpcClient.DescribeNatGatewaysResponse
+		describeNatGateways := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeNatGatewaysRequest := &alicloudVpcClient.DescribeNatGatewaysRequest{
+				RegionId:   d.client.RegionId,
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+			if !plan.VpcId.IsNull() {
+				describeNatGatewaysRequest.VpcId = tea.String(plan.VpcId.ValueString())
+			}
+			if !plan.Name.IsNull() {
+				describeNatGatewaysRequest.Name = tea.String(plan.Name.ValueString())
+			}
+			for key, value := range inputTags {
+				describeNatGatewaysRequest.Tag = append(describeNatGatewaysRequest.Tag, &alicloudVpcClient.DescribeNatGatewaysRequestTag{
+					Key:   tea.String(key),
+					Value: tea.String(value),
+				})
+			}
+
+			var err error
+			describeNatGatewaysResponse, err = d.client.DescribeNatGatewaysWithOptions(describeNatGatewaysRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeNatGateways); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe NAT Gateways.",
+				err.Error(),
+			)
+			return
+		}
+
+	natGatewayLoop:
+		for _, natGateway := range describeNatGatewaysResponse.Body.NatGateways.NatGateway {
+			tags := make(map[string]attr.Value)
+			natGatewayTags := make(map[string]string)
+			if natGateway.Tags != nil {
+				for _, tag := range natGateway.Tags.Tag {
+					tags[tea.StringValue(tag.TagKey)] = types.StringValue(tea.StringValue(tag.TagValue))
+					natGatewayTags[tea.StringValue(tag.TagKey)] = tea.StringValue(tag.TagValue)
+				}
+			}
+
+			// Match all the given tags, as the NAT gateways matching any
+			// of the tags may be returned by AliCloud API.
+			for inputTagKey, inputTagValue := range inputTags {
+				if value, ok := natGatewayTags[inputTagKey]; !ok || value != inputTagValue {
+					continue natGatewayLoop
+				}
+			}
+
+			var snatTableIds, forwardTableIds []*string
+			if natGateway.SnatTableIds != nil {
+				snatTableIds = natGateway.SnatTableIds.SnatTableId
+			}
+			if natGateway.ForwardTableIds != nil {
+				forwardTableIds = natGateway.ForwardTableIds.ForwardTableId
+			}
+
+			eips := []*vpcNatGatewayEip{}
+			if natGateway.IpLists != nil {
+				for _, ip := range natGateway.IpLists.IpList {
+					eips = append(eips, &vpcNatGatewayEip{
+						AllocationId:     types.StringValue(tea.StringValue(ip.AllocationId)),
+						IpAddress:        types.StringValue(tea.StringValue(ip.IpAddress)),
+						SnatEntryEnabled: types.BoolValue(tea.BoolValue(ip.SnatEntryEnabled)),
+						UsingStatus:      types.StringValue(tea.StringValue(ip.UsingStatus)),
+					})
+				}
+			}
+
+			state.NatGateways = append(state.NatGateways, &vpcNatGatewaysDetail{
+				Id:              types.StringValue(tea.StringValue(natGateway.NatGatewayId)),
+				Name:            types.StringValue(tea.StringValue(natGateway.Name)),
+				VpcId:           types.StringValue(tea.StringValue(natGateway.VpcId)),
+				NatType:         types.StringValue(tea.StringValue(natGateway.NatType)),
+				NetworkType:     types.StringValue(tea.StringValue(natGateway.NetworkType)),
+				Spec:            types.StringValue(tea.StringValue(natGateway.Spec)),
+				Status:          types.StringValue(tea.StringValue(natGateway.Status)),
+				SnatTableIds:    types.ListValueMust(types.StringType, convertStringPointersToAttrValues(snatTableIds)),
+				ForwardTableIds: types.ListValueMust(types.StringType, convertStringPointersToAttrValues(forwardTableIds)),
+				Eips:            eips,
+				Tags:            types.MapValueMust(types.StringType, tags),
+			})
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeNatGatewaysResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -1,5 +1,13 @@
 package alicloud
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/cenkalti/backoff/v4"
+)
+
 const (
 	ERR_CLOSE_DNS_SLB_FAILED  = "CloseDnsSlbFailed"
 	ERR_DISABLE_DNS_SLB       = "DisableDNSSLB"
@@ -32,3 +40,19 @@ func isAbleToRetry(errCode string) bool {
 	}
 	// return false
 }
+
+// Function to check whether the error is caused by an existing resource, e.g.
+// EntityAlreadyExists.Policy of RAM.
+func isAlreadyExistsError(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(_t.Code), "AlreadyExist")
+	}
+	return false
+}
+
+// Function to build the permanent error of an existing resource which is not
+// adopted, with the hint of the provider option to adopt it.
+func newAlreadyExistsError(err error) error {
+	return backoff.Permanent(fmt.Errorf("the resource already exists, set adopt_existing_resources = true "+
+		"in the provider to adopt and manage it: %w", err))
+}
@@ -2,11 +2,17 @@ package alicloud
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"strings"
 
 	alicloudOpenapiClient "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Convert the result for an array and returns a Json string
@@ -93,3 +99,139 @@ func initNewClient(providerConfig *alicloudOpenapiClient.Client, planConfig *cli
 
 	return
 }
+
+// Same as handleAPIError, but for the errors returned by OSS SDK which does
+// not use the tea SDK error.
+func handleOssAPIError(err error) error {
+	if _t, ok := err.(oss.ServiceError); ok {
+		if isAbleToRetry(_t.Code) {
+			return err
+		} else {
+			return backoff.Permanent(err)
+		}
+	} else {
+		return err
+	}
+}
+
+// Convert the list of string pointers returned by AliCloud API to the list
+// of Terraform string values.
+func convertStringPointersToAttrValues(values []*string) []attr.Value {
+	attrValues := []attr.Value{}
+	for _, value := range values {
+		attrValues = append(attrValues, types.StringValue(tea.StringValue(value)))
+	}
+	return attrValues
+}
+
+// Convert the Terraform list of string values to the list of strings.
+func convertListValueToStrings(list types.List) []string {
+	values := []string{}
+	for _, element := range list.Elements() {
+		if value, ok := element.(types.String); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
+
+// Convert the map of strings to the Terraform map of string values.
+func convertStringMapToMapValue(values map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// Compare the JSON strings ignoring the formatting, the strings which are not
+// valid JSON are compared as is.
+func isJsonStringEqual(a, b string) bool {
+	var aValue, bValue interface{}
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// Returns the strings in a which are not in b.
+func convertStringsDifference(a, b []string) []string {
+	exists := make(map[string]struct{})
+	for _, value := range b {
+		exists[value] = struct{}{}
+	}
+
+	var difference []string
+	for _, value := range a {
+		if _, ok := exists[value]; !ok {
+			difference = append(difference, value)
+		}
+	}
+	return difference
+}
+
+// Function to parse the import ID of the permission resources in the format
+// of <uid> or <uid>:<resource_id>[,<resource_id>...], and returns the uid with
+// the set of resource IDs to be imported (empty means all).
+func parsePermissionImportID(id string) (string, map[string]bool, error) {
+	ids := strings.Split(id, ":")
+	if len(ids) > 2 || ids[0] == "" {
+		return "", nil, fmt.Errorf("invalid import identifier: %q", id)
+	}
+
+	resourceIds := map[string]bool{}
+	if len(ids) == 2 {
+		for _, resourceId := range strings.Split(ids[1], ",") {
+			if resourceId = strings.TrimSpace(resourceId); resourceId == "" {
+				return "", nil, fmt.Errorf("invalid import identifier: %q", id)
+			}
+			resourceIds[resourceId] = true
+		}
+	}
+	return ids[0], resourceIds, nil
+}
+
+// Function to convert the string value to a pointer, returns nil if the value
+// is null or unknown.
+func stringPointerOrNil(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.String(value.ValueString())
+}
+
+// Function to convert the int64 value to an int32 pointer, returns nil if the
+// value is null or unknown.
+func int32PointerOrNil(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Int32(int32(value.ValueInt64()))
+}
+
+// Function to convert the string returned by the API to a string value, keeps
+// it null if it was not set before and the API returns an empty string.
+func stringValueOrNull(previous types.String, value *string) types.String {
+	if previous.IsNull() && tea.StringValue(value) == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(tea.StringValue(value))
+}
+
+// Function to convert the int32 returned by the API to an int64 value, keeps
+// it null if it was not set before and the API returns zero.
+func int64ValueOrNull(previous types.Int64, value *int32) types.Int64 {
+	if previous.IsNull() && tea.Int32Value(value) == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(tea.Int32Value(value)))
+}
+
+// Function to convert the bool returned by the API to a bool value, keeps it
+// null if it was not set before and the API returns false.
+func boolValueOrNull(previous types.Bool, value *bool) types.Bool {
+	if previous.IsNull() && !tea.BoolValue(value) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(tea.BoolValue(value))
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	alicloudAdbClient "github.com/alibabacloud-go/adb-20190315/v2/client"
@@ -17,7 +22,7 @@ import (
 	alicloudBaseClient "github.com/alibabacloud-go/bssopenapi-20171214/v3/client"
 	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
 	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
-	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v4/client"
+	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v5/client"
 	alicloudOpenapiClient "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	alicloudAntiddosClient "github.com/alibabacloud-go/ddoscoo-20200101/v2/client"
 	alicloudEmrClient "github.com/alibabacloud-go/emr-20210320/client"
@@ -25,24 +30,77 @@ import (
 	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
 	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
 	alicloudServicemeshClient  "github.com/alibabacloud-go/servicemesh-20200111/v4/client"
+	alicloudSlsClient "github.com/alibabacloud-go/sls-20201230/v5/client"
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	alicloudNlbClient "github.com/alibabacloud-go/nlb-20220430/v2/client"
+	alicloudStsClient "github.com/alibabacloud-go/sts-20150401/v2/client"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+	alicloudVpcipamClient "github.com/alibabacloud-go/vpcipam-20230228/client"
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	alicloudDcdnClient "github.com/alibabacloud-go/dcdn-20180115/v3/client"
+	alicloudWafClient "github.com/alibabacloud-go/waf-openapi-20211001/v4/client"
+	alicloudOosClient "github.com/alibabacloud-go/oos-20190601/v3/client"
+	alicloudHologramClient "github.com/alibabacloud-go/hologram-20220601/client"
+	alicloudDataworksClient "github.com/alibabacloud-go/dataworks-public-20200518/v5/client"
+	alicloudAiworkspaceClient "github.com/alibabacloud-go/aiworkspace-20210204/v3/client"
+	alicloudCasClient "github.com/alibabacloud-go/cas-20200407/v3/client"
+	alicloudCloudfwClient "github.com/alibabacloud-go/cloudfw-20171207/v7/client"
+	alicloudMscClient "github.com/alibabacloud-go/mscopensubscription-20210713/client"
+	alicloudRosClient "github.com/alibabacloud-go/ros-20190910/v4/client"
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	alicloudTagClient "github.com/alibabacloud-go/tag-20180828/v2/client"
+	alicloudConfigClient "github.com/alibabacloud-go/config-20200907/v3/client"
+	alicloudVpcpeerClient "github.com/alibabacloud-go/vpcpeer-20220101/v2/client"
 
 	"github.com/alibabacloud-go/tea/tea"
 )
 
 // Wrapper of AliCloud client
 type alicloudClients struct {
-	baseClient        *alicloudBaseClient.Client
-	cdnClient         *alicloudCdnClient.Client
-	antiddosClient    *alicloudAntiddosClient.Client
-	slbClient         *alicloudSlbClient.Client
-	dnsClient         *alicloudDnsClient.Client
-	ramClient         *alicloudRamClient.Client
-	cmsClient         *alicloudCmsClient.Client
-	adbClient         *alicloudAdbClient.Client
-	emrClient         *alicloudEmrClient.Client
-	csClient          *alicloudCsClient.Client
-	essClient         *alicloudEssClient.Client
-	servicemeshClient *alicloudServicemeshClient.Client
+	baseClient            *alicloudBaseClient.Client
+	cdnClient             *alicloudCdnClient.Client
+	antiddosClient        *alicloudAntiddosClient.Client
+	slbClient             *alicloudSlbClient.Client
+	dnsClient             *alicloudDnsClient.Client
+	ramClient             *alicloudRamClient.Client
+	cmsClient             *alicloudCmsClient.Client
+	adbClient             *alicloudAdbClient.Client
+	emrClient             *alicloudEmrClient.Client
+	csClient              *alicloudCsClient.Client
+	essClient             *alicloudEssClient.Client
+	servicemeshClient     *alicloudServicemeshClient.Client
+	slsClient             *alicloudSlsClient.Client
+	ossClient             *alicloudOssClient.Client
+	kmsClient             *alicloudKmsClient.Client
+	albClient             *alicloudAlbClient.Client
+	nlbClient             *alicloudNlbClient.Client
+	stsClient             *alicloudStsClient.Client
+	vpcClient             *alicloudVpcClient.Client
+	vpcipamClient         *alicloudVpcipamClient.Client
+	ecsClient             *alicloudEcsClient.Client
+	armsClient            *alicloudArmsClient.Client
+	resourcemanagerClient *alicloudResourceManagerClient.Client
+	dcdnClient            *alicloudDcdnClient.Client
+	wafClient             *alicloudWafClient.Client
+	oosClient             *alicloudOosClient.Client
+	hologramClient        *alicloudHologramClient.Client
+	dataworksClient       *alicloudDataworksClient.Client
+	aiworkspaceClient     *alicloudAiworkspaceClient.Client
+	casClient             *alicloudCasClient.Client
+	cloudfwClient         *alicloudCloudfwClient.Client
+	mscClient             *alicloudMscClient.Client
+	rosClient             *alicloudRosClient.Client
+	cloudssoClient        *alicloudCloudssoClient.Client
+	tagClient             *alicloudTagClient.Client
+	configClient          *alicloudConfigClient.Client
+	vpcpeerClient         *alicloudVpcpeerClient.Client
+	readOnly              bool
+	adoptExisting         bool
+	namePrefix            string
 }
 
 // Ensure the implementation satisfies the expected interfaces
@@ -58,9 +116,22 @@ func New() provider.Provider {
 type alicloudProvider struct{}
 
 type alicloudProviderModel struct {
-	Region    types.String `tfsdk:"region"`
-	AccessKey types.String `tfsdk:"access_key"`
-	SecretKey types.String `tfsdk:"secret_key"`
+	Region              types.String                `tfsdk:"region"`
+	AccessKey           types.String                `tfsdk:"access_key"`
+	SecretKey           types.String                `tfsdk:"secret_key"`
+	ReadOnly            types.Bool                  `tfsdk:"read_only"`
+	AdoptExisting       types.Bool                  `tfsdk:"adopt_existing_resources"`
+	NamePrefix          types.String                `tfsdk:"name_prefix"`
+	Retry               *alicloudProviderRetryModel `tfsdk:"retry"`
+	ValidateOnConfigure types.Bool                  `tfsdk:"validate_on_configure"`
+}
+
+type alicloudProviderRetryModel struct {
+	MaxElapsedTime                    types.String  `tfsdk:"max_elapsed_time"`
+	MaxAttempts                       types.Int64   `tfsdk:"max_attempts"`
+	Jitter                            types.Float64 `tfsdk:"jitter"`
+	ThrottlingCircuitBreakerThreshold types.Int64   `tfsdk:"throttling_circuit_breaker_threshold"`
+	ThrottlingCircuitBreakerCooldown  types.String  `tfsdk:"throttling_circuit_breaker_cooldown"`
 }
 
 // Metadata returns the provider type name.
@@ -87,6 +158,73 @@ func (p *alicloudProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"read_only": schema.BoolAttribute{
+				Description: "Reject all create, update and delete operations of resources while still allowing " +
+					"reads and data sources, e.g. for drift detection pipelines using production credentials. " +
+					"May also be provided via ALICLOUD_READ_ONLY environment variable.",
+				Optional: true,
+			},
+			"adopt_existing_resources": schema.BoolAttribute{
+				Description: "Adopt the existing resources when creating the RAM policies, RAM group memberships " +
+					"and CMS event rule targets, instead of failing with the already exists error, e.g. for the " +
+					"resources pre-created by legacy scripts. May also be provided via ALICLOUD_ADOPT_EXISTING_RESOURCES " +
+					"environment variable.",
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "The prefix applied to the names of the objects generated by the provider, e.g. the " +
+					"combined RAM policies, so that multiple workspaces can manage the same account without name " +
+					"collisions. May also be provided via ALICLOUD_NAME_PREFIX environment variable.",
+				Optional: true,
+			},
+			"validate_on_configure": schema.BoolAttribute{
+				Description: "Validate the credentials, the clock and the basic RAM permissions with cheap read " +
+					"calls when the provider is configured, so that a bad access key or a missing permission fails " +
+					"the plan early instead of the apply midway. May also be provided via ALICLOUD_VALIDATE_ON_CONFIGURE " +
+					"environment variable.",
+				Optional: true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "The retry settings of the AliCloud API calls, e.g. to reduce the retries when the API " +
+					"is throttled by many workspaces applying at the same time.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max_elapsed_time": schema.StringAttribute{
+						Description: "The maximum time to retry an API call, e.g. `30s` or `2m`. Default to `30s`.",
+						Optional:    true,
+					},
+					"max_attempts": schema.Int64Attribute{
+						Description: "The maximum number of attempts of an API call, including the first attempt. " +
+							"Default to 0, which is limited by max_elapsed_time only.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"jitter": schema.Float64Attribute{
+						Description: "The randomization factor of the retry interval, from 0 to 1, so that the " +
+							"resources retrying at the same time are spread out. Default to 0.5.",
+						Optional: true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+					"throttling_circuit_breaker_threshold": schema.Int64Attribute{
+						Description: "Abort all the remaining API calls of the apply after this number of consecutive " +
+							"throttling failures, instead of every resource retrying against the throttled API. " +
+							"Default to 0, which disables the circuit breaker.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"throttling_circuit_breaker_cooldown": schema.StringAttribute{
+						Description: "The time to resume the API calls after the circuit breaker is opened, e.g. `1m`. " +
+							"Default to empty, which aborts the API calls for the rest of the apply.",
+						Optional: true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -129,6 +267,44 @@ func (p *alicloudProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown AliCloud read-only mode",
+			"The provider cannot determine the read-only mode as there is an unknown configuration value for the "+
+				"read_only attribute. Set the value statically in the configuration, or use the ALICLOUD_READ_ONLY environment variable.",
+		)
+	}
+
+	if config.AdoptExisting.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("adopt_existing_resources"),
+			"Unknown AliCloud adopt existing resources option",
+			"The provider cannot determine whether to adopt the existing resources as there is an unknown configuration "+
+				"value for the adopt_existing_resources attribute. Set the value statically in the configuration, or use "+
+				"the ALICLOUD_ADOPT_EXISTING_RESOURCES environment variable.",
+		)
+	}
+
+	if config.NamePrefix.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_prefix"),
+			"Unknown AliCloud name prefix",
+			"The provider cannot determine the name prefix as there is an unknown configuration value for the "+
+				"name_prefix attribute. Set the value statically in the configuration, or use the ALICLOUD_NAME_PREFIX environment variable.",
+		)
+	}
+
+	if config.ValidateOnConfigure.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_on_configure"),
+			"Unknown AliCloud validate on configure option",
+			"The provider cannot determine whether to validate the credentials as there is an unknown configuration "+
+				"value for the validate_on_configure attribute. Set the value statically in the configuration, or use "+
+				"the ALICLOUD_VALIDATE_ON_CONFIGURE environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -196,6 +372,102 @@ func (p *alicloudProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	readOnly := false
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	} else if v := os.Getenv("ALICLOUD_READ_ONLY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid AliCloud read-only mode",
+				"The provider cannot parse the value of the ALICLOUD_READ_ONLY environment variable "+
+					"as a boolean: "+err.Error(),
+			)
+			return
+		}
+		readOnly = parsed
+	}
+
+	adoptExisting := false
+	if !config.AdoptExisting.IsNull() {
+		adoptExisting = config.AdoptExisting.ValueBool()
+	} else if v := os.Getenv("ALICLOUD_ADOPT_EXISTING_RESOURCES"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("adopt_existing_resources"),
+				"Invalid AliCloud adopt existing resources option",
+				"The provider cannot parse the value of the ALICLOUD_ADOPT_EXISTING_RESOURCES environment variable "+
+					"as a boolean: "+err.Error(),
+			)
+			return
+		}
+		adoptExisting = parsed
+	}
+
+	validateOnConfigure := false
+	if !config.ValidateOnConfigure.IsNull() {
+		validateOnConfigure = config.ValidateOnConfigure.ValueBool()
+	} else if v := os.Getenv("ALICLOUD_VALIDATE_ON_CONFIGURE"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validate_on_configure"),
+				"Invalid AliCloud validate on configure option",
+				"The provider cannot parse the value of the ALICLOUD_VALIDATE_ON_CONFIGURE environment variable "+
+					"as a boolean: "+err.Error(),
+			)
+			return
+		}
+		validateOnConfigure = parsed
+	}
+
+	var namePrefix string
+	if !config.NamePrefix.IsNull() {
+		namePrefix = config.NamePrefix.ValueString()
+	} else {
+		namePrefix = os.Getenv("ALICLOUD_NAME_PREFIX")
+	}
+
+	settings := defaultRetrySettings
+	if config.Retry != nil {
+		if !config.Retry.MaxElapsedTime.IsNull() {
+			maxElapsedTime, err := time.ParseDuration(config.Retry.MaxElapsedTime.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retry").AtName("max_elapsed_time"),
+					"Invalid AliCloud retry maximum elapsed time",
+					"The provider cannot parse the value of the max_elapsed_time attribute as a duration: "+err.Error(),
+				)
+				return
+			}
+			settings.maxElapsedTime = maxElapsedTime
+		}
+		if !config.Retry.MaxAttempts.IsNull() {
+			settings.maxAttempts = uint64(config.Retry.MaxAttempts.ValueInt64())
+		}
+		if !config.Retry.Jitter.IsNull() {
+			settings.jitter = config.Retry.Jitter.ValueFloat64()
+		}
+		if !config.Retry.ThrottlingCircuitBreakerThreshold.IsNull() {
+			settings.throttlingThreshold = int(config.Retry.ThrottlingCircuitBreakerThreshold.ValueInt64())
+		}
+		if !config.Retry.ThrottlingCircuitBreakerCooldown.IsNull() {
+			throttlingCooldown, err := time.ParseDuration(config.Retry.ThrottlingCircuitBreakerCooldown.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retry").AtName("throttling_circuit_breaker_cooldown"),
+					"Invalid AliCloud retry circuit breaker cooldown",
+					"The provider cannot parse the value of the throttling_circuit_breaker_cooldown attribute as a duration: "+err.Error(),
+				)
+				return
+			}
+			settings.throttlingCooldown = throttlingCooldown
+		}
+	}
+	apiRetrier = newRetrier(settings)
+
 	clientCredentialsConfig := &alicloudOpenapiClient.Config{
 		RegionId:        &region,
 		AccessKeyId:     &accessKey,
@@ -375,20 +647,428 @@ func (p *alicloudProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// AliCloud SLS Client
+	slsClientConfig := clientCredentialsConfig
+	slsClientConfig.Endpoint = tea.String(fmt.Sprintf("%s.log.aliyuncs.com", region))
+	slsClient, err := alicloudSlsClient.NewClient(slsClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud SLS API Client",
+			"An unexpected error occurred when creating the AliCloud SLS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud SLS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud OSS Client
+	ossClient, err := alicloudOssClient.New(fmt.Sprintf("oss-%s.aliyuncs.com", region), accessKey, secretKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud OSS API Client",
+			"An unexpected error occurred when creating the AliCloud OSS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud OSS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud KMS Client
+	kmsClientConfig := clientCredentialsConfig
+	kmsClientConfig.Endpoint = tea.String(fmt.Sprintf("kms.%s.aliyuncs.com", region))
+	kmsClient, err := alicloudKmsClient.NewClient(kmsClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud KMS API Client",
+			"An unexpected error occurred when creating the AliCloud KMS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud KMS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud ALB Client
+	albClientConfig := clientCredentialsConfig
+	albClientConfig.Endpoint = tea.String(fmt.Sprintf("alb.%s.aliyuncs.com", region))
+	albClient, err := alicloudAlbClient.NewClient(albClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud ALB API Client",
+			"An unexpected error occurred when creating the AliCloud ALB API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud ALB Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud NLB Client
+	nlbClientConfig := clientCredentialsConfig
+	nlbClientConfig.Endpoint = tea.String(fmt.Sprintf("nlb.%s.aliyuncs.com", region))
+	nlbClient, err := alicloudNlbClient.NewClient(nlbClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud NLB API Client",
+			"An unexpected error occurred when creating the AliCloud NLB API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud NLB Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud STS Client
+	stsClientConfig := clientCredentialsConfig
+	stsClientConfig.Endpoint = tea.String(fmt.Sprintf("sts.%s.aliyuncs.com", region))
+	stsClient, err := alicloudStsClient.NewClient(stsClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud STS API Client",
+			"An unexpected error occurred when creating the AliCloud STS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud STS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud VPC Client
+	vpcClientConfig := clientCredentialsConfig
+	vpcClientConfig.Endpoint = tea.String(fmt.Sprintf("vpc.%s.aliyuncs.com", region))
+	vpcClient, err := alicloudVpcClient.NewClient(vpcClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud VPC API Client",
+			"An unexpected error occurred when creating the AliCloud VPC API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud VPC Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud VPC IPAM Client
+	vpcipamClientConfig := clientCredentialsConfig
+	vpcipamClientConfig.Endpoint = tea.String(fmt.Sprintf("vpcipam.%s.aliyuncs.com", region))
+	vpcipamClient, err := alicloudVpcipamClient.NewClient(vpcipamClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud VPC IPAM API Client",
+			"An unexpected error occurred when creating the AliCloud VPC IPAM API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud VPC IPAM Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud ECS Client
+	ecsClientConfig := clientCredentialsConfig
+	ecsClientConfig.Endpoint = tea.String(fmt.Sprintf("ecs.%s.aliyuncs.com", region))
+	ecsClient, err := alicloudEcsClient.NewClient(ecsClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud ECS API Client",
+			"An unexpected error occurred when creating the AliCloud ECS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud ECS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud ARMS Client
+	armsClientConfig := clientCredentialsConfig
+	armsClientConfig.Endpoint = tea.String(fmt.Sprintf("arms.%s.aliyuncs.com", region))
+	armsClient, err := alicloudArmsClient.NewClient(armsClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud ARMS API Client",
+			"An unexpected error occurred when creating the AliCloud ARMS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud ARMS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Resource Manager Client
+	resourcemanagerClientConfig := clientCredentialsConfig
+	resourcemanagerClientConfig.Endpoint = tea.String("resourcemanager.aliyuncs.com")
+	resourcemanagerClient, err := alicloudResourceManagerClient.NewClient(resourcemanagerClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Resource Manager API Client",
+			"An unexpected error occurred when creating the AliCloud Resource Manager API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Resource Manager Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud DCDN Client
+	dcdnClientConfig := clientCredentialsConfig
+	dcdnClientConfig.Endpoint = tea.String("dcdn.aliyuncs.com")
+	dcdnClient, err := alicloudDcdnClient.NewClient(dcdnClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud DCDN API Client",
+			"An unexpected error occurred when creating the AliCloud DCDN API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud DCDN Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud WAF Client
+	wafClientConfig := clientCredentialsConfig
+	wafClientConfig.Endpoint = tea.String(fmt.Sprintf("wafopenapi.%s.aliyuncs.com", region))
+	wafClient, err := alicloudWafClient.NewClient(wafClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud WAF API Client",
+			"An unexpected error occurred when creating the AliCloud WAF API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud WAF Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud OOS Client
+	oosClientConfig := clientCredentialsConfig
+	oosClientConfig.Endpoint = tea.String(fmt.Sprintf("oos.%s.aliyuncs.com", region))
+	oosClient, err := alicloudOosClient.NewClient(oosClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud OOS API Client",
+			"An unexpected error occurred when creating the AliCloud OOS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud OOS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Hologres Client
+	hologramClientConfig := clientCredentialsConfig
+	hologramClientConfig.Endpoint = tea.String(fmt.Sprintf("hologram.%s.aliyuncs.com", region))
+	hologramClient, err := alicloudHologramClient.NewClient(hologramClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Hologres API Client",
+			"An unexpected error occurred when creating the AliCloud Hologres API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Hologres Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud DataWorks Client
+	dataworksClientConfig := clientCredentialsConfig
+	dataworksClientConfig.Endpoint = tea.String(fmt.Sprintf("dataworks.%s.aliyuncs.com", region))
+	dataworksClient, err := alicloudDataworksClient.NewClient(dataworksClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud DataWorks API Client",
+			"An unexpected error occurred when creating the AliCloud DataWorks API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud DataWorks Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud PAI Workspace Client
+	aiworkspaceClientConfig := clientCredentialsConfig
+	aiworkspaceClientConfig.Endpoint = tea.String(fmt.Sprintf("aiworkspace.%s.aliyuncs.com", region))
+	aiworkspaceClient, err := alicloudAiworkspaceClient.NewClient(aiworkspaceClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud PAI Workspace API Client",
+			"An unexpected error occurred when creating the AliCloud PAI Workspace API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud PAI Workspace Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud CAS Client
+	casClientConfig := clientCredentialsConfig
+	casClientConfig.Endpoint = tea.String("cas.aliyuncs.com")
+	casClient, err := alicloudCasClient.NewClient(casClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud CAS API Client",
+			"An unexpected error occurred when creating the AliCloud CAS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud CAS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Cloud Firewall Client
+	cloudfwClientConfig := clientCredentialsConfig
+	cloudfwClientConfig.Endpoint = tea.String("cloudfw.aliyuncs.com")
+	cloudfwClient, err := alicloudCloudfwClient.NewClient(cloudfwClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Cloud Firewall API Client",
+			"An unexpected error occurred when creating the AliCloud Cloud Firewall API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Cloud Firewall Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Message Center Client
+	mscClientConfig := clientCredentialsConfig
+	mscClientConfig.Endpoint = tea.String("mscopensubscription.aliyuncs.com")
+	mscClient, err := alicloudMscClient.NewClient(mscClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Message Center API Client",
+			"An unexpected error occurred when creating the AliCloud Message Center API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Message Center Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud ROS Client
+	rosClientConfig := clientCredentialsConfig
+	rosClientConfig.Endpoint = tea.String("ros.aliyuncs.com")
+	rosClient, err := alicloudRosClient.NewClient(rosClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud ROS API Client",
+			"An unexpected error occurred when creating the AliCloud ROS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud ROS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud CloudSSO Client
+	cloudssoClientConfig := clientCredentialsConfig
+	cloudssoClientConfig.Endpoint = tea.String(fmt.Sprintf("cloudsso.%s.aliyuncs.com", region))
+	cloudssoClient, err := alicloudCloudssoClient.NewClient(cloudssoClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud CloudSSO API Client",
+			"An unexpected error occurred when creating the AliCloud CloudSSO API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud CloudSSO Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Tag Client
+	tagClientConfig := clientCredentialsConfig
+	tagClientConfig.Endpoint = tea.String(fmt.Sprintf("tag.%s.aliyuncs.com", region))
+	tagClient, err := alicloudTagClient.NewClient(tagClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Tag API Client",
+			"An unexpected error occurred when creating the AliCloud Tag API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Tag Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud Config Client
+	configClientConfig := clientCredentialsConfig
+	configClientConfig.Endpoint = tea.String("config.cn-shanghai.aliyuncs.com")
+	configClient, err := alicloudConfigClient.NewClient(configClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud Config API Client",
+			"An unexpected error occurred when creating the AliCloud Config API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud Config Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	// AliCloud VPC Peer Client
+	vpcpeerClientConfig := clientCredentialsConfig
+	vpcpeerClientConfig.Endpoint = tea.String("vpcpeer.aliyuncs.com")
+	vpcpeerClient, err := alicloudVpcpeerClient.NewClient(vpcpeerClientConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create AliCloud VPC Peer API Client",
+			"An unexpected error occurred when creating the AliCloud VPC Peer API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud VPC Peer Client Error: "+err.Error(),
+		)
+		return
+	}
+
 	// AliCloud clients wrapper
 	alicloudClients := alicloudClients{
-		baseClient:        baseClient,
-		cdnClient:         cdnClient,
-		antiddosClient:    antiddosClient,
-		slbClient:         slbClient,
-		dnsClient:         dnsClient,
-		ramClient:         ramClient,
-		cmsClient:         cmsClient,
-		adbClient:         adbClient,
-		emrClient:         emrClient,
-		csClient:          csClient,
-		essClient:         essClient,
-		servicemeshClient: servicemeshClient,
+		baseClient:            baseClient,
+		cdnClient:             cdnClient,
+		antiddosClient:        antiddosClient,
+		slbClient:             slbClient,
+		dnsClient:             dnsClient,
+		ramClient:             ramClient,
+		cmsClient:             cmsClient,
+		adbClient:             adbClient,
+		emrClient:             emrClient,
+		csClient:              csClient,
+		essClient:             essClient,
+		servicemeshClient:     servicemeshClient,
+		slsClient:             slsClient,
+		ossClient:             ossClient,
+		kmsClient:             kmsClient,
+		albClient:             albClient,
+		nlbClient:             nlbClient,
+		stsClient:             stsClient,
+		vpcClient:             vpcClient,
+		vpcipamClient:         vpcipamClient,
+		ecsClient:             ecsClient,
+		armsClient:            armsClient,
+		resourcemanagerClient: resourcemanagerClient,
+		dcdnClient:            dcdnClient,
+		wafClient:             wafClient,
+		oosClient:             oosClient,
+		hologramClient:        hologramClient,
+		dataworksClient:       dataworksClient,
+		aiworkspaceClient:     aiworkspaceClient,
+		casClient:             casClient,
+		cloudfwClient:         cloudfwClient,
+		mscClient:             mscClient,
+		rosClient:             rosClient,
+		cloudssoClient:        cloudssoClient,
+		tagClient:             tagClient,
+		configClient:          configClient,
+		vpcpeerClient:         vpcpeerClient,
+		readOnly:              readOnly,
+		adoptExisting:         adoptExisting,
+		namePrefix:            namePrefix,
+	}
+
+	if validateOnConfigure {
+		resp.Diagnostics.Append(validateProviderCredentials(stsClient, ecsClient, region)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.DataSourceData = alicloudClients
@@ -402,11 +1082,27 @@ func (p *alicloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewDdosCooDomainResourcesDataSource,
 		NewSlbLoadBalancersDataSource,
 		NewCsUserKubeconfigDataSource,
+		NewStsAssumeRoleDataSource,
+		NewVpcNatGatewaysDataSource,
+		NewEssScalingGroupsDataSource,
+		NewAlbLoadBalancersDataSource,
+		NewAlbServerGroupsDataSource,
+		NewResourceManagerTrustedServicesDataSource,
+		NewRamConditionalPolicyDocumentDataSource,
+		NewSlbListenersDataSource,
+		NewGaBandwidthUsageDataSource,
+		NewCdnQuotaUsageDataSource,
+		NewCdnDomainsDataSource,
+		NewAliDnsRecordsDataSource,
+		NewRamServiceLinkedRolesDataSource,
+		NewOssBucketObjectsDataSource,
+		NewKmsSecretVersionDataSource,
+		NewTagComplianceDataSource,
 	}
 }
 
 func (p *alicloudProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return newReadOnlyResources([]func() resource.Resource{
 		NewAliDnsRecordWeightResource,
 		NewAliDnsGtmInstanceResource,
 		NewRamUserGroupAttachmentResource,
@@ -422,5 +1118,98 @@ func (p *alicloudProvider) Resources(_ context.Context) []func() resource.Resour
 		NewEssClbDefaultServerGroupAttachmentResource,
 		NewCsKubernetesPermissionsResource,
 		NewServicemeshUserPermissionResource,
-	}
+		NewCsClusterAuditLogResource,
+		NewSlbVServerGroupResource,
+		NewSlbListenerRuleResource,
+		NewOssBucketWebsiteResource,
+		NewServicemeshClusterAttachmentResource,
+		NewServicemeshGatewayResource,
+		NewOssBucketRefererResource,
+		NewKmsKeyGrantResource,
+		NewEssAttachAlbServerGroupResource,
+		NewKmsSecretRotationResource,
+		NewEssAttachNlbServerGroupResource,
+		NewEssScalingRuleBatchResource,
+		NewEssLifecycleHookResource,
+		NewEssScheduledTaskResource,
+		NewVpcNatDnatRulesResource,
+		NewEssInstanceProtectionResource,
+		NewEssScalingGroupWarmPoolResource,
+		NewEssScalingGroupMixedInstancesPolicyResource,
+		NewVpcIpamPoolResource,
+		NewVpcIpamPoolAllocationResource,
+		NewEcsSnapshotResource,
+		NewEcsImageResource,
+		NewEcsImageCopyResource,
+		NewAlbListenerCertificateAttachmentResource,
+		NewEcsImageSharePermissionResource,
+		NewAlbRulePriorityManagerResource,
+		NewEcsActivationResource,
+		NewArmsPrometheusInstanceResource,
+		NewArmsPrometheusAckIntegrationResource,
+		NewArmsPrometheusRemoteWriteResource,
+		NewArmsAlertRuleResource,
+		NewArmsNotificationPolicyResource,
+		NewNlbServerGroupServerAttachmentResource,
+		NewCmsCustomMetricNamespaceResource,
+		NewEssScalingGroupTagsResource,
+		NewAlbAccessLogResource,
+		NewSlbAclAttachmentResource,
+		NewLoadBalancerProtectionResource,
+		NewCmsMetricRuleBatchResource,
+		NewCmsEventRuleResource,
+		NewWafManagedRuleGroupResource,
+		NewDdosCooSchedulerRuleResource,
+		NewCdnDomainResource,
+		NewCdnDomainSslCertificateResource,
+		NewCdnCacheRefreshResource,
+		NewEipBandwidthScheduleResource,
+		NewDcdnDomainResource,
+		NewHologresInstanceUserResource,
+		NewHologresWarehouseResource,
+		NewAliDnsRecordWeightedResource,
+		NewDataworksProjectMemberResource,
+		NewAliDnsGtmInstanceConfigResource,
+		NewPaiWorkspaceMemberResource,
+		NewAliDnsDomainResolutionLineResource,
+		NewEcsNetworkInterfaceResource,
+		NewEcsNetworkInterfaceAttachmentResource,
+		NewCasCertificateResource,
+		NewVpcDhcpOptionsSetResource,
+		NewVpcDhcpOptionsSetAttachmentResource,
+		NewCasCertificateDeploymentResource,
+		NewVpcTrafficMirrorFilterResource,
+		NewVpcTrafficMirrorSessionResource,
+		NewVpcFlowLogResource,
+		NewVpcPeeringConnectionResource,
+		NewOssBucketResource,
+		NewCloudfwAddressBookResource,
+		NewCloudfwNatFirewallControlPolicyResource,
+		NewArmsWebhookContactResource,
+		NewOssObjectResource,
+		NewResourceManagerServiceLinkedRoleResource,
+		NewMscNotificationSettingsResource,
+		NewKmsKeyResource,
+		NewKmsAliasResource,
+		NewAlbServerGroupServerAttachmentResource,
+		NewKmsSecretResource,
+		NewLoadBalancerZoneMappingsResource,
+		NewCsClusterRrsaResource,
+		NewRosStackResource,
+		NewServicemeshSidecarInjectionResource,
+		NewResourceManagerAccountResource,
+		NewServicemeshTrafficPolicyResource,
+		NewResourceManagerControlPolicyAttachmentResource,
+		NewResourceManagerDelegatedAdminResource,
+		NewResourceManagerTrustedServiceResource,
+		NewCloudssoDirectoryResource,
+		NewCloudssoUserResource,
+		NewCloudssoGroupResource,
+		NewCloudssoAccessConfigurationResource,
+		NewCloudssoAccessAssignmentResource,
+		NewCmsMetricRuleByTagResource,
+		NewConfigRuleResource,
+		NewConfigCompliancePackResource,
+		NewAliDnsRecordFailoverResource,
+	})
 }
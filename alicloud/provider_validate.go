@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	alicloudStsClient "github.com/alibabacloud-go/sts-20150401/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// Function to validate the provider credentials with cheap read calls, so
+// that a bad access key, a skewed clock or a missing RAM permission is
+// reported when the provider is configured, instead of failing the apply
+// midway.
+func validateProviderCredentials(stsClient *alicloudStsClient.Client, ecsClient *alicloudEcsClient.Client, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var getCallerIdentityResponse *alicloudStsClient.GetCallerIdentityResponse
+	getCallerIdentity := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		getCallerIdentityResponse, err = stsClient.GetCallerIdentityWithOptions(runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getCallerIdentity); err != nil {
+		diags.Append(newProviderValidationDiagnostic("sts:GetCallerIdentity", err))
+		return diags
+	}
+
+	var describeRegionsResponse *alicloudEcsClient.DescribeRegionsResponse
+	describeRegions := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeRegionsRequest := &alicloudEcsClient.DescribeRegionsRequest{}
+
+		var err error
+		describeRegionsResponse, err = ecsClient.DescribeRegionsWithOptions(describeRegionsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeRegions); err != nil {
+		diags.Append(newProviderValidationDiagnostic("ecs:DescribeRegions", err))
+		return diags
+	}
+
+	var regionIds []string
+	if describeRegionsResponse.Body.Regions != nil {
+		for _, r := range describeRegionsResponse.Body.Regions.Region {
+			regionIds = append(regionIds, tea.StringValue(r.RegionId))
+		}
+	}
+	if len(regionIds) > 0 && len(convertStringsDifference([]string{region}, regionIds)) > 0 {
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Invalid AliCloud API region",
+			fmt.Sprintf("The region %s is not available to the account %s, the available regions are: %s.",
+				region,
+				tea.StringValue(getCallerIdentityResponse.Body.AccountId),
+				strings.Join(regionIds, ", ")),
+		)
+	}
+
+	return diags
+}
+
+// Function to convert the error of the validation call to a diagnostic with
+// the actionable guidance of the common causes.
+func newProviderValidationDiagnostic(action string, err error) diag.Diagnostic {
+	var code string
+	var sdkError *tea.SDKError
+	if errors.As(err, &sdkError) {
+		code = tea.StringValue(sdkError.Code)
+	}
+
+	switch {
+	case strings.HasPrefix(code, "InvalidAccessKeyId"),
+		code == "SignatureDoesNotMatch",
+		code == "IncompleteSignature":
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("access_key"),
+			"Invalid AliCloud Credentials",
+			fmt.Sprintf("The provider failed to call %s as the access key or the secret key is invalid, "+
+				"disabled or deleted. Check the access_key and secret_key values in the configuration or the "+
+				"ALICLOUD_ACCESS_KEY and ALICLOUD_SECRET_KEY environment variables.\n\n%s", action, err.Error()),
+		)
+	case strings.HasPrefix(code, "InvalidTimeStamp"),
+		code == "RequestTimeTooSkewed":
+		return diag.NewErrorDiagnostic(
+			"AliCloud Request Time Skewed",
+			fmt.Sprintf("The provider failed to call %s as the request timestamp is rejected by AliCloud API. "+
+				"Synchronize the clock of the machine running Terraform, e.g. with NTP.\n\n%s", action, err.Error()),
+		)
+	case strings.HasPrefix(code, "Forbidden"),
+		strings.HasPrefix(code, "NoPermission"):
+		return diag.NewErrorDiagnostic(
+			"Missing AliCloud RAM Permission",
+			fmt.Sprintf("The provider failed to call %s as the RAM permission is missing. Grant the permission "+
+				"to the RAM user or role of the access key.\n\n%s", action, err.Error()),
+		)
+	default:
+		return diag.NewErrorDiagnostic(
+			"[API ERROR] Failed to Validate AliCloud Credentials.",
+			fmt.Sprintf("The provider failed to call %s.\n\n%s", action, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,116 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &readOnlyResource{}
+	_ resource.ResourceWithConfigure        = &readOnlyResource{}
+	_ resource.ResourceWithImportState      = &readOnlyResource{}
+	_ resource.ResourceWithModifyPlan       = &readOnlyResource{}
+	_ resource.ResourceWithConfigValidators = &readOnlyResource{}
+	_ resource.ResourceWithValidateConfig   = &readOnlyResource{}
+)
+
+// Wrapper of a resource which rejects the Create, Update and Delete calls
+// when the provider is configured as read-only, so that the production
+// credentials can be used for drift detection without making any changes.
+type readOnlyResource struct {
+	resource.Resource
+	readOnly bool
+}
+
+// Function to wrap the resource constructors with the read-only guard.
+func newReadOnlyResources(constructors []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(constructors))
+	for _, constructor := range constructors {
+		constructor := constructor
+		wrapped = append(wrapped, func() resource.Resource {
+			return &readOnlyResource{Resource: constructor()}
+		})
+	}
+	return wrapped
+}
+
+// Configure adds the provider configured client to the wrapped resource.
+func (r *readOnlyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		r.readOnly = req.ProviderData.(alicloudClients).readOnly
+	}
+
+	if wrapped, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		wrapped.Configure(ctx, req, resp)
+	}
+}
+
+func (r *readOnlyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create"))
+		return
+	}
+	r.Resource.Create(ctx, req, resp)
+}
+
+func (r *readOnlyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update"))
+		return
+	}
+	r.Resource.Update(ctx, req, resp)
+}
+
+func (r *readOnlyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete"))
+		return
+	}
+	r.Resource.Delete(ctx, req, resp)
+}
+
+// Importing only writes to the Terraform state, hence it is allowed in
+// read-only mode.
+func (r *readOnlyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	wrapped, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developers for additional information.",
+		)
+		return
+	}
+	wrapped.ImportState(ctx, req, resp)
+}
+
+func (r *readOnlyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if wrapped, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		wrapped.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *readOnlyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if wrapped, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return wrapped.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *readOnlyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if wrapped, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		wrapped.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// Function to build the diagnostic of the rejected operation.
+func readOnlyDiagnostic(operation string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Provider Is Read-Only",
+		"The provider is configured with read_only = true, unable to "+operation+" the resource. "+
+			"Reading resources and data sources is still allowed. Unset read_only or the ALICLOUD_READ_ONLY "+
+			"environment variable to apply the changes.",
+	)
+}
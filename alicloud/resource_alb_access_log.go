@@ -0,0 +1,478 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	alicloudSlsClient "github.com/alibabacloud-go/sls-20201230/v5/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &albAccessLogResource{}
+	_ resource.ResourceWithConfigure   = &albAccessLogResource{}
+	_ resource.ResourceWithImportState = &albAccessLogResource{}
+)
+
+func NewAlbAccessLogResource() resource.Resource {
+	return &albAccessLogResource{}
+}
+
+type albAccessLogResource struct {
+	client    *alicloudAlbClient.Client
+	slsClient *alicloudSlsClient.Client
+}
+
+// The product and data code of the NLB access logs in the SLS collection
+// policy APIs.
+const (
+	nlbAccessLogProductCode = "nlb"
+	nlbAccessLogDataCode    = "access_log"
+)
+
+type albAccessLogModel struct {
+	LoadBalancerId types.String `tfsdk:"load_balancer_id"`
+	LogProject     types.String `tfsdk:"log_project"`
+	LogStore       types.String `tfsdk:"log_store"`
+}
+
+// Metadata returns the ALB Access Log resource name.
+func (r *albAccessLogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_access_log"
+}
+
+// Schema defines the schema for the ALB Access Log resource.
+func (r *albAccessLogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable the access log delivery of an application load balancer (ALB) or a network load " +
+			"balancer (NLB) to a Logstore of Simple Log Service (SLS).",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Description: "The ID of the ALB or NLB. The access log delivery of an NLB is configured with an SLS " +
+					"collection policy, as the NLB API does not provide any operation to configure it.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_project": schema.StringAttribute{
+				Description: "The SLS project which the access logs are delivered to. The project must be in " +
+					"the same region as the load balancer.",
+				Required: true,
+			},
+			"log_store": schema.StringAttribute{
+				Description: "The SLS Logstore which the access logs are delivered to.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *albAccessLogResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).albClient
+	r.slsClient = req.ProviderData.(alicloudClients).slsClient
+}
+
+// Enable the access log delivery of the load balancer.
+func (r *albAccessLogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *albAccessLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if isNlbLoadBalancerId(plan.LoadBalancerId.ValueString()) {
+		err = r.upsertNlbAccessLog(plan)
+	} else {
+		err = r.enableAccessLog(plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Load Balancer Access Log.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the access log config of the load balancer. The resource is removed
+// from state when the access log delivery is disabled or the ALB is deleted.
+func (r *albAccessLogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *albAccessLogModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isNlbLoadBalancerId(state.LoadBalancerId.ValueString()) {
+		collectionPolicy, err := r.getNlbAccessLogPolicy(state.LoadBalancerId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Get Collection Policy.",
+				err.Error(),
+			)
+			return
+		}
+		if collectionPolicy == nil || !tea.BoolValue(collectionPolicy.Enabled) || collectionPolicy.CentralizeConfig == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		state.LogProject = types.StringValue(tea.StringValue(collectionPolicy.CentralizeConfig.DestProject))
+		state.LogStore = types.StringValue(tea.StringValue(collectionPolicy.CentralizeConfig.DestLogstore))
+
+		setStateDiags := resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(setStateDiags...)
+		return
+	}
+
+	accessLogConfig, err := r.getAccessLogConfig(state.LoadBalancerId.ValueString())
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ResourceNotFound.LoadBalancer" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Load Balancer Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	if accessLogConfig == nil || tea.StringValue(accessLogConfig.LogStore) == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.LogProject = types.StringValue(tea.StringValue(accessLogConfig.LogProject))
+	state.LogStore = types.StringValue(tea.StringValue(accessLogConfig.LogStore))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable and re-enable the access log delivery of the ALB with the new
+// Logstore, as AliCloud API does not support changing the Logstore of an
+// enabled access log delivery. The collection policy of the NLB is updated in
+// place.
+func (r *albAccessLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *albAccessLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isNlbLoadBalancerId(plan.LoadBalancerId.ValueString()) {
+		if err := r.upsertNlbAccessLog(plan); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Enable Load Balancer Access Log.",
+				err.Error(),
+			)
+			return
+		}
+
+		setStateDiags := resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(setStateDiags...)
+		return
+	}
+
+	err := r.disableAccessLog(plan.LoadBalancerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Load Balancer Access Log.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.enableAccessLog(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Load Balancer Access Log.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the access log delivery of the load balancer.
+func (r *albAccessLogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *albAccessLogModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isNlbLoadBalancerId(state.LoadBalancerId.ValueString()) {
+		if err := r.deleteNlbAccessLog(state.LoadBalancerId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Disable Load Balancer Access Log.",
+				err.Error(),
+			)
+		}
+		return
+	}
+
+	err := r.disableAccessLog(state.LoadBalancerId.ValueString())
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ResourceNotFound.LoadBalancer" {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Load Balancer Access Log.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *albAccessLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("load_balancer_id"), req, resp)
+}
+
+// Function to enable the access log delivery and wait until the access log
+// config of the ALB is updated, as the API is asynchronous.
+func (r *albAccessLogResource) enableAccessLog(model *albAccessLogModel) error {
+	enableLoadBalancerAccessLog := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		enableLoadBalancerAccessLogRequest := &alicloudAlbClient.EnableLoadBalancerAccessLogRequest{
+			LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			LogProject:     tea.String(model.LogProject.ValueString()),
+			LogStore:       tea.String(model.LogStore.ValueString()),
+		}
+
+		if _, err := r.client.EnableLoadBalancerAccessLogWithOptions(enableLoadBalancerAccessLogRequest, runtime); err != nil {
+			return handleAlbListenerAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(enableLoadBalancerAccessLog); err != nil {
+		return err
+	}
+	return r.waitAccessLogConfig(model.LoadBalancerId.ValueString(), model.LogStore.ValueString())
+}
+
+// Function to disable the access log delivery and wait until the access log
+// config of the ALB is cleared. Nothing is done if the access log delivery
+// is already disabled.
+func (r *albAccessLogResource) disableAccessLog(loadBalancerId string) error {
+	accessLogConfig, err := r.getAccessLogConfig(loadBalancerId)
+	if err != nil {
+		return err
+	}
+	if accessLogConfig == nil || tea.StringValue(accessLogConfig.LogStore) == "" {
+		return nil
+	}
+
+	disableLoadBalancerAccessLog := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		disableLoadBalancerAccessLogRequest := &alicloudAlbClient.DisableLoadBalancerAccessLogRequest{
+			LoadBalancerId: tea.String(loadBalancerId),
+		}
+
+		if _, err := r.client.DisableLoadBalancerAccessLogWithOptions(disableLoadBalancerAccessLogRequest, runtime); err != nil {
+			return handleAlbListenerAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(disableLoadBalancerAccessLog); err != nil {
+		return err
+	}
+	return r.waitAccessLogConfig(loadBalancerId, "")
+}
+
+// Function to wait until the access log of the ALB is delivered to the
+// Logstore, or is disabled if the Logstore is empty.
+func (r *albAccessLogResource) waitAccessLogConfig(loadBalancerId, logStore string) error {
+	waitAccessLogConfig := func() error {
+		accessLogConfig, err := r.getAccessLogConfig(loadBalancerId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		currentLogStore := ""
+		if accessLogConfig != nil {
+			currentLogStore = tea.StringValue(accessLogConfig.LogStore)
+		}
+		if currentLogStore != logStore {
+			return fmt.Errorf("access log config of load balancer %s is still being updated", loadBalancerId)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	return backoff.Retry(waitAccessLogConfig, reconnectBackoff)
+}
+
+// Function to read the access log config of the ALB.
+func (r *albAccessLogResource) getAccessLogConfig(loadBalancerId string) (*alicloudAlbClient.GetLoadBalancerAttributeResponseBodyAccessLogConfig, error) {
+	var getLoadBalancerAttributeResponse *alicloudAlbClient.GetLoadBalancerAttributeResponse
+	getLoadBalancerAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getLoadBalancerAttributeRequest := &alicloudAlbClient.GetLoadBalancerAttributeRequest{
+			LoadBalancerId: tea.String(loadBalancerId),
+		}
+
+		var err error
+		getLoadBalancerAttributeResponse, err = r.client.GetLoadBalancerAttributeWithOptions(getLoadBalancerAttributeRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getLoadBalancerAttribute); err != nil {
+		return nil, err
+	}
+	return getLoadBalancerAttributeResponse.Body.AccessLogConfig, nil
+}
+
+// Function to check whether the load balancer is an NLB by the ID prefix.
+func isNlbLoadBalancerId(loadBalancerId string) bool {
+	return strings.HasPrefix(loadBalancerId, "nlb-")
+}
+
+// Function to get the name of the SLS collection policy of the NLB access
+// logs, one policy is created for each NLB.
+func nlbAccessLogPolicyName(loadBalancerId string) string {
+	return "access-log-" + loadBalancerId
+}
+
+// Function to create or update the SLS collection policy which delivers the
+// access logs of the NLB to the Logstore.
+func (r *albAccessLogResource) upsertNlbAccessLog(model *albAccessLogModel) error {
+	upsertCollectionPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		upsertCollectionPolicyRequest := &alicloudSlsClient.UpsertCollectionPolicyRequest{
+			PolicyName:  tea.String(nlbAccessLogPolicyName(model.LoadBalancerId.ValueString())),
+			ProductCode: tea.String(nlbAccessLogProductCode),
+			DataCode:    tea.String(nlbAccessLogDataCode),
+			Enabled:     tea.Bool(true),
+			PolicyConfig: &alicloudSlsClient.UpsertCollectionPolicyRequestPolicyConfig{
+				ResourceMode: tea.String("instanceMode"),
+				InstanceIds:  []*string{tea.String(model.LoadBalancerId.ValueString())},
+				Regions:      []*string{r.slsClient.RegionId},
+			},
+			CentralizeEnabled: tea.Bool(true),
+			CentralizeConfig: &alicloudSlsClient.UpsertCollectionPolicyRequestCentralizeConfig{
+				DestRegion:   r.slsClient.RegionId,
+				DestProject:  tea.String(model.LogProject.ValueString()),
+				DestLogstore: tea.String(model.LogStore.ValueString()),
+			},
+		}
+
+		if _, err := r.slsClient.UpsertCollectionPolicyWithOptions(upsertCollectionPolicyRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(upsertCollectionPolicy)
+}
+
+// Function to read the SLS collection policy of the NLB access logs, returns
+// nil if the policy does not exist.
+func (r *albAccessLogResource) getNlbAccessLogPolicy(loadBalancerId string) (*alicloudSlsClient.GetCollectionPolicyResponseBodyCollectionPolicy, error) {
+	var getCollectionPolicyResponse *alicloudSlsClient.GetCollectionPolicyResponse
+	getCollectionPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		getCollectionPolicyRequest := &alicloudSlsClient.GetCollectionPolicyRequest{
+			ProductCode: tea.String(nlbAccessLogProductCode),
+			DataCode:    tea.String(nlbAccessLogDataCode),
+		}
+
+		var err error
+		getCollectionPolicyResponse, err = r.slsClient.GetCollectionPolicyWithOptions(tea.String(nlbAccessLogPolicyName(loadBalancerId)), getCollectionPolicyRequest, headers, runtime)
+		if err != nil {
+			if isNlbAccessLogPolicyNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getCollectionPolicy); err != nil {
+		return nil, err
+	}
+	if getCollectionPolicyResponse == nil || getCollectionPolicyResponse.Body == nil {
+		return nil, nil
+	}
+	return getCollectionPolicyResponse.Body.CollectionPolicy, nil
+}
+
+// Function to delete the SLS collection policy of the NLB access logs.
+func (r *albAccessLogResource) deleteNlbAccessLog(loadBalancerId string) error {
+	deleteCollectionPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		deleteCollectionPolicyRequest := &alicloudSlsClient.DeleteCollectionPolicyRequest{
+			ProductCode: tea.String(nlbAccessLogProductCode),
+			DataCode:    tea.String(nlbAccessLogDataCode),
+		}
+
+		if _, err := r.slsClient.DeleteCollectionPolicyWithOptions(tea.String(nlbAccessLogPolicyName(loadBalancerId)), deleteCollectionPolicyRequest, headers, runtime); err != nil {
+			if isNlbAccessLogPolicyNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteCollectionPolicy)
+}
+
+// Function to check whether the error is returned as the collection policy
+// does not exist.
+func isNlbAccessLogPolicyNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(_t.Code), "NotExist") || tea.IntValue(_t.StatusCode) == 404
+	}
+	return false
+}
@@ -0,0 +1,423 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The maximum number of certificates to be associated or dissociated in
+	// a single request.
+	albListenerCertificateBatchSize = 15
+)
+
+var (
+	_ resource.Resource                = &albListenerCertificateAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &albListenerCertificateAttachmentResource{}
+	_ resource.ResourceWithImportState = &albListenerCertificateAttachmentResource{}
+)
+
+func NewAlbListenerCertificateAttachmentResource() resource.Resource {
+	return &albListenerCertificateAttachmentResource{}
+}
+
+type albListenerCertificateAttachmentResource struct {
+	client *alicloudAlbClient.Client
+}
+
+type albListenerCertificateAttachmentModel struct {
+	ListenerId     types.String `tfsdk:"listener_id"`
+	CertificateIds types.List   `tfsdk:"certificate_ids"`
+}
+
+// Metadata returns the ALB Listener Certificate Attachment resource name.
+func (r *albListenerCertificateAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_listener_certificate_attachment"
+}
+
+// Schema defines the schema for the ALB Listener Certificate Attachment resource.
+func (r *albListenerCertificateAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the full set of additional (SNI) certificates of an application load balancer (ALB) " +
+			"HTTPS or QUIC listener. The additional certificates associated outside of this resource are dissociated.",
+		Attributes: map[string]schema.Attribute{
+			"listener_id": schema.StringAttribute{
+				Description: "The ID of the ALB listener.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_ids": schema.ListAttribute{
+				Description: "List of certificate IDs to be associated with the listener as additional certificates.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *albListenerCertificateAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).albClient
+}
+
+// Associate the additional certificates with the listener.
+func (r *albListenerCertificateAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *albListenerCertificateAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.associateCertificates(plan.ListenerId.ValueString(), convertListValueToStrings(plan.CertificateIds))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Associate Additional Certificates with Listener.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the additional certificates of the listener. The certificates in
+// state are kept in the same order, and the certificates associated outside
+// of Terraform are appended, so that they are dissociated in the next apply.
+func (r *albListenerCertificateAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *albListenerCertificateAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificates, err := r.listAdditionalCertificates(state.ListenerId.ValueString())
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ResourceNotFound.Listener" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Listener Certificates.",
+			err.Error(),
+		)
+		return
+	}
+
+	associated := make(map[string]bool)
+	for _, certificate := range certificates {
+		associated[tea.StringValue(certificate.CertificateId)] = true
+	}
+
+	certificateIds := []attr.Value{}
+	for _, certificateId := range convertListValueToStrings(state.CertificateIds) {
+		if associated[certificateId] {
+			certificateIds = append(certificateIds, types.StringValue(certificateId))
+			delete(associated, certificateId)
+		}
+	}
+	for _, certificate := range certificates {
+		if associated[tea.StringValue(certificate.CertificateId)] {
+			certificateIds = append(certificateIds, types.StringValue(tea.StringValue(certificate.CertificateId)))
+		}
+	}
+
+	if len(certificateIds) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.CertificateIds = types.ListValueMust(types.StringType, certificateIds)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Associate the added certificates before dissociating the removed
+// certificates, so that the domains are always served during rotation.
+func (r *albListenerCertificateAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *albListenerCertificateAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listenerId := plan.ListenerId.ValueString()
+	planCertificateIds := convertListValueToStrings(plan.CertificateIds)
+	stateCertificateIds := convertListValueToStrings(state.CertificateIds)
+
+	err := r.associateCertificates(listenerId, convertStringsDifference(planCertificateIds, stateCertificateIds))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Associate Additional Certificates with Listener.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.dissociateCertificates(listenerId, convertStringsDifference(stateCertificateIds, planCertificateIds))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Dissociate Additional Certificates from Listener.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Dissociate the additional certificates from the listener.
+func (r *albListenerCertificateAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *albListenerCertificateAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.dissociateCertificates(state.ListenerId.ValueString(), convertListValueToStrings(state.CertificateIds))
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ResourceNotFound.Listener" {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Dissociate Additional Certificates from Listener.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *albListenerCertificateAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("listener_id"), req, resp)
+}
+
+// Function to list the additional server certificates of the listener, the
+// default certificate is excluded.
+func (r *albListenerCertificateAttachmentResource) listAdditionalCertificates(listenerId string) ([]*alicloudAlbClient.ListListenerCertificatesResponseBodyCertificates, error) {
+	var certificates []*alicloudAlbClient.ListListenerCertificatesResponseBodyCertificates
+	var nextToken *string
+
+	for {
+		var listListenerCertificatesResponse *alicloudAlbClient.ListListenerCertificatesResponse
+		listListenerCertificates := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listListenerCertificatesRequest := &alicloudAlbClient.ListListenerCertificatesRequest{
+				ListenerId:      tea.String(listenerId),
+				CertificateType: tea.String("Server"),
+				MaxResults:      tea.Int32(100),
+				NextToken:       nextToken,
+			}
+
+			var err error
+			listListenerCertificatesResponse, err = r.client.ListListenerCertificatesWithOptions(listListenerCertificatesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listListenerCertificates); err != nil {
+			return nil, err
+		}
+
+		for _, certificate := range listListenerCertificatesResponse.Body.Certificates {
+			if !tea.BoolValue(certificate.IsDefault) {
+				certificates = append(certificates, certificate)
+			}
+		}
+
+		nextToken = listListenerCertificatesResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return certificates, nil
+}
+
+// Function to associate the certificates with the listener in batches, and
+// wait until all the certificates are associated.
+func (r *albListenerCertificateAttachmentResource) associateCertificates(listenerId string, certificateIds []string) error {
+	for start := 0; start < len(certificateIds); start += albListenerCertificateBatchSize {
+		end := start + albListenerCertificateBatchSize
+		if end > len(certificateIds) {
+			end = len(certificateIds)
+		}
+
+		associateCertificates := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			associateCertificatesRequest := &alicloudAlbClient.AssociateAdditionalCertificatesWithListenerRequest{
+				ListenerId: tea.String(listenerId),
+			}
+			for _, certificateId := range certificateIds[start:end] {
+				associateCertificatesRequest.Certificates = append(associateCertificatesRequest.Certificates,
+					&alicloudAlbClient.AssociateAdditionalCertificatesWithListenerRequestCertificates{
+						CertificateId: tea.String(certificateId),
+					})
+			}
+
+			if _, err := r.client.AssociateAdditionalCertificatesWithListenerWithOptions(associateCertificatesRequest, runtime); err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(associateCertificates); err != nil {
+			return err
+		}
+	}
+	return r.waitCertificates(listenerId, certificateIds, true)
+}
+
+// Function to dissociate the certificates from the listener in batches, and
+// wait until all the certificates are dissociated. The certificates which
+// are not associated with the listener are skipped.
+func (r *albListenerCertificateAttachmentResource) dissociateCertificates(listenerId string, certificateIds []string) error {
+	if len(certificateIds) == 0 {
+		return nil
+	}
+
+	certificates, err := r.listAdditionalCertificates(listenerId)
+	if err != nil {
+		return err
+	}
+	associated := make(map[string]bool)
+	for _, certificate := range certificates {
+		associated[tea.StringValue(certificate.CertificateId)] = true
+	}
+	var dissociateCertificateIds []string
+	for _, certificateId := range certificateIds {
+		if associated[certificateId] {
+			dissociateCertificateIds = append(dissociateCertificateIds, certificateId)
+		}
+	}
+
+	for start := 0; start < len(dissociateCertificateIds); start += albListenerCertificateBatchSize {
+		end := start + albListenerCertificateBatchSize
+		if end > len(dissociateCertificateIds) {
+			end = len(dissociateCertificateIds)
+		}
+
+		dissociateCertificates := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			dissociateCertificatesRequest := &alicloudAlbClient.DissociateAdditionalCertificatesFromListenerRequest{
+				ListenerId: tea.String(listenerId),
+			}
+			for _, certificateId := range dissociateCertificateIds[start:end] {
+				dissociateCertificatesRequest.Certificates = append(dissociateCertificatesRequest.Certificates,
+					&alicloudAlbClient.DissociateAdditionalCertificatesFromListenerRequestCertificates{
+						CertificateId: tea.String(certificateId),
+					})
+			}
+
+			if _, err := r.client.DissociateAdditionalCertificatesFromListenerWithOptions(dissociateCertificatesRequest, runtime); err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(dissociateCertificates); err != nil {
+			return err
+		}
+	}
+	return r.waitCertificates(listenerId, dissociateCertificateIds, false)
+}
+
+// Function to wait until the certificates are associated with the listener,
+// or are dissociated from the listener if associated is false.
+func (r *albListenerCertificateAttachmentResource) waitCertificates(listenerId string, certificateIds []string, associated bool) error {
+	if len(certificateIds) == 0 {
+		return nil
+	}
+
+	waitCertificates := func() error {
+		certificates, err := r.listAdditionalCertificates(listenerId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		status := make(map[string]string)
+		for _, certificate := range certificates {
+			status[tea.StringValue(certificate.CertificateId)] = tea.StringValue(certificate.Status)
+		}
+
+		var pending []string
+		for _, certificateId := range certificateIds {
+			certificateStatus, ok := status[certificateId]
+			if associated && certificateStatus != "Associated" {
+				pending = append(pending, certificateId)
+			}
+			if !associated && ok {
+				pending = append(pending, certificateId)
+			}
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("certificates are still pending on listener %s: %s", listenerId, strings.Join(pending, ", "))
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	return backoff.Retry(waitCertificates, reconnectBackoff)
+}
+
+// Same as handleAPIError, but also retries when the listener is being
+// configured by another request.
+func handleAlbListenerAPIError(err error) error {
+	if _t, ok := err.(*tea.SDKError); ok {
+		code := tea.StringValue(_t.Code)
+		if strings.HasPrefix(code, "IncorrectStatus.") || strings.HasPrefix(code, "Conflict.Lock") {
+			return err
+		}
+	}
+	return handleAPIError(err)
+}
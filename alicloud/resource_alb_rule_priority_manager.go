@@ -0,0 +1,417 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The maximum number of rules to be updated in a single
+	// UpdateRulesAttribute request.
+	albRulePriorityBatchSize = 10
+
+	// The maximum priority of a forwarding rule.
+	albRuleMaxPriority = 10000
+)
+
+var (
+	_ resource.Resource                = &albRulePriorityManagerResource{}
+	_ resource.ResourceWithConfigure   = &albRulePriorityManagerResource{}
+	_ resource.ResourceWithImportState = &albRulePriorityManagerResource{}
+)
+
+func NewAlbRulePriorityManagerResource() resource.Resource {
+	return &albRulePriorityManagerResource{}
+}
+
+type albRulePriorityManagerResource struct {
+	client *alicloudAlbClient.Client
+}
+
+type albRulePriorityManagerModel struct {
+	ListenerId    types.String `tfsdk:"listener_id"`
+	RuleIds       types.List   `tfsdk:"rule_ids"`
+	PriorityStart types.Int64  `tfsdk:"priority_start"`
+	PriorityStep  types.Int64  `tfsdk:"priority_step"`
+}
+
+// Metadata returns the ALB Rule Priority Manager resource name.
+func (r *albRulePriorityManagerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_rule_priority_manager"
+}
+
+// Schema defines the schema for the ALB Rule Priority Manager resource.
+func (r *albRulePriorityManagerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the priorities of all the forwarding rules of an application load balancer (ALB) " +
+			"listener. The priorities are computed from the order of the rules, and are re-computed without " +
+			"collision when the rules are added, removed or reordered.",
+		Attributes: map[string]schema.Attribute{
+			"listener_id": schema.StringAttribute{
+				Description: "The ID of the ALB listener.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				Description: "List of forwarding rule IDs of the listener, ordered from the highest precedence " +
+					"to the lowest. The rules created outside of Terraform are detected and moved after the listed rules.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"priority_start": schema.Int64Attribute{
+				Description: "The priority of the first rule. Default to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, albRuleMaxPriority),
+				},
+			},
+			"priority_step": schema.Int64Attribute{
+				Description: "The difference between the priorities of two adjacent rules. Default to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *albRulePriorityManagerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).albClient
+}
+
+// Set the priorities of the rules.
+func (r *albRulePriorityManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *albRulePriorityManagerModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRulePriorities(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Rule Priorities.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the rules of the listener ordered by their current priorities. The
+// rules created outside of Terraform are included, so that the rules which
+// are reordered or added outside of Terraform are shown in the next plan.
+func (r *albRulePriorityManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *albRulePriorityManagerModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.listRules(state.ListenerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(rules) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ruleIds := []attr.Value{}
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, types.StringValue(tea.StringValue(rule.RuleId)))
+	}
+	state.RuleIds = types.ListValueMust(types.StringType, ruleIds)
+
+	// Set the defaults when the resource is imported.
+	if state.PriorityStart.IsNull() {
+		state.PriorityStart = types.Int64Value(1)
+	}
+	if state.PriorityStep.IsNull() {
+		state.PriorityStep = types.Int64Value(1)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Re-compute the priorities of the rules.
+func (r *albRulePriorityManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *albRulePriorityManagerModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRulePriorities(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Rule Priorities.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the resource from state, the rules are kept with
+// their current priorities.
+func (r *albRulePriorityManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *albRulePriorityManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("listener_id"), req, resp)
+}
+
+// Function to list the forwarding rules of the listener, ordered by their
+// priorities.
+func (r *albRulePriorityManagerResource) listRules(listenerId string) ([]*alicloudAlbClient.ListRulesResponseBodyRules, error) {
+	var rules []*alicloudAlbClient.ListRulesResponseBodyRules
+	var nextToken *string
+
+	for {
+		var listRulesResponse *alicloudAlbClient.ListRulesResponse
+		listRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listRulesRequest := &alicloudAlbClient.ListRulesRequest{
+				ListenerIds: []*string{tea.String(listenerId)},
+				Direction:   tea.String("Request"),
+				MaxResults:  tea.Int32(100),
+				NextToken:   nextToken,
+			}
+
+			var err error
+			listRulesResponse, err = r.client.ListRulesWithOptions(listRulesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listRules); err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, listRulesResponse.Body.Rules...)
+
+		nextToken = listRulesResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return tea.Int32Value(rules[i].Priority) < tea.Int32Value(rules[j].Priority)
+	})
+	return rules, nil
+}
+
+// Function to compute the priorities of the rules from their order, and
+// update the rules whose priorities are changed. The rules which are not in
+// the model are moved after the rules in the model.
+//
+// The priorities of the rules in a listener must be unique, so when the
+// changed rules do not fit in a single request, the changed rules are first
+// moved to unused priorities, and then moved to their target priorities.
+func (r *albRulePriorityManagerResource) setRulePriorities(model *albRulePriorityManagerModel) error {
+	listenerId := model.ListenerId.ValueString()
+	ruleIds := convertListValueToStrings(model.RuleIds)
+
+	rules, err := r.listRules(listenerId)
+	if err != nil {
+		return err
+	}
+
+	currentPriorities := make(map[string]int32)
+	for _, rule := range rules {
+		currentPriorities[tea.StringValue(rule.RuleId)] = tea.Int32Value(rule.Priority)
+	}
+
+	var notFoundRuleIds []string
+	for _, ruleId := range ruleIds {
+		if _, ok := currentPriorities[ruleId]; !ok {
+			notFoundRuleIds = append(notFoundRuleIds, ruleId)
+		}
+	}
+	if len(notFoundRuleIds) > 0 {
+		return fmt.Errorf("rules are not found in listener %s: %s", listenerId, strings.Join(notFoundRuleIds, ", "))
+	}
+
+	// The rules are already ordered by their current priorities, so the
+	// rules which are not in the model keep their relative order.
+	orderedRuleIds := append([]string{}, ruleIds...)
+	orderedRuleIds = append(orderedRuleIds, convertStringsDifference(
+		albRuleIds(rules), ruleIds)...)
+
+	start := model.PriorityStart.ValueInt64()
+	step := model.PriorityStep.ValueInt64()
+	if last := start + int64(len(orderedRuleIds)-1)*step; last > albRuleMaxPriority {
+		return fmt.Errorf("the priority of the last rule of listener %s is %d, which exceeds the maximum priority %d",
+			listenerId, last, albRuleMaxPriority)
+	}
+
+	targetPriorities := make(map[string]int32)
+	var changedRuleIds []string
+	for i, ruleId := range orderedRuleIds {
+		targetPriorities[ruleId] = int32(start + int64(i)*step)
+		if targetPriorities[ruleId] != currentPriorities[ruleId] {
+			changedRuleIds = append(changedRuleIds, ruleId)
+		}
+	}
+
+	if len(changedRuleIds) == 0 {
+		return nil
+	}
+	if len(changedRuleIds) <= albRulePriorityBatchSize {
+		return r.updateRulePriorities(listenerId, changedRuleIds, targetPriorities)
+	}
+
+	usedPriorities := make(map[int32]bool)
+	for _, ruleId := range orderedRuleIds {
+		usedPriorities[currentPriorities[ruleId]] = true
+		usedPriorities[targetPriorities[ruleId]] = true
+	}
+	temporaryPriorities := make(map[string]int32)
+	priority := int32(albRuleMaxPriority)
+	for _, ruleId := range changedRuleIds {
+		for priority > 0 && usedPriorities[priority] {
+			priority--
+		}
+		if priority == 0 {
+			return fmt.Errorf("no unused priority is left in listener %s to reorder the rules", listenerId)
+		}
+		temporaryPriorities[ruleId] = priority
+		priority--
+	}
+
+	if err := r.updateRulePriorities(listenerId, changedRuleIds, temporaryPriorities); err != nil {
+		return err
+	}
+	return r.updateRulePriorities(listenerId, changedRuleIds, targetPriorities)
+}
+
+// Function to update the priorities of the rules in batches, and wait until
+// all the rules are available with the new priorities.
+func (r *albRulePriorityManagerResource) updateRulePriorities(listenerId string, ruleIds []string, priorities map[string]int32) error {
+	for start := 0; start < len(ruleIds); start += albRulePriorityBatchSize {
+		end := start + albRulePriorityBatchSize
+		if end > len(ruleIds) {
+			end = len(ruleIds)
+		}
+
+		updateRulesAttribute := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateRulesAttributeRequest := &alicloudAlbClient.UpdateRulesAttributeRequest{}
+			for _, ruleId := range ruleIds[start:end] {
+				updateRulesAttributeRequest.Rules = append(updateRulesAttributeRequest.Rules,
+					&alicloudAlbClient.UpdateRulesAttributeRequestRules{
+						RuleId:   tea.String(ruleId),
+						Priority: tea.Int32(priorities[ruleId]),
+					})
+			}
+
+			if _, err := r.client.UpdateRulesAttributeWithOptions(updateRulesAttributeRequest, runtime); err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateRulesAttribute); err != nil {
+			return err
+		}
+	}
+
+	waitRules := func() error {
+		rules, err := r.listRules(listenerId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		updated := make(map[string]bool)
+		for _, rule := range rules {
+			ruleId := tea.StringValue(rule.RuleId)
+			updated[ruleId] = tea.StringValue(rule.RuleStatus) == "Available" &&
+				tea.Int32Value(rule.Priority) == priorities[ruleId]
+		}
+
+		var pending []string
+		for _, ruleId := range ruleIds {
+			if !updated[ruleId] {
+				pending = append(pending, ruleId)
+			}
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("rules are still being updated in listener %s: %s", listenerId, strings.Join(pending, ", "))
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	return backoff.Retry(waitRules, reconnectBackoff)
+}
+
+// Function to get the IDs of the rules.
+func albRuleIds(rules []*alicloudAlbClient.ListRulesResponseBodyRules) []string {
+	var ruleIds []string
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, tea.StringValue(rule.RuleId))
+	}
+	return ruleIds
+}
@@ -0,0 +1,575 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &albServerGroupServerAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &albServerGroupServerAttachmentResource{}
+	_ resource.ResourceWithImportState = &albServerGroupServerAttachmentResource{}
+)
+
+// The maximum number of servers in a request of AliCloud API.
+const albServerGroupServerBatchSize = 200
+
+func NewAlbServerGroupServerAttachmentResource() resource.Resource {
+	return &albServerGroupServerAttachmentResource{}
+}
+
+type albServerGroupServerAttachmentResource struct {
+	client *alicloudAlbClient.Client
+}
+
+type albServerGroupServerAttachmentModel struct {
+	ServerGroupId types.String            `tfsdk:"server_group_id"`
+	Servers       []*albServerGroupServer `tfsdk:"servers"`
+}
+
+type albServerGroupServer struct {
+	ServerType  types.String `tfsdk:"server_type"`
+	ServerId    types.String `tfsdk:"server_id"`
+	ServerIp    types.String `tfsdk:"server_ip"`
+	Port        types.Int64  `tfsdk:"port"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Metadata returns the ALB Server Group Server Attachment resource name.
+func (r *albServerGroupServerAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_server_group_server_attachment"
+}
+
+// Schema defines the schema for the ALB Server Group Server Attachment resource.
+func (r *albServerGroupServerAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Register a list of static servers to an application load balancer (ALB) server group. Only " +
+			"the servers in the list are managed, the other servers in the server group, e.g. the instances " +
+			"registered by auto scaling, are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"server_group_id": schema.StringAttribute{
+				Description: "ALB server group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"servers": schema.ListNestedBlock{
+				Description: "List of servers to be registered to the ALB server group. A server is identified " +
+					"by the server ID and port.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"server_type": schema.StringAttribute{
+							Description: "The type of the server. Valid values: Ecs, Eni, Eci, Ip.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Ecs", "Eni", "Eci", "Ip"),
+							},
+						},
+						"server_id": schema.StringAttribute{
+							Description: "The ID of the ECS instance, ENI or elastic container instance, or the IP " +
+								"address if the server type is Ip.",
+							Required: true,
+						},
+						"server_ip": schema.StringAttribute{
+							Description: "The IP address of the server. Required if the server type is Eni, Eci " +
+								"or Ip.",
+							Optional: true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port used by the server.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the server. Default to 100.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(100),
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the server.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *albServerGroupServerAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).albClient
+}
+
+// Register the servers to the ALB server group.
+func (r *albServerGroupServerAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *albServerGroupServerAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addServers(plan.ServerGroupId.ValueString(), plan.Servers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Servers to ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the servers registered to the ALB server group. All the servers of
+// the server group are read when the resource is imported.
+func (r *albServerGroupServerAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *albServerGroupServerAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := r.listServers(state.ServerGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Servers of ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	registered := make(map[string]*alicloudAlbClient.ListServerGroupServersResponseBodyServers)
+	for _, server := range servers {
+		registered[albServerGroupServerKey(tea.StringValue(server.ServerId), int64(tea.Int32Value(server.Port)))] = server
+	}
+
+	if state.Servers == nil {
+		for _, server := range servers {
+			state.Servers = append(state.Servers, &albServerGroupServer{
+				ServerType:  types.StringValue(tea.StringValue(server.ServerType)),
+				ServerId:    types.StringValue(tea.StringValue(server.ServerId)),
+				ServerIp:    types.StringNull(),
+				Port:        types.Int64Value(int64(tea.Int32Value(server.Port))),
+				Description: types.StringNull(),
+			})
+		}
+	}
+
+	stateServers := []*albServerGroupServer{}
+	for _, stateServer := range state.Servers {
+		server, ok := registered[albServerGroupServerKey(stateServer.ServerId.ValueString(), stateServer.Port.ValueInt64())]
+		if !ok {
+			continue
+		}
+		stateServer.ServerType = types.StringValue(tea.StringValue(server.ServerType))
+		stateServer.Weight = types.Int64Value(int64(tea.Int32Value(server.Weight)))
+		if !stateServer.ServerIp.IsNull() || tea.StringValue(server.ServerType) != "Ecs" {
+			stateServer.ServerIp = types.StringValue(tea.StringValue(server.ServerIp))
+		}
+		if !stateServer.Description.IsNull() || tea.StringValue(server.Description) != "" {
+			stateServer.Description = types.StringValue(tea.StringValue(server.Description))
+		}
+		stateServers = append(stateServers, stateServer)
+	}
+	if len(stateServers) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Servers = stateServers
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Add the new servers, remove the deleted servers and update the weight and
+// description of the remaining servers in the ALB server group.
+func (r *albServerGroupServerAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *albServerGroupServerAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateServers := make(map[string]*albServerGroupServer)
+	for _, server := range state.Servers {
+		stateServers[albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())] = server
+	}
+	planServers := make(map[string]*albServerGroupServer)
+	for _, server := range plan.Servers {
+		planServers[albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())] = server
+	}
+
+	var addServers, removeServers, updateServers []*albServerGroupServer
+	for _, server := range plan.Servers {
+		stateServer, exists := stateServers[albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())]
+		switch {
+		case !exists:
+			addServers = append(addServers, server)
+		case !server.ServerType.Equal(stateServer.ServerType) || !server.ServerIp.Equal(stateServer.ServerIp):
+			// The server type and IP address can not be updated, the server
+			// is re-registered instead.
+			removeServers = append(removeServers, stateServer)
+			addServers = append(addServers, server)
+		case !server.Weight.Equal(stateServer.Weight) || !server.Description.Equal(stateServer.Description):
+			updateServers = append(updateServers, server)
+		}
+	}
+	for _, server := range state.Servers {
+		if _, exists := planServers[albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())]; !exists {
+			removeServers = append(removeServers, server)
+		}
+	}
+
+	serverGroupId := plan.ServerGroupId.ValueString()
+	if err := r.removeServers(serverGroupId, removeServers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Remove Servers from ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.addServers(serverGroupId, addServers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Servers to ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updateServers(serverGroupId, updateServers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Servers of ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the servers from the ALB server group.
+func (r *albServerGroupServerAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *albServerGroupServerAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.removeServers(state.ServerGroupId.ValueString(), state.Servers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Remove Servers from ALB Server Group.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *albServerGroupServerAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_group_id"), req, resp)
+}
+
+// Function to list all servers in the ALB server group.
+func (r *albServerGroupServerAttachmentResource) listServers(serverGroupId string) ([]*alicloudAlbClient.ListServerGroupServersResponseBodyServers, error) {
+	var servers []*alicloudAlbClient.ListServerGroupServersResponseBodyServers
+	var nextToken *string
+
+	for {
+		var listServerGroupServersResponse *alicloudAlbClient.ListServerGroupServersResponse
+		listServerGroupServers := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listServerGroupServersRequest := &alicloudAlbClient.ListServerGroupServersRequest{
+				ServerGroupId: tea.String(serverGroupId),
+				MaxResults:    tea.Int32(100),
+				NextToken:     nextToken,
+			}
+
+			var err error
+			listServerGroupServersResponse, err = r.client.ListServerGroupServersWithOptions(listServerGroupServersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listServerGroupServers); err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, listServerGroupServersResponse.Body.Servers...)
+
+		nextToken = listServerGroupServersResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return servers, nil
+}
+
+// Function to add the servers to the ALB server group in batches, and wait
+// until all the servers are available.
+func (r *albServerGroupServerAttachmentResource) addServers(serverGroupId string, servers []*albServerGroupServer) error {
+	for start := 0; start < len(servers); start += albServerGroupServerBatchSize {
+		end := start + albServerGroupServerBatchSize
+		if end > len(servers) {
+			end = len(servers)
+		}
+
+		addServersToServerGroup := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			addServersToServerGroupRequest := &alicloudAlbClient.AddServersToServerGroupRequest{
+				ServerGroupId: tea.String(serverGroupId),
+			}
+			for _, server := range servers[start:end] {
+				addServersToServerGroupRequest.Servers = append(addServersToServerGroupRequest.Servers,
+					&alicloudAlbClient.AddServersToServerGroupRequestServers{
+						ServerType:  tea.String(server.ServerType.ValueString()),
+						ServerId:    tea.String(server.ServerId.ValueString()),
+						ServerIp:    albStringPointer(server.ServerIp),
+						Port:        tea.Int32(int32(server.Port.ValueInt64())),
+						Weight:      tea.Int32(int32(server.Weight.ValueInt64())),
+						Description: albStringPointer(server.Description),
+					})
+			}
+
+			if _, err := r.client.AddServersToServerGroupWithOptions(addServersToServerGroupRequest, runtime); err != nil {
+				return handleAlbServerGroupAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(addServersToServerGroup); err != nil {
+			return err
+		}
+		if err := r.waitServers(serverGroupId, servers[start:end], true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to remove the servers from the ALB server group in batches, and
+// wait until all the servers are removed. The servers which are not in the
+// server group are skipped.
+func (r *albServerGroupServerAttachmentResource) removeServers(serverGroupId string, servers []*albServerGroupServer) error {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	registeredServers, err := r.listServers(serverGroupId)
+	if err != nil {
+		return err
+	}
+	registered := make(map[string]bool)
+	for _, server := range registeredServers {
+		registered[albServerGroupServerKey(tea.StringValue(server.ServerId), int64(tea.Int32Value(server.Port)))] = true
+	}
+	var removeServers []*albServerGroupServer
+	for _, server := range servers {
+		if registered[albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())] {
+			removeServers = append(removeServers, server)
+		}
+	}
+
+	for start := 0; start < len(removeServers); start += albServerGroupServerBatchSize {
+		end := start + albServerGroupServerBatchSize
+		if end > len(removeServers) {
+			end = len(removeServers)
+		}
+
+		removeServersFromServerGroup := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			removeServersFromServerGroupRequest := &alicloudAlbClient.RemoveServersFromServerGroupRequest{
+				ServerGroupId: tea.String(serverGroupId),
+			}
+			for _, server := range removeServers[start:end] {
+				removeServersFromServerGroupRequest.Servers = append(removeServersFromServerGroupRequest.Servers,
+					&alicloudAlbClient.RemoveServersFromServerGroupRequestServers{
+						ServerType: tea.String(server.ServerType.ValueString()),
+						ServerId:   tea.String(server.ServerId.ValueString()),
+						ServerIp:   albStringPointer(server.ServerIp),
+						Port:       tea.Int32(int32(server.Port.ValueInt64())),
+					})
+			}
+
+			if _, err := r.client.RemoveServersFromServerGroupWithOptions(removeServersFromServerGroupRequest, runtime); err != nil {
+				return handleAlbServerGroupAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(removeServersFromServerGroup); err != nil {
+			return err
+		}
+		if err := r.waitServers(serverGroupId, removeServers[start:end], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to update the weight and description of the servers in the ALB
+// server group in batches, and wait until all the servers are available.
+func (r *albServerGroupServerAttachmentResource) updateServers(serverGroupId string, servers []*albServerGroupServer) error {
+	for start := 0; start < len(servers); start += albServerGroupServerBatchSize {
+		end := start + albServerGroupServerBatchSize
+		if end > len(servers) {
+			end = len(servers)
+		}
+
+		updateServerGroupServersAttribute := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateServerGroupServersAttributeRequest := &alicloudAlbClient.UpdateServerGroupServersAttributeRequest{
+				ServerGroupId: tea.String(serverGroupId),
+			}
+			for _, server := range servers[start:end] {
+				updateServerGroupServersAttributeRequest.Servers = append(updateServerGroupServersAttributeRequest.Servers,
+					&alicloudAlbClient.UpdateServerGroupServersAttributeRequestServers{
+						ServerType:  tea.String(server.ServerType.ValueString()),
+						ServerId:    tea.String(server.ServerId.ValueString()),
+						ServerIp:    albStringPointer(server.ServerIp),
+						Port:        tea.Int32(int32(server.Port.ValueInt64())),
+						Weight:      tea.Int32(int32(server.Weight.ValueInt64())),
+						Description: tea.String(server.Description.ValueString()),
+					})
+			}
+
+			if _, err := r.client.UpdateServerGroupServersAttributeWithOptions(updateServerGroupServersAttributeRequest, runtime); err != nil {
+				return handleAlbServerGroupAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateServerGroupServersAttribute); err != nil {
+			return err
+		}
+		if err := r.waitServers(serverGroupId, servers[start:end], true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to wait until the servers are available in the ALB server group,
+// or are removed from the server group if registered is false.
+func (r *albServerGroupServerAttachmentResource) waitServers(serverGroupId string, servers []*albServerGroupServer, registered bool) error {
+	waitServers := func() error {
+		registeredServers, err := r.listServers(serverGroupId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		status := make(map[string]string)
+		for _, server := range registeredServers {
+			status[albServerGroupServerKey(tea.StringValue(server.ServerId), int64(tea.Int32Value(server.Port)))] = tea.StringValue(server.Status)
+		}
+
+		var pending []string
+		for _, server := range servers {
+			key := albServerGroupServerKey(server.ServerId.ValueString(), server.Port.ValueInt64())
+			serverStatus, ok := status[key]
+			if registered && serverStatus != "Available" {
+				pending = append(pending, key)
+			}
+			if !registered && ok {
+				pending = append(pending, key)
+			}
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("servers are still pending in ALB server group %s: %s", serverGroupId, strings.Join(pending, ", "))
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	return backoff.Retry(waitServers, reconnectBackoff)
+}
+
+// Function to identify a server in the ALB server group, as the same server
+// may be registered with different ports.
+func albServerGroupServerKey(serverId string, port int64) string {
+	return fmt.Sprintf("%s:%d", serverId, port)
+}
+
+// Function to convert the optional string to the pointer of ALB request,
+// which is nil if the string is null or empty.
+func albStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil
+	}
+	return tea.String(value.ValueString())
+}
+
+// Same as handleAlbListenerAPIError, but for the ALB server group which is
+// locked by another job.
+func handleAlbServerGroupAPIError(err error) error {
+	if _t, ok := err.(*tea.SDKError); ok {
+		code := tea.StringValue(_t.Code)
+		if strings.HasPrefix(code, "IncorrectStatus.") || strings.HasPrefix(code, "Conflict.Lock") {
+			return err
+		}
+	}
+	return handleAPIError(err)
+}
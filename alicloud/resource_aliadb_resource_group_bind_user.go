@@ -4,9 +4,8 @@ import (
 	"context"
 	"errors"
 	"strings"
-	"time"
 
-	"github.com/cenkalti/backoff"
+	"github.com/cenkalti/backoff/v4"
 
 	alicloudAdbClient "github.com/alibabacloud-go/adb-20190315/v2/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
@@ -264,9 +263,7 @@ func (r *aliadbResourceGroupBindResource) bindGroupUser(plan *aliadbResourceGrou
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(bindGroupUser, reconnectBackoff)
+	err := retryAPICall(bindGroupUser)
 	if err != nil {
 		return err
 	}
@@ -300,9 +297,7 @@ func (r *aliadbResourceGroupBindResource) unbindGroupUser(plan *aliadbResourceGr
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(setRecordWeight, reconnectBackoff)
+	err := retryAPICall(setRecordWeight)
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/cenkalti/backoff/v4"
@@ -144,9 +143,7 @@ func (r *alidnsDomainAttachmentResource) Read(ctx context.Context, req resource.
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(readDomainRecord, reconnectBackoff)
+	err := retryAPICall(readDomainRecord)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to get domain info.",
@@ -252,9 +249,7 @@ func (r *alidnsDomainAttachmentResource) createBindInstance(plan *alidnsDomainAt
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(bindInstanceRecord, reconnectBackoff)
+	err := retryAPICall(bindInstanceRecord)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -289,9 +284,7 @@ func (r *alidnsDomainAttachmentResource) removeBindInstance(state *alidnsDomainA
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(unbindInstanceRecord, reconnectBackoff)
+	err := retryAPICall(unbindInstanceRecord)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
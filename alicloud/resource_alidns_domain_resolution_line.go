@@ -0,0 +1,324 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDnsClient "github.com/alibabacloud-go/alidns-20150109/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &aliDnsDomainResolutionLineResource{}
+	_ resource.ResourceWithConfigure   = &aliDnsDomainResolutionLineResource{}
+	_ resource.ResourceWithImportState = &aliDnsDomainResolutionLineResource{}
+)
+
+func NewAliDnsDomainResolutionLineResource() resource.Resource {
+	return &aliDnsDomainResolutionLineResource{}
+}
+
+type aliDnsDomainResolutionLineResource struct {
+	client *alicloudDnsClient.Client
+}
+
+type aliDnsDomainResolutionLineModel struct {
+	DomainName types.String           `tfsdk:"domain_name"`
+	Name       types.String           `tfsdk:"name"`
+	IpSegments []*aliDnsLineIpSegment `tfsdk:"ip_segments"`
+	LineId     types.Int64            `tfsdk:"line_id"`
+	LineCode   types.String           `tfsdk:"line_code"`
+}
+
+type aliDnsLineIpSegment struct {
+	StartIp types.String `tfsdk:"start_ip"`
+	EndIp   types.String `tfsdk:"end_ip"`
+}
+
+// Metadata returns the custom resolution line resource name.
+func (r *aliDnsDomainResolutionLineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alidns_domain_resolution_line"
+}
+
+// Schema defines the schema for the custom resolution line resource.
+func (r *aliDnsDomainResolutionLineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a custom resolution line of an Alidns domain, the line matches the source IP " +
+			"segments and can be used as the line of the records.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The domain name of the custom line.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the custom line.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+			},
+			"line_id": schema.Int64Attribute{
+				Description: "The ID of the custom line.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"line_code": schema.StringAttribute{
+				Description: "The code of the custom line, which is used as the line of the records.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ip_segments": schema.ListNestedBlock{
+				Description: "The source IP segments matched by the custom line.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"start_ip": schema.StringAttribute{
+							Description: "The start IP address of the segment.",
+							Required:    true,
+						},
+						"end_ip": schema.StringAttribute{
+							Description: "The end IP address of the segment.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliDnsDomainResolutionLineResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dnsClient
+}
+
+// Create the custom line.
+func (r *aliDnsDomainResolutionLineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *aliDnsDomainResolutionLineModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addCustomLine := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addCustomLineRequest := &alicloudDnsClient.AddCustomLineRequest{
+			DomainName: tea.String(plan.DomainName.ValueString()),
+			LineName:   tea.String(plan.Name.ValueString()),
+		}
+		for _, segment := range plan.IpSegments {
+			addCustomLineRequest.IpSegment = append(addCustomLineRequest.IpSegment, &alicloudDnsClient.AddCustomLineRequestIpSegment{
+				StartIp: tea.String(segment.StartIp.ValueString()),
+				EndIp:   tea.String(segment.EndIp.ValueString()),
+			})
+		}
+
+		addCustomLineResponse, err := r.client.AddCustomLineWithOptions(addCustomLineRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.LineId = types.Int64Value(tea.Int64Value(addCustomLineResponse.Body.LineId))
+		plan.LineCode = types.StringValue(tea.StringValue(addCustomLineResponse.Body.LineCode))
+		return nil
+	}
+
+	if err := retryAPICall(addCustomLine); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Custom Line.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the custom line.
+func (r *aliDnsDomainResolutionLineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *aliDnsDomainResolutionLineModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var notFound bool
+	describeCustomLine := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeCustomLineRequest := &alicloudDnsClient.DescribeCustomLineRequest{
+			LineId: tea.Int64(state.LineId.ValueInt64()),
+		}
+
+		describeCustomLineResponse, err := r.client.DescribeCustomLineWithOptions(describeCustomLineRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Code), "NotExist") {
+				notFound = true
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		body := describeCustomLineResponse.Body
+		state.DomainName = types.StringValue(tea.StringValue(body.DomainName))
+		state.Name = types.StringValue(tea.StringValue(body.Name))
+		state.LineCode = types.StringValue(tea.StringValue(body.Code))
+		state.IpSegments = []*aliDnsLineIpSegment{}
+		for _, segment := range body.IpSegmentList {
+			state.IpSegments = append(state.IpSegments, &aliDnsLineIpSegment{
+				StartIp: types.StringValue(tea.StringValue(segment.StartIp)),
+				EndIp:   types.StringValue(tea.StringValue(segment.EndIp)),
+			})
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeCustomLine); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Custom Line.",
+			err.Error(),
+		)
+		return
+	}
+	if notFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name and the IP segments of the custom line.
+func (r *aliDnsDomainResolutionLineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *aliDnsDomainResolutionLineModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCustomLine := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateCustomLineRequest := &alicloudDnsClient.UpdateCustomLineRequest{
+			LineId:   tea.Int64(state.LineId.ValueInt64()),
+			LineName: tea.String(plan.Name.ValueString()),
+		}
+		for _, segment := range plan.IpSegments {
+			updateCustomLineRequest.IpSegment = append(updateCustomLineRequest.IpSegment, &alicloudDnsClient.UpdateCustomLineRequestIpSegment{
+				StartIp: tea.String(segment.StartIp.ValueString()),
+				EndIp:   tea.String(segment.EndIp.ValueString()),
+			})
+		}
+
+		if _, err := r.client.UpdateCustomLineWithOptions(updateCustomLineRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateCustomLine); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Custom Line.",
+			err.Error(),
+		)
+		return
+	}
+	plan.LineId = state.LineId
+	plan.LineCode = state.LineCode
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the custom line.
+func (r *aliDnsDomainResolutionLineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *aliDnsDomainResolutionLineModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteCustomLines := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteCustomLinesRequest := &alicloudDnsClient.DeleteCustomLinesRequest{
+			LineIds: tea.String(strconv.FormatInt(state.LineId.ValueInt64(), 10)),
+		}
+
+		if _, err := r.client.DeleteCustomLinesWithOptions(deleteCustomLinesRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Code), "NotExist") {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteCustomLines); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Custom Line.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the custom line with the line ID.
+func (r *aliDnsDomainResolutionLineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	lineId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the line ID as the import identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("line_id"), lineId)...)
+}
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -387,9 +386,7 @@ func (r *alidnsGtmInstanceResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(createGtmInstance, reconnectBackoff)
+	err = retryAPICall(createGtmInstance)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Create GTM Instance",
@@ -665,9 +662,7 @@ func (r *alidnsGtmInstanceResource) readGtmInstance(state *alidnsGtmInstanceReso
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(createGtmInstance, reconnectBackoff)
+	err = retryAPICall(createGtmInstance)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -708,9 +703,7 @@ func (r *alidnsGtmInstanceResource) readGtmInstance(state *alidnsGtmInstanceReso
 	}
 
 	// Retry backoff
-	reconnectBackoff = backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(queryGtmInstance, reconnectBackoff)
+	err = retryAPICall(queryGtmInstance)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -854,9 +847,7 @@ func (r *alidnsGtmInstanceResource) updateGtmInstance(plan *alidnsGtmInstanceRes
 		}
 
 		// Retry backoff
-		reconnectBackoff := backoff.NewExponentialBackOff()
-		reconnectBackoff.MaxElapsedTime = 30 * time.Second
-		err = backoff.Retry(moveGtmInstance, reconnectBackoff)
+		err = retryAPICall(moveGtmInstance)
 		if err != nil {
 			return diag.Diagnostics{
 				diag.NewErrorDiagnostic(
@@ -893,9 +884,7 @@ func (r *alidnsGtmInstanceResource) updateGtmInstance(plan *alidnsGtmInstanceRes
 		}
 
 		// Retry backoff
-		reconnectBackoff := backoff.NewExponentialBackOff()
-		reconnectBackoff.MaxElapsedTime = 30 * time.Second
-		err = backoff.Retry(createGtmInstance, reconnectBackoff)
+		err = retryAPICall(createGtmInstance)
 		if err != nil {
 			return diag.Diagnostics{
 				diag.NewErrorDiagnostic(
@@ -974,9 +963,7 @@ func (r *alidnsGtmInstanceResource) updateGtmInstance(plan *alidnsGtmInstanceRes
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(createGtmInstance, reconnectBackoff)
+	err = retryAPICall(createGtmInstance)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -1010,7 +997,5 @@ func (r alidnsGtmInstanceResource) setInstanceRenewal(clientEndpoint string, req
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return backoff.Retry(setRenewal, reconnectBackoff)
+	return retryAPICall(setRenewal)
 }
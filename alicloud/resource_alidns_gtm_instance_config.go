@@ -0,0 +1,1153 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDnsClient "github.com/alibabacloud-go/alidns-20150109/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &alidnsGtmInstanceConfigResource{}
+	_ resource.ResourceWithConfigure   = &alidnsGtmInstanceConfigResource{}
+	_ resource.ResourceWithImportState = &alidnsGtmInstanceConfigResource{}
+)
+
+func NewAliDnsGtmInstanceConfigResource() resource.Resource {
+	return &alidnsGtmInstanceConfigResource{}
+}
+
+type alidnsGtmInstanceConfigResource struct {
+	client *alicloudDnsClient.Client
+}
+
+type alidnsGtmInstanceConfigModel struct {
+	InstanceId        types.String               `tfsdk:"instance_id"`
+	AddressPools      []*alidnsGtmAddressPool    `tfsdk:"address_pool"`
+	AccessStrategies  []*alidnsGtmAccessStrategy `tfsdk:"access_strategy"`
+	AddressPoolIds    types.Map                  `tfsdk:"address_pool_ids"`
+	AccessStrategyIds types.Map                  `tfsdk:"access_strategy_ids"`
+}
+
+type alidnsGtmAddressPool struct {
+	Name        types.String          `tfsdk:"name"`
+	Type        types.String          `tfsdk:"type"`
+	LbaStrategy types.String          `tfsdk:"lba_strategy"`
+	Addresses   []*alidnsGtmAddress   `tfsdk:"address"`
+	HealthCheck *alidnsGtmHealthCheck `tfsdk:"health_check"`
+}
+
+type alidnsGtmAddress struct {
+	Address   types.String `tfsdk:"address"`
+	Mode      types.String `tfsdk:"mode"`
+	LineCodes types.List   `tfsdk:"line_codes"`
+	Weight    types.Int64  `tfsdk:"weight"`
+}
+
+type alidnsGtmHealthCheck struct {
+	Protocol          types.String            `tfsdk:"protocol"`
+	Interval          types.Int64             `tfsdk:"interval"`
+	Timeout           types.Int64             `tfsdk:"timeout"`
+	EvaluationCount   types.Int64             `tfsdk:"evaluation_count"`
+	MonitorExtendInfo types.String            `tfsdk:"monitor_extend_info"`
+	IspCityNodes      []*alidnsGtmIspCityNode `tfsdk:"isp_city_node"`
+}
+
+type alidnsGtmIspCityNode struct {
+	CityCode types.String `tfsdk:"city_code"`
+	IspCode  types.String `tfsdk:"isp_code"`
+}
+
+type alidnsGtmAccessStrategy struct {
+	Name                        types.String `tfsdk:"name"`
+	Lines                       types.List   `tfsdk:"lines"`
+	AccessMode                  types.String `tfsdk:"access_mode"`
+	DefaultAddrPoolType         types.String `tfsdk:"default_addr_pool_type"`
+	DefaultAddrPools            types.List   `tfsdk:"default_addr_pools"`
+	DefaultMinAvailableAddrNum  types.Int64  `tfsdk:"default_min_available_addr_num"`
+	FailoverAddrPoolType        types.String `tfsdk:"failover_addr_pool_type"`
+	FailoverAddrPools           types.List   `tfsdk:"failover_addr_pools"`
+	FailoverMinAvailableAddrNum types.Int64  `tfsdk:"failover_min_available_addr_num"`
+}
+
+// The attribute info of the address, which is the JSON string of the lines
+// the address serves.
+type alidnsGtmAddressAttributeInfo struct {
+	LineCodes []string `json:"lineCodes"`
+}
+
+// Metadata returns the GTM instance config resource name.
+func (r *alidnsGtmInstanceConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alidns_gtm_instance_config"
+}
+
+// Schema defines the schema for the GTM instance config resource.
+func (r *alidnsGtmInstanceConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	poolTypeValidators := []validator.String{
+		stringvalidator.OneOf("IPV4", "IPV6", "DOMAIN"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage the address pools, the health checks and the geo access strategies with failover " +
+			"of a Global Traffic Manager (GTM) instance. The address pools and the access strategies which " +
+			"are not in the configuration are removed from the instance.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the GTM instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address_pool_ids": schema.MapAttribute{
+				Description: "The IDs of the address pools keyed by the names.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"access_strategy_ids": schema.MapAttribute{
+				Description: "The IDs of the access strategies keyed by the names.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"address_pool": schema.ListNestedBlock{
+				Description: "The address pools of the instance.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the address pool, which is unique in the instance.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the address pool. Valid values: IPV4, IPV6, DOMAIN.",
+							Required:    true,
+							Validators:  poolTypeValidators,
+						},
+						"lba_strategy": schema.StringAttribute{
+							Description: "The load balancing strategy of the addresses. Valid values: ALL_RR, RATIO. " +
+								"Default to ALL_RR.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("ALL_RR"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("ALL_RR", "RATIO"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"address": schema.ListNestedBlock{
+							Description: "The addresses in the address pool.",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"address": schema.StringAttribute{
+										Description: "The IP address or the domain name.",
+										Required:    true,
+									},
+									"mode": schema.StringAttribute{
+										Description: "The mode of the address. Valid values: SMART, ONLINE, OFFLINE. " +
+											"Default to SMART, which takes the address offline when the health check fails.",
+										Optional: true,
+										Computed: true,
+										Default:  stringdefault.StaticString("SMART"),
+										Validators: []validator.String{
+											stringvalidator.OneOf("SMART", "ONLINE", "OFFLINE"),
+										},
+									},
+									"line_codes": schema.ListAttribute{
+										Description: "The codes of the source lines served by the address, e.g. default.",
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+									"weight": schema.Int64Attribute{
+										Description: "The weight of the address when the lba_strategy is RATIO. Default to 1.",
+										Optional:    true,
+										Computed:    true,
+										Default:     int64default.StaticInt64(1),
+										Validators: []validator.Int64{
+											int64validator.Between(1, 100),
+										},
+									},
+								},
+							},
+						},
+						"health_check": schema.SingleNestedBlock{
+							Description: "The health check of the addresses, the health check is disabled when the block is omitted.",
+							Attributes: map[string]schema.Attribute{
+								"protocol": schema.StringAttribute{
+									Description: "The protocol of the health check. Valid values: PING, HTTP, HTTPS, TCP.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("PING", "HTTP", "HTTPS", "TCP"),
+									},
+								},
+								"interval": schema.Int64Attribute{
+									Description: "The interval of the health check in seconds. Default to 60.",
+									Optional:    true,
+									Computed:    true,
+									Default:     int64default.StaticInt64(60),
+									Validators: []validator.Int64{
+										int64validator.OneOf(15, 60, 300, 900, 1800, 3600),
+									},
+								},
+								"timeout": schema.Int64Attribute{
+									Description: "The timeout of the health check in milliseconds. Default to 5000.",
+									Optional:    true,
+									Computed:    true,
+									Default:     int64default.StaticInt64(5000),
+									Validators: []validator.Int64{
+										int64validator.OneOf(2000, 3000, 5000, 10000),
+									},
+								},
+								"evaluation_count": schema.Int64Attribute{
+									Description: "The number of the consecutive failures to mark the address unhealthy. Default to 1.",
+									Optional:    true,
+									Computed:    true,
+									Default:     int64default.StaticInt64(1),
+									Validators: []validator.Int64{
+										int64validator.Between(1, 3),
+									},
+								},
+								"monitor_extend_info": schema.StringAttribute{
+									Description: "The extended JSON config of the health check, e.g. " +
+										"{\"port\":80,\"host\":\"www.example.com\",\"path\":\"/health\",\"code\":400,\"failureRate\":50}.",
+									Required: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"isp_city_node": schema.ListNestedBlock{
+									Description: "The nodes which run the health check, at least one node is required.",
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"city_code": schema.StringAttribute{
+												Description: "The city code of the node.",
+												Required:    true,
+											},
+											"isp_code": schema.StringAttribute{
+												Description: "The ISP code of the node.",
+												Required:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"access_strategy": schema.ListNestedBlock{
+				Description: "The geo access strategies of the instance.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the access strategy, which is unique in the instance.",
+							Required:    true,
+						},
+						"lines": schema.ListAttribute{
+							Description: "The codes of the source lines matched by the access strategy, e.g. default.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"access_mode": schema.StringAttribute{
+							Description: "The access mode of the address pools. Valid values: AUTO, DEFAULT, FAILOVER. " +
+								"Default to AUTO, which switches to the failover address pools when the default address pools " +
+								"are unavailable.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("AUTO"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("AUTO", "DEFAULT", "FAILOVER"),
+							},
+						},
+						"default_addr_pool_type": schema.StringAttribute{
+							Description: "The type of the default address pools. Valid values: IPV4, IPV6, DOMAIN.",
+							Required:    true,
+							Validators:  poolTypeValidators,
+						},
+						"default_addr_pools": schema.ListAttribute{
+							Description: "The names of the default address pools.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"default_min_available_addr_num": schema.Int64Attribute{
+							Description: "The minimum number of the available addresses in the default address pools. Default to 1.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(1),
+						},
+						"failover_addr_pool_type": schema.StringAttribute{
+							Description: "The type of the failover address pools. Valid values: IPV4, IPV6, DOMAIN.",
+							Optional:    true,
+							Validators: append(poolTypeValidators,
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("failover_addr_pools")),
+							),
+						},
+						"failover_addr_pools": schema.ListAttribute{
+							Description: "The names of the failover address pools.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("failover_addr_pool_type")),
+							},
+						},
+						"failover_min_available_addr_num": schema.Int64Attribute{
+							Description: "The minimum number of the available addresses in the failover address pools. Default to 1.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alidnsGtmInstanceConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dnsClient
+}
+
+// Create the address pools and then the access strategies.
+func (r *alidnsGtmInstanceConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *alidnsGtmInstanceConfigModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyConfig(plan, &alidnsGtmInstanceConfigModel{}, map[string]string{}, map[string]string{}, resp.Diagnostics.AddError)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read all the address pools and the access strategies of the instance.
+func (r *alidnsGtmInstanceConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *alidnsGtmInstanceConfigModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolIds, err := r.listAddressPools(state.InstanceId.ValueString())
+	if err != nil {
+		if isGtmNotExistError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List GTM Address Pools.",
+			err.Error(),
+		)
+		return
+	}
+
+	statePools := map[string]*alidnsGtmAddressPool{}
+	for _, pool := range state.AddressPools {
+		statePools[pool.Name.ValueString()] = pool
+	}
+	pools := map[string]*alidnsGtmAddressPool{}
+	poolNames := map[string]string{}
+	for name, poolId := range poolIds {
+		pool, err := r.describeAddressPool(poolId, statePools[name])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe GTM Address Pool.",
+				err.Error(),
+			)
+			return
+		}
+		pools[name] = pool
+		poolNames[poolId] = name
+	}
+
+	strategyIds, err := r.listAccessStrategies(state.InstanceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List GTM Access Strategies.",
+			err.Error(),
+		)
+		return
+	}
+
+	strategies := map[string]*alidnsGtmAccessStrategy{}
+	for name, strategyId := range strategyIds {
+		strategy, err := r.describeAccessStrategy(strategyId, poolNames)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe GTM Access Strategy.",
+				err.Error(),
+			)
+			return
+		}
+		strategies[name] = strategy
+	}
+
+	// Keep the order of the state, the address pools and the access
+	// strategies created outside of Terraform are appended at the end.
+	poolNameList := []string{}
+	for name := range pools {
+		poolNameList = append(poolNameList, name)
+	}
+	state.AddressPools = []*alidnsGtmAddressPool{}
+	for _, name := range alidnsGtmOrderedNames(state.addressPoolNames(), poolNameList) {
+		state.AddressPools = append(state.AddressPools, pools[name])
+	}
+	stateStrategyNames := []string{}
+	for _, strategy := range state.AccessStrategies {
+		stateStrategyNames = append(stateStrategyNames, strategy.Name.ValueString())
+	}
+	strategyNameList := []string{}
+	for name := range strategies {
+		strategyNameList = append(strategyNameList, name)
+	}
+	state.AccessStrategies = []*alidnsGtmAccessStrategy{}
+	for _, name := range alidnsGtmOrderedNames(stateStrategyNames, strategyNameList) {
+		state.AccessStrategies = append(state.AccessStrategies, strategies[name])
+	}
+	state.AddressPoolIds = convertStringMapToMapValue(poolIds)
+	state.AccessStrategyIds = convertStringMapToMapValue(strategyIds)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the address pools and the access strategies to match the plan.
+func (r *alidnsGtmInstanceConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *alidnsGtmInstanceConfigModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolIds := map[string]string{}
+	strategyIds := map[string]string{}
+	resp.Diagnostics.Append(state.AddressPoolIds.ElementsAs(ctx, &poolIds, false)...)
+	resp.Diagnostics.Append(state.AccessStrategyIds.ElementsAs(ctx, &strategyIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyConfig(plan, state, poolIds, strategyIds, resp.Diagnostics.AddError)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the access strategies and then the address pools.
+func (r *alidnsGtmInstanceConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *alidnsGtmInstanceConfigModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolIds := map[string]string{}
+	strategyIds := map[string]string{}
+	resp.Diagnostics.Append(state.AddressPoolIds.ElementsAs(ctx, &poolIds, false)...)
+	resp.Diagnostics.Append(state.AccessStrategyIds.ElementsAs(ctx, &strategyIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyConfig(&alidnsGtmInstanceConfigModel{InstanceId: state.InstanceId}, state, poolIds, strategyIds, resp.Diagnostics.AddError)
+}
+
+// Import all the address pools and the access strategies of the instance with
+// the instance ID.
+func (r *alidnsGtmInstanceConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
+
+// Function to apply the config of the plan on the instance, the access
+// strategies are removed before the address pools which they reference. The
+// IDs of the plan are set with the address pools and the access strategies
+// which are applied.
+func (r *alidnsGtmInstanceConfigResource) applyConfig(plan, state *alidnsGtmInstanceConfigModel, poolIds, strategyIds map[string]string, addError func(string, string)) {
+	instanceId := plan.InstanceId.ValueString()
+	defer func() {
+		plan.AddressPoolIds = convertStringMapToMapValue(poolIds)
+		plan.AccessStrategyIds = convertStringMapToMapValue(strategyIds)
+	}()
+
+	statePools := map[string]*alidnsGtmAddressPool{}
+	for _, pool := range state.AddressPools {
+		statePools[pool.Name.ValueString()] = pool
+	}
+	for _, pool := range plan.AddressPools {
+		name := pool.Name.ValueString()
+		poolId, ok := poolIds[name]
+		if ok && reflect.DeepEqual(pool, statePools[name]) {
+			continue
+		}
+
+		var err error
+		if ok {
+			err = r.updateAddressPool(poolId, pool)
+		} else {
+			poolId, err = r.addAddressPool(instanceId, pool)
+		}
+		if err != nil {
+			addError("[API ERROR] Failed to Apply GTM Address Pool.", err.Error())
+			return
+		}
+		poolIds[name] = poolId
+
+		if err := r.setHealthCheck(poolId, pool); err != nil {
+			addError("[API ERROR] Failed to Apply GTM Address Pool Health Check.", err.Error())
+			return
+		}
+	}
+
+	stateStrategies := map[string]*alidnsGtmAccessStrategy{}
+	for _, strategy := range state.AccessStrategies {
+		stateStrategies[strategy.Name.ValueString()] = strategy
+	}
+	planStrategyNames := map[string]bool{}
+	for _, strategy := range plan.AccessStrategies {
+		name := strategy.Name.ValueString()
+		planStrategyNames[name] = true
+		strategyId, ok := strategyIds[name]
+		if ok && reflect.DeepEqual(strategy, stateStrategies[name]) {
+			continue
+		}
+
+		strategyId, err := r.setAccessStrategy(instanceId, strategyId, strategy, poolIds)
+		if err != nil {
+			addError("[API ERROR] Failed to Apply GTM Access Strategy.", err.Error())
+			return
+		}
+		strategyIds[name] = strategyId
+	}
+	for name, strategyId := range strategyIds {
+		if planStrategyNames[name] {
+			continue
+		}
+		if err := r.deleteAccessStrategy(strategyId); err != nil {
+			addError("[API ERROR] Failed to Delete GTM Access Strategy.", err.Error())
+			return
+		}
+		delete(strategyIds, name)
+	}
+
+	planPoolNames := map[string]bool{}
+	for _, pool := range plan.AddressPools {
+		planPoolNames[pool.Name.ValueString()] = true
+	}
+	for name, poolId := range poolIds {
+		if planPoolNames[name] {
+			continue
+		}
+		if err := r.deleteAddressPool(poolId); err != nil {
+			addError("[API ERROR] Failed to Delete GTM Address Pool.", err.Error())
+			return
+		}
+		delete(poolIds, name)
+	}
+}
+
+// Function to add the address pool, returns the address pool ID.
+func (r *alidnsGtmInstanceConfigResource) addAddressPool(instanceId string, pool *alidnsGtmAddressPool) (string, error) {
+	var poolId string
+	addDnsGtmAddressPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addDnsGtmAddressPoolRequest := &alicloudDnsClient.AddDnsGtmAddressPoolRequest{
+			InstanceId:    tea.String(instanceId),
+			Name:          tea.String(pool.Name.ValueString()),
+			Type:          tea.String(pool.Type.ValueString()),
+			LbaStrategy:   tea.String(pool.LbaStrategy.ValueString()),
+			MonitorStatus: tea.String("CLOSE"),
+		}
+		for _, address := range pool.Addresses {
+			addDnsGtmAddressPoolRequest.Addr = append(addDnsGtmAddressPoolRequest.Addr, &alicloudDnsClient.AddDnsGtmAddressPoolRequestAddr{
+				Addr:          tea.String(address.Address.ValueString()),
+				Mode:          tea.String(address.Mode.ValueString()),
+				AttributeInfo: tea.String(address.attributeInfo()),
+				LbaWeight:     tea.Int32(int32(address.Weight.ValueInt64())),
+			})
+		}
+
+		addDnsGtmAddressPoolResponse, err := r.client.AddDnsGtmAddressPoolWithOptions(addDnsGtmAddressPoolRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		poolId = tea.StringValue(addDnsGtmAddressPoolResponse.Body.AddrPoolId)
+		return nil
+	}
+
+	err := retryAPICall(addDnsGtmAddressPool)
+	return poolId, err
+}
+
+// Function to update the name, the strategy and the addresses of the address
+// pool.
+func (r *alidnsGtmInstanceConfigResource) updateAddressPool(poolId string, pool *alidnsGtmAddressPool) error {
+	updateDnsGtmAddressPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateDnsGtmAddressPoolRequest := &alicloudDnsClient.UpdateDnsGtmAddressPoolRequest{
+			AddrPoolId:  tea.String(poolId),
+			Name:        tea.String(pool.Name.ValueString()),
+			LbaStrategy: tea.String(pool.LbaStrategy.ValueString()),
+		}
+		for _, address := range pool.Addresses {
+			updateDnsGtmAddressPoolRequest.Addr = append(updateDnsGtmAddressPoolRequest.Addr, &alicloudDnsClient.UpdateDnsGtmAddressPoolRequestAddr{
+				Addr:          tea.String(address.Address.ValueString()),
+				Mode:          tea.String(address.Mode.ValueString()),
+				AttributeInfo: tea.String(address.attributeInfo()),
+				LbaWeight:     tea.Int32(int32(address.Weight.ValueInt64())),
+			})
+		}
+
+		if _, err := r.client.UpdateDnsGtmAddressPoolWithOptions(updateDnsGtmAddressPoolRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateDnsGtmAddressPool)
+}
+
+// Function to create, update or disable the health check of the address pool.
+func (r *alidnsGtmInstanceConfigResource) setHealthCheck(poolId string, pool *alidnsGtmAddressPool) error {
+	setHealthCheck := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDnsGtmInstanceAddressPoolRequest := &alicloudDnsClient.DescribeDnsGtmInstanceAddressPoolRequest{
+			AddrPoolId: tea.String(poolId),
+		}
+
+		describeDnsGtmInstanceAddressPoolResponse, err := r.client.DescribeDnsGtmInstanceAddressPoolWithOptions(describeDnsGtmInstanceAddressPoolRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		monitorConfigId := tea.StringValue(describeDnsGtmInstanceAddressPoolResponse.Body.MonitorConfigId)
+		monitorStatus := tea.StringValue(describeDnsGtmInstanceAddressPoolResponse.Body.MonitorStatus)
+
+		healthCheck := pool.HealthCheck
+		if healthCheck == nil {
+			if monitorConfigId == "" || monitorStatus != "OPEN" {
+				return nil
+			}
+			return r.setMonitorStatus(monitorConfigId, "CLOSE", runtime)
+		}
+
+		if monitorConfigId == "" {
+			addDnsGtmMonitorRequest := &alicloudDnsClient.AddDnsGtmMonitorRequest{
+				AddrPoolId:        tea.String(poolId),
+				ProtocolType:      tea.String(healthCheck.Protocol.ValueString()),
+				Interval:          tea.Int32(int32(healthCheck.Interval.ValueInt64())),
+				Timeout:           tea.Int32(int32(healthCheck.Timeout.ValueInt64())),
+				EvaluationCount:   tea.Int32(int32(healthCheck.EvaluationCount.ValueInt64())),
+				MonitorExtendInfo: tea.String(healthCheck.MonitorExtendInfo.ValueString()),
+			}
+			for _, node := range healthCheck.IspCityNodes {
+				addDnsGtmMonitorRequest.IspCityNode = append(addDnsGtmMonitorRequest.IspCityNode, &alicloudDnsClient.AddDnsGtmMonitorRequestIspCityNode{
+					CityCode: tea.String(node.CityCode.ValueString()),
+					IspCode:  tea.String(node.IspCode.ValueString()),
+				})
+			}
+
+			addDnsGtmMonitorResponse, err := r.client.AddDnsGtmMonitorWithOptions(addDnsGtmMonitorRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			monitorConfigId = tea.StringValue(addDnsGtmMonitorResponse.Body.MonitorConfigId)
+		} else {
+			updateDnsGtmMonitorRequest := &alicloudDnsClient.UpdateDnsGtmMonitorRequest{
+				MonitorConfigId:   tea.String(monitorConfigId),
+				ProtocolType:      tea.String(healthCheck.Protocol.ValueString()),
+				Interval:          tea.Int32(int32(healthCheck.Interval.ValueInt64())),
+				Timeout:           tea.Int32(int32(healthCheck.Timeout.ValueInt64())),
+				EvaluationCount:   tea.Int32(int32(healthCheck.EvaluationCount.ValueInt64())),
+				MonitorExtendInfo: tea.String(healthCheck.MonitorExtendInfo.ValueString()),
+			}
+			for _, node := range healthCheck.IspCityNodes {
+				updateDnsGtmMonitorRequest.IspCityNode = append(updateDnsGtmMonitorRequest.IspCityNode, &alicloudDnsClient.UpdateDnsGtmMonitorRequestIspCityNode{
+					CityCode: tea.String(node.CityCode.ValueString()),
+					IspCode:  tea.String(node.IspCode.ValueString()),
+				})
+			}
+
+			if _, err := r.client.UpdateDnsGtmMonitorWithOptions(updateDnsGtmMonitorRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+
+		if monitorStatus == "OPEN" {
+			return nil
+		}
+		return r.setMonitorStatus(monitorConfigId, "OPEN", runtime)
+	}
+
+	return retryAPICall(setHealthCheck)
+}
+
+// Function to open or close the health check.
+func (r *alidnsGtmInstanceConfigResource) setMonitorStatus(monitorConfigId, status string, runtime *util.RuntimeOptions) error {
+	setDnsGtmMonitorStatusRequest := &alicloudDnsClient.SetDnsGtmMonitorStatusRequest{
+		MonitorConfigId: tea.String(monitorConfigId),
+		Status:          tea.String(status),
+	}
+
+	if _, err := r.client.SetDnsGtmMonitorStatusWithOptions(setDnsGtmMonitorStatusRequest, runtime); err != nil {
+		return handleAPIError(err)
+	}
+	return nil
+}
+
+// Function to delete the address pool, the address pool not found is ignored.
+func (r *alidnsGtmInstanceConfigResource) deleteAddressPool(poolId string) error {
+	deleteDnsGtmAddressPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDnsGtmAddressPoolRequest := &alicloudDnsClient.DeleteDnsGtmAddressPoolRequest{
+			AddrPoolId: tea.String(poolId),
+		}
+
+		if _, err := r.client.DeleteDnsGtmAddressPoolWithOptions(deleteDnsGtmAddressPoolRequest, runtime); err != nil {
+			if isGtmNotExistError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteDnsGtmAddressPool)
+}
+
+// Function to add or update the access strategy, the address pools are
+// referenced by the names. Returns the access strategy ID.
+func (r *alidnsGtmInstanceConfigResource) setAccessStrategy(instanceId, strategyId string, strategy *alidnsGtmAccessStrategy, poolIds map[string]string) (string, error) {
+	lines := convertListStringToJsonString(convertListValueToStrings(strategy.Lines))
+	defaultPoolNames := convertListValueToStrings(strategy.DefaultAddrPools)
+	failoverPoolNames := convertListValueToStrings(strategy.FailoverAddrPools)
+
+	setAccessStrategy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		if strategyId == "" {
+			addDnsGtmAccessStrategyRequest := &alicloudDnsClient.AddDnsGtmAccessStrategyRequest{
+				InstanceId:                 tea.String(instanceId),
+				StrategyName:               tea.String(strategy.Name.ValueString()),
+				StrategyMode:               tea.String("GEO"),
+				Lines:                      tea.String(lines),
+				DefaultAddrPoolType:        tea.String(strategy.DefaultAddrPoolType.ValueString()),
+				DefaultMinAvailableAddrNum: tea.Int32(int32(strategy.DefaultMinAvailableAddrNum.ValueInt64())),
+			}
+			for _, name := range defaultPoolNames {
+				addDnsGtmAccessStrategyRequest.DefaultAddrPool = append(addDnsGtmAccessStrategyRequest.DefaultAddrPool, &alicloudDnsClient.AddDnsGtmAccessStrategyRequestDefaultAddrPool{
+					Id:        tea.String(poolIds[name]),
+					LbaWeight: tea.Int32(1),
+				})
+			}
+			if len(failoverPoolNames) > 0 {
+				addDnsGtmAccessStrategyRequest.FailoverAddrPoolType = tea.String(strategy.FailoverAddrPoolType.ValueString())
+				addDnsGtmAccessStrategyRequest.FailoverMinAvailableAddrNum = tea.Int32(int32(strategy.FailoverMinAvailableAddrNum.ValueInt64()))
+				for _, name := range failoverPoolNames {
+					addDnsGtmAccessStrategyRequest.FailoverAddrPool = append(addDnsGtmAccessStrategyRequest.FailoverAddrPool, &alicloudDnsClient.AddDnsGtmAccessStrategyRequestFailoverAddrPool{
+						Id:        tea.String(poolIds[name]),
+						LbaWeight: tea.Int32(1),
+					})
+				}
+			}
+
+			addDnsGtmAccessStrategyResponse, err := r.client.AddDnsGtmAccessStrategyWithOptions(addDnsGtmAccessStrategyRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			strategyId = tea.StringValue(addDnsGtmAccessStrategyResponse.Body.StrategyId)
+
+			// The access mode can not be set when adding the access strategy,
+			// which is AUTO by default.
+			if strategy.AccessMode.ValueString() == "AUTO" {
+				return nil
+			}
+			setDnsGtmAccessModeRequest := &alicloudDnsClient.SetDnsGtmAccessModeRequest{
+				StrategyId: tea.String(strategyId),
+				AccessMode: tea.String(strategy.AccessMode.ValueString()),
+			}
+
+			if _, err := r.client.SetDnsGtmAccessModeWithOptions(setDnsGtmAccessModeRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		updateDnsGtmAccessStrategyRequest := &alicloudDnsClient.UpdateDnsGtmAccessStrategyRequest{
+			StrategyId:                 tea.String(strategyId),
+			StrategyName:               tea.String(strategy.Name.ValueString()),
+			Lines:                      tea.String(lines),
+			AccessMode:                 tea.String(strategy.AccessMode.ValueString()),
+			DefaultAddrPoolType:        tea.String(strategy.DefaultAddrPoolType.ValueString()),
+			DefaultMinAvailableAddrNum: tea.Int32(int32(strategy.DefaultMinAvailableAddrNum.ValueInt64())),
+		}
+		for _, name := range defaultPoolNames {
+			updateDnsGtmAccessStrategyRequest.DefaultAddrPool = append(updateDnsGtmAccessStrategyRequest.DefaultAddrPool, &alicloudDnsClient.UpdateDnsGtmAccessStrategyRequestDefaultAddrPool{
+				Id:        tea.String(poolIds[name]),
+				LbaWeight: tea.Int32(1),
+			})
+		}
+		if len(failoverPoolNames) > 0 {
+			updateDnsGtmAccessStrategyRequest.FailoverAddrPoolType = tea.String(strategy.FailoverAddrPoolType.ValueString())
+			updateDnsGtmAccessStrategyRequest.FailoverMinAvailableAddrNum = tea.Int32(int32(strategy.FailoverMinAvailableAddrNum.ValueInt64()))
+			for _, name := range failoverPoolNames {
+				updateDnsGtmAccessStrategyRequest.FailoverAddrPool = append(updateDnsGtmAccessStrategyRequest.FailoverAddrPool, &alicloudDnsClient.UpdateDnsGtmAccessStrategyRequestFailoverAddrPool{
+					Id:        tea.String(poolIds[name]),
+					LbaWeight: tea.Int32(1),
+				})
+			}
+		}
+
+		if _, err := r.client.UpdateDnsGtmAccessStrategyWithOptions(updateDnsGtmAccessStrategyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(setAccessStrategy)
+	return strategyId, err
+}
+
+// Function to delete the access strategy, the access strategy not found is
+// ignored.
+func (r *alidnsGtmInstanceConfigResource) deleteAccessStrategy(strategyId string) error {
+	deleteDnsGtmAccessStrategy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDnsGtmAccessStrategyRequest := &alicloudDnsClient.DeleteDnsGtmAccessStrategyRequest{
+			StrategyId: tea.String(strategyId),
+		}
+
+		if _, err := r.client.DeleteDnsGtmAccessStrategyWithOptions(deleteDnsGtmAccessStrategyRequest, runtime); err != nil {
+			if isGtmNotExistError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteDnsGtmAccessStrategy)
+}
+
+// Function to list the IDs of the address pools of the instance keyed by the
+// names.
+func (r *alidnsGtmInstanceConfigResource) listAddressPools(instanceId string) (map[string]string, error) {
+	poolIds := map[string]string{}
+	listAddressPools := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		pageNumber := int32(1)
+		for {
+			describeDnsGtmInstanceAddressPoolsRequest := &alicloudDnsClient.DescribeDnsGtmInstanceAddressPoolsRequest{
+				InstanceId: tea.String(instanceId),
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(100),
+			}
+
+			describeDnsGtmInstanceAddressPoolsResponse, err := r.client.DescribeDnsGtmInstanceAddressPoolsWithOptions(describeDnsGtmInstanceAddressPoolsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			body := describeDnsGtmInstanceAddressPoolsResponse.Body
+			if body.AddrPools == nil || len(body.AddrPools.AddrPool) == 0 {
+				return nil
+			}
+			for _, pool := range body.AddrPools.AddrPool {
+				poolIds[tea.StringValue(pool.Name)] = tea.StringValue(pool.AddrPoolId)
+			}
+			if pageNumber*100 >= tea.Int32Value(body.TotalItems) {
+				return nil
+			}
+			pageNumber++
+		}
+	}
+
+	err := retryAPICall(listAddressPools)
+	return poolIds, err
+}
+
+// Function to describe the address pool and its health check. The health
+// check extend info of the state is kept when it is the same JSON.
+func (r *alidnsGtmInstanceConfigResource) describeAddressPool(poolId string, statePool *alidnsGtmAddressPool) (*alidnsGtmAddressPool, error) {
+	var pool *alidnsGtmAddressPool
+	describeAddressPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDnsGtmInstanceAddressPoolRequest := &alicloudDnsClient.DescribeDnsGtmInstanceAddressPoolRequest{
+			AddrPoolId: tea.String(poolId),
+		}
+
+		describeDnsGtmInstanceAddressPoolResponse, err := r.client.DescribeDnsGtmInstanceAddressPoolWithOptions(describeDnsGtmInstanceAddressPoolRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		body := describeDnsGtmInstanceAddressPoolResponse.Body
+		pool = &alidnsGtmAddressPool{
+			Name:        types.StringValue(tea.StringValue(body.Name)),
+			Type:        types.StringValue(tea.StringValue(body.Type)),
+			LbaStrategy: types.StringValue(tea.StringValue(body.LbaStrategy)),
+			Addresses:   []*alidnsGtmAddress{},
+		}
+		if body.Addrs != nil {
+			for _, addr := range body.Addrs.Addr {
+				attributeInfo := &alidnsGtmAddressAttributeInfo{}
+				_ = json.Unmarshal([]byte(tea.StringValue(addr.AttributeInfo)), attributeInfo)
+				pool.Addresses = append(pool.Addresses, &alidnsGtmAddress{
+					Address:   types.StringValue(tea.StringValue(addr.Addr)),
+					Mode:      types.StringValue(tea.StringValue(addr.Mode)),
+					LineCodes: types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(attributeInfo.LineCodes))),
+					Weight:    types.Int64Value(int64(tea.Int32Value(addr.LbaWeight))),
+				})
+			}
+		}
+
+		monitorConfigId := tea.StringValue(body.MonitorConfigId)
+		if monitorConfigId == "" || tea.StringValue(body.MonitorStatus) != "OPEN" {
+			return nil
+		}
+
+		describeDnsGtmMonitorConfigRequest := &alicloudDnsClient.DescribeDnsGtmMonitorConfigRequest{
+			MonitorConfigId: tea.String(monitorConfigId),
+		}
+
+		describeDnsGtmMonitorConfigResponse, err := r.client.DescribeDnsGtmMonitorConfigWithOptions(describeDnsGtmMonitorConfigRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		monitor := describeDnsGtmMonitorConfigResponse.Body
+		pool.HealthCheck = &alidnsGtmHealthCheck{
+			Protocol:          types.StringValue(strings.ToUpper(tea.StringValue(monitor.ProtocolType))),
+			Interval:          types.Int64Value(int64(tea.Int32Value(monitor.Interval))),
+			Timeout:           types.Int64Value(int64(tea.Int32Value(monitor.Timeout))),
+			EvaluationCount:   types.Int64Value(int64(tea.Int32Value(monitor.EvaluationCount))),
+			MonitorExtendInfo: types.StringValue(tea.StringValue(monitor.MonitorExtendInfo)),
+			IspCityNodes:      []*alidnsGtmIspCityNode{},
+		}
+		if statePool != nil && statePool.HealthCheck != nil &&
+			isJsonStringEqual(statePool.HealthCheck.MonitorExtendInfo.ValueString(), tea.StringValue(monitor.MonitorExtendInfo)) {
+			pool.HealthCheck.MonitorExtendInfo = statePool.HealthCheck.MonitorExtendInfo
+		}
+		if monitor.IspCityNodes != nil {
+			for _, node := range monitor.IspCityNodes.IspCityNode {
+				pool.HealthCheck.IspCityNodes = append(pool.HealthCheck.IspCityNodes, &alidnsGtmIspCityNode{
+					CityCode: types.StringValue(tea.StringValue(node.CityCode)),
+					IspCode:  types.StringValue(tea.StringValue(node.IspCode)),
+				})
+			}
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeAddressPool)
+	return pool, err
+}
+
+// Function to list the IDs of the geo access strategies of the instance
+// keyed by the names.
+func (r *alidnsGtmInstanceConfigResource) listAccessStrategies(instanceId string) (map[string]string, error) {
+	strategyIds := map[string]string{}
+	listAccessStrategies := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		pageNumber := int32(1)
+		for {
+			describeDnsGtmAccessStrategiesRequest := &alicloudDnsClient.DescribeDnsGtmAccessStrategiesRequest{
+				InstanceId:   tea.String(instanceId),
+				StrategyMode: tea.String("GEO"),
+				PageNumber:   tea.Int32(pageNumber),
+				PageSize:     tea.Int32(100),
+			}
+
+			describeDnsGtmAccessStrategiesResponse, err := r.client.DescribeDnsGtmAccessStrategiesWithOptions(describeDnsGtmAccessStrategiesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			body := describeDnsGtmAccessStrategiesResponse.Body
+			if body.Strategies == nil || len(body.Strategies.Strategy) == 0 {
+				return nil
+			}
+			for _, strategy := range body.Strategies.Strategy {
+				strategyIds[tea.StringValue(strategy.StrategyName)] = tea.StringValue(strategy.StrategyId)
+			}
+			if pageNumber*100 >= tea.Int32Value(body.TotalItems) {
+				return nil
+			}
+			pageNumber++
+		}
+	}
+
+	err := retryAPICall(listAccessStrategies)
+	return strategyIds, err
+}
+
+// Function to describe the access strategy, the address pools are converted
+// to the names.
+func (r *alidnsGtmInstanceConfigResource) describeAccessStrategy(strategyId string, poolNames map[string]string) (*alidnsGtmAccessStrategy, error) {
+	var strategy *alidnsGtmAccessStrategy
+	describeAccessStrategy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDnsGtmAccessStrategyRequest := &alicloudDnsClient.DescribeDnsGtmAccessStrategyRequest{
+			StrategyId: tea.String(strategyId),
+		}
+
+		describeDnsGtmAccessStrategyResponse, err := r.client.DescribeDnsGtmAccessStrategyWithOptions(describeDnsGtmAccessStrategyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		body := describeDnsGtmAccessStrategyResponse.Body
+		lines := []string{}
+		if body.Lines != nil {
+			for _, line := range body.Lines.Line {
+				lines = append(lines, tea.StringValue(line.LineCode))
+			}
+		}
+		defaultPools := []string{}
+		if body.DefaultAddrPools != nil {
+			for _, pool := range body.DefaultAddrPools.DefaultAddrPool {
+				defaultPools = append(defaultPools, poolNames[tea.StringValue(pool.Id)])
+			}
+		}
+
+		strategy = &alidnsGtmAccessStrategy{
+			Name:                        types.StringValue(tea.StringValue(body.StrategyName)),
+			Lines:                       types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(lines))),
+			AccessMode:                  types.StringValue(tea.StringValue(body.AccessMode)),
+			DefaultAddrPoolType:         types.StringValue(tea.StringValue(body.DefaultAddrPoolType)),
+			DefaultAddrPools:            types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(defaultPools))),
+			DefaultMinAvailableAddrNum:  types.Int64Value(int64(tea.Int32Value(body.DefaultMinAvailableAddrNum))),
+			FailoverAddrPoolType:        types.StringNull(),
+			FailoverAddrPools:           types.ListNull(types.StringType),
+			FailoverMinAvailableAddrNum: types.Int64Value(int64(tea.Int32Value(body.FailoverMinAvailableAddrNum))),
+		}
+		if body.FailoverAddrPools != nil && len(body.FailoverAddrPools.FailoverAddrPool) > 0 {
+			failoverPools := []string{}
+			for _, pool := range body.FailoverAddrPools.FailoverAddrPool {
+				failoverPools = append(failoverPools, poolNames[tea.StringValue(pool.Id)])
+			}
+			strategy.FailoverAddrPoolType = types.StringValue(tea.StringValue(body.FailoverAddrPoolType))
+			strategy.FailoverAddrPools = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(failoverPools)))
+		} else {
+			strategy.FailoverMinAvailableAddrNum = types.Int64Value(1)
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeAccessStrategy)
+	return strategy, err
+}
+
+// Function to get the names of the address pools in the model.
+func (m *alidnsGtmInstanceConfigModel) addressPoolNames() []string {
+	names := []string{}
+	for _, pool := range m.AddressPools {
+		names = append(names, pool.Name.ValueString())
+	}
+	return names
+}
+
+// Function to build the attribute info of the address with the line codes.
+func (a *alidnsGtmAddress) attributeInfo() string {
+	attributeInfo, _ := json.Marshal(&alidnsGtmAddressAttributeInfo{
+		LineCodes: convertListValueToStrings(a.LineCodes),
+	})
+	return string(attributeInfo)
+}
+
+// Function to order the names by the names of the state, the names which are
+// not in the state are appended at the end in the alphabetical order.
+func alidnsGtmOrderedNames(stateNames, names []string) []string {
+	exists := map[string]bool{}
+	for _, name := range names {
+		exists[name] = true
+	}
+
+	orderedNames := []string{}
+	for _, name := range stateNames {
+		if exists[name] {
+			orderedNames = append(orderedNames, name)
+		}
+	}
+	newNames := convertStringsDifference(names, stateNames)
+	sort.Strings(newNames)
+	return append(orderedNames, newNames...)
+}
+
+// Function to check whether the error is caused by the GTM instance, the
+// address pool or the access strategy which does not exist.
+func isGtmNotExistError(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(_t.Code), "NotExist")
+	}
+	return false
+}
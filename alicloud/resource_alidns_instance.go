@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/cenkalti/backoff/v4"
@@ -208,9 +207,7 @@ func (r *alidnsInstanceResource) Create(ctx context.Context, req resource.Create
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(createAlidnsInstance, reconnectBackoff)
+	err = retryAPICall(createAlidnsInstance)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Create AliDNS Instance",
@@ -278,9 +275,7 @@ func (r *alidnsInstanceResource) Read(ctx context.Context, req resource.ReadRequ
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(readInstanceDomain, reconnectBackoff)
+	err := retryAPICall(readInstanceDomain)
 	if err != nil {
 		// Remove state if dns instance is not found
 		// This will make terraform to create a new instance
@@ -442,9 +437,7 @@ func (r *alidnsInstanceResource) Update(ctx context.Context, req resource.Update
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(modifyAlidnsInstance, reconnectBackoff)
+	err = retryAPICall(modifyAlidnsInstance)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Create AliDNS Instance",
@@ -515,7 +508,5 @@ func (r alidnsInstanceResource) setInstanceRenewal(req *alicloudBaseClient.SetRe
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return backoff.Retry(setRenewal, reconnectBackoff)
+	return retryAPICall(setRenewal)
 }
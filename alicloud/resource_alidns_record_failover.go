@@ -0,0 +1,709 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDnsClient "github.com/alibabacloud-go/alidns-20150109/v4/client"
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource               = &aliDnsRecordFailoverResource{}
+	_ resource.ResourceWithConfigure  = &aliDnsRecordFailoverResource{}
+	_ resource.ResourceWithModifyPlan = &aliDnsRecordFailoverResource{}
+)
+
+func NewAliDnsRecordFailoverResource() resource.Resource {
+	return &aliDnsRecordFailoverResource{}
+}
+
+type aliDnsRecordFailoverResource struct {
+	client    *alicloudDnsClient.Client
+	cmsClient *alicloudCmsClient.Client
+}
+
+type aliDnsRecordFailoverModel struct {
+	DomainName        types.String                 `tfsdk:"domain_name"`
+	RR                types.String                 `tfsdk:"rr"`
+	Type              types.String                 `tfsdk:"type"`
+	Line              types.String                 `tfsdk:"line"`
+	Ttl               types.Int64                  `tfsdk:"ttl"`
+	PrimaryAddress    types.String                 `tfsdk:"primary_address"`
+	SecondaryAddress  types.String                 `tfsdk:"secondary_address"`
+	Monitor           *aliDnsRecordFailoverMonitor `tfsdk:"monitor"`
+	PrimaryRecordId   types.String                 `tfsdk:"primary_record_id"`
+	SecondaryRecordId types.String                 `tfsdk:"secondary_record_id"`
+	MonitorTaskId     types.String                 `tfsdk:"monitor_task_id"`
+	ActiveAddress     types.String                 `tfsdk:"active_address"`
+}
+
+type aliDnsRecordFailoverMonitor struct {
+	Protocol        types.String `tfsdk:"protocol"`
+	Port            types.Int64  `tfsdk:"port"`
+	Path            types.String `tfsdk:"path"`
+	Interval        types.Int64  `tfsdk:"interval"`
+	MinAvailability types.Int64  `tfsdk:"min_availability"`
+}
+
+// Metadata returns the failover DNS record resource name.
+func (r *aliDnsRecordFailoverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alidns_record_failover"
+}
+
+// Schema defines the schema for the failover DNS record resource.
+func (r *aliDnsRecordFailoverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a primary and a secondary Alidns record on the same RR with the health check " +
+			"driven failover, without GTM. The primary address is health checked by a site monitor task of " +
+			"CMS, the secondary record is enabled and the primary record is disabled when the availability " +
+			"of the primary address is below min_availability, and switched back when it is recovered. The " +
+			"failover is planned by every plan, so that it is applied by running Terraform periodically.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The domain name of the records.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rr": schema.StringAttribute{
+				Description: "The host record (RR) of the records, e.g. www or @.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the records. Valid values: A, AAAA, CNAME.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CNAME"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"line": schema.StringAttribute{
+				Description: "The resolution line of the records. Default to default.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "The TTL of the records in seconds. Default to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+			},
+			"primary_address": schema.StringAttribute{
+				Description: "The value of the primary record, which is health checked.",
+				Required:    true,
+			},
+			"secondary_address": schema.StringAttribute{
+				Description: "The value of the secondary record, which is resolved when the primary address is unhealthy.",
+				Required:    true,
+			},
+			"primary_record_id": schema.StringAttribute{
+				Description: "The ID of the primary record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_record_id": schema.StringAttribute{
+				Description: "The ID of the secondary record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_task_id": schema.StringAttribute{
+				Description: "The ID of the site monitor task of the primary address.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active_address": schema.StringAttribute{
+				Description: "The address of the record which is currently enabled.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"monitor": schema.SingleNestedBlock{
+				Description: "The health check of the primary address.",
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"protocol": schema.StringAttribute{
+						Description: "The protocol of the health check. Valid values: HTTP, HTTPS, TCP, PING. Default to HTTP.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("HTTP"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("HTTP", "HTTPS", "TCP", "PING"),
+						},
+					},
+					"port": schema.Int64Attribute{
+						Description: "The port of the health check, ignored for PING. Default to 80.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(80),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"path": schema.StringAttribute{
+						Description: "The path of the HTTP and HTTPS health check. Default to /.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("/"),
+					},
+					"interval": schema.Int64Attribute{
+						Description: "The interval of the health check in minutes. Valid values: 1, 5, 15, 30, 60. Default to 1.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.OneOf(1, 5, 15, 30, 60),
+						},
+					},
+					"min_availability": schema.Int64Attribute{
+						Description: "The minimum availability in percentage of the primary address, the secondary " +
+							"record is enabled when the availability is below it. Default to 100.",
+						Optional: true,
+						Computed: true,
+						Default:  int64default.StaticInt64(100),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliDnsRecordFailoverResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dnsClient
+	r.cmsClient = req.ProviderData.(alicloudClients).cmsClient
+}
+
+// ModifyPlan plans the failover by the latest availability of the primary
+// address reported by the site monitor task.
+func (r *aliDnsRecordFailoverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *aliDnsRecordFailoverModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.PrimaryAddress.IsUnknown() || plan.SecondaryAddress.IsUnknown() || plan.Monitor == nil {
+		return
+	}
+
+	availability, err := r.describeAvailability(state.MonitorTaskId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Metric Last.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The primary address is treated as healthy if the availability is not
+	// reported yet, e.g. the site monitor task is just created.
+	activeAddress := plan.PrimaryAddress
+	if availability >= 0 && availability < float64(plan.Monitor.MinAvailability.ValueInt64()) {
+		activeAddress = plan.SecondaryAddress
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active_address"), activeAddress)...)
+}
+
+// Create the records and the site monitor task, the primary record is
+// enabled and the secondary record is disabled.
+func (r *aliDnsRecordFailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *aliDnsRecordFailoverModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primaryRecordId, err := r.addDomainRecord(plan, plan.PrimaryAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Domain Record.",
+			err.Error(),
+		)
+		return
+	}
+	plan.PrimaryRecordId = types.StringValue(primaryRecordId)
+
+	secondaryRecordId, err := r.addDomainRecord(plan, plan.SecondaryAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Domain Record.",
+			err.Error(),
+		)
+		return
+	}
+	plan.SecondaryRecordId = types.StringValue(secondaryRecordId)
+
+	if err := r.setDomainRecordStatus(secondaryRecordId, "Disable"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Domain Record Status.",
+			err.Error(),
+		)
+		return
+	}
+	plan.ActiveAddress = plan.PrimaryAddress
+
+	createSiteMonitor := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createSiteMonitorRequest := &alicloudCmsClient.CreateSiteMonitorRequest{
+			TaskName: tea.String(aliDnsRecordFailoverTaskName(plan)),
+			TaskType: tea.String(plan.Monitor.Protocol.ValueString()),
+			Address:  tea.String(aliDnsRecordFailoverMonitorAddress(plan)),
+			Interval: tea.String(fmt.Sprint(plan.Monitor.Interval.ValueInt64())),
+		}
+
+		createSiteMonitorResponse, err := r.cmsClient.CreateSiteMonitorWithOptions(createSiteMonitorRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if tea.StringValue(createSiteMonitorResponse.Body.Code) != "200" {
+			return backoff.Permanent(fmt.Errorf("%s: %s",
+				tea.StringValue(createSiteMonitorResponse.Body.Code),
+				tea.StringValue(createSiteMonitorResponse.Body.Message)))
+		}
+
+		data := createSiteMonitorResponse.Body.Data
+		if data == nil || data.CreateResultList == nil || len(data.CreateResultList.CreateResultList) == 0 {
+			return backoff.Permanent(fmt.Errorf("the site monitor task is not returned"))
+		}
+		plan.MonitorTaskId = types.StringValue(tea.StringValue(data.CreateResultList.CreateResultList[0].TaskId))
+		return nil
+	}
+
+	if err := retryAPICall(createSiteMonitor); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Site Monitor.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the records, the active address is the value of the enabled record.
+func (r *aliDnsRecordFailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *aliDnsRecordFailoverModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primaryRecord, err := r.describeDomainRecordInfo(state.PrimaryRecordId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Domain Record Info.",
+			err.Error(),
+		)
+		return
+	}
+	secondaryRecord, err := r.describeDomainRecordInfo(state.SecondaryRecordId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Domain Record Info.",
+			err.Error(),
+		)
+		return
+	}
+	if primaryRecord == nil || secondaryRecord == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.PrimaryAddress = types.StringValue(tea.StringValue(primaryRecord.Value))
+	state.SecondaryAddress = types.StringValue(tea.StringValue(secondaryRecord.Value))
+	state.Ttl = types.Int64Value(tea.Int64Value(primaryRecord.TTL))
+	if tea.StringValue(primaryRecord.Status) != "ENABLE" && tea.StringValue(secondaryRecord.Status) == "ENABLE" {
+		state.ActiveAddress = state.SecondaryAddress
+	} else {
+		state.ActiveAddress = state.PrimaryAddress
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the records and the site monitor task, and switch the records to the
+// planned active address.
+func (r *aliDnsRecordFailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *aliDnsRecordFailoverModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.PrimaryRecordId = state.PrimaryRecordId
+	plan.SecondaryRecordId = state.SecondaryRecordId
+	plan.MonitorTaskId = state.MonitorTaskId
+
+	if !plan.PrimaryAddress.Equal(state.PrimaryAddress) || !plan.Ttl.Equal(state.Ttl) {
+		if err := r.updateDomainRecord(plan, plan.PrimaryRecordId.ValueString(), plan.PrimaryAddress.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+	}
+	if !plan.SecondaryAddress.Equal(state.SecondaryAddress) || !plan.Ttl.Equal(state.Ttl) {
+		if err := r.updateDomainRecord(plan, plan.SecondaryRecordId.ValueString(), plan.SecondaryAddress.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.PrimaryAddress.Equal(state.PrimaryAddress) || !plan.Monitor.equal(state.Monitor) {
+		modifySiteMonitor := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifySiteMonitorRequest := &alicloudCmsClient.ModifySiteMonitorRequest{
+				TaskId:   tea.String(plan.MonitorTaskId.ValueString()),
+				TaskName: tea.String(aliDnsRecordFailoverTaskName(plan)),
+				Address:  tea.String(aliDnsRecordFailoverMonitorAddress(plan)),
+				Interval: tea.String(fmt.Sprint(plan.Monitor.Interval.ValueInt64())),
+			}
+
+			modifySiteMonitorResponse, err := r.cmsClient.ModifySiteMonitorWithOptions(modifySiteMonitorRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			if tea.StringValue(modifySiteMonitorResponse.Body.Code) != "200" {
+				return backoff.Permanent(fmt.Errorf("%s: %s",
+					tea.StringValue(modifySiteMonitorResponse.Body.Code),
+					tea.StringValue(modifySiteMonitorResponse.Body.Message)))
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifySiteMonitor); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify Site Monitor.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Enable the active record before disabling the other one, so that the
+	// RR is always resolvable.
+	if plan.ActiveAddress.IsUnknown() {
+		plan.ActiveAddress = state.ActiveAddress
+	}
+	activeRecordId, inactiveRecordId := plan.PrimaryRecordId.ValueString(), plan.SecondaryRecordId.ValueString()
+	if plan.ActiveAddress.Equal(plan.SecondaryAddress) && !plan.ActiveAddress.Equal(plan.PrimaryAddress) {
+		activeRecordId, inactiveRecordId = inactiveRecordId, activeRecordId
+	} else {
+		plan.ActiveAddress = plan.PrimaryAddress
+	}
+	if err := r.setDomainRecordStatus(activeRecordId, "Enable"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Domain Record Status.",
+			err.Error(),
+		)
+		return
+	}
+	if err := r.setDomainRecordStatus(inactiveRecordId, "Disable"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Domain Record Status.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the site monitor task and the records.
+func (r *aliDnsRecordFailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *aliDnsRecordFailoverModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteSiteMonitors := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteSiteMonitorsRequest := &alicloudCmsClient.DeleteSiteMonitorsRequest{
+			TaskIds:        tea.String(state.MonitorTaskId.ValueString()),
+			IsDeleteAlarms: tea.Bool(true),
+		}
+
+		if _, err := r.cmsClient.DeleteSiteMonitorsWithOptions(deleteSiteMonitorsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteSiteMonitors); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Site Monitors.",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, recordId := range []string{state.PrimaryRecordId.ValueString(), state.SecondaryRecordId.ValueString()} {
+		if err := r.deleteDomainRecord(recordId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// Function to add a record on the RR, returns the record ID.
+func (r *aliDnsRecordFailoverResource) addDomainRecord(plan *aliDnsRecordFailoverModel, value string) (string, error) {
+	var recordId string
+	addDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addDomainRecordRequest := &alicloudDnsClient.AddDomainRecordRequest{
+			DomainName: tea.String(plan.DomainName.ValueString()),
+			RR:         tea.String(plan.RR.ValueString()),
+			Type:       tea.String(plan.Type.ValueString()),
+			Value:      tea.String(value),
+			TTL:        tea.Int64(plan.Ttl.ValueInt64()),
+			Line:       tea.String(plan.Line.ValueString()),
+		}
+
+		addDomainRecordResponse, err := r.client.AddDomainRecordWithOptions(addDomainRecordRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		recordId = tea.StringValue(addDomainRecordResponse.Body.RecordId)
+		return nil
+	}
+
+	err := retryAPICall(addDomainRecord)
+	return recordId, err
+}
+
+// Function to update the value and the TTL of a record.
+func (r *aliDnsRecordFailoverResource) updateDomainRecord(plan *aliDnsRecordFailoverModel, recordId, value string) error {
+	updateDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateDomainRecordRequest := &alicloudDnsClient.UpdateDomainRecordRequest{
+			RecordId: tea.String(recordId),
+			RR:       tea.String(plan.RR.ValueString()),
+			Type:     tea.String(plan.Type.ValueString()),
+			Value:    tea.String(value),
+			TTL:      tea.Int64(plan.Ttl.ValueInt64()),
+			Line:     tea.String(plan.Line.ValueString()),
+		}
+
+		if _, err := r.client.UpdateDomainRecordWithOptions(updateDomainRecordRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "DomainRecordDuplicate" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateDomainRecord)
+}
+
+// Function to enable or disable a record.
+func (r *aliDnsRecordFailoverResource) setDomainRecordStatus(recordId, status string) error {
+	setDomainRecordStatus := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setDomainRecordStatusRequest := &alicloudDnsClient.SetDomainRecordStatusRequest{
+			RecordId: tea.String(recordId),
+			Status:   tea.String(status),
+		}
+
+		if _, err := r.client.SetDomainRecordStatusWithOptions(setDomainRecordStatusRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setDomainRecordStatus)
+}
+
+// Function to delete a record, the record not found is ignored.
+func (r *aliDnsRecordFailoverResource) deleteDomainRecord(recordId string) error {
+	deleteDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDomainRecordRequest := &alicloudDnsClient.DeleteDomainRecordRequest{
+			RecordId: tea.String(recordId),
+		}
+
+		if _, err := r.client.DeleteDomainRecordWithOptions(deleteDomainRecordRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "DomainRecordNotBelongToUser" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteDomainRecord)
+}
+
+// Function to describe a record, returns nil if the record is not found.
+func (r *aliDnsRecordFailoverResource) describeDomainRecordInfo(recordId string) (*alicloudDnsClient.DescribeDomainRecordInfoResponseBody, error) {
+	var record *alicloudDnsClient.DescribeDomainRecordInfoResponseBody
+	describeDomainRecordInfo := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDomainRecordInfoRequest := &alicloudDnsClient.DescribeDomainRecordInfoRequest{
+			RecordId: tea.String(recordId),
+		}
+
+		describeDomainRecordInfoResponse, err := r.client.DescribeDomainRecordInfoWithOptions(describeDomainRecordInfoRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "DomainRecordNotBelongToUser" {
+				record = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		record = describeDomainRecordInfoResponse.Body
+		return nil
+	}
+
+	err := retryAPICall(describeDomainRecordInfo)
+	return record, err
+}
+
+// Function to describe the latest availability in percentage of the site
+// monitor task, returns -1 if it is not reported yet.
+func (r *aliDnsRecordFailoverResource) describeAvailability(taskId string) (float64, error) {
+	availability := float64(-1)
+	describeMetricLast := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeMetricLastRequest := &alicloudCmsClient.DescribeMetricLastRequest{
+			Namespace:  tea.String("acs_networkmonitor"),
+			MetricName: tea.String("Availability"),
+			Dimensions: tea.String(fmt.Sprintf(`[{"taskId":"%s"}]`, taskId)),
+		}
+
+		describeMetricLastResponse, err := r.cmsClient.DescribeMetricLastWithOptions(describeMetricLastRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		var datapoints []struct {
+			Availability float64 `json:"Availability"`
+		}
+		if points := tea.StringValue(describeMetricLastResponse.Body.Datapoints); points != "" {
+			if err := json.Unmarshal([]byte(points), &datapoints); err != nil {
+				return backoff.Permanent(err)
+			}
+		}
+		if len(datapoints) > 0 {
+			availability = datapoints[0].Availability
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeMetricLast)
+	return availability, err
+}
+
+// Returns true if the health checks are the same.
+func (monitor *aliDnsRecordFailoverMonitor) equal(other *aliDnsRecordFailoverMonitor) bool {
+	if monitor == nil || other == nil {
+		return monitor == other
+	}
+	return monitor.Protocol.Equal(other.Protocol) &&
+		monitor.Port.Equal(other.Port) &&
+		monitor.Path.Equal(other.Path) &&
+		monitor.Interval.Equal(other.Interval)
+}
+
+// Function to generate the name of the site monitor task of the RR.
+func aliDnsRecordFailoverTaskName(plan *aliDnsRecordFailoverModel) string {
+	return fmt.Sprintf("failover-%s", aliDnsSubDomain(plan.DomainName.ValueString(), plan.RR.ValueString()))
+}
+
+// Function to generate the address of the site monitor task by the protocol
+// of the health check.
+func aliDnsRecordFailoverMonitorAddress(plan *aliDnsRecordFailoverModel) string {
+	address := plan.PrimaryAddress.ValueString()
+	switch protocol := plan.Monitor.Protocol.ValueString(); protocol {
+	case "PING":
+		return address
+	case "TCP":
+		return fmt.Sprintf("%s:%d", address, plan.Monitor.Port.ValueInt64())
+	default:
+		return fmt.Sprintf("%s://%s:%d%s", strings.ToLower(protocol), address,
+			plan.Monitor.Port.ValueInt64(), plan.Monitor.Path.ValueString())
+	}
+}
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 
@@ -205,11 +204,7 @@ func (r *aliDnsRecordWeightResource) Read(ctx context.Context, req resource.Read
 		return nil
 	}
 
-	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-
-	err := backoff.Retry(readRecordWeight, reconnectBackoff)
+	err := retryAPICall(readRecordWeight)
 	if err != nil {
 		if err.Error() == "domain record not found" {
 			resp.State.RemoveResource(ctx)
@@ -391,9 +386,7 @@ func (r *aliDnsRecordWeightResource) setWeight(plan *aliDnsRecordWeightResourceM
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(setRecordWeight, reconnectBackoff)
+	err := retryAPICall(setRecordWeight)
 	if err != nil {
 		return err
 	}
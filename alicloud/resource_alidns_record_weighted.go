@@ -0,0 +1,516 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDnsClient "github.com/alibabacloud-go/alidns-20150109/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &aliDnsRecordWeightedResource{}
+	_ resource.ResourceWithConfigure   = &aliDnsRecordWeightedResource{}
+	_ resource.ResourceWithImportState = &aliDnsRecordWeightedResource{}
+)
+
+func NewAliDnsRecordWeightedResource() resource.Resource {
+	return &aliDnsRecordWeightedResource{}
+}
+
+type aliDnsRecordWeightedResource struct {
+	client *alicloudDnsClient.Client
+}
+
+type aliDnsRecordWeightedModel struct {
+	DomainName types.String            `tfsdk:"domain_name"`
+	RR         types.String            `tfsdk:"rr"`
+	Type       types.String            `tfsdk:"type"`
+	Line       types.String            `tfsdk:"line"`
+	Ttl        types.Int64             `tfsdk:"ttl"`
+	Records    []*aliDnsWeightedRecord `tfsdk:"records"`
+	RecordIds  types.Map               `tfsdk:"record_ids"`
+}
+
+type aliDnsWeightedRecord struct {
+	Value  types.String `tfsdk:"value"`
+	Weight types.Int64  `tfsdk:"weight"`
+}
+
+// Metadata returns the weighted DNS record resource name.
+func (r *aliDnsRecordWeightedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alidns_record_weighted"
+}
+
+// Schema defines the schema for the weighted DNS record resource.
+func (r *aliDnsRecordWeightedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage multiple Alidns records on the same RR with the weighted round-robin, the " +
+			"traffic is distributed to the records by their weights.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The domain name of the records.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rr": schema.StringAttribute{
+				Description: "The host record (RR) of the records, e.g. www or @.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the records. Valid values: A, AAAA, CNAME.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CNAME"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"line": schema.StringAttribute{
+				Description: "The resolution line of the records. Default to default.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "The TTL of the records in seconds. Default to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+			},
+			"record_ids": schema.MapAttribute{
+				Description: "The IDs of the records keyed by the record values.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"records": schema.ListNestedBlock{
+				Description: "The values and the weights of the records.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The value of the record.",
+							Required:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the record, from 1 to 100.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliDnsRecordWeightedResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dnsClient
+}
+
+// Create the records and enable the weighted round-robin.
+func (r *aliDnsRecordWeightedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *aliDnsRecordWeightedModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordIds := map[string]string{}
+	for _, record := range plan.Records {
+		recordId, err := r.addDomainRecord(plan, record.Value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Add Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+		recordIds[record.Value.ValueString()] = recordId
+	}
+
+	if err := r.setWeights(plan, recordIds, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set DNS Record Weights.",
+			err.Error(),
+		)
+		return
+	}
+	plan.RecordIds = convertStringMapToMapValue(recordIds)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the records on the RR.
+func (r *aliDnsRecordWeightedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *aliDnsRecordWeightedModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteRecords, err := r.describeSubDomainRecords(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Sub Domain Records.",
+			err.Error(),
+		)
+		return
+	}
+	if len(remoteRecords) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the order of the records in the state, the records added outside
+	// of Terraform are appended at the end.
+	remoteByValue := map[string]*alicloudDnsClient.DescribeSubDomainRecordsResponseBodyDomainRecordsRecord{}
+	for _, record := range remoteRecords {
+		remoteByValue[tea.StringValue(record.Value)] = record
+	}
+
+	records := []*aliDnsWeightedRecord{}
+	recordIds := map[string]string{}
+	appendRecord := func(record *alicloudDnsClient.DescribeSubDomainRecordsResponseBodyDomainRecordsRecord) {
+		value := tea.StringValue(record.Value)
+		records = append(records, &aliDnsWeightedRecord{
+			Value:  types.StringValue(value),
+			Weight: types.Int64Value(int64(tea.Int32Value(record.Weight))),
+		})
+		recordIds[value] = tea.StringValue(record.RecordId)
+		delete(remoteByValue, value)
+	}
+	for _, record := range state.Records {
+		if remoteRecord, ok := remoteByValue[record.Value.ValueString()]; ok {
+			appendRecord(remoteRecord)
+		}
+	}
+	for _, record := range remoteRecords {
+		if _, ok := remoteByValue[tea.StringValue(record.Value)]; ok {
+			appendRecord(record)
+		}
+	}
+
+	state.Records = records
+	state.RecordIds = convertStringMapToMapValue(recordIds)
+	state.Ttl = types.Int64Value(tea.Int64Value(remoteRecords[0].TTL))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Add, delete and update the records to match the plan.
+func (r *aliDnsRecordWeightedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *aliDnsRecordWeightedModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRecordIds := map[string]string{}
+	resp.Diagnostics.Append(state.RecordIds.ElementsAs(ctx, &stateRecordIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateWeights := map[string]int64{}
+	for _, record := range state.Records {
+		stateWeights[record.Value.ValueString()] = record.Weight.ValueInt64()
+	}
+
+	// Add the new records before deleting the removed ones, so that the RR
+	// is always resolvable.
+	recordIds := map[string]string{}
+	for _, record := range plan.Records {
+		value := record.Value.ValueString()
+		if recordId, ok := stateRecordIds[value]; ok {
+			recordIds[value] = recordId
+			delete(stateRecordIds, value)
+
+			if !plan.Ttl.Equal(state.Ttl) {
+				if err := r.updateDomainRecord(plan, recordId, value); err != nil {
+					resp.Diagnostics.AddError(
+						"[API ERROR] Failed to Update Domain Record.",
+						err.Error(),
+					)
+					return
+				}
+			}
+			continue
+		}
+
+		recordId, err := r.addDomainRecord(plan, value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Add Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+		recordIds[value] = recordId
+	}
+
+	for _, recordId := range stateRecordIds {
+		if err := r.deleteDomainRecord(recordId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if err := r.setWeights(plan, recordIds, stateWeights); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set DNS Record Weights.",
+			err.Error(),
+		)
+		return
+	}
+	plan.RecordIds = convertStringMapToMapValue(recordIds)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete all the records.
+func (r *aliDnsRecordWeightedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *aliDnsRecordWeightedModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordIds := map[string]string{}
+	resp.Diagnostics.Append(state.RecordIds.ElementsAs(ctx, &recordIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, recordId := range recordIds {
+		if err := r.deleteDomainRecord(recordId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Domain Record.",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// Import the records with the ID in the format of <domain_name>:<rr>:<type>.
+func (r *aliDnsRecordWeightedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <domain_name>:<rr>:<type>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rr"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("line"), "default")...)
+}
+
+// Function to add a record on the RR, returns the record ID.
+func (r *aliDnsRecordWeightedResource) addDomainRecord(plan *aliDnsRecordWeightedModel, value string) (string, error) {
+	var recordId string
+	addDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addDomainRecordRequest := &alicloudDnsClient.AddDomainRecordRequest{
+			DomainName: tea.String(plan.DomainName.ValueString()),
+			RR:         tea.String(plan.RR.ValueString()),
+			Type:       tea.String(plan.Type.ValueString()),
+			Value:      tea.String(value),
+			TTL:        tea.Int64(plan.Ttl.ValueInt64()),
+			Line:       tea.String(plan.Line.ValueString()),
+		}
+
+		addDomainRecordResponse, err := r.client.AddDomainRecordWithOptions(addDomainRecordRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		recordId = tea.StringValue(addDomainRecordResponse.Body.RecordId)
+		return nil
+	}
+
+	err := retryAPICall(addDomainRecord)
+	return recordId, err
+}
+
+// Function to update the TTL of a record.
+func (r *aliDnsRecordWeightedResource) updateDomainRecord(plan *aliDnsRecordWeightedModel, recordId, value string) error {
+	updateDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateDomainRecordRequest := &alicloudDnsClient.UpdateDomainRecordRequest{
+			RecordId: tea.String(recordId),
+			RR:       tea.String(plan.RR.ValueString()),
+			Type:     tea.String(plan.Type.ValueString()),
+			Value:    tea.String(value),
+			TTL:      tea.Int64(plan.Ttl.ValueInt64()),
+			Line:     tea.String(plan.Line.ValueString()),
+		}
+
+		if _, err := r.client.UpdateDomainRecordWithOptions(updateDomainRecordRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateDomainRecord)
+}
+
+// Function to delete a record, the record not found is ignored.
+func (r *aliDnsRecordWeightedResource) deleteDomainRecord(recordId string) error {
+	deleteDomainRecord := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDomainRecordRequest := &alicloudDnsClient.DeleteDomainRecordRequest{
+			RecordId: tea.String(recordId),
+		}
+
+		if _, err := r.client.DeleteDomainRecordWithOptions(deleteDomainRecordRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "DomainRecordNotBelongToUser" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteDomainRecord)
+}
+
+// Function to enable the weighted round-robin of the RR and update the
+// weights of the records which are changed.
+func (r *aliDnsRecordWeightedResource) setWeights(plan *aliDnsRecordWeightedModel, recordIds map[string]string, stateWeights map[string]int64) error {
+	subDomain := aliDnsSubDomain(plan.DomainName.ValueString(), plan.RR.ValueString())
+
+	setWeights := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setDNSSLBStatusRequest := &alicloudDnsClient.SetDNSSLBStatusRequest{
+			SubDomain: tea.String(subDomain),
+			Type:      tea.String(plan.Type.ValueString()),
+			Line:      tea.String(plan.Line.ValueString()),
+			Open:      tea.Bool(true),
+		}
+
+		if _, err := r.client.SetDNSSLBStatusWithOptions(setDNSSLBStatusRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+
+		for _, record := range plan.Records {
+			value := record.Value.ValueString()
+			if weight, ok := stateWeights[value]; ok && weight == record.Weight.ValueInt64() {
+				continue
+			}
+
+			updateDNSSLBWeightRequest := &alicloudDnsClient.UpdateDNSSLBWeightRequest{
+				RecordId: tea.String(recordIds[value]),
+				Weight:   tea.Int32(int32(record.Weight.ValueInt64())),
+			}
+
+			if _, err := r.client.UpdateDNSSLBWeightWithOptions(updateDNSSLBWeightRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+		return nil
+	}
+
+	return retryAPICall(setWeights)
+}
+
+// Function to describe the records of the RR with the type and the line.
+func (r *aliDnsRecordWeightedResource) describeSubDomainRecords(state *aliDnsRecordWeightedModel) ([]*alicloudDnsClient.DescribeSubDomainRecordsResponseBodyDomainRecordsRecord, error) {
+	var records []*alicloudDnsClient.DescribeSubDomainRecordsResponseBodyDomainRecordsRecord
+	describeSubDomainRecords := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeSubDomainRecordsRequest := &alicloudDnsClient.DescribeSubDomainRecordsRequest{
+			DomainName: tea.String(state.DomainName.ValueString()),
+			SubDomain:  tea.String(aliDnsSubDomain(state.DomainName.ValueString(), state.RR.ValueString())),
+			Type:       tea.String(state.Type.ValueString()),
+			Line:       tea.String(state.Line.ValueString()),
+			PageSize:   tea.Int64(500),
+		}
+
+		describeSubDomainRecordsResponse, err := r.client.DescribeSubDomainRecordsWithOptions(describeSubDomainRecordsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		records = nil
+		if describeSubDomainRecordsResponse.Body.DomainRecords != nil {
+			records = describeSubDomainRecordsResponse.Body.DomainRecords.Record
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeSubDomainRecords)
+	return records, err
+}
+
+// Function to combine the RR and the domain name into the sub domain.
+func aliDnsSubDomain(domainName, rr string) string {
+	if rr == "@" {
+		return domainName
+	}
+	return fmt.Sprintf("%s.%s", rr, domainName)
+}
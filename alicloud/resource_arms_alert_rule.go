@@ -0,0 +1,483 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsAlertRuleResource{}
+	_ resource.ResourceWithConfigure   = &armsAlertRuleResource{}
+	_ resource.ResourceWithImportState = &armsAlertRuleResource{}
+)
+
+func NewArmsAlertRuleResource() resource.Resource {
+	return &armsAlertRuleResource{}
+}
+
+type armsAlertRuleResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsAlertRuleModel struct {
+	AlertRuleId          types.String `tfsdk:"alert_rule_id"`
+	AlertRuleName        types.String `tfsdk:"alert_rule_name"`
+	ClusterId            types.String `tfsdk:"cluster_id"`
+	Expression           types.String `tfsdk:"expression"`
+	Duration             types.Int64  `tfsdk:"duration"`
+	Severity             types.String `tfsdk:"severity"`
+	Message              types.String `tfsdk:"message"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Annotations          types.Map    `tfsdk:"annotations"`
+	NotificationPolicyId types.String `tfsdk:"notification_policy_id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+}
+
+// The label or annotation of the Prometheus alert rule in the format of
+// AliCloud API.
+type armsAlertRuleLabel struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// Metadata returns the ARMS Alert Rule resource name.
+func (r *armsAlertRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_alert_rule"
+}
+
+// Schema defines the schema for the ARMS Alert Rule resource.
+func (r *armsAlertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an Application Real-Time Monitoring Service (ARMS) Prometheus alert rule with a " +
+			"custom PromQL expression.",
+		Attributes: map[string]schema.Attribute{
+			"alert_rule_id": schema.StringAttribute{
+				Description: "The ID of the alert rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alert_rule_name": schema.StringAttribute{
+				Description: "The name of the alert rule.",
+				Required:    true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the Prometheus instance to evaluate the alert rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expression": schema.StringAttribute{
+				Description: "The PromQL expression of the alert rule.",
+				Required:    true,
+			},
+			"duration": schema.Int64Attribute{
+				Description: "The duration in minutes for which the expression must be true before the alert is " +
+					"fired. Default to 1.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1440),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Description: "The severity of the alert. Accepted values: \"P1\", \"P2\", \"P3\", \"P4\". Default to \"P2\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("P2"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("P1", "P2", "P3", "P4"),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The alert message, which supports the Prometheus template variables, e.g. $labels.pod.",
+				Required:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "The labels of the alert, which can be matched by the notification policies.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				Description: "The annotations of the alert.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"notification_policy_id": schema.StringAttribute{
+				Description: "The ID of the notification policy to send the alert notifications. A matching rule of " +
+					"the alert rule is added to the notification policy by ARMS.",
+				Optional: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to enable the alert rule. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsAlertRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Create the alert rule.
+func (r *armsAlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsAlertRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createOrUpdateAlertRule(ctx, plan, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Alert Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The alert rule ID returned by AliCloud API is a float which may lose
+	// precision, so the alert rule is queried again by its unique name.
+	alertRule, err := r.getAlertRule(&alicloudArmsClient.GetAlertRulesRequest{
+		AlertNames: tea.String(fmt.Sprintf("[%q]", plan.AlertRuleName.ValueString())),
+		ClusterId:  tea.String(plan.ClusterId.ValueString()),
+	}, func(rule *alicloudArmsClient.GetAlertRulesResponseBodyPageBeanAlertRules) bool {
+		return tea.StringValue(rule.AlertName) == plan.AlertRuleName.ValueString()
+	})
+	if err == nil && alertRule == nil {
+		err = fmt.Errorf("alert rule %s is not found after creation", plan.AlertRuleName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Alert Rules.",
+			err.Error(),
+		)
+		return
+	}
+	plan.AlertRuleId = types.StringValue(strconv.FormatInt(tea.Int64Value(alertRule.AlertId), 10))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the alert rule.
+func (r *armsAlertRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsAlertRuleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertRule, err := r.getAlertRule(&alicloudArmsClient.GetAlertRulesRequest{
+		AlertIds: tea.String(fmt.Sprintf("[%q]", state.AlertRuleId.ValueString())),
+	}, func(rule *alicloudArmsClient.GetAlertRulesResponseBodyPageBeanAlertRules) bool {
+		return strconv.FormatInt(tea.Int64Value(rule.AlertId), 10) == state.AlertRuleId.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Alert Rules.",
+			err.Error(),
+		)
+		return
+	}
+	if alertRule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.AlertRuleName = types.StringValue(tea.StringValue(alertRule.AlertName))
+	state.ClusterId = types.StringValue(tea.StringValue(alertRule.ClusterId))
+	state.Expression = types.StringValue(tea.StringValue(alertRule.PromQL))
+	if duration, err := strconv.ParseInt(tea.StringValue(alertRule.Duration), 10, 64); err == nil {
+		state.Duration = types.Int64Value(duration)
+	}
+	state.Severity = types.StringValue(tea.StringValue(alertRule.Level))
+	state.Message = types.StringValue(tea.StringValue(alertRule.Message))
+	state.Enabled = types.BoolValue(tea.StringValue(alertRule.AlertStatus) != "STOPPED")
+
+	notificationPolicyId := tea.StringValue(alertRule.NotifyStrategy)
+	if state.NotificationPolicyId.IsNull() && notificationPolicyId == "" {
+		state.NotificationPolicyId = types.StringNull()
+	} else {
+		state.NotificationPolicyId = types.StringValue(notificationPolicyId)
+	}
+
+	labels := make(map[string]string)
+	for _, label := range alertRule.Labels {
+		labels[tea.StringValue(label.Name)] = tea.StringValue(label.Value)
+	}
+	state.Labels = armsAlertRuleLabelsValue(state.Labels, labels)
+
+	annotations := make(map[string]string)
+	for _, annotation := range alertRule.Annotations {
+		annotations[tea.StringValue(annotation.Name)] = tea.StringValue(annotation.Value)
+	}
+	state.Annotations = armsAlertRuleLabelsValue(state.Annotations, annotations)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the alert rule.
+func (r *armsAlertRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *armsAlertRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertRuleId, err := strconv.ParseInt(state.AlertRuleId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Alert Rule ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.createOrUpdateAlertRule(ctx, plan, tea.Int64(alertRuleId)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Alert Rule.",
+			err.Error(),
+		)
+		return
+	}
+	plan.AlertRuleId = state.AlertRuleId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the alert rule.
+func (r *armsAlertRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsAlertRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertRuleId, err := strconv.ParseInt(state.AlertRuleId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Alert Rule ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteAlertRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAlertRuleRequest := &alicloudArmsClient.DeleteAlertRuleRequest{
+			AlertId: tea.Int64(alertRuleId),
+		}
+
+		deleteAlertRuleResponse, err := r.client.DeleteAlertRuleWithOptions(deleteAlertRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if !tea.BoolValue(deleteAlertRuleResponse.Body.IsSuccess) {
+			return backoff.Permanent(fmt.Errorf("failed to delete alert rule %d, request ID: %s",
+				alertRuleId, tea.StringValue(deleteAlertRuleResponse.Body.RequestId)))
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAlertRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Alert Rule.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *armsAlertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("alert_rule_id"), req, resp)
+}
+
+// Function to create the alert rule, or update the alert rule if the alert
+// rule ID is given.
+func (r *armsAlertRuleResource) createOrUpdateAlertRule(ctx context.Context, model *armsAlertRuleModel, alertRuleId *int64) error {
+	labels, err := armsAlertRuleLabelsJson(ctx, model.Labels)
+	if err != nil {
+		return err
+	}
+	annotations, err := armsAlertRuleLabelsJson(ctx, model.Annotations)
+	if err != nil {
+		return err
+	}
+
+	alertStatus := "RUNNING"
+	if !model.Enabled.ValueBool() {
+		alertStatus = "STOPPED"
+	}
+
+	createOrUpdateAlertRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createOrUpdateAlertRuleRequest := &alicloudArmsClient.CreateOrUpdateAlertRuleRequest{
+			RegionId:       r.client.RegionId,
+			AlertId:        alertRuleId,
+			AlertType:      tea.String("PROMETHEUS_MONITORING_ALERT_RULE"),
+			AlertCheckType: tea.String("CUSTOM"),
+			AlertGroup:     tea.Int64(-1),
+			AlertName:      tea.String(model.AlertRuleName.ValueString()),
+			ClusterId:      tea.String(model.ClusterId.ValueString()),
+			PromQL:         tea.String(model.Expression.ValueString()),
+			Duration:       tea.Int64(model.Duration.ValueInt64()),
+			Level:          tea.String(model.Severity.ValueString()),
+			Message:        tea.String(model.Message.ValueString()),
+			Labels:         tea.String(labels),
+			Annotations:    tea.String(annotations),
+			NotifyStrategy: armsStringPointer(model.NotificationPolicyId),
+			AlertStatus:    tea.String(alertStatus),
+		}
+
+		if _, err := r.client.CreateOrUpdateAlertRuleWithOptions(createOrUpdateAlertRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(createOrUpdateAlertRule)
+}
+
+// Function to get the first Prometheus alert rule matched by the filter from
+// the alert rules returned by the request, returns nil if no alert rule is
+// matched.
+func (r *armsAlertRuleResource) getAlertRule(request *alicloudArmsClient.GetAlertRulesRequest, filter func(*alicloudArmsClient.GetAlertRulesResponseBodyPageBeanAlertRules) bool) (*alicloudArmsClient.GetAlertRulesResponseBodyPageBeanAlertRules, error) {
+	request.RegionId = r.client.RegionId
+	request.AlertType = tea.String("PROMETHEUS_MONITORING_ALERT_RULE")
+	request.Size = tea.Int64(50)
+
+	page := int64(1)
+	for {
+		var getAlertRulesResponse *alicloudArmsClient.GetAlertRulesResponse
+		getAlertRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			request.Page = tea.Int64(page)
+
+			var err error
+			getAlertRulesResponse, err = r.client.GetAlertRulesWithOptions(request, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getAlertRules); err != nil {
+			return nil, err
+		}
+
+		pageBean := getAlertRulesResponse.Body.PageBean
+		if pageBean == nil {
+			return nil, nil
+		}
+		for _, alertRule := range pageBean.AlertRules {
+			if filter(alertRule) {
+				return alertRule, nil
+			}
+		}
+
+		if page*tea.Int64Value(request.Size) >= tea.Int64Value(pageBean.Total) {
+			return nil, nil
+		}
+		page++
+	}
+}
+
+// Function to convert the Terraform map to the labels or annotations in the
+// JSON format of AliCloud API, sorted by the names.
+func armsAlertRuleLabelsJson(ctx context.Context, value types.Map) (string, error) {
+	labelMap := make(map[string]string)
+	if !value.IsNull() && !value.IsUnknown() {
+		if diags := value.ElementsAs(ctx, &labelMap, false); diags.HasError() {
+			return "", fmt.Errorf("failed to convert the labels: %v", diags)
+		}
+	}
+
+	labels := []armsAlertRuleLabel{}
+	for name, labelValue := range labelMap {
+		labels = append(labels, armsAlertRuleLabel{Name: name, Value: labelValue})
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name < labels[j].Name
+	})
+
+	labelsJson, err := json.Marshal(labels)
+	if err != nil {
+		return "", err
+	}
+	return string(labelsJson), nil
+}
+
+// Function to convert the labels or annotations returned by AliCloud API to
+// the Terraform map. The system labels prefixed with "_aliyun" added by ARMS
+// are ignored, and the map is kept null if it is not configured and no label
+// is returned.
+func armsAlertRuleLabelsValue(prev types.Map, labels map[string]string) types.Map {
+	elements := make(map[string]attr.Value)
+	for name, value := range labels {
+		if strings.HasPrefix(name, "_aliyun") {
+			continue
+		}
+		elements[name] = types.StringValue(value)
+	}
+	if prev.IsNull() && len(elements) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -0,0 +1,617 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsNotificationPolicyResource{}
+	_ resource.ResourceWithConfigure   = &armsNotificationPolicyResource{}
+	_ resource.ResourceWithImportState = &armsNotificationPolicyResource{}
+)
+
+func NewArmsNotificationPolicyResource() resource.Resource {
+	return &armsNotificationPolicyResource{}
+}
+
+type armsNotificationPolicyResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsNotificationPolicyModel struct {
+	NotificationPolicyId types.String                    `tfsdk:"notification_policy_id"`
+	Name                 types.String                    `tfsdk:"name"`
+	Enabled              types.Bool                      `tfsdk:"enabled"`
+	SendRecoverMessage   types.Bool                      `tfsdk:"send_recover_message"`
+	RepeatInterval       types.Int64                     `tfsdk:"repeat_interval"`
+	EscalationPolicyId   types.String                    `tfsdk:"escalation_policy_id"`
+	GroupWait            types.Int64                     `tfsdk:"group_wait"`
+	GroupInterval        types.Int64                     `tfsdk:"group_interval"`
+	GroupingFields       types.List                      `tfsdk:"grouping_fields"`
+	NotifyStartTime      types.String                    `tfsdk:"notify_start_time"`
+	NotifyEndTime        types.String                    `tfsdk:"notify_end_time"`
+	NotifyChannels       types.List                      `tfsdk:"notify_channels"`
+	MatchingRules        []*armsNotificationMatchingRule `tfsdk:"matching_rules"`
+	NotifyObjects        []*armsNotificationNotifyObject `tfsdk:"notify_objects"`
+}
+
+type armsNotificationMatchingRule struct {
+	Conditions []*armsNotificationMatchingCondition `tfsdk:"conditions"`
+}
+
+type armsNotificationMatchingCondition struct {
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type armsNotificationNotifyObject struct {
+	Type     types.String `tfsdk:"type"`
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Channels types.List   `tfsdk:"channels"`
+}
+
+// The notification rule, group rule and matching rules of the notification
+// policy in the JSON format of AliCloud API.
+type armsNotifyRuleJson struct {
+	NotifyStartTime string                 `json:"notifyStartTime"`
+	NotifyEndTime   string                 `json:"notifyEndTime"`
+	NotifyChannels  []string               `json:"notifyChannels"`
+	NotifyObjects   []armsNotifyObjectJson `json:"notifyObjects"`
+}
+
+type armsNotifyObjectJson struct {
+	NotifyObjectType string   `json:"notifyObjectType"`
+	NotifyObjectId   int64    `json:"notifyObjectId"`
+	NotifyObjectName string   `json:"notifyObjectName"`
+	NotifyChannels   []string `json:"notifyChannels,omitempty"`
+}
+
+type armsGroupRuleJson struct {
+	GroupWait      int64    `json:"groupWait"`
+	GroupInterval  int64    `json:"groupInterval"`
+	GroupingFields []string `json:"groupingFields,omitempty"`
+}
+
+type armsMatchingRuleJson struct {
+	MatchingConditions []armsMatchingConditionJson `json:"matchingConditions"`
+}
+
+type armsMatchingConditionJson struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// Metadata returns the ARMS Notification Policy resource name.
+func (r *armsNotificationPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_notification_policy"
+}
+
+// Schema defines the schema for the ARMS Notification Policy resource.
+func (r *armsNotificationPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an Application Real-Time Monitoring Service (ARMS) notification policy, which routes " +
+			"the matched alert events to the contacts or contact groups, and resends or escalates the unresolved " +
+			"alerts.",
+		Attributes: map[string]schema.Attribute{
+			"notification_policy_id": schema.StringAttribute{
+				Description: "The ID of the notification policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the notification policy.",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to enable the notification policy. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"send_recover_message": schema.BoolAttribute{
+				Description: "Whether to send a notification when the alert is resolved. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"repeat_interval": schema.Int64Attribute{
+				Description: "The interval in seconds to resend the notification of an unresolved alert. Ignored " +
+					"if escalation_policy_id is set. Default to 600.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(600),
+			},
+			"escalation_policy_id": schema.StringAttribute{
+				Description: "The ID of the escalation policy to escalate an unresolved alert, instead of resending " +
+					"the notification.",
+				Optional: true,
+			},
+			"group_wait": schema.Int64Attribute{
+				Description: "The time in seconds to wait for the alert events of the same group before sending " +
+					"the notification. Default to 5.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(5),
+			},
+			"group_interval": schema.Int64Attribute{
+				Description: "The interval in seconds to send the notification of the new alert events of the " +
+					"same group. Default to 30.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(30),
+			},
+			"grouping_fields": schema.ListAttribute{
+				Description: "The labels to group the alert events. Default to group by alertname.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"notify_start_time": schema.StringAttribute{
+				Description: "The start time of the notification window in the format of HH:mm. Default to \"00:00\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("00:00"),
+			},
+			"notify_end_time": schema.StringAttribute{
+				Description: "The end time of the notification window in the format of HH:mm. Default to \"23:59\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("23:59"),
+			},
+			"notify_channels": schema.ListAttribute{
+				Description: "The notification channels of the notification policy. Accepted values: \"dingTalk\", " +
+					"\"email\", \"sms\", \"tts\", \"webhook\".",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("dingTalk", "email", "sms", "tts", "webhook"),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"matching_rules": schema.ListNestedBlock{
+				Description: "List of matching rules. An alert event is matched if any of the rules is matched, and " +
+					"a rule is matched if all of its conditions are matched. All the alert events are matched if " +
+					"no rule is set.",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"conditions": schema.ListNestedBlock{
+							Description: "List of conditions of the matching rule.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Description: "The label key of the alert event, e.g. alertname, severity.",
+										Required:    true,
+									},
+									"operator": schema.StringAttribute{
+										Description: "The operator of the condition. Accepted values: \"eq\", \"neq\", " +
+											"\"in\", \"nin\", \"re\", \"nre\".",
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("eq", "neq", "in", "nin", "re", "nre"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Description: "The value of the condition.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notify_objects": schema.ListNestedBlock{
+				Description: "List of objects to receive the notifications.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the notification object. Accepted values: \"CONTACT\", " +
+								"\"CONTACT_GROUP\", \"ARMS_CONTACT\", \"ARMS_CONTACT_GROUP\", \"DING_ROBOT_GROUP\", " +
+								"\"CONTACT_SCHEDULE\", \"WEBHOOK\". The alert contact groups shared with CloudMonitor (CMS), " +
+								"e.g. the contact_groups of st-alicloud_cms_composite_group_metric_rule, are CONTACT_GROUP. " +
+								"The webhook contacts, e.g. st-alicloud_arms_webhook_contact, are WEBHOOK.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("CONTACT", "CONTACT_GROUP", "ARMS_CONTACT", "ARMS_CONTACT_GROUP",
+									"DING_ROBOT_GROUP", "CONTACT_SCHEDULE", "WEBHOOK"),
+							},
+						},
+						"id": schema.StringAttribute{
+							Description: "The ID of the notification object.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the notification object.",
+							Required:    true,
+						},
+						"channels": schema.ListAttribute{
+							Description: "The notification channels of the contact. Accepted values: \"email\", " +
+								"\"sms\", \"tts\". Default to the notify_channels of the notification policy.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(
+									stringvalidator.OneOf("email", "sms", "tts"),
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsNotificationPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Create the notification policy.
+func (r *armsNotificationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsNotificationPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationPolicyId, err := r.createOrUpdateNotificationPolicy(plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Notification Policy.",
+			err.Error(),
+		)
+		return
+	}
+	plan.NotificationPolicyId = types.StringValue(strconv.FormatInt(notificationPolicyId, 10))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the notification policy.
+func (r *armsNotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsNotificationPolicyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var listNotificationPoliciesResponse *alicloudArmsClient.ListNotificationPoliciesResponse
+	listNotificationPolicies := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listNotificationPoliciesRequest := &alicloudArmsClient.ListNotificationPoliciesRequest{
+			RegionId: r.client.RegionId,
+			Ids:      tea.String(state.NotificationPolicyId.ValueString()),
+			IsDetail: tea.Bool(true),
+			Page:     tea.Int64(1),
+			Size:     tea.Int64(10),
+		}
+
+		var err error
+		listNotificationPoliciesResponse, err = r.client.ListNotificationPoliciesWithOptions(listNotificationPoliciesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listNotificationPolicies); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Notification Policies.",
+			err.Error(),
+		)
+		return
+	}
+
+	var policy *alicloudArmsClient.ListNotificationPoliciesResponseBodyPageBeanNotificationPolicies
+	if listNotificationPoliciesResponse.Body.PageBean != nil {
+		for _, notificationPolicy := range listNotificationPoliciesResponse.Body.PageBean.NotificationPolicies {
+			if strconv.FormatInt(tea.Int64Value(notificationPolicy.Id), 10) == state.NotificationPolicyId.ValueString() {
+				policy = notificationPolicy
+				break
+			}
+		}
+	}
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(tea.StringValue(policy.Name))
+	state.Enabled = types.BoolValue(tea.StringValue(policy.State) != "disable")
+	state.SendRecoverMessage = types.BoolValue(tea.BoolValue(policy.SendRecoverMessage))
+	if tea.BoolValue(policy.Repeat) {
+		state.RepeatInterval = types.Int64Value(tea.Int64Value(policy.RepeatInterval))
+		state.EscalationPolicyId = types.StringNull()
+	} else if policy.EscalationPolicyId != nil {
+		state.EscalationPolicyId = types.StringValue(strconv.FormatInt(tea.Int64Value(policy.EscalationPolicyId), 10))
+	}
+
+	if policy.GroupRule != nil {
+		state.GroupWait = types.Int64Value(tea.Int64Value(policy.GroupRule.GroupWait))
+		state.GroupInterval = types.Int64Value(tea.Int64Value(policy.GroupRule.GroupInterval))
+		if !state.GroupingFields.IsNull() || len(policy.GroupRule.GroupingFields) > 0 {
+			state.GroupingFields = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(policy.GroupRule.GroupingFields))
+		}
+	}
+
+	if policy.NotifyRule != nil {
+		state.NotifyStartTime = types.StringValue(tea.StringValue(policy.NotifyRule.NotifyStartTime))
+		state.NotifyEndTime = types.StringValue(tea.StringValue(policy.NotifyRule.NotifyEndTime))
+		state.NotifyChannels = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(policy.NotifyRule.NotifyChannels))
+
+		prevNotifyObjects := state.NotifyObjects
+		state.NotifyObjects = nil
+		for i, notifyObject := range policy.NotifyRule.NotifyObjects {
+			channels := types.ListNull(types.StringType)
+			if len(notifyObject.NotifyChannels) > 0 || (i < len(prevNotifyObjects) && !prevNotifyObjects[i].Channels.IsNull()) {
+				channels = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(notifyObject.NotifyChannels))
+			}
+			state.NotifyObjects = append(state.NotifyObjects, &armsNotificationNotifyObject{
+				Type:     types.StringValue(tea.StringValue(notifyObject.NotifyObjectType)),
+				Id:       types.StringValue(strconv.FormatInt(tea.Int64Value(notifyObject.NotifyObjectId), 10)),
+				Name:     types.StringValue(tea.StringValue(notifyObject.NotifyObjectName)),
+				Channels: channels,
+			})
+		}
+	}
+
+	state.MatchingRules = nil
+	for _, matchingRule := range policy.MatchingRules {
+		rule := &armsNotificationMatchingRule{}
+		for _, condition := range matchingRule.MatchingConditions {
+			rule.Conditions = append(rule.Conditions, &armsNotificationMatchingCondition{
+				Key:      types.StringValue(tea.StringValue(condition.Key)),
+				Operator: types.StringValue(tea.StringValue(condition.Operator)),
+				Value:    types.StringValue(tea.StringValue(condition.Value)),
+			})
+		}
+		state.MatchingRules = append(state.MatchingRules, rule)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the notification policy.
+func (r *armsNotificationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *armsNotificationPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationPolicyId, err := strconv.ParseInt(state.NotificationPolicyId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Notification Policy ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.createOrUpdateNotificationPolicy(plan, tea.Int64(notificationPolicyId)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Notification Policy.",
+			err.Error(),
+		)
+		return
+	}
+	plan.NotificationPolicyId = state.NotificationPolicyId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the notification policy.
+func (r *armsNotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsNotificationPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationPolicyId, err := strconv.ParseInt(state.NotificationPolicyId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Notification Policy ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteNotificationPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteNotificationPolicyRequest := &alicloudArmsClient.DeleteNotificationPolicyRequest{
+			Id: tea.Int64(notificationPolicyId),
+		}
+
+		deleteNotificationPolicyResponse, err := r.client.DeleteNotificationPolicyWithOptions(deleteNotificationPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if !tea.BoolValue(deleteNotificationPolicyResponse.Body.IsSuccess) {
+			return backoff.Permanent(fmt.Errorf("failed to delete notification policy %d, request ID: %s",
+				notificationPolicyId, tea.StringValue(deleteNotificationPolicyResponse.Body.RequestId)))
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteNotificationPolicy); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Notification Policy.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *armsNotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("notification_policy_id"), req, resp)
+}
+
+// Function to create the notification policy, or update the notification
+// policy if the notification policy ID is given. Returns the ID of the
+// notification policy.
+func (r *armsNotificationPolicyResource) createOrUpdateNotificationPolicy(model *armsNotificationPolicyModel, notificationPolicyId *int64) (int64, error) {
+	notifyRule := armsNotifyRuleJson{
+		NotifyStartTime: model.NotifyStartTime.ValueString(),
+		NotifyEndTime:   model.NotifyEndTime.ValueString(),
+		NotifyChannels:  convertListValueToStrings(model.NotifyChannels),
+		NotifyObjects:   []armsNotifyObjectJson{},
+	}
+	for _, notifyObject := range model.NotifyObjects {
+		notifyObjectId, err := strconv.ParseInt(notifyObject.Id.ValueString(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ID of notify object %s: %v", notifyObject.Name.ValueString(), err)
+		}
+		notifyRule.NotifyObjects = append(notifyRule.NotifyObjects, armsNotifyObjectJson{
+			NotifyObjectType: notifyObject.Type.ValueString(),
+			NotifyObjectId:   notifyObjectId,
+			NotifyObjectName: notifyObject.Name.ValueString(),
+			NotifyChannels:   convertListValueToStrings(notifyObject.Channels),
+		})
+	}
+
+	groupRule := armsGroupRuleJson{
+		GroupWait:      model.GroupWait.ValueInt64(),
+		GroupInterval:  model.GroupInterval.ValueInt64(),
+		GroupingFields: convertListValueToStrings(model.GroupingFields),
+	}
+
+	matchingRules := []armsMatchingRuleJson{}
+	for _, matchingRule := range model.MatchingRules {
+		rule := armsMatchingRuleJson{}
+		for _, condition := range matchingRule.Conditions {
+			rule.MatchingConditions = append(rule.MatchingConditions, armsMatchingConditionJson{
+				Key:      condition.Key.ValueString(),
+				Operator: condition.Operator.ValueString(),
+				Value:    condition.Value.ValueString(),
+			})
+		}
+		matchingRules = append(matchingRules, rule)
+	}
+
+	notifyRuleJson, err := json.Marshal(notifyRule)
+	if err != nil {
+		return 0, err
+	}
+	groupRuleJson, err := json.Marshal(groupRule)
+	if err != nil {
+		return 0, err
+	}
+	matchingRulesJson, err := json.Marshal(matchingRules)
+	if err != nil {
+		return 0, err
+	}
+
+	state := "enable"
+	if !model.Enabled.ValueBool() {
+		state = "disable"
+	}
+
+	createOrUpdateNotificationPolicyRequest := &alicloudArmsClient.CreateOrUpdateNotificationPolicyRequest{
+		RegionId:           r.client.RegionId,
+		Id:                 notificationPolicyId,
+		Name:               tea.String(model.Name.ValueString()),
+		State:              tea.String(state),
+		SendRecoverMessage: tea.Bool(model.SendRecoverMessage.ValueBool()),
+		NotifyRule:         tea.String(string(notifyRuleJson)),
+		GroupRule:          tea.String(string(groupRuleJson)),
+		MatchingRules:      tea.String(string(matchingRulesJson)),
+		Repeat:             tea.Bool(true),
+		RepeatInterval:     tea.Int64(model.RepeatInterval.ValueInt64()),
+	}
+	if !model.EscalationPolicyId.IsNull() {
+		escalationPolicyId, err := strconv.ParseInt(model.EscalationPolicyId.ValueString(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid escalation policy ID: %v", err)
+		}
+		createOrUpdateNotificationPolicyRequest.Repeat = tea.Bool(false)
+		createOrUpdateNotificationPolicyRequest.RepeatInterval = nil
+		createOrUpdateNotificationPolicyRequest.EscalationPolicyId = tea.Int64(escalationPolicyId)
+	}
+
+	var createOrUpdateNotificationPolicyResponse *alicloudArmsClient.CreateOrUpdateNotificationPolicyResponse
+	createOrUpdateNotificationPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		createOrUpdateNotificationPolicyResponse, err = r.client.CreateOrUpdateNotificationPolicyWithOptions(createOrUpdateNotificationPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createOrUpdateNotificationPolicy); err != nil {
+		return 0, err
+	}
+
+	notificationPolicy := createOrUpdateNotificationPolicyResponse.Body.NotificationPolicy
+	if notificationPolicy == nil || notificationPolicy.Id == nil {
+		return 0, fmt.Errorf("notification policy is not returned, request ID: %s",
+			tea.StringValue(createOrUpdateNotificationPolicyResponse.Body.RequestId))
+	}
+	return tea.Int64Value(notificationPolicy.Id), nil
+}
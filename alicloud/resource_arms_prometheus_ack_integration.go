@@ -0,0 +1,284 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsPrometheusAckIntegrationResource{}
+	_ resource.ResourceWithConfigure   = &armsPrometheusAckIntegrationResource{}
+	_ resource.ResourceWithImportState = &armsPrometheusAckIntegrationResource{}
+)
+
+func NewArmsPrometheusAckIntegrationResource() resource.Resource {
+	return &armsPrometheusAckIntegrationResource{}
+}
+
+type armsPrometheusAckIntegrationResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsPrometheusAckIntegrationModel struct {
+	ClusterId              types.String `tfsdk:"cluster_id"`
+	StorageDuration        types.Int64  `tfsdk:"storage_duration"`
+	ArchiveDuration        types.Int64  `tfsdk:"archive_duration"`
+	ResourceGroupId        types.String `tfsdk:"resource_group_id"`
+	RemoteWriteIntranetUrl types.String `tfsdk:"remote_write_intranet_url"`
+	RemoteWriteInternetUrl types.String `tfsdk:"remote_write_internet_url"`
+	HttpApiIntranetUrl     types.String `tfsdk:"http_api_intranet_url"`
+	HttpApiInternetUrl     types.String `tfsdk:"http_api_internet_url"`
+}
+
+// Metadata returns the ARMS Prometheus ACK Integration resource name.
+func (r *armsPrometheusAckIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_prometheus_ack_integration"
+}
+
+// Schema defines the schema for the ARMS Prometheus ACK Integration resource.
+func (r *armsPrometheusAckIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Integrate an ACK cluster with Application Real-Time Monitoring Service (ARMS) managed " +
+			"Prometheus. A Prometheus instance with the same ID as the cluster is created, and the Prometheus " +
+			"agent is installed into the cluster. The Prometheus instance is released and the agent is " +
+			"uninstalled when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the ACK cluster, which is also the ID of the Prometheus instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_duration": schema.Int64Attribute{
+				Description: "The storage duration of the metrics in days. Valid values: 15, 30, 60, 90, 180. " +
+					"Default to the storage duration of ARMS.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_duration": schema.Int64Attribute{
+				Description: "The archive duration of the metrics in days. Default to the archive duration of ARMS.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_group_id": schema.StringAttribute{
+				Description: "The ID of the resource group. Default to the default resource group.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_write_intranet_url": schema.StringAttribute{
+				Description: "The remote write URL over the internal network.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_write_internet_url": schema.StringAttribute{
+				Description: "The remote write URL over the Internet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_api_intranet_url": schema.StringAttribute{
+				Description: "The HTTP API URL over the internal network, which is used as the Grafana data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_api_internet_url": schema.StringAttribute{
+				Description: "The HTTP API URL over the Internet, which is used as the Grafana data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsPrometheusAckIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Create the Prometheus instance for the ACK cluster.
+func (r *armsPrometheusAckIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsPrometheusAckIntegrationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := createArmsPrometheusInstance(r.client, &alicloudArmsClient.CreatePrometheusInstanceRequest{
+		RegionId:        r.client.RegionId,
+		ClusterType:     tea.String("aliyun-cs"),
+		ClusterId:       tea.String(plan.ClusterId.ValueString()),
+		Duration:        armsInt32Pointer(plan.StorageDuration),
+		ArchiveDuration: armsInt32Pointer(plan.ArchiveDuration),
+		ResourceGroupId: armsStringPointer(plan.ResourceGroupId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Prometheus Instance for ACK Cluster.",
+			err.Error(),
+		)
+		return
+	}
+
+	instance, err := describeArmsPrometheusInstance(r.client, plan.ClusterId.ValueString())
+	if err != nil || instance == nil {
+		if err == nil {
+			err = fmt.Errorf("prometheus instance of cluster %s is not found after creation", plan.ClusterId.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		// Save the cluster ID so that the instance is not leaked.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), plan.ClusterId)...)
+		return
+	}
+	r.updateModel(plan, instance)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the Prometheus instance of the ACK cluster.
+func (r *armsPrometheusAckIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsPrometheusAckIntegrationModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := describeArmsPrometheusInstance(r.client, state.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+	if instance == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.updateModel(state, instance)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the storage duration, archive duration and resource group of the
+// Prometheus instance.
+func (r *armsPrometheusAckIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *armsPrometheusAckIntegrationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateArmsPrometheusInstance(r.client, &alicloudArmsClient.UpdatePrometheusInstanceRequest{
+		RegionId:        r.client.RegionId,
+		ClusterId:       tea.String(plan.ClusterId.ValueString()),
+		StorageDuration: armsInt32Pointer(plan.StorageDuration),
+		ArchiveDuration: armsInt32Pointer(plan.ArchiveDuration),
+		ResourceGroupId: armsStringPointer(plan.ResourceGroupId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+
+	instance, err := describeArmsPrometheusInstance(r.client, plan.ClusterId.ValueString())
+	if err != nil || instance == nil {
+		if err == nil {
+			err = fmt.Errorf("prometheus instance of cluster %s is not found", plan.ClusterId.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+	r.updateModel(plan, instance)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Release the Prometheus instance and uninstall the agent from the ACK
+// cluster.
+func (r *armsPrometheusAckIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsPrometheusAckIntegrationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := uninstallArmsPrometheusInstance(r.client, state.ClusterId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Release Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *armsPrometheusAckIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// Function to set the computed attributes of the model from the Prometheus
+// instance.
+func (r *armsPrometheusAckIntegrationResource) updateModel(model *armsPrometheusAckIntegrationModel, instance *alicloudArmsClient.GetPrometheusInstanceResponseBodyData) {
+	model.StorageDuration = types.Int64Value(int64(tea.Int32Value(instance.StorageDuration)))
+	model.ArchiveDuration = types.Int64Value(int64(tea.Int32Value(instance.ArchiveDuration)))
+	model.ResourceGroupId = types.StringValue(tea.StringValue(instance.ResourceGroupId))
+	model.RemoteWriteIntranetUrl = types.StringValue(tea.StringValue(instance.RemoteWriteIntraUrl))
+	model.RemoteWriteInternetUrl = types.StringValue(tea.StringValue(instance.RemoteWriteInterUrl))
+	model.HttpApiIntranetUrl = types.StringValue(tea.StringValue(instance.HttpApiIntraUrl))
+	model.HttpApiInternetUrl = types.StringValue(tea.StringValue(instance.HttpApiInterUrl))
+}
@@ -0,0 +1,429 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsPrometheusInstanceResource{}
+	_ resource.ResourceWithConfigure   = &armsPrometheusInstanceResource{}
+	_ resource.ResourceWithImportState = &armsPrometheusInstanceResource{}
+)
+
+func NewArmsPrometheusInstanceResource() resource.Resource {
+	return &armsPrometheusInstanceResource{}
+}
+
+type armsPrometheusInstanceResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsPrometheusInstanceModel struct {
+	InstanceId             types.String `tfsdk:"instance_id"`
+	InstanceName           types.String `tfsdk:"instance_name"`
+	StorageDuration        types.Int64  `tfsdk:"storage_duration"`
+	ArchiveDuration        types.Int64  `tfsdk:"archive_duration"`
+	ResourceGroupId        types.String `tfsdk:"resource_group_id"`
+	RemoteWriteIntranetUrl types.String `tfsdk:"remote_write_intranet_url"`
+	RemoteWriteInternetUrl types.String `tfsdk:"remote_write_internet_url"`
+	HttpApiIntranetUrl     types.String `tfsdk:"http_api_intranet_url"`
+	HttpApiInternetUrl     types.String `tfsdk:"http_api_internet_url"`
+	AuthToken              types.String `tfsdk:"auth_token"`
+}
+
+// Metadata returns the ARMS Prometheus Instance resource name.
+func (r *armsPrometheusInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_prometheus_instance"
+}
+
+// Schema defines the schema for the ARMS Prometheus Instance resource.
+func (r *armsPrometheusInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an Application Real-Time Monitoring Service (ARMS) managed Prometheus instance for " +
+			"remote write, which receives metrics pushed by self-managed Prometheus or agents.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the Prometheus instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Description: "The name of the Prometheus instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_duration": schema.Int64Attribute{
+				Description: "The storage duration of the metrics in days. Valid values: 15, 30, 60, 90, 180. " +
+					"Default to the storage duration of ARMS.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_duration": schema.Int64Attribute{
+				Description: "The archive duration of the metrics in days. Default to the archive duration of ARMS.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_group_id": schema.StringAttribute{
+				Description: "The ID of the resource group. Default to the default resource group.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_write_intranet_url": schema.StringAttribute{
+				Description: "The remote write URL over the internal network.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_write_internet_url": schema.StringAttribute{
+				Description: "The remote write URL over the Internet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_api_intranet_url": schema.StringAttribute{
+				Description: "The HTTP API URL over the internal network, which is used as the Grafana data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_api_internet_url": schema.StringAttribute{
+				Description: "The HTTP API URL over the Internet, which is used as the Grafana data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "The authorization token to access the URLs of the Prometheus instance.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsPrometheusInstanceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Create the Prometheus instance.
+func (r *armsPrometheusInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsPrometheusInstanceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceId, err := createArmsPrometheusInstance(r.client, &alicloudArmsClient.CreatePrometheusInstanceRequest{
+		RegionId:        r.client.RegionId,
+		ClusterType:     tea.String("remote-write"),
+		ClusterName:     tea.String(plan.InstanceName.ValueString()),
+		Duration:        armsInt32Pointer(plan.StorageDuration),
+		ArchiveDuration: armsInt32Pointer(plan.ArchiveDuration),
+		ResourceGroupId: armsStringPointer(plan.ResourceGroupId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+	plan.InstanceId = types.StringValue(instanceId)
+
+	instance, err := describeArmsPrometheusInstance(r.client, instanceId)
+	if err != nil || instance == nil {
+		if err == nil {
+			err = fmt.Errorf("prometheus instance %s is not found after creation", instanceId)
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		// Save the instance ID so that the instance is not leaked.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceId)...)
+		return
+	}
+	r.updateModel(plan, instance)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the Prometheus instance.
+func (r *armsPrometheusInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsPrometheusInstanceModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := describeArmsPrometheusInstance(r.client, state.InstanceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+	if instance == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.InstanceName = types.StringValue(tea.StringValue(instance.ClusterName))
+	r.updateModel(state, instance)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the storage duration, archive duration and resource group of the
+// Prometheus instance.
+func (r *armsPrometheusInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *armsPrometheusInstanceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateArmsPrometheusInstance(r.client, &alicloudArmsClient.UpdatePrometheusInstanceRequest{
+		RegionId:        r.client.RegionId,
+		ClusterId:       tea.String(state.InstanceId.ValueString()),
+		StorageDuration: armsInt32Pointer(plan.StorageDuration),
+		ArchiveDuration: armsInt32Pointer(plan.ArchiveDuration),
+		ResourceGroupId: armsStringPointer(plan.ResourceGroupId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+
+	instance, err := describeArmsPrometheusInstance(r.client, state.InstanceId.ValueString())
+	if err != nil || instance == nil {
+		if err == nil {
+			err = fmt.Errorf("prometheus instance %s is not found", state.InstanceId.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+	plan.InstanceId = state.InstanceId
+	r.updateModel(plan, instance)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Release the Prometheus instance.
+func (r *armsPrometheusInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsPrometheusInstanceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := uninstallArmsPrometheusInstance(r.client, state.InstanceId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Release Prometheus Instance.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *armsPrometheusInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
+
+// Function to set the computed attributes of the model from the Prometheus
+// instance.
+func (r *armsPrometheusInstanceResource) updateModel(model *armsPrometheusInstanceModel, instance *alicloudArmsClient.GetPrometheusInstanceResponseBodyData) {
+	model.StorageDuration = types.Int64Value(int64(tea.Int32Value(instance.StorageDuration)))
+	model.ArchiveDuration = types.Int64Value(int64(tea.Int32Value(instance.ArchiveDuration)))
+	model.ResourceGroupId = types.StringValue(tea.StringValue(instance.ResourceGroupId))
+	model.RemoteWriteIntranetUrl = types.StringValue(tea.StringValue(instance.RemoteWriteIntraUrl))
+	model.RemoteWriteInternetUrl = types.StringValue(tea.StringValue(instance.RemoteWriteInterUrl))
+	model.HttpApiIntranetUrl = types.StringValue(tea.StringValue(instance.HttpApiIntraUrl))
+	model.HttpApiInternetUrl = types.StringValue(tea.StringValue(instance.HttpApiInterUrl))
+	model.AuthToken = types.StringValue(tea.StringValue(instance.AuthToken))
+}
+
+// Function to create the Prometheus instance and return the instance ID.
+func createArmsPrometheusInstance(client *alicloudArmsClient.Client, request *alicloudArmsClient.CreatePrometheusInstanceRequest) (string, error) {
+	var createPrometheusInstanceResponse *alicloudArmsClient.CreatePrometheusInstanceResponse
+	createPrometheusInstance := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		createPrometheusInstanceResponse, err = client.CreatePrometheusInstanceWithOptions(request, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(createPrometheusInstanceResponse.Body.Code, createPrometheusInstanceResponse.Body.Message))
+	}
+
+	if err := retryAPICall(createPrometheusInstance); err != nil {
+		return "", err
+	}
+	return tea.StringValue(createPrometheusInstanceResponse.Body.Data), nil
+}
+
+// Function to get the Prometheus instance, returns nil if the instance is
+// not found.
+func describeArmsPrometheusInstance(client *alicloudArmsClient.Client, instanceId string) (*alicloudArmsClient.GetPrometheusInstanceResponseBodyData, error) {
+	var getPrometheusInstanceResponse *alicloudArmsClient.GetPrometheusInstanceResponse
+	getPrometheusInstance := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getPrometheusInstanceRequest := &alicloudArmsClient.GetPrometheusInstanceRequest{
+			RegionId:  client.RegionId,
+			ClusterId: tea.String(instanceId),
+		}
+
+		var err error
+		getPrometheusInstanceResponse, err = client.GetPrometheusInstanceWithOptions(getPrometheusInstanceRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getPrometheusInstance); err != nil {
+		return nil, err
+	}
+
+	instance := getPrometheusInstanceResponse.Body.Data
+	if instance == nil || tea.StringValue(instance.ClusterId) == "" {
+		return nil, nil
+	}
+	return instance, nil
+}
+
+// Function to update the storage duration, archive duration and resource
+// group of the Prometheus instance.
+func updateArmsPrometheusInstance(client *alicloudArmsClient.Client, request *alicloudArmsClient.UpdatePrometheusInstanceRequest) error {
+	updatePrometheusInstance := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updatePrometheusInstanceResponse, err := client.UpdatePrometheusInstanceWithOptions(request, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(updatePrometheusInstanceResponse.Body.Code, updatePrometheusInstanceResponse.Body.Message))
+	}
+
+	return retryAPICall(updatePrometheusInstance)
+}
+
+// Function to release the Prometheus instance, the instance which is not
+// found is ignored.
+func uninstallArmsPrometheusInstance(client *alicloudArmsClient.Client, instanceId string) error {
+	instance, err := describeArmsPrometheusInstance(client, instanceId)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return nil
+	}
+
+	uninstallPromCluster := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		uninstallPromClusterRequest := &alicloudArmsClient.UninstallPromClusterRequest{
+			RegionId:  client.RegionId,
+			ClusterId: tea.String(instanceId),
+		}
+
+		uninstallPromClusterResponse, err := client.UninstallPromClusterWithOptions(uninstallPromClusterRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(uninstallPromClusterResponse.Body.Code, uninstallPromClusterResponse.Body.Message))
+	}
+
+	return retryAPICall(uninstallPromCluster)
+}
+
+// Some of the ARMS APIs return the failure in the response body instead of
+// an error, returns the failure as an error if the code is not 200.
+func armsResponseError(code *int32, message *string) error {
+	if code == nil || tea.Int32Value(code) == 200 {
+		return nil
+	}
+	return fmt.Errorf("code: %d, message: %s", tea.Int32Value(code), tea.StringValue(message))
+}
+
+func armsStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.String(value.ValueString())
+}
+
+func armsInt32Pointer(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Int32(int32(value.ValueInt64()))
+}
@@ -0,0 +1,281 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsPrometheusRemoteWriteResource{}
+	_ resource.ResourceWithConfigure   = &armsPrometheusRemoteWriteResource{}
+	_ resource.ResourceWithImportState = &armsPrometheusRemoteWriteResource{}
+)
+
+func NewArmsPrometheusRemoteWriteResource() resource.Resource {
+	return &armsPrometheusRemoteWriteResource{}
+}
+
+type armsPrometheusRemoteWriteResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsPrometheusRemoteWriteModel struct {
+	InstanceId      types.String `tfsdk:"instance_id"`
+	RemoteWriteName types.String `tfsdk:"remote_write_name"`
+	RemoteWriteYaml types.String `tfsdk:"remote_write_yaml"`
+}
+
+// Metadata returns the ARMS Prometheus Remote Write resource name.
+func (r *armsPrometheusRemoteWriteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_prometheus_remote_write"
+}
+
+// Schema defines the schema for the ARMS Prometheus Remote Write resource.
+func (r *armsPrometheusRemoteWriteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a remote write configuration of an Application Real-Time Monitoring Service (ARMS) " +
+			"managed Prometheus instance, which forwards the metrics of the instance to another storage.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the Prometheus instance. For the Prometheus instance of an ACK cluster, " +
+					"it is the ID of the cluster.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_write_name": schema.StringAttribute{
+				Description: "The name of the remote write configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_write_yaml": schema.StringAttribute{
+				Description: "The remote write configuration in the format of Prometheus remote_write YAML, " +
+					"which contains exactly one remote write item.",
+				Required: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsPrometheusRemoteWriteResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Add the remote write configuration to the Prometheus instance.
+func (r *armsPrometheusRemoteWriteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsPrometheusRemoteWriteModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addPrometheusRemoteWriteResponse *alicloudArmsClient.AddPrometheusRemoteWriteResponse
+	addPrometheusRemoteWrite := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addPrometheusRemoteWriteRequest := &alicloudArmsClient.AddPrometheusRemoteWriteRequest{
+			RegionId:        r.client.RegionId,
+			ClusterId:       tea.String(plan.InstanceId.ValueString()),
+			RemoteWriteYaml: tea.String(plan.RemoteWriteYaml.ValueString()),
+		}
+
+		var err error
+		addPrometheusRemoteWriteResponse, err = r.client.AddPrometheusRemoteWriteWithOptions(addPrometheusRemoteWriteRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(addPrometheusRemoteWriteResponse.Body.Code, addPrometheusRemoteWriteResponse.Body.Data))
+	}
+
+	if err := retryAPICall(addPrometheusRemoteWrite); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Prometheus Remote Write.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.RemoteWriteName = types.StringValue(tea.StringValue(addPrometheusRemoteWriteResponse.Body.Data))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the remote write configuration. The YAML returned by ARMS is
+// re-formatted, so the YAML in state is only set when it is imported, to
+// avoid the perpetual difference.
+func (r *armsPrometheusRemoteWriteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsPrometheusRemoteWriteModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var getPrometheusRemoteWriteResponse *alicloudArmsClient.GetPrometheusRemoteWriteResponse
+	getPrometheusRemoteWrite := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getPrometheusRemoteWriteRequest := &alicloudArmsClient.GetPrometheusRemoteWriteRequest{
+			RegionId:        r.client.RegionId,
+			ClusterId:       tea.String(state.InstanceId.ValueString()),
+			RemoteWriteName: tea.String(state.RemoteWriteName.ValueString()),
+		}
+
+		var err error
+		getPrometheusRemoteWriteResponse, err = r.client.GetPrometheusRemoteWriteWithOptions(getPrometheusRemoteWriteRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getPrometheusRemoteWrite); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Prometheus Remote Write.",
+			err.Error(),
+		)
+		return
+	}
+
+	remoteWrite := getPrometheusRemoteWriteResponse.Body.Data
+	if remoteWrite == nil || tea.StringValue(remoteWrite.RemoteWriteName) == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if state.RemoteWriteYaml.IsNull() {
+		state.RemoteWriteYaml = types.StringValue(tea.StringValue(remoteWrite.RemoteWriteYaml))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the remote write configuration.
+func (r *armsPrometheusRemoteWriteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *armsPrometheusRemoteWriteModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updatePrometheusRemoteWriteResponse *alicloudArmsClient.UpdatePrometheusRemoteWriteResponse
+	updatePrometheusRemoteWrite := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updatePrometheusRemoteWriteRequest := &alicloudArmsClient.UpdatePrometheusRemoteWriteRequest{
+			RegionId:        r.client.RegionId,
+			ClusterId:       tea.String(state.InstanceId.ValueString()),
+			RemoteWriteName: tea.String(state.RemoteWriteName.ValueString()),
+			RemoteWriteYaml: tea.String(plan.RemoteWriteYaml.ValueString()),
+		}
+
+		var err error
+		updatePrometheusRemoteWriteResponse, err = r.client.UpdatePrometheusRemoteWriteWithOptions(updatePrometheusRemoteWriteRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(updatePrometheusRemoteWriteResponse.Body.Code, updatePrometheusRemoteWriteResponse.Body.Data))
+	}
+
+	if err := retryAPICall(updatePrometheusRemoteWrite); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Prometheus Remote Write.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The remote write is renamed if the name in the YAML is changed.
+	plan.RemoteWriteName = types.StringValue(tea.StringValue(updatePrometheusRemoteWriteResponse.Body.Data))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the remote write configuration from the Prometheus instance.
+func (r *armsPrometheusRemoteWriteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsPrometheusRemoteWriteModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletePrometheusRemoteWrite := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deletePrometheusRemoteWriteRequest := &alicloudArmsClient.DeletePrometheusRemoteWriteRequest{
+			RegionId:         r.client.RegionId,
+			ClusterId:        tea.String(state.InstanceId.ValueString()),
+			RemoteWriteNames: tea.String(state.RemoteWriteName.ValueString()),
+		}
+
+		deletePrometheusRemoteWriteResponse, err := r.client.DeletePrometheusRemoteWriteWithOptions(deletePrometheusRemoteWriteRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return backoff.Permanent(armsResponseError(deletePrometheusRemoteWriteResponse.Body.Code, deletePrometheusRemoteWriteResponse.Body.Message))
+	}
+
+	if err := retryAPICall(deletePrometheusRemoteWrite); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Prometheus Remote Write.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the remote write configuration by the ID in the format
+// of <instance_id>:<remote_write_name>.
+func (r *armsPrometheusRemoteWriteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <instance_id>:<remote_write_name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_write_name"), ids[1])...)
+}
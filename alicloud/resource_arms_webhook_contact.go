@@ -0,0 +1,417 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudArmsClient "github.com/alibabacloud-go/arms-20190808/v6/client"
+	alicloudOpenapiClient "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &armsWebhookContactResource{}
+	_ resource.ResourceWithConfigure   = &armsWebhookContactResource{}
+	_ resource.ResourceWithImportState = &armsWebhookContactResource{}
+)
+
+func NewArmsWebhookContactResource() resource.Resource {
+	return &armsWebhookContactResource{}
+}
+
+type armsWebhookContactResource struct {
+	client *alicloudArmsClient.Client
+}
+
+type armsWebhookContactModel struct {
+	WebhookId   types.String `tfsdk:"webhook_id"`
+	Name        types.String `tfsdk:"name"`
+	Url         types.String `tfsdk:"url"`
+	Method      types.String `tfsdk:"method"`
+	Headers     types.Map    `tfsdk:"headers"`
+	Params      types.Map    `tfsdk:"params"`
+	Body        types.String `tfsdk:"body"`
+	RecoverBody types.String `tfsdk:"recover_body"`
+}
+
+// Metadata returns the ARMS Webhook Contact resource name.
+func (r *armsWebhookContactResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arms_webhook_contact"
+}
+
+// Schema defines the schema for the ARMS Webhook Contact resource.
+func (r *armsWebhookContactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an Application Real-Time Monitoring Service (ARMS) webhook contact, which forwards the " +
+			"alert events, including the CloudMonitor (CMS) alerts, to a third-party incident tool with the " +
+			"templated payloads.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Description: "The ID of the webhook contact, which is used as the ID of the WEBHOOK notify object of " +
+					"the notification policies.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the webhook contact.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the webhook, e.g. the events API of PagerDuty or the alert API of OpsGenie.",
+				Required:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method of the webhook. Accepted values: \"Post\", \"Get\". Default to \"Post\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Post"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Post", "Get"),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "The HTTP headers of the webhook requests, e.g. the authorization headers of the " +
+					"incident tool.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"params": schema.MapAttribute{
+				Description: "The query parameters of the webhook requests.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The template of the payload sent when the alert is triggered. The alert variables, " +
+					"e.g. {{ .commonLabels.alertname }}, are rendered by ARMS.",
+				Optional: true,
+			},
+			"recover_body": schema.StringAttribute{
+				Description: "The template of the payload sent when the alert is resolved.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *armsWebhookContactResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).armsClient
+}
+
+// Create the webhook contact.
+func (r *armsWebhookContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *armsWebhookContactModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId, err := r.createOrUpdateWebhookContact(plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Webhook Contact.",
+			err.Error(),
+		)
+		return
+	}
+	plan.WebhookId = types.StringValue(webhookId)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the webhook contact.
+func (r *armsWebhookContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *armsWebhookContactModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeWebhookContactsResponse *alicloudArmsClient.DescribeWebhookContactsResponse
+	describeWebhookContacts := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeWebhookContactsRequest := &alicloudArmsClient.DescribeWebhookContactsRequest{
+			ContactIds: tea.String(state.WebhookId.ValueString()),
+			Page:       tea.Int64(1),
+			Size:       tea.Int64(10),
+		}
+
+		var err error
+		describeWebhookContactsResponse, err = r.client.DescribeWebhookContactsWithOptions(describeWebhookContactsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeWebhookContacts); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Webhook Contacts.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The webhook contacts are filtered by the ID, the single result is accepted
+	// as is, since the webhook ID returned by the SDK is a float32 and loses
+	// precision for the large IDs.
+	var webhookContact *alicloudArmsClient.DescribeWebhookContactsResponseBodyPageBeanWebhookContacts
+	if pageBean := describeWebhookContactsResponse.Body.PageBean; pageBean != nil && len(pageBean.WebhookContacts) == 1 {
+		webhookContact = pageBean.WebhookContacts[0]
+	}
+	if webhookContact == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(tea.StringValue(webhookContact.WebhookName))
+	if webhook := webhookContact.Webhook; webhook != nil {
+		state.Url = types.StringValue(tea.StringValue(webhook.Url))
+		state.Method = types.StringValue(tea.StringValue(webhook.Method))
+		state.Body = stringValueOrNull(state.Body, webhook.Body)
+		state.RecoverBody = stringValueOrNull(state.RecoverBody, webhook.RecoverBody)
+
+		state.Headers = armsWebhookPairsToMapValue(state.Headers, webhook.BizHeaders)
+		state.Params = armsWebhookPairsToMapValue(state.Params, webhook.BizParams)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the webhook contact.
+func (r *armsWebhookContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *armsWebhookContactModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId, err := strconv.ParseInt(state.WebhookId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Webhook ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.createOrUpdateWebhookContact(plan, tea.Int64(webhookId)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Webhook Contact.",
+			err.Error(),
+		)
+		return
+	}
+	plan.WebhookId = state.WebhookId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the webhook contact.
+func (r *armsWebhookContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *armsWebhookContactModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId, err := strconv.ParseInt(state.WebhookId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Webhook ID.",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteWebhookContact := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteWebhookContactRequest := &alicloudArmsClient.DeleteWebhookContactRequest{
+			WebhookId: tea.Int64(webhookId),
+		}
+
+		deleteWebhookContactResponse, err := r.client.DeleteWebhookContactWithOptions(deleteWebhookContactRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if !tea.BoolValue(deleteWebhookContactResponse.Body.IsSuccess) {
+			return backoff.Permanent(fmt.Errorf("failed to delete webhook contact %d, request ID: %s",
+				webhookId, tea.StringValue(deleteWebhookContactResponse.Body.RequestId)))
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteWebhookContact); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Webhook Contact.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *armsWebhookContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("webhook_id"), req, resp)
+}
+
+// Function to create the webhook contact, or update the webhook contact if
+// the webhook ID is given. Returns the ID of the webhook contact.
+//
+// The API is called without the typed response of the SDK, which decodes the
+// webhook ID as a float32 and loses precision for the IDs larger than 2^24.
+func (r *armsWebhookContactResource) createOrUpdateWebhookContact(model *armsWebhookContactModel, webhookId *int64) (string, error) {
+	headersJson, err := armsWebhookPairsJson(model.Headers)
+	if err != nil {
+		return "", err
+	}
+	paramsJson, err := armsWebhookPairsJson(model.Params)
+	if err != nil {
+		return "", err
+	}
+
+	body := map[string]interface{}{
+		"WebhookName": model.Name.ValueString(),
+		"Url":         model.Url.ValueString(),
+		"Method":      model.Method.ValueString(),
+		"BizHeaders":  headersJson,
+		"BizParams":   paramsJson,
+	}
+	if webhookId != nil {
+		body["WebhookId"] = strconv.FormatInt(*webhookId, 10)
+	}
+	if !model.Body.IsNull() && !model.Body.IsUnknown() {
+		body["Body"] = model.Body.ValueString()
+	}
+	if !model.RecoverBody.IsNull() && !model.RecoverBody.IsUnknown() {
+		body["RecoverBody"] = model.RecoverBody.ValueString()
+	}
+
+	createOrUpdateWebhookContactRequest := &alicloudOpenapiClient.OpenApiRequest{
+		Body: body,
+	}
+	createOrUpdateWebhookContactParams := &alicloudOpenapiClient.Params{
+		Action:      tea.String("CreateOrUpdateWebhookContact"),
+		Version:     tea.String("2019-08-08"),
+		Protocol:    tea.String("HTTPS"),
+		Pathname:    tea.String("/"),
+		Method:      tea.String("POST"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("RPC"),
+		ReqBodyType: tea.String("formData"),
+		BodyType:    tea.String("json"),
+	}
+
+	var createOrUpdateWebhookContactResponse map[string]interface{}
+	createOrUpdateWebhookContact := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		createOrUpdateWebhookContactResponse, err = r.client.CallApi(createOrUpdateWebhookContactParams, createOrUpdateWebhookContactRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createOrUpdateWebhookContact); err != nil {
+		return "", err
+	}
+
+	responseBody, _ := createOrUpdateWebhookContactResponse["body"].(map[string]interface{})
+	webhookContact, _ := responseBody["WebhookContact"].(map[string]interface{})
+	// The number is decoded as json.Number by the SDK, which keeps all the
+	// digits of the ID.
+	responseWebhookId := fmt.Sprint(webhookContact["WebhookId"])
+	if _, err := strconv.ParseInt(responseWebhookId, 10, 64); err != nil {
+		return "", fmt.Errorf("webhook contact is not returned, request ID: %v", responseBody["RequestId"])
+	}
+	return responseWebhookId, nil
+}
+
+// Function to convert the headers or params of the webhook to the JSON format
+// of AliCloud API, which is a list of single key-value objects, e.g.
+// [{"Content-Type":"application/json"}].
+func armsWebhookPairsJson(pairs types.Map) (string, error) {
+	values := map[string]string{}
+	for key, value := range pairs.Elements() {
+		values[key] = value.(types.String).ValueString()
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairsJson := []map[string]string{}
+	for _, key := range keys {
+		pairsJson = append(pairsJson, map[string]string{key: values[key]})
+	}
+
+	result, err := json.Marshal(pairsJson)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// Function to convert the headers or params of the webhook returned by
+// AliCloud API to the map value. The null map is kept if nothing is set.
+func armsWebhookPairsToMapValue(prev types.Map, pairs map[string]interface{}) types.Map {
+	values := map[string]string{}
+	for key, value := range pairs {
+		if stringValue, ok := value.(string); ok {
+			values[key] = stringValue
+		} else {
+			values[key] = fmt.Sprint(value)
+		}
+	}
+	if len(values) == 0 && prev.IsNull() {
+		return prev
+	}
+	return convertStringMapToMapValue(values)
+}
@@ -0,0 +1,382 @@
+package alicloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCasClient "github.com/alibabacloud-go/cas-20200407/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &casCertificateResource{}
+	_ resource.ResourceWithConfigure   = &casCertificateResource{}
+	_ resource.ResourceWithImportState = &casCertificateResource{}
+	_ resource.ResourceWithModifyPlan  = &casCertificateResource{}
+)
+
+func NewCasCertificateResource() resource.Resource {
+	return &casCertificateResource{}
+}
+
+type casCertificateResource struct {
+	client     *alicloudCasClient.Client
+	namePrefix string
+}
+
+type casCertificateModel struct {
+	Name        types.String `tfsdk:"name"`
+	Cert        types.String `tfsdk:"cert"`
+	Key         types.String `tfsdk:"key"`
+	CertId      types.Int64  `tfsdk:"cert_id"`
+	CertName    types.String `tfsdk:"cert_name"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	CommonName  types.String `tfsdk:"common_name"`
+	ExpireTime  types.String `tfsdk:"expire_time"`
+}
+
+// Metadata returns the CAS certificate resource name.
+func (r *casCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cas_certificate"
+}
+
+// Schema defines the schema for the CAS certificate resource.
+func (r *casCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upload a certificate to the Certificate Management Service (CAS). Rotating the certificate " +
+			"uploads a new certificate, use it with create_before_destroy so that the dependents are updated " +
+			"before the old certificate is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the certificate, the fingerprint is appended to the name uploaded to CAS " +
+					"so that the rotated certificates do not conflict.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cert": schema.StringAttribute{
+				Description: "The content of the certificate in PEM format, including the certificate chain.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The private key of the certificate in PEM format.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cert_id": schema.Int64Attribute{
+				Description: "The ID of the certificate in CAS.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cert_name": schema.StringAttribute{
+				Description: "The name of the certificate uploaded to CAS.",
+				Computed:    true,
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The SHA-256 fingerprint of the leaf certificate, which is known in the plan and can be " +
+					"used in replace_triggered_by of the dependents.",
+				Computed: true,
+			},
+			"common_name": schema.StringAttribute{
+				Description: "The common name of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "The expiration date of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *casCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).casClient
+	r.namePrefix = req.ProviderData.(alicloudClients).namePrefix
+}
+
+// Upload the certificate.
+func (r *casCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *casCertificateModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploadUserCertificate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		uploadUserCertificateRequest := &alicloudCasClient.UploadUserCertificateRequest{
+			Name: tea.String(plan.CertName.ValueString()),
+			Cert: tea.String(plan.Cert.ValueString()),
+			Key:  tea.String(plan.Key.ValueString()),
+		}
+
+		uploadUserCertificateResponse, err := r.client.UploadUserCertificateWithOptions(uploadUserCertificateRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.CertId = types.Int64Value(tea.Int64Value(uploadUserCertificateResponse.Body.CertId))
+		return nil
+	}
+
+	if err := retryAPICall(uploadUserCertificate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Upload User Certificate.",
+			err.Error(),
+		)
+		return
+	}
+
+	certificate, err := r.describeCertificate(plan.CertId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get User Certificate Detail.",
+			err.Error(),
+		)
+		return
+	}
+	plan.CommonName = types.StringNull()
+	plan.ExpireTime = types.StringNull()
+	if certificate != nil {
+		plan.CommonName = types.StringValue(tea.StringValue(certificate.Common))
+		plan.ExpireTime = types.StringValue(tea.StringValue(certificate.EndDate))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the certificate.
+func (r *casCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *casCertificateModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.describeCertificate(state.CertId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get User Certificate Detail.",
+			err.Error(),
+		)
+		return
+	}
+	if certificate == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The key is not returned by the API, it is kept in the state.
+	state.CertName = types.StringValue(tea.StringValue(certificate.Name))
+	state.CommonName = types.StringValue(tea.StringValue(certificate.Common))
+	state.ExpireTime = types.StringValue(tea.StringValue(certificate.EndDate))
+	if cert := tea.StringValue(certificate.Cert); cert != "" {
+		if state.Cert.IsNull() || strings.TrimSpace(cert) != strings.TrimSpace(state.Cert.ValueString()) {
+			state.Cert = types.StringValue(cert)
+		}
+		if fingerprint, err := casCertificateFingerprint(state.Cert.ValueString()); err == nil {
+			state.Fingerprint = types.StringValue(fingerprint)
+		}
+	}
+	if state.Name.IsNull() {
+		state.Name = types.StringValue(tea.StringValue(certificate.Name))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// All the configurable attributes require replacement, there is nothing to
+// update.
+func (r *casCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *casCertificateModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the certificate.
+func (r *casCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *casCertificateModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteUserCertificate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteUserCertificateRequest := &alicloudCasClient.DeleteUserCertificateRequest{
+			CertId: tea.Int64(state.CertId.ValueInt64()),
+		}
+
+		if _, err := r.client.DeleteUserCertificateWithOptions(deleteUserCertificateRequest, runtime); err != nil {
+			if isCasCertificateNotFoundError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteUserCertificate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete User Certificate.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the certificate with the certificate ID, the private key is not
+// imported as it is not returned by the API.
+func (r *casCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	certId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the certificate ID as the import identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cert_id"), certId)...)
+}
+
+// ModifyPlan computes the fingerprint and the uploaded name of the
+// certificate, so that they are known in the plan for the dependents.
+func (r *casCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *casCertificateModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Cert.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	fingerprint, err := casCertificateFingerprint(plan.Cert.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cert"),
+			"Invalid Certificate",
+			err.Error(),
+		)
+		return
+	}
+	plan.Fingerprint = types.StringValue(fingerprint)
+	plan.CertName = types.StringValue(fmt.Sprintf("%s%s-%s", r.namePrefix, plan.Name.ValueString(), fingerprint[:12]))
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Function to get the detail of the certificate, returns nil if the
+// certificate is not found.
+func (r *casCertificateResource) describeCertificate(certId int64) (*alicloudCasClient.GetUserCertificateDetailResponseBody, error) {
+	var certificate *alicloudCasClient.GetUserCertificateDetailResponseBody
+	getUserCertificateDetail := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getUserCertificateDetailRequest := &alicloudCasClient.GetUserCertificateDetailRequest{
+			CertId: tea.Int64(certId),
+		}
+
+		getUserCertificateDetailResponse, err := r.client.GetUserCertificateDetailWithOptions(getUserCertificateDetailRequest, runtime)
+		if err != nil {
+			if isCasCertificateNotFoundError(err) {
+				certificate = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		certificate = getUserCertificateDetailResponse.Body
+		return nil
+	}
+
+	err := retryAPICall(getUserCertificateDetail)
+	return certificate, err
+}
+
+// Function to calculate the SHA-256 fingerprint of the first certificate in
+// the PEM content, which is the leaf certificate.
+func casCertificateFingerprint(cert string) (string, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(cert)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("the certificate is not a PEM encoded certificate")
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Function to check whether the error is caused by the certificate which does
+// not exist.
+func isCasCertificateNotFoundError(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		code := tea.StringValue(_t.Code)
+		return strings.Contains(code, "NotFound") || strings.Contains(code, "NotExist")
+	}
+	return false
+}
@@ -0,0 +1,470 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCasClient "github.com/alibabacloud-go/cas-20200407/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                   = &casCertificateDeploymentResource{}
+	_ resource.ResourceWithConfigure      = &casCertificateDeploymentResource{}
+	_ resource.ResourceWithValidateConfig = &casCertificateDeploymentResource{}
+)
+
+func NewCasCertificateDeploymentResource() resource.Resource {
+	return &casCertificateDeploymentResource{}
+}
+
+type casCertificateDeploymentResource struct {
+	client *alicloudCasClient.Client
+}
+
+type casCertificateDeploymentModel struct {
+	Name       types.String                      `tfsdk:"name"`
+	CertId     types.Int64                       `tfsdk:"cert_id"`
+	ContactIds types.List                        `tfsdk:"contact_ids"`
+	Resources  []*casCertificateDeploymentTarget `tfsdk:"resources"`
+	JobId      types.Int64                       `tfsdk:"job_id"`
+	Status     types.String                      `tfsdk:"status"`
+}
+
+type casCertificateDeploymentTarget struct {
+	CloudProduct types.String `tfsdk:"cloud_product"`
+	InstanceId   types.String `tfsdk:"instance_id"`
+	ListenerPort types.Int64  `tfsdk:"listener_port"`
+	Domain       types.String `tfsdk:"domain"`
+	ResourceId   types.Int64  `tfsdk:"resource_id"`
+}
+
+// Metadata returns the CAS certificate deployment resource name.
+func (r *casCertificateDeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cas_certificate_deployment"
+}
+
+// Schema defines the schema for the CAS certificate deployment resource.
+func (r *casCertificateDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deploy a CAS certificate to the cloud resources with a deployment job and wait until the job " +
+			"is completed. Any change deploys the certificate again with a new job. Destroying the resource " +
+			"deletes the deployment job, the certificate deployed to the cloud resources is not rolled back.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the deployment job.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cert_id": schema.Int64Attribute{
+				Description: "The ID of the CAS certificate to deploy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"contact_ids": schema.ListAttribute{
+				Description: "The IDs of the contacts to be notified of the deployment.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Description: "The ID of the deployment job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the deployment job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resources": schema.ListNestedBlock{
+				Description: "The cloud resources to deploy the certificate to. The cloud resources are matched " +
+					"with the cloud resources synchronized to CAS.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_product": schema.StringAttribute{
+							Description: "The cloud product of the resource. Valid values: SLB, ALB, CDN, APIGateway.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("SLB", "ALB", "CDN", "APIGateway"),
+							},
+						},
+						"instance_id": schema.StringAttribute{
+							Description: "The ID of the instance, e.g. the ID of the load balancer or the API group.",
+							Optional:    true,
+						},
+						"listener_port": schema.Int64Attribute{
+							Description: "The port of the listener of the load balancer.",
+							Optional:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The domain of the CDN or the API Gateway.",
+							Optional:    true,
+						},
+						"resource_id": schema.Int64Attribute{
+							Description: "The ID of the cloud resource in CAS.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every cloud resource can be identified.
+func (r *casCertificateDeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *casCertificateDeploymentModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, target := range config.Resources {
+		if target.InstanceId.IsNull() && target.Domain.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resources").AtListIndex(i),
+				"Missing Attribute Configuration",
+				"Expected instance_id or domain to be configured to identify the cloud resource.",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *casCertificateDeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).casClient
+}
+
+// Create the deployment job, start it and wait until it is completed.
+func (r *casCertificateDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *casCertificateDeploymentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var resourceIds []string
+	for _, target := range plan.Resources {
+		resourceId, err := r.findCloudResource(target)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Cloud Resources.",
+				err.Error(),
+			)
+			return
+		}
+		target.ResourceId = types.Int64Value(resourceId)
+		resourceIds = append(resourceIds, strconv.FormatInt(resourceId, 10))
+	}
+
+	createDeploymentJob := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createDeploymentJobRequest := &alicloudCasClient.CreateDeploymentJobRequest{
+			Name:        tea.String(plan.Name.ValueString()),
+			JobType:     tea.String("user"),
+			CertIds:     tea.String(strconv.FormatInt(plan.CertId.ValueInt64(), 10)),
+			ResourceIds: tea.String(strings.Join(resourceIds, ",")),
+		}
+		if contactIds := convertListValueToStrings(plan.ContactIds); len(contactIds) > 0 {
+			createDeploymentJobRequest.ContactIds = tea.String(strings.Join(contactIds, ","))
+		}
+
+		createDeploymentJobResponse, err := r.client.CreateDeploymentJobWithOptions(createDeploymentJobRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.JobId = types.Int64Value(tea.Int64Value(createDeploymentJobResponse.Body.JobId))
+		return nil
+	}
+
+	if err := retryAPICall(createDeploymentJob); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Deployment Job.",
+			err.Error(),
+		)
+		return
+	}
+	plan.Status = types.StringValue("editing")
+
+	// Save the deployment job into state before starting it, so that it is
+	// not leaked if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The deployment job is created in the editing status, it is started by
+	// changing the status to scheduling.
+	updateDeploymentJobStatus := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateDeploymentJobStatusRequest := &alicloudCasClient.UpdateDeploymentJobStatusRequest{
+			JobId:  tea.Int64(plan.JobId.ValueInt64()),
+			Status: tea.String("scheduling"),
+		}
+
+		if _, err := r.client.UpdateDeploymentJobStatusWithOptions(updateDeploymentJobStatusRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateDeploymentJobStatus); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Deployment Job Status.",
+			err.Error(),
+		)
+		return
+	}
+
+	status, err := r.waitDeploymentJobCompleted(plan.JobId.ValueInt64())
+	plan.Status = types.StringValue(status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Deployment Job to be Completed.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the status of the deployment job.
+func (r *casCertificateDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *casCertificateDeploymentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.describeDeploymentJob(state.JobId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Deployment Job.",
+			err.Error(),
+		)
+		return
+	}
+	if job == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Status = types.StringValue(tea.StringValue(job.Status))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// All the attributes require replacement, there is nothing to update.
+func (r *casCertificateDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *casCertificateDeploymentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the deployment job.
+func (r *casCertificateDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *casCertificateDeploymentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteDeploymentJob := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDeploymentJobRequest := &alicloudCasClient.DeleteDeploymentJobRequest{
+			JobId: tea.Int64(state.JobId.ValueInt64()),
+		}
+
+		if _, err := r.client.DeleteDeploymentJobWithOptions(deleteDeploymentJobRequest, runtime); err != nil {
+			if isCasCertificateNotFoundError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteDeploymentJob); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Deployment Job.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to find the ID of the cloud resource in CAS which matches the
+// target.
+func (r *casCertificateDeploymentResource) findCloudResource(target *casCertificateDeploymentTarget) (int64, error) {
+	keyword := target.Domain.ValueString()
+	if keyword == "" {
+		keyword = target.InstanceId.ValueString()
+	}
+
+	currentPage := int32(1)
+	showSize := int32(50)
+	for {
+		var listCloudResourcesResponse *alicloudCasClient.ListCloudResourcesResponse
+		listCloudResources := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listCloudResourcesRequest := &alicloudCasClient.ListCloudResourcesRequest{
+				CloudName:    tea.String("aliyun"),
+				CloudProduct: tea.String(target.CloudProduct.ValueString()),
+				Keyword:      tea.String(keyword),
+				CurrentPage:  tea.Int32(currentPage),
+				ShowSize:     tea.Int32(showSize),
+			}
+
+			var err error
+			listCloudResourcesResponse, err = r.client.ListCloudResourcesWithOptions(listCloudResourcesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listCloudResources); err != nil {
+			return 0, err
+		}
+
+		for _, cloudResource := range listCloudResourcesResponse.Body.Data {
+			if !target.InstanceId.IsNull() && tea.StringValue(cloudResource.InstanceId) != target.InstanceId.ValueString() {
+				continue
+			}
+			if !target.ListenerPort.IsNull() && tea.Int64Value(cloudResource.ListenerPort) != target.ListenerPort.ValueInt64() {
+				continue
+			}
+			if !target.Domain.IsNull() && tea.StringValue(cloudResource.Domain) != target.Domain.ValueString() {
+				continue
+			}
+			return tea.Int64Value(cloudResource.Id), nil
+		}
+
+		if currentPage*showSize >= tea.Int32Value(listCloudResourcesResponse.Body.Total) {
+			break
+		}
+		currentPage++
+	}
+	return 0, fmt.Errorf("no %s cloud resource in CAS matches %q, make sure the cloud resources are synchronized to CAS",
+		target.CloudProduct.ValueString(), keyword)
+}
+
+// Function to describe the deployment job, returns nil if the job is not
+// found.
+func (r *casCertificateDeploymentResource) describeDeploymentJob(jobId int64) (*alicloudCasClient.DescribeDeploymentJobResponseBody, error) {
+	var job *alicloudCasClient.DescribeDeploymentJobResponseBody
+	describeDeploymentJob := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDeploymentJobRequest := &alicloudCasClient.DescribeDeploymentJobRequest{
+			JobId: tea.Int64(jobId),
+		}
+
+		describeDeploymentJobResponse, err := r.client.DescribeDeploymentJobWithOptions(describeDeploymentJobRequest, runtime)
+		if err != nil {
+			if isCasCertificateNotFoundError(err) {
+				job = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		job = describeDeploymentJobResponse.Body
+		return nil
+	}
+
+	err := retryAPICall(describeDeploymentJob)
+	return job, err
+}
+
+// Function to wait until the deployment job is completed, returns the last
+// status of the job.
+func (r *casCertificateDeploymentResource) waitDeploymentJobCompleted(jobId int64) (string, error) {
+	var status string
+	waitDeploymentJobCompleted := func() error {
+		job, err := r.describeDeploymentJob(jobId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if job == nil {
+			return backoff.Permanent(fmt.Errorf("deployment job %d is not found", jobId))
+		}
+
+		switch status = tea.StringValue(job.Status); status {
+		case "success":
+			return nil
+		case "error", "fail":
+			return backoff.Permanent(fmt.Errorf("deployment job %d is failed, check the failed resources in CAS console", jobId))
+		default:
+			return fmt.Errorf("deployment job %d is %s", jobId, status)
+		}
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 30 * time.Minute
+	err := backoff.Retry(waitDeploymentJobCompleted, reconnectBackoff)
+	return status, err
+}
@@ -0,0 +1,345 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// The maximum number of the objects in a refresh or preload request.
+const (
+	cdnRefreshUrlBatchSize       = 1000
+	cdnRefreshDirectoryBatchSize = 100
+	cdnPreloadUrlBatchSize       = 1000
+)
+
+var (
+	_ resource.Resource                     = &cdnCacheRefreshResource{}
+	_ resource.ResourceWithConfigure        = &cdnCacheRefreshResource{}
+	_ resource.ResourceWithConfigValidators = &cdnCacheRefreshResource{}
+)
+
+func NewCdnCacheRefreshResource() resource.Resource {
+	return &cdnCacheRefreshResource{}
+}
+
+type cdnCacheRefreshResource struct {
+	client *alicloudCdnClient.Client
+}
+
+type cdnCacheRefreshModel struct {
+	Triggers           types.Map    `tfsdk:"triggers"`
+	RefreshUrls        types.List   `tfsdk:"refresh_urls"`
+	RefreshDirectories types.List   `tfsdk:"refresh_directories"`
+	PreloadUrls        types.List   `tfsdk:"preload_urls"`
+	PreloadArea        types.String `tfsdk:"preload_area"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
+	TaskIds            types.List   `tfsdk:"task_ids"`
+}
+
+// Metadata returns the CDN cache refresh resource name.
+func (r *cdnCacheRefreshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_cache_refresh"
+}
+
+// Schema defines the schema for the CDN cache refresh resource.
+func (r *cdnCacheRefreshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Trigger the CDN refresh and preload tasks of the URLs and directories. The tasks are " +
+			"triggered again when any of the triggers or the objects is changed. Destroying the resource " +
+			"does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger the tasks again, e.g. " +
+					"the release version.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"refresh_urls": schema.ListAttribute{
+				Description: "The URLs to be refreshed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"refresh_directories": schema.ListAttribute{
+				Description: "The directories to be refreshed, e.g. https://example.com/static/.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"preload_urls": schema.ListAttribute{
+				Description: "The URLs to be preloaded to the CDN nodes.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"preload_area": schema.StringAttribute{
+				Description: "The area of the CDN nodes to preload. Valid values: domestic, overseas. Default " +
+					"to all the areas.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("domestic", "overseas"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait until the tasks are completed. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"task_ids": schema.ListAttribute{
+				Description: "The IDs of the triggered refresh and preload tasks.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *cdnCacheRefreshResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("refresh_urls"),
+			path.MatchRoot("refresh_directories"),
+			path.MatchRoot("preload_urls"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cdnCacheRefreshResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cdnClient
+}
+
+// Trigger the refresh and preload tasks.
+func (r *cdnCacheRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cdnCacheRefreshModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taskIds []string
+	for _, urls := range cdnSplitBatches(convertListValueToStrings(plan.RefreshUrls), cdnRefreshUrlBatchSize) {
+		taskId, err := r.refreshObjectCaches(urls, "File")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Refresh Object Caches.",
+				err.Error(),
+			)
+			return
+		}
+		taskIds = append(taskIds, taskId)
+	}
+
+	for _, directories := range cdnSplitBatches(convertListValueToStrings(plan.RefreshDirectories), cdnRefreshDirectoryBatchSize) {
+		taskId, err := r.refreshObjectCaches(directories, "Directory")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Refresh Object Caches.",
+				err.Error(),
+			)
+			return
+		}
+		taskIds = append(taskIds, taskId)
+	}
+
+	// Preload after the refresh tasks are triggered, so that the new objects
+	// are fetched from the origin.
+	for _, urls := range cdnSplitBatches(convertListValueToStrings(plan.PreloadUrls), cdnPreloadUrlBatchSize) {
+		taskId, err := r.pushObjectCache(urls, plan.PreloadArea)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Push Object Cache.",
+				err.Error(),
+			)
+			return
+		}
+		taskIds = append(taskIds, taskId)
+	}
+
+	// Split the comma separated task IDs of a request.
+	var splitTaskIds []string
+	for _, taskId := range taskIds {
+		splitTaskIds = append(splitTaskIds, cdnSplitList(taskId)...)
+	}
+	plan.TaskIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(splitTaskIds)))
+
+	if plan.WaitForCompletion.ValueBool() {
+		for _, taskId := range splitTaskIds {
+			if err := r.waitTaskCompleted(taskId); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Wait CDN Task to be Completed.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read function (Do nothing).
+func (r *cdnCacheRefreshResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cdnCacheRefreshModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only the wait_for_completion flag, the other attributes trigger the
+// tasks again by replacing the resource.
+func (r *cdnCacheRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *cdnCacheRefreshModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete function (Do nothing).
+func (r *cdnCacheRefreshResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Function to refresh the caches of the objects, returns the task ID.
+func (r *cdnCacheRefreshResource) refreshObjectCaches(objectPaths []string, objectType string) (string, error) {
+	var taskId string
+	refreshObjectCaches := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		refreshObjectCachesRequest := &alicloudCdnClient.RefreshObjectCachesRequest{
+			ObjectPath: tea.String(strings.Join(objectPaths, "\n")),
+			ObjectType: tea.String(objectType),
+		}
+
+		refreshObjectCachesResponse, err := r.client.RefreshObjectCachesWithOptions(refreshObjectCachesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		taskId = tea.StringValue(refreshObjectCachesResponse.Body.RefreshTaskId)
+		return nil
+	}
+
+	err := retryAPICall(refreshObjectCaches)
+	return taskId, err
+}
+
+// Function to preload the objects to the CDN nodes, returns the task ID.
+func (r *cdnCacheRefreshResource) pushObjectCache(objectPaths []string, area types.String) (string, error) {
+	var taskId string
+	pushObjectCache := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		pushObjectCacheRequest := &alicloudCdnClient.PushObjectCacheRequest{
+			ObjectPath: tea.String(strings.Join(objectPaths, "\n")),
+			Area:       stringPointerOrNil(area),
+		}
+
+		pushObjectCacheResponse, err := r.client.PushObjectCacheWithOptions(pushObjectCacheRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		taskId = tea.StringValue(pushObjectCacheResponse.Body.PushTaskId)
+		return nil
+	}
+
+	err := retryAPICall(pushObjectCache)
+	return taskId, err
+}
+
+// Function to wait until the refresh or preload task is completed.
+func (r *cdnCacheRefreshResource) waitTaskCompleted(taskId string) error {
+	waitTaskCompleted := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeRefreshTaskByIdRequest := &alicloudCdnClient.DescribeRefreshTaskByIdRequest{
+			TaskId: tea.String(taskId),
+		}
+
+		describeRefreshTaskByIdResponse, err := r.client.DescribeRefreshTaskByIdWithOptions(describeRefreshTaskByIdRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		for _, task := range describeRefreshTaskByIdResponse.Body.Tasks {
+			switch status := tea.StringValue(task.Status); status {
+			case "Complete":
+			case "Failed":
+				return backoff.Permanent(fmt.Errorf("CDN task %s of %s is failed: %s",
+					taskId, tea.StringValue(task.ObjectPath), tea.StringValue(task.Description)))
+			default:
+				return fmt.Errorf("CDN task %s of %s is %s", taskId, tea.StringValue(task.ObjectPath), status)
+			}
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 30 * time.Minute
+	return backoff.Retry(waitTaskCompleted, reconnectBackoff)
+}
+
+// Function to split the objects into the batches of the API limit.
+func cdnSplitBatches(objects []string, batchSize int) [][]string {
+	var batches [][]string
+	for start := 0; start < len(objects); start += batchSize {
+		end := start + batchSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+		batches = append(batches, objects[start:end])
+	}
+	return batches
+}
@@ -0,0 +1,1061 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	cdnFunctionPathTtl       = "path_based_ttl_set"
+	cdnFunctionFileTypeTtl   = "filetype_based_ttl_set"
+	cdnFunctionHttpsForce    = "https_force"
+	cdnFunctionHttpsOption   = "https_option"
+	cdnFunctionHsts          = "HSTS"
+	cdnFunctionRefererAllow  = "referer_white_list_set"
+	cdnFunctionRefererDeny   = "referer_black_list_set"
+	cdnFunctionIpAllow       = "ip_allow_list_set"
+	cdnFunctionIpDeny        = "ip_black_list_set"
+	cdnFunctionRange         = "range"
+	cdnFunctionOriginHost    = "set_req_host_header"
+	cdnDomainNotFoundErrCode = "InvalidDomain.NotFound"
+)
+
+// The functions of the domain config managed by the resource.
+var cdnDomainFunctionNames = []string{
+	cdnFunctionPathTtl,
+	cdnFunctionFileTypeTtl,
+	cdnFunctionHttpsForce,
+	cdnFunctionHttpsOption,
+	cdnFunctionHsts,
+	cdnFunctionRefererAllow,
+	cdnFunctionRefererDeny,
+	cdnFunctionIpAllow,
+	cdnFunctionIpDeny,
+	cdnFunctionRange,
+	cdnFunctionOriginHost,
+}
+
+var (
+	_ resource.Resource                = &cdnDomainResource{}
+	_ resource.ResourceWithConfigure   = &cdnDomainResource{}
+	_ resource.ResourceWithImportState = &cdnDomainResource{}
+)
+
+func NewCdnDomainResource() resource.Resource {
+	return &cdnDomainResource{}
+}
+
+type cdnDomainResource struct {
+	client *alicloudCdnClient.Client
+}
+
+type cdnDomainModel struct {
+	DomainName       types.String             `tfsdk:"domain_name"`
+	CdnType          types.String             `tfsdk:"cdn_type"`
+	Scope            types.String             `tfsdk:"scope"`
+	ResourceGroupId  types.String             `tfsdk:"resource_group_id"`
+	Cname            types.String             `tfsdk:"cname"`
+	Sources          []*cdnDomainSource       `tfsdk:"sources"`
+	CacheTtlRules    []*cdnDomainCacheTtlRule `tfsdk:"cache_ttl_rules"`
+	Https            *cdnDomainHttps          `tfsdk:"https"`
+	Referer          *cdnDomainReferer        `tfsdk:"referer"`
+	IpAcl            *cdnDomainIpAcl          `tfsdk:"ip_acl"`
+	RangeOrigin      types.String             `tfsdk:"range_origin"`
+	BackToOriginHost types.String             `tfsdk:"back_to_origin_host"`
+}
+
+type cdnDomainSource struct {
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+}
+
+type cdnDomainCacheTtlRule struct {
+	Type   types.String `tfsdk:"type"`
+	Value  types.String `tfsdk:"value"`
+	Ttl    types.Int64  `tfsdk:"ttl"`
+	Weight types.Int64  `tfsdk:"weight"`
+}
+
+type cdnDomainHttps struct {
+	ForceHttps types.Bool  `tfsdk:"force_https"`
+	Http2      types.Bool  `tfsdk:"http2"`
+	HstsMaxAge types.Int64 `tfsdk:"hsts_max_age"`
+}
+
+type cdnDomainReferer struct {
+	Type       types.String `tfsdk:"type"`
+	Domains    types.List   `tfsdk:"domains"`
+	AllowEmpty types.Bool   `tfsdk:"allow_empty"`
+}
+
+type cdnDomainIpAcl struct {
+	Type types.String `tfsdk:"type"`
+	Ips  types.List   `tfsdk:"ips"`
+}
+
+// The source of the domain in the format of AliCloud API.
+type cdnDomainSourceConfig struct {
+	Type     string `json:"type"`
+	Content  string `json:"content"`
+	Port     int64  `json:"port"`
+	Priority string `json:"priority"`
+	Weight   string `json:"weight"`
+}
+
+// The function of the domain config in the format of AliCloud API.
+type cdnDomainFunction struct {
+	FunctionName string                  `json:"functionName"`
+	FunctionArgs []*cdnDomainFunctionArg `json:"functionArgs"`
+}
+
+type cdnDomainFunctionArg struct {
+	ArgName  string `json:"argName"`
+	ArgValue string `json:"argValue"`
+}
+
+// Metadata returns the CDN domain resource name.
+func (r *cdnDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_domain"
+}
+
+// Schema defines the schema for the CDN domain resource.
+func (r *cdnDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a CDN accelerated domain together with its configs, e.g. cache TTL rules, HTTPS " +
+			"settings, referer and IP access control, range origin and back-to-origin host.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The accelerated domain name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cdn_type": schema.StringAttribute{
+				Description: "The business type of the domain. Valid values: web, download, video.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("web", "download", "video"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description: "The acceleration region. Valid values: domestic, overseas, global.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("domestic", "overseas", "global"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_group_id": schema.StringAttribute{
+				Description: "The ID of the resource group.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cname": schema.StringAttribute{
+				Description: "The CNAME assigned to the domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"range_origin": schema.StringAttribute{
+				Description: "Whether to fetch the object from the origin by range. Valid values: on, off, force.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "force"),
+				},
+			},
+			"back_to_origin_host": schema.StringAttribute{
+				Description: "The Host header of the back-to-origin requests.",
+				Optional:    true,
+			},
+		},
+		Blocks: cdnDomainConfigBlocks("The HTTPS settings of the domain. The certificate is managed by " +
+			"st-alicloud_cdn_domain_ssl_certificate."),
+	}
+}
+
+// Function to build the blocks of the origins and the domain configs, which
+// are shared by the CDN and DCDN domain resources.
+func cdnDomainConfigBlocks(httpsDescription string) map[string]schema.Block {
+	return map[string]schema.Block{
+		"sources": schema.ListNestedBlock{
+			Description: "The origins of the domain.",
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The type of the origin. Valid values: ipaddr, domain, oss.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ipaddr", "domain", "oss"),
+						},
+					},
+					"content": schema.StringAttribute{
+						Description: "The address of the origin.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "The port of the origin. Default to 80.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(80),
+					},
+					"priority": schema.Int64Attribute{
+						Description: "The priority of the origin. Valid values: 20 (primary), 30 (secondary). " +
+							"Default to 20.",
+						Optional: true,
+						Computed: true,
+						Default:  int64default.StaticInt64(20),
+						Validators: []validator.Int64{
+							int64validator.OneOf(20, 30),
+						},
+					},
+					"weight": schema.Int64Attribute{
+						Description: "The weight of the origin, from 0 to 100. Default to 10.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(10),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+		},
+		"cache_ttl_rules": schema.ListNestedBlock{
+			Description: "The cache expiration rules of the domain.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The type of the rule. Valid values: path, suffix.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("path", "suffix"),
+						},
+					},
+					"value": schema.StringAttribute{
+						Description: "The directory path, e.g. /static, or the comma separated file " +
+							"suffixes, e.g. jpg,png.",
+						Required: true,
+					},
+					"ttl": schema.Int64Attribute{
+						Description: "The cache expiration time in seconds.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"weight": schema.Int64Attribute{
+						Description: "The weight of the rule, from 1 to 99. Default to 1.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 99),
+						},
+					},
+				},
+			},
+		},
+		"https": schema.SingleNestedBlock{
+			Description: httpsDescription,
+			Attributes: map[string]schema.Attribute{
+				"force_https": schema.BoolAttribute{
+					Description: "Whether to redirect the HTTP requests to HTTPS.",
+					Optional:    true,
+				},
+				"http2": schema.BoolAttribute{
+					Description: "Whether to enable HTTP/2.",
+					Optional:    true,
+				},
+				"hsts_max_age": schema.Int64Attribute{
+					Description: "The max-age of HSTS in seconds. HSTS is disabled if not set.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+		},
+		"referer": schema.SingleNestedBlock{
+			Description: "The referer access control of the domain.",
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of the referer list. Valid values: whitelist, blacklist.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("whitelist", "blacklist"),
+					},
+				},
+				"domains": schema.ListAttribute{
+					Description: "The referer domains.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"allow_empty": schema.BoolAttribute{
+					Description: "Whether to allow the requests with an empty referer.",
+					Optional:    true,
+				},
+			},
+		},
+		"ip_acl": schema.SingleNestedBlock{
+			Description: "The IP access control of the domain.",
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of the IP list. Valid values: allow, deny.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("allow", "deny"),
+					},
+				},
+				"ips": schema.ListAttribute{
+					Description: "The IP addresses or CIDR blocks.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cdnDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cdnClient
+}
+
+// Create the CDN domain and set its configs.
+func (r *cdnDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cdnDomainModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := newCdnDomainSources(plan.Sources)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal CDN Domain Sources.",
+			err.Error(),
+		)
+		return
+	}
+
+	addCdnDomain := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addCdnDomainRequest := &alicloudCdnClient.AddCdnDomainRequest{
+			DomainName:      tea.String(plan.DomainName.ValueString()),
+			CdnType:         tea.String(plan.CdnType.ValueString()),
+			Sources:         tea.String(sources),
+			Scope:           stringPointerOrNil(plan.Scope),
+			ResourceGroupId: stringPointerOrNil(plan.ResourceGroupId),
+		}
+
+		if _, err := r.client.AddCdnDomainWithOptions(addCdnDomainRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(addCdnDomain); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add CDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := r.waitCdnDomainConfigurable(plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait CDN Domain to be Online.",
+			err.Error(),
+		)
+		return
+	}
+	plan.Cname = types.StringValue(tea.StringValue(domain.Cname))
+	plan.Scope = types.StringValue(tea.StringValue(domain.Scope))
+	plan.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+
+	if err := r.setCdnDomainConfigs(plan.DomainName.ValueString(), nil, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set CDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the CDN domain and its configs.
+func (r *cdnDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cdnDomainModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.describeCdnDomain(state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe CDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+	if domain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.CdnType = types.StringValue(tea.StringValue(domain.CdnType))
+	state.Scope = types.StringValue(tea.StringValue(domain.Scope))
+	state.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+	state.Cname = types.StringValue(tea.StringValue(domain.Cname))
+
+	sources := []*cdnDomainSource{}
+	if domain.SourceModels != nil {
+		for _, source := range domain.SourceModels.SourceModel {
+			priority, _ := strconv.ParseInt(tea.StringValue(source.Priority), 10, 64)
+			weight, _ := strconv.ParseInt(tea.StringValue(source.Weight), 10, 64)
+			sources = append(sources, &cdnDomainSource{
+				Type:     types.StringValue(tea.StringValue(source.Type)),
+				Content:  types.StringValue(tea.StringValue(source.Content)),
+				Port:     types.Int64Value(int64(tea.Int32Value(source.Port))),
+				Priority: types.Int64Value(priority),
+				Weight:   types.Int64Value(weight),
+			})
+		}
+	}
+	state.Sources = sources
+
+	domainConfigs, err := r.describeCdnDomainConfigs(state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe CDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Every function is compared with the state separately, so that the
+	// drift of a single function is detected without touching the others.
+	functions := map[string][]map[string]string{}
+	for _, domainConfig := range domainConfigs {
+		args := map[string]string{}
+		if domainConfig.FunctionArgs != nil {
+			for _, arg := range domainConfig.FunctionArgs.FunctionArg {
+				args[tea.StringValue(arg.ArgName)] = tea.StringValue(arg.ArgValue)
+			}
+		}
+		functionName := tea.StringValue(domainConfig.FunctionName)
+		functions[functionName] = append(functions[functionName], args)
+	}
+
+	resp.Diagnostics.Append(state.flattenCdnDomainFunctions(functions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the CDN domain and the changed configs.
+func (r *cdnDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cdnDomainModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !reflect.DeepEqual(plan.Sources, state.Sources) ||
+		(!plan.ResourceGroupId.IsUnknown() && !plan.ResourceGroupId.Equal(state.ResourceGroupId)) {
+		sources, err := newCdnDomainSources(plan.Sources)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Marshal CDN Domain Sources.",
+				err.Error(),
+			)
+			return
+		}
+
+		modifyCdnDomain := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyCdnDomainRequest := &alicloudCdnClient.ModifyCdnDomainRequest{
+				DomainName:      tea.String(plan.DomainName.ValueString()),
+				Sources:         tea.String(sources),
+				ResourceGroupId: stringPointerOrNil(plan.ResourceGroupId),
+			}
+
+			if _, err := r.client.ModifyCdnDomainWithOptions(modifyCdnDomainRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyCdnDomain); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify CDN Domain.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Scope.IsUnknown() && !plan.Scope.Equal(state.Scope) {
+		modifyCdnDomainSchdmByProperty := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyCdnDomainSchdmByPropertyRequest := &alicloudCdnClient.ModifyCdnDomainSchdmByPropertyRequest{
+				DomainName: tea.String(plan.DomainName.ValueString()),
+				Property:   tea.String(fmt.Sprintf(`{"coverage":"%s"}`, plan.Scope.ValueString())),
+			}
+
+			if _, err := r.client.ModifyCdnDomainSchdmByPropertyWithOptions(modifyCdnDomainSchdmByPropertyRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyCdnDomainSchdmByProperty); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify CDN Domain Scope.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if err := r.setCdnDomainConfigs(plan.DomainName.ValueString(), state, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set CDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := r.describeCdnDomain(plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe CDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+	if domain != nil {
+		plan.Cname = types.StringValue(tea.StringValue(domain.Cname))
+		plan.Scope = types.StringValue(tea.StringValue(domain.Scope))
+		plan.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the CDN domain.
+func (r *cdnDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cdnDomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteCdnDomain := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteCdnDomainRequest := &alicloudCdnClient.DeleteCdnDomainRequest{
+			DomainName: tea.String(state.DomainName.ValueString()),
+		}
+
+		if _, err := r.client.DeleteCdnDomainWithOptions(deleteCdnDomainRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteCdnDomain); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete CDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cdnDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain_name"), req, resp)
+}
+
+// Function to describe the CDN domain, returns nil if the domain is not found.
+func (r *cdnDomainResource) describeCdnDomain(domainName string) (*alicloudCdnClient.DescribeCdnDomainDetailResponseBodyGetDomainDetailModel, error) {
+	var domain *alicloudCdnClient.DescribeCdnDomainDetailResponseBodyGetDomainDetailModel
+	describeCdnDomainDetail := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeCdnDomainDetailRequest := &alicloudCdnClient.DescribeCdnDomainDetailRequest{
+			DomainName: tea.String(domainName),
+		}
+
+		describeCdnDomainDetailResponse, err := r.client.DescribeCdnDomainDetailWithOptions(describeCdnDomainDetailRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				domain = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		domain = describeCdnDomainDetailResponse.Body.GetDomainDetailModel
+		return nil
+	}
+
+	err := retryAPICall(describeCdnDomainDetail)
+	return domain, err
+}
+
+// Function to wait until the CDN domain is able to be configured.
+func (r *cdnDomainResource) waitCdnDomainConfigurable(domainName string) (*alicloudCdnClient.DescribeCdnDomainDetailResponseBodyGetDomainDetailModel, error) {
+	var domain *alicloudCdnClient.DescribeCdnDomainDetailResponseBodyGetDomainDetailModel
+	waitCdnDomainConfigurable := func() error {
+		var err error
+		domain, err = r.describeCdnDomain(domainName)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if domain == nil {
+			return fmt.Errorf("CDN domain %s is not found", domainName)
+		}
+		switch status := tea.StringValue(domain.DomainStatus); status {
+		case "online", "configuring":
+			return nil
+		case "check_failed", "configure_failed":
+			return backoff.Permanent(fmt.Errorf("CDN domain %s is %s", domainName, status))
+		default:
+			return fmt.Errorf("CDN domain %s is %s", domainName, status)
+		}
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitCdnDomainConfigurable, reconnectBackoff)
+	return domain, err
+}
+
+// Function to describe the configs of the managed functions of the domain.
+func (r *cdnDomainResource) describeCdnDomainConfigs(domainName string) ([]*alicloudCdnClient.DescribeCdnDomainConfigsResponseBodyDomainConfigsDomainConfig, error) {
+	var domainConfigs []*alicloudCdnClient.DescribeCdnDomainConfigsResponseBodyDomainConfigsDomainConfig
+	describeCdnDomainConfigs := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeCdnDomainConfigsRequest := &alicloudCdnClient.DescribeCdnDomainConfigsRequest{
+			DomainName:    tea.String(domainName),
+			FunctionNames: tea.String(strings.Join(cdnDomainFunctionNames, ",")),
+		}
+
+		describeCdnDomainConfigsResponse, err := r.client.DescribeCdnDomainConfigsWithOptions(describeCdnDomainConfigsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		domainConfigs = nil
+		if describeCdnDomainConfigsResponse.Body.DomainConfigs != nil {
+			domainConfigs = describeCdnDomainConfigsResponse.Body.DomainConfigs.DomainConfig
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeCdnDomainConfigs)
+	return domainConfigs, err
+}
+
+// Function to apply the changed functions between the state and the plan. The
+// existing configs of a changed function are removed before setting the new
+// ones, as the functions like the cache TTL rules may have multiple configs.
+func (r *cdnDomainResource) setCdnDomainConfigs(domainName string, state, plan *cdnDomainModel) error {
+	oldFunctions := map[string][]*cdnDomainFunction{}
+	if state != nil {
+		oldFunctions = state.cdnDomainFunctions()
+	}
+	newFunctions := plan.cdnDomainFunctions()
+
+	changedFunctionNames := map[string]bool{}
+	for _, functionName := range cdnDomainFunctionNames {
+		if !reflect.DeepEqual(oldFunctions[functionName], newFunctions[functionName]) {
+			changedFunctionNames[functionName] = true
+		}
+	}
+	if len(changedFunctionNames) == 0 {
+		return nil
+	}
+
+	if state != nil {
+		domainConfigs, err := r.describeCdnDomainConfigs(domainName)
+		if err != nil {
+			return err
+		}
+		for _, domainConfig := range domainConfigs {
+			if !changedFunctionNames[tea.StringValue(domainConfig.FunctionName)] {
+				continue
+			}
+			if err := r.deleteCdnDomainConfig(domainName, tea.StringValue(domainConfig.ConfigId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	functions := []*cdnDomainFunction{}
+	for _, functionName := range cdnDomainFunctionNames {
+		if changedFunctionNames[functionName] {
+			functions = append(functions, newFunctions[functionName]...)
+		}
+	}
+	if len(functions) == 0 {
+		return nil
+	}
+
+	functionsJson, err := json.Marshal(functions)
+	if err != nil {
+		return err
+	}
+
+	batchSetCdnDomainConfig := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		batchSetCdnDomainConfigRequest := &alicloudCdnClient.BatchSetCdnDomainConfigRequest{
+			DomainNames: tea.String(domainName),
+			Functions:   tea.String(string(functionsJson)),
+		}
+
+		if _, err := r.client.BatchSetCdnDomainConfigWithOptions(batchSetCdnDomainConfigRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(batchSetCdnDomainConfig)
+}
+
+// Function to delete a config of the domain.
+func (r *cdnDomainResource) deleteCdnDomainConfig(domainName, configId string) error {
+	deleteSpecificConfig := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteSpecificConfigRequest := &alicloudCdnClient.DeleteSpecificConfigRequest{
+			DomainName: tea.String(domainName),
+			ConfigId:   tea.String(configId),
+		}
+
+		if _, err := r.client.DeleteSpecificConfigWithOptions(deleteSpecificConfigRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteSpecificConfig)
+}
+
+// Function to convert the sources into the JSON of AliCloud API.
+func newCdnDomainSources(sources []*cdnDomainSource) (string, error) {
+	sourceConfigs := []*cdnDomainSourceConfig{}
+	for _, source := range sources {
+		sourceConfigs = append(sourceConfigs, &cdnDomainSourceConfig{
+			Type:     source.Type.ValueString(),
+			Content:  source.Content.ValueString(),
+			Port:     source.Port.ValueInt64(),
+			Priority: strconv.FormatInt(source.Priority.ValueInt64(), 10),
+			Weight:   strconv.FormatInt(source.Weight.ValueInt64(), 10),
+		})
+	}
+
+	sourcesJson, err := json.Marshal(sourceConfigs)
+	if err != nil {
+		return "", err
+	}
+	return string(sourcesJson), nil
+}
+
+// Function to build a function of the domain config.
+func newCdnDomainFunction(functionName string, args ...string) *cdnDomainFunction {
+	function := &cdnDomainFunction{
+		FunctionName: functionName,
+		FunctionArgs: []*cdnDomainFunctionArg{},
+	}
+	for i := 0; i+1 < len(args); i += 2 {
+		function.FunctionArgs = append(function.FunctionArgs, &cdnDomainFunctionArg{
+			ArgName:  args[i],
+			ArgValue: args[i+1],
+		})
+	}
+	return function
+}
+
+// Function to convert the boolean into the switch value of AliCloud API.
+func cdnSwitchValue(value bool) string {
+	if value {
+		return "on"
+	}
+	return "off"
+}
+
+// Function to convert the configs of the model into the functions of the
+// domain config grouped by the function name.
+func (m *cdnDomainModel) cdnDomainFunctions() map[string][]*cdnDomainFunction {
+	functions := map[string][]*cdnDomainFunction{}
+	add := func(function *cdnDomainFunction) {
+		functions[function.FunctionName] = append(functions[function.FunctionName], function)
+	}
+
+	for _, rule := range m.CacheTtlRules {
+		ttl := strconv.FormatInt(rule.Ttl.ValueInt64(), 10)
+		weight := strconv.FormatInt(rule.Weight.ValueInt64(), 10)
+		if rule.Type.ValueString() == "path" {
+			add(newCdnDomainFunction(cdnFunctionPathTtl, "path", rule.Value.ValueString(), "ttl", ttl, "weight", weight))
+		} else {
+			add(newCdnDomainFunction(cdnFunctionFileTypeTtl, "file_type", rule.Value.ValueString(), "ttl", ttl, "weight", weight))
+		}
+	}
+
+	if m.Https != nil {
+		if !m.Https.ForceHttps.IsNull() {
+			add(newCdnDomainFunction(cdnFunctionHttpsForce, "enable", cdnSwitchValue(m.Https.ForceHttps.ValueBool())))
+		}
+		if !m.Https.Http2.IsNull() {
+			add(newCdnDomainFunction(cdnFunctionHttpsOption, "http2", cdnSwitchValue(m.Https.Http2.ValueBool())))
+		}
+		if !m.Https.HstsMaxAge.IsNull() {
+			add(newCdnDomainFunction(cdnFunctionHsts, "enabled", "on",
+				"https_hsts_max_age", strconv.FormatInt(m.Https.HstsMaxAge.ValueInt64(), 10)))
+		}
+	}
+
+	if m.Referer != nil && !m.Referer.Type.IsNull() {
+		domains := strings.Join(convertListValueToStrings(m.Referer.Domains), ",")
+		allowEmpty := cdnSwitchValue(m.Referer.AllowEmpty.ValueBool())
+		if m.Referer.Type.ValueString() == "whitelist" {
+			add(newCdnDomainFunction(cdnFunctionRefererAllow, "refer_domain_allow_list", domains, "allow_empty", allowEmpty))
+		} else {
+			add(newCdnDomainFunction(cdnFunctionRefererDeny, "refer_domain_deny_list", domains, "allow_empty", allowEmpty))
+		}
+	}
+
+	if m.IpAcl != nil && !m.IpAcl.Type.IsNull() {
+		ips := strings.Join(convertListValueToStrings(m.IpAcl.Ips), ",")
+		if m.IpAcl.Type.ValueString() == "allow" {
+			add(newCdnDomainFunction(cdnFunctionIpAllow, "ip_list", ips))
+		} else {
+			add(newCdnDomainFunction(cdnFunctionIpDeny, "ip_list", ips))
+		}
+	}
+
+	if !m.RangeOrigin.IsNull() {
+		add(newCdnDomainFunction(cdnFunctionRange, "enable", m.RangeOrigin.ValueString()))
+	}
+
+	if !m.BackToOriginHost.IsNull() {
+		add(newCdnDomainFunction(cdnFunctionOriginHost, "domain_name", m.BackToOriginHost.ValueString()))
+	}
+
+	return functions
+}
+
+// Function to flatten the functions of the domain config into the model. The
+// values of the model are kept null if they are neither set in the state nor
+// configured on AliCloud.
+func (m *cdnDomainModel) flattenCdnDomainFunctions(functions map[string][]map[string]string) (diags diag.Diagnostics) {
+	// Cache TTL rules, keep the order of the state.
+	remoteRules := []*cdnDomainCacheTtlRule{}
+	for _, functionName := range []string{cdnFunctionPathTtl, cdnFunctionFileTypeTtl} {
+		for _, args := range functions[functionName] {
+			ttl, _ := strconv.ParseInt(args["ttl"], 10, 64)
+			weight, _ := strconv.ParseInt(args["weight"], 10, 64)
+			rule := &cdnDomainCacheTtlRule{
+				Type:   types.StringValue("path"),
+				Value:  types.StringValue(args["path"]),
+				Ttl:    types.Int64Value(ttl),
+				Weight: types.Int64Value(weight),
+			}
+			if functionName == cdnFunctionFileTypeTtl {
+				rule.Type = types.StringValue("suffix")
+				rule.Value = types.StringValue(args["file_type"])
+			}
+			remoteRules = append(remoteRules, rule)
+		}
+	}
+	cacheTtlRules := []*cdnDomainCacheTtlRule{}
+	for _, rule := range m.CacheTtlRules {
+		for i, remoteRule := range remoteRules {
+			if remoteRule != nil && rule.Type.Equal(remoteRule.Type) && rule.Value.Equal(remoteRule.Value) {
+				cacheTtlRules = append(cacheTtlRules, remoteRule)
+				remoteRules[i] = nil
+				break
+			}
+		}
+	}
+	for _, remoteRule := range remoteRules {
+		if remoteRule != nil {
+			cacheTtlRules = append(cacheTtlRules, remoteRule)
+		}
+	}
+	if len(cacheTtlRules) > 0 || m.CacheTtlRules != nil {
+		m.CacheTtlRules = cacheTtlRules
+	}
+
+	// HTTPS settings.
+	https := &cdnDomainHttps{
+		ForceHttps: types.BoolNull(),
+		Http2:      types.BoolNull(),
+		HstsMaxAge: types.Int64Null(),
+	}
+	if m.Https != nil {
+		https = m.Https
+	}
+	if args := cdnFirstFunctionArgs(functions, cdnFunctionHttpsForce); args != nil || !https.ForceHttps.IsNull() {
+		https.ForceHttps = types.BoolValue(args["enable"] == "on")
+	}
+	if args := cdnFirstFunctionArgs(functions, cdnFunctionHttpsOption); args != nil || !https.Http2.IsNull() {
+		https.Http2 = types.BoolValue(args["http2"] == "on")
+	}
+	if args := cdnFirstFunctionArgs(functions, cdnFunctionHsts); args != nil && args["enabled"] == "on" {
+		maxAge, _ := strconv.ParseInt(args["https_hsts_max_age"], 10, 64)
+		https.HstsMaxAge = types.Int64Value(maxAge)
+	} else {
+		https.HstsMaxAge = types.Int64Null()
+	}
+	if m.Https != nil || !https.ForceHttps.IsNull() || !https.Http2.IsNull() || !https.HstsMaxAge.IsNull() {
+		m.Https = https
+	}
+
+	// Referer access control.
+	refererType, refererArgs := "whitelist", cdnFirstFunctionArgs(functions, cdnFunctionRefererAllow)
+	refererListArgName := "refer_domain_allow_list"
+	if refererArgs == nil {
+		refererType, refererArgs = "blacklist", cdnFirstFunctionArgs(functions, cdnFunctionRefererDeny)
+		refererListArgName = "refer_domain_deny_list"
+	}
+	if refererArgs != nil {
+		domains, listDiags := types.ListValueFrom(context.Background(), types.StringType, cdnSplitList(refererArgs[refererListArgName]))
+		diags.Append(listDiags...)
+		allowEmpty := types.BoolValue(refererArgs["allow_empty"] == "on")
+		if m.Referer != nil {
+			allowEmpty = boolValueOrNull(m.Referer.AllowEmpty, tea.Bool(refererArgs["allow_empty"] == "on"))
+		}
+		m.Referer = &cdnDomainReferer{
+			Type:       types.StringValue(refererType),
+			Domains:    domains,
+			AllowEmpty: allowEmpty,
+		}
+	} else if m.Referer != nil {
+		m.Referer.Type = types.StringNull()
+	}
+
+	// IP access control.
+	ipAclType, ipAclArgs := "allow", cdnFirstFunctionArgs(functions, cdnFunctionIpAllow)
+	if ipAclArgs == nil {
+		ipAclType, ipAclArgs = "deny", cdnFirstFunctionArgs(functions, cdnFunctionIpDeny)
+	}
+	if ipAclArgs != nil {
+		ips, listDiags := types.ListValueFrom(context.Background(), types.StringType, cdnSplitList(ipAclArgs["ip_list"]))
+		diags.Append(listDiags...)
+		m.IpAcl = &cdnDomainIpAcl{
+			Type: types.StringValue(ipAclType),
+			Ips:  ips,
+		}
+	} else if m.IpAcl != nil {
+		m.IpAcl.Type = types.StringNull()
+	}
+
+	// Range origin and back-to-origin host.
+	if args := cdnFirstFunctionArgs(functions, cdnFunctionRange); args != nil {
+		m.RangeOrigin = types.StringValue(args["enable"])
+	} else {
+		m.RangeOrigin = types.StringNull()
+	}
+	if args := cdnFirstFunctionArgs(functions, cdnFunctionOriginHost); args != nil {
+		m.BackToOriginHost = types.StringValue(args["domain_name"])
+	} else {
+		m.BackToOriginHost = types.StringNull()
+	}
+
+	return diags
+}
+
+// Function to get the arguments of the first config of the function.
+func cdnFirstFunctionArgs(functions map[string][]map[string]string, functionName string) map[string]string {
+	if len(functions[functionName]) == 0 {
+		return nil
+	}
+	return functions[functionName][0]
+}
+
+// Function to split the comma separated list of AliCloud API.
+func cdnSplitList(value string) []string {
+	list := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,382 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCdnClient "github.com/alibabacloud-go/cdn-20180510/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                     = &cdnDomainSslCertificateResource{}
+	_ resource.ResourceWithConfigure        = &cdnDomainSslCertificateResource{}
+	_ resource.ResourceWithConfigValidators = &cdnDomainSslCertificateResource{}
+	_ resource.ResourceWithImportState      = &cdnDomainSslCertificateResource{}
+)
+
+func NewCdnDomainSslCertificateResource() resource.Resource {
+	return &cdnDomainSslCertificateResource{}
+}
+
+type cdnDomainSslCertificateResource struct {
+	client     *alicloudCdnClient.Client
+	namePrefix string
+}
+
+type cdnDomainSslCertificateModel struct {
+	DomainName  types.String `tfsdk:"domain_name"`
+	CasCertName types.String `tfsdk:"cas_cert_name"`
+	CertName    types.String `tfsdk:"cert_name"`
+	CertContent types.String `tfsdk:"cert_content"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	CertType    types.String `tfsdk:"cert_type"`
+	ExpireTime  types.String `tfsdk:"expire_time"`
+}
+
+// Metadata returns the CDN domain SSL certificate resource name.
+func (r *cdnDomainSslCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_domain_ssl_certificate"
+}
+
+// Schema defines the schema for the CDN domain SSL certificate resource.
+func (r *cdnDomainSslCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Bind the SSL certificate to a CDN domain, with either the certificate content or a " +
+			"certificate of Certificate Management Service (CAS). The certificate is rotated by changing " +
+			"the certificate, and HTTPS is disabled when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The accelerated domain name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cas_cert_name": schema.StringAttribute{
+				Description: "The name of the certificate in Certificate Management Service. Conflicts with cert_content.",
+				Optional:    true,
+			},
+			"cert_name": schema.StringAttribute{
+				Description: "The name of the uploaded certificate. Default to the domain name with the upload timestamp, " +
+					"prefixed by the name_prefix of the provider.",
+				Optional: true,
+				Computed: true,
+			},
+			"cert_content": schema.StringAttribute{
+				Description: "The content of the certificate in PEM format. Conflicts with cas_cert_name.",
+				Optional:    true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "The private key of the certificate in PEM format.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"cert_type": schema.StringAttribute{
+				Description: "The type of the certificate bound to the domain, upload or cas.",
+				Computed:    true,
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "The expiration time of the certificate.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *cdnDomainSslCertificateResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cas_cert_name"),
+			path.MatchRoot("cert_content"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("cert_content"),
+			path.MatchRoot("private_key"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cdnDomainSslCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cdnClient
+	r.namePrefix = req.ProviderData.(alicloudClients).namePrefix
+}
+
+// Bind the SSL certificate to the domain.
+func (r *cdnDomainSslCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cdnDomainSslCertificateModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setSslCertificate(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set CDN Domain SSL Certificate.",
+			err.Error(),
+		)
+		return
+	}
+
+	certInfo, err := r.waitSslCertificateDeployed(plan.DomainName.ValueString(), plan.boundCertName())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait CDN Domain SSL Certificate to be Deployed.",
+			err.Error(),
+		)
+		return
+	}
+	plan.setCertInfo(certInfo)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the SSL certificate of the domain.
+func (r *cdnDomainSslCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cdnDomainSslCertificateModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certInfo, err := r.describeSslCertificate(state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe CDN Domain Certificate Info.",
+			err.Error(),
+		)
+		return
+	}
+	if certInfo == nil || tea.StringValue(certInfo.ServerCertificateStatus) != "on" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The certificate is rotated outside of Terraform, or imported.
+	if state.CertType.IsNull() || tea.StringValue(certInfo.CertType) != state.CertType.ValueString() ||
+		tea.StringValue(certInfo.CertName) != state.boundCertName() {
+		if tea.StringValue(certInfo.CertType) == "cas" {
+			state.CasCertName = types.StringValue(tea.StringValue(certInfo.CertName))
+			state.CertContent = types.StringNull()
+		} else {
+			state.CasCertName = types.StringNull()
+			state.CertContent = types.StringValue(tea.StringValue(certInfo.ServerCertificate))
+		}
+	}
+	state.setCertInfo(certInfo)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Rotate the SSL certificate of the domain.
+func (r *cdnDomainSslCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cdnDomainSslCertificateModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the name of the uploaded certificate unless the content is
+	// rotated, which requires a new name.
+	if plan.CertName.IsUnknown() && plan.CertContent.Equal(state.CertContent) {
+		plan.CertName = state.CertName
+	}
+
+	if err := r.setSslCertificate(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set CDN Domain SSL Certificate.",
+			err.Error(),
+		)
+		return
+	}
+
+	certInfo, err := r.waitSslCertificateDeployed(plan.DomainName.ValueString(), plan.boundCertName())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait CDN Domain SSL Certificate to be Deployed.",
+			err.Error(),
+		)
+		return
+	}
+	plan.setCertInfo(certInfo)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable HTTPS of the domain.
+func (r *cdnDomainSslCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cdnDomainSslCertificateModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setDomainServerCertificate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setDomainServerCertificateRequest := &alicloudCdnClient.SetDomainServerCertificateRequest{
+			DomainName:              tea.String(state.DomainName.ValueString()),
+			ServerCertificateStatus: tea.String("off"),
+		}
+
+		if _, err := r.client.SetDomainServerCertificateWithOptions(setDomainServerCertificateRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(setDomainServerCertificate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable CDN Domain SSL Certificate.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cdnDomainSslCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain_name"), req, resp)
+}
+
+// Function to set the SSL certificate of the domain from the model.
+func (r *cdnDomainSslCertificateResource) setSslCertificate(model *cdnDomainSslCertificateModel) error {
+	setDomainServerCertificateRequest := &alicloudCdnClient.SetDomainServerCertificateRequest{
+		DomainName:              tea.String(model.DomainName.ValueString()),
+		ServerCertificateStatus: tea.String("on"),
+	}
+	if !model.CasCertName.IsNull() {
+		setDomainServerCertificateRequest.CertType = tea.String("cas")
+		setDomainServerCertificateRequest.CertName = tea.String(model.CasCertName.ValueString())
+	} else {
+		if model.CertName.IsUnknown() || model.CertName.IsNull() {
+			model.CertName = types.StringValue(fmt.Sprintf("%s%s-%d", r.namePrefix, model.DomainName.ValueString(), time.Now().Unix()))
+		}
+		setDomainServerCertificateRequest.CertType = tea.String("upload")
+		setDomainServerCertificateRequest.CertName = tea.String(model.CertName.ValueString())
+		setDomainServerCertificateRequest.ServerCertificate = tea.String(model.CertContent.ValueString())
+		setDomainServerCertificateRequest.PrivateKey = tea.String(model.PrivateKey.ValueString())
+	}
+
+	setDomainServerCertificate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		if _, err := r.client.SetDomainServerCertificateWithOptions(setDomainServerCertificateRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setDomainServerCertificate)
+}
+
+// Function to describe the SSL certificate of the domain, returns nil if the
+// domain has no certificate.
+func (r *cdnDomainSslCertificateResource) describeSslCertificate(domainName string) (*alicloudCdnClient.DescribeDomainCertificateInfoResponseBodyCertInfosCertInfo, error) {
+	var certInfo *alicloudCdnClient.DescribeDomainCertificateInfoResponseBodyCertInfosCertInfo
+	describeDomainCertificateInfo := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDomainCertificateInfoRequest := &alicloudCdnClient.DescribeDomainCertificateInfoRequest{
+			DomainName: tea.String(domainName),
+		}
+
+		describeDomainCertificateInfoResponse, err := r.client.DescribeDomainCertificateInfoWithOptions(describeDomainCertificateInfoRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				certInfo = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		certInfo = nil
+		certInfos := describeDomainCertificateInfoResponse.Body.CertInfos
+		if certInfos != nil && len(certInfos.CertInfo) > 0 {
+			certInfo = certInfos.CertInfo[0]
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeDomainCertificateInfo)
+	return certInfo, err
+}
+
+// Function to wait until the SSL certificate with the name is enabled on the
+// domain.
+func (r *cdnDomainSslCertificateResource) waitSslCertificateDeployed(domainName, certName string) (*alicloudCdnClient.DescribeDomainCertificateInfoResponseBodyCertInfosCertInfo, error) {
+	var certInfo *alicloudCdnClient.DescribeDomainCertificateInfoResponseBodyCertInfosCertInfo
+	waitSslCertificateDeployed := func() error {
+		var err error
+		certInfo, err = r.describeSslCertificate(domainName)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if certInfo == nil {
+			return fmt.Errorf("SSL certificate of CDN domain %s is not found", domainName)
+		}
+		if tea.StringValue(certInfo.ServerCertificateStatus) != "on" || tea.StringValue(certInfo.CertName) != certName {
+			return fmt.Errorf("SSL certificate %s of CDN domain %s is not enabled yet", certName, domainName)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitSslCertificateDeployed, reconnectBackoff)
+	return certInfo, err
+}
+
+// Function to set the computed attributes from the certificate info.
+func (m *cdnDomainSslCertificateModel) setCertInfo(certInfo *alicloudCdnClient.DescribeDomainCertificateInfoResponseBodyCertInfosCertInfo) {
+	m.CertType = types.StringValue(tea.StringValue(certInfo.CertType))
+	m.ExpireTime = types.StringValue(tea.StringValue(certInfo.CertExpireTime))
+	if tea.StringValue(certInfo.CertType) == "upload" {
+		m.CertName = types.StringValue(tea.StringValue(certInfo.CertName))
+	} else if m.CertName.IsUnknown() {
+		m.CertName = types.StringNull()
+	}
+}
+
+// Function to get the name of the certificate which is bound to the domain.
+func (m *cdnDomainSslCertificateModel) boundCertName() string {
+	if !m.CasCertName.IsNull() {
+		return m.CasCertName.ValueString()
+	}
+	return m.CertName.ValueString()
+}
@@ -0,0 +1,303 @@
+package alicloud
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudfwClient "github.com/alibabacloud-go/cloudfw-20171207/v7/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cloudfwAddressBookResource{}
+	_ resource.ResourceWithConfigure   = &cloudfwAddressBookResource{}
+	_ resource.ResourceWithImportState = &cloudfwAddressBookResource{}
+)
+
+func NewCloudfwAddressBookResource() resource.Resource {
+	return &cloudfwAddressBookResource{}
+}
+
+type cloudfwAddressBookResource struct {
+	client *alicloudCloudfwClient.Client
+}
+
+type cloudfwAddressBookModel struct {
+	GroupUuid   types.String `tfsdk:"group_uuid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Addresses   types.List   `tfsdk:"addresses"`
+}
+
+// Metadata returns the Cloud Firewall address book resource name.
+func (r *cloudfwAddressBookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudfw_address_book"
+}
+
+// Schema defines the schema for the Cloud Firewall address book resource.
+func (r *cloudfwAddressBookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Cloud Firewall address book, e.g. the list of the domains to be allowed or denied " +
+			"by the NAT firewall control policies.",
+		Attributes: map[string]schema.Attribute{
+			"group_uuid": schema.StringAttribute{
+				Description: "The UUID of the address book.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the address book, which is referenced by the control policies.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the address book.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the address book. Valid values: `domain`, `ip`, `port`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "ip", "port"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				Description: "The addresses in the address book, e.g. the domains such as `*.example.com`, the " +
+					"CIDR blocks or the ports.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudfwAddressBookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudfwClient
+}
+
+// Create the address book.
+func (r *cloudfwAddressBookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudfwAddressBookModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addAddressBook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addAddressBookRequest := &alicloudCloudfwClient.AddAddressBookRequest{
+			GroupName:   tea.String(plan.Name.ValueString()),
+			Description: tea.String(plan.Description.ValueString()),
+			GroupType:   tea.String(plan.Type.ValueString()),
+			AddressList: tea.String(strings.Join(convertListValueToStrings(plan.Addresses), ",")),
+		}
+
+		addAddressBookResponse, err := r.client.AddAddressBookWithOptions(addAddressBookRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.GroupUuid = types.StringValue(tea.StringValue(addAddressBookResponse.Body.GroupUuid))
+		return nil
+	}
+
+	if err := retryAPICall(addAddressBook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Address Book.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the address book.
+func (r *cloudfwAddressBookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudfwAddressBookModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addressBook, err := r.describeAddressBook(state.GroupUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Address Book.",
+			err.Error(),
+		)
+		return
+	}
+	if addressBook == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(tea.StringValue(addressBook.GroupName))
+	state.Description = types.StringValue(tea.StringValue(addressBook.Description))
+	state.Type = types.StringValue(tea.StringValue(addressBook.GroupType))
+
+	// Keep the addresses in the same order as the state.
+	addresses := tea.StringSliceValue(addressBook.AddressList)
+	stateAddresses := convertListValueToStrings(state.Addresses)
+	orderedAddresses := convertStringsDifference(stateAddresses, convertStringsDifference(stateAddresses, addresses))
+	orderedAddresses = append(orderedAddresses, convertStringsDifference(addresses, stateAddresses)...)
+	state.Addresses = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(orderedAddresses)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the address book.
+func (r *cloudfwAddressBookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudfwAddressBookModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyAddressBook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyAddressBookRequest := &alicloudCloudfwClient.ModifyAddressBookRequest{
+			GroupUuid:   tea.String(state.GroupUuid.ValueString()),
+			GroupName:   tea.String(plan.Name.ValueString()),
+			Description: tea.String(plan.Description.ValueString()),
+			AddressList: tea.String(strings.Join(convertListValueToStrings(plan.Addresses), ",")),
+		}
+
+		if _, err := r.client.ModifyAddressBookWithOptions(modifyAddressBookRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyAddressBook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Address Book.",
+			err.Error(),
+		)
+		return
+	}
+	plan.GroupUuid = state.GroupUuid
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the address book, it must not be referenced by any control policy.
+func (r *cloudfwAddressBookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudfwAddressBookModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteAddressBook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAddressBookRequest := &alicloudCloudfwClient.DeleteAddressBookRequest{
+			GroupUuid: tea.String(state.GroupUuid.ValueString()),
+		}
+
+		if _, err := r.client.DeleteAddressBookWithOptions(deleteAddressBookRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAddressBook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Address Book.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cloudfwAddressBookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_uuid"), req, resp)
+}
+
+// Function to find the address book by the UUID, returns nil if the address
+// book is not found. The address books can not be filtered by the UUID, all
+// the pages are listed.
+func (r *cloudfwAddressBookResource) describeAddressBook(groupUuid string) (*alicloudCloudfwClient.DescribeAddressBookResponseBodyAcls, error) {
+	currentPage := 1
+	pageSize := 50
+	for {
+		var describeAddressBookResponse *alicloudCloudfwClient.DescribeAddressBookResponse
+		describeAddressBook := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeAddressBookRequest := &alicloudCloudfwClient.DescribeAddressBookRequest{
+				CurrentPage: tea.String(strconv.Itoa(currentPage)),
+				PageSize:    tea.String(strconv.Itoa(pageSize)),
+			}
+
+			var err error
+			describeAddressBookResponse, err = r.client.DescribeAddressBookWithOptions(describeAddressBookRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeAddressBook); err != nil {
+			return nil, err
+		}
+
+		for _, addressBook := range describeAddressBookResponse.Body.Acls {
+			if tea.StringValue(addressBook.GroupUuid) == groupUuid {
+				return addressBook, nil
+			}
+		}
+
+		totalCount, _ := strconv.Atoi(tea.StringValue(describeAddressBookResponse.Body.TotalCount))
+		if currentPage*pageSize >= totalCount {
+			return nil, nil
+		}
+		currentPage++
+	}
+}
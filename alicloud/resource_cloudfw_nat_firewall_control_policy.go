@@ -0,0 +1,493 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudfwClient "github.com/alibabacloud-go/cloudfw-20171207/v7/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The NAT firewall only controls the outbound traffic of the NAT gateway.
+	cloudfwNatFirewallDirection = "out"
+)
+
+// The domain resolve types accepted by the API.
+var cloudfwDomainResolveTypes = map[string]int32{
+	"FQDN":         0,
+	"DNS":          1,
+	"FQDN_AND_DNS": 2,
+}
+
+var (
+	_ resource.Resource                = &cloudfwNatFirewallControlPolicyResource{}
+	_ resource.ResourceWithConfigure   = &cloudfwNatFirewallControlPolicyResource{}
+	_ resource.ResourceWithImportState = &cloudfwNatFirewallControlPolicyResource{}
+)
+
+func NewCloudfwNatFirewallControlPolicyResource() resource.Resource {
+	return &cloudfwNatFirewallControlPolicyResource{}
+}
+
+type cloudfwNatFirewallControlPolicyResource struct {
+	client *alicloudCloudfwClient.Client
+}
+
+type cloudfwNatFirewallControlPolicyModel struct {
+	AclUuid             types.String `tfsdk:"acl_uuid"`
+	NatGatewayId        types.String `tfsdk:"nat_gateway_id"`
+	Description         types.String `tfsdk:"description"`
+	AclAction           types.String `tfsdk:"acl_action"`
+	Source              types.String `tfsdk:"source"`
+	SourceType          types.String `tfsdk:"source_type"`
+	Destination         types.String `tfsdk:"destination"`
+	DestinationType     types.String `tfsdk:"destination_type"`
+	Proto               types.String `tfsdk:"proto"`
+	DestPort            types.String `tfsdk:"dest_port"`
+	DestPortGroup       types.String `tfsdk:"dest_port_group"`
+	DestPortType        types.String `tfsdk:"dest_port_type"`
+	ApplicationNameList types.List   `tfsdk:"application_name_list"`
+	DomainResolveType   types.String `tfsdk:"domain_resolve_type"`
+	Order               types.Int64  `tfsdk:"order"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+}
+
+// Metadata returns the Cloud Firewall NAT firewall control policy resource name.
+func (r *cloudfwNatFirewallControlPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudfw_nat_firewall_control_policy"
+}
+
+// Schema defines the schema for the Cloud Firewall NAT firewall control policy resource.
+func (r *cloudfwNatFirewallControlPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an outbound access control policy of the Cloud Firewall NAT firewall, e.g. allow " +
+			"or deny the egress traffic of a NAT gateway to a domain or an address book of domains.",
+		Attributes: map[string]schema.Attribute{
+			"acl_uuid": schema.StringAttribute{
+				Description: "The UUID of the control policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nat_gateway_id": schema.StringAttribute{
+				Description: "The ID of the NAT gateway protected by the NAT firewall.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the control policy.",
+				Required:    true,
+			},
+			"acl_action": schema.StringAttribute{
+				Description: "The action of the control policy. Valid values: `accept`, `drop`, `log`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "drop", "log"),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The source of the traffic, a CIDR block or the name of an IP address book.",
+				Required:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: "The type of the source. Valid values: `net`, `group`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("net", "group"),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description: "The destination of the traffic, a CIDR block, a domain or the name of an address book.",
+				Required:    true,
+			},
+			"destination_type": schema.StringAttribute{
+				Description: "The type of the destination. Valid values: `net`, `group`, `domain`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("net", "group", "domain"),
+				},
+			},
+			"proto": schema.StringAttribute{
+				Description: "The protocol of the traffic. Valid values: `ANY`, `TCP`, `UDP`, `ICMP`. Default to `ANY`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ANY"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ANY", "TCP", "UDP", "ICMP"),
+				},
+			},
+			"dest_port": schema.StringAttribute{
+				Description: "The destination port range, e.g. `443/443`. Required when `dest_port_type` is `port`.",
+				Optional:    true,
+			},
+			"dest_port_group": schema.StringAttribute{
+				Description: "The name of the port address book. Required when `dest_port_type` is `group`.",
+				Optional:    true,
+			},
+			"dest_port_type": schema.StringAttribute{
+				Description: "The type of the destination port. Valid values: `port`, `group`. Default to `port`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("port"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("port", "group"),
+				},
+			},
+			"application_name_list": schema.ListAttribute{
+				Description: "The applications of the traffic, e.g. `HTTP`, `HTTPS`. Default to `[\"ANY\"]`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.StringType, []attr.Value{types.StringValue("ANY")},
+				)),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"domain_resolve_type": schema.StringAttribute{
+				Description: "How the domains in the destination are matched. Valid values: `FQDN`, `DNS`, " +
+					"`FQDN_AND_DNS`. Default to `FQDN`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("FQDN"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("FQDN", "DNS", "FQDN_AND_DNS"),
+				},
+			},
+			"order": schema.Int64Attribute{
+				Description: "The priority of the control policy, the smaller the value, the higher the priority. " +
+					"Default to the lowest priority.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the control policy is enabled. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudfwNatFirewallControlPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudfwClient
+}
+
+// Create the control policy.
+func (r *cloudfwNatFirewallControlPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudfwNatFirewallControlPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addNatFirewallControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addNatFirewallControlPolicyRequest := &alicloudCloudfwClient.AddNatFirewallControlPolicyRequest{
+			NatGatewayId:        tea.String(plan.NatGatewayId.ValueString()),
+			Direction:           tea.String(cloudfwNatFirewallDirection),
+			Description:         tea.String(plan.Description.ValueString()),
+			AclAction:           tea.String(plan.AclAction.ValueString()),
+			Source:              tea.String(plan.Source.ValueString()),
+			SourceType:          tea.String(plan.SourceType.ValueString()),
+			Destination:         tea.String(plan.Destination.ValueString()),
+			DestinationType:     tea.String(plan.DestinationType.ValueString()),
+			Proto:               tea.String(plan.Proto.ValueString()),
+			DestPort:            ecsStringPointer(plan.DestPort),
+			DestPortGroup:       ecsStringPointer(plan.DestPortGroup),
+			DestPortType:        tea.String(plan.DestPortType.ValueString()),
+			ApplicationNameList: tea.StringSlice(convertListValueToStrings(plan.ApplicationNameList)),
+			DomainResolveType:   tea.Int32(cloudfwDomainResolveTypes[plan.DomainResolveType.ValueString()]),
+			NewOrder:            tea.String("-1"),
+			Release:             tea.String(fmt.Sprint(plan.Enabled.ValueBool())),
+		}
+		if !plan.Order.IsUnknown() && !plan.Order.IsNull() {
+			addNatFirewallControlPolicyRequest.NewOrder = tea.String(fmt.Sprint(plan.Order.ValueInt64()))
+		}
+
+		addNatFirewallControlPolicyResponse, err := r.client.AddNatFirewallControlPolicyWithOptions(addNatFirewallControlPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.AclUuid = types.StringValue(tea.StringValue(addNatFirewallControlPolicyResponse.Body.AclUuid))
+		return nil
+	}
+
+	if err := retryAPICall(addNatFirewallControlPolicy); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add NAT Firewall Control Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The priority is assigned by Cloud Firewall when it is not configured.
+	policy, err := r.describeControlPolicy(plan.NatGatewayId.ValueString(), plan.AclUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe NAT Firewall Control Policy.",
+			err.Error(),
+		)
+		return
+	}
+	if policy != nil {
+		plan.Order = types.Int64Value(int64(tea.Int32Value(policy.Order)))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the control policy.
+func (r *cloudfwNatFirewallControlPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudfwNatFirewallControlPolicyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.describeControlPolicy(state.NatGatewayId.ValueString(), state.AclUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe NAT Firewall Control Policy.",
+			err.Error(),
+		)
+		return
+	}
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Description = types.StringValue(tea.StringValue(policy.Description))
+	state.AclAction = types.StringValue(tea.StringValue(policy.AclAction))
+	state.Source = types.StringValue(tea.StringValue(policy.Source))
+	state.SourceType = types.StringValue(tea.StringValue(policy.SourceType))
+	state.Destination = types.StringValue(tea.StringValue(policy.Destination))
+	state.DestinationType = types.StringValue(tea.StringValue(policy.DestinationType))
+	state.Proto = types.StringValue(tea.StringValue(policy.Proto))
+	state.DestPortType = types.StringValue(tea.StringValue(policy.DestPortType))
+	state.DestPort = ecsStringValue(state.DestPort, policy.DestPort)
+	state.DestPortGroup = ecsStringValue(state.DestPortGroup, policy.DestPortGroup)
+	state.ApplicationNameList = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(policy.ApplicationNameList))
+	for name, value := range cloudfwDomainResolveTypes {
+		if value == tea.Int32Value(policy.DomainResolveType) {
+			state.DomainResolveType = types.StringValue(name)
+		}
+	}
+	state.Order = types.Int64Value(int64(tea.Int32Value(policy.Order)))
+	state.Enabled = types.BoolValue(tea.StringValue(policy.Release) == "true")
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the control policy.
+func (r *cloudfwNatFirewallControlPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudfwNatFirewallControlPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AclUuid = state.AclUuid
+
+	modifyNatFirewallControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyNatFirewallControlPolicyRequest := &alicloudCloudfwClient.ModifyNatFirewallControlPolicyRequest{
+			AclUuid:             tea.String(plan.AclUuid.ValueString()),
+			NatGatewayId:        tea.String(plan.NatGatewayId.ValueString()),
+			Direction:           tea.String(cloudfwNatFirewallDirection),
+			Description:         tea.String(plan.Description.ValueString()),
+			AclAction:           tea.String(plan.AclAction.ValueString()),
+			Source:              tea.String(plan.Source.ValueString()),
+			SourceType:          tea.String(plan.SourceType.ValueString()),
+			Destination:         tea.String(plan.Destination.ValueString()),
+			DestinationType:     tea.String(plan.DestinationType.ValueString()),
+			Proto:               tea.String(plan.Proto.ValueString()),
+			DestPort:            ecsStringPointer(plan.DestPort),
+			DestPortGroup:       ecsStringPointer(plan.DestPortGroup),
+			DestPortType:        tea.String(plan.DestPortType.ValueString()),
+			ApplicationNameList: tea.StringSlice(convertListValueToStrings(plan.ApplicationNameList)),
+			DomainResolveType:   tea.Int32(cloudfwDomainResolveTypes[plan.DomainResolveType.ValueString()]),
+			Release:             tea.String(fmt.Sprint(plan.Enabled.ValueBool())),
+		}
+
+		if _, err := r.client.ModifyNatFirewallControlPolicyWithOptions(modifyNatFirewallControlPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyNatFirewallControlPolicy); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify NAT Firewall Control Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The priority can only be changed with the dedicated API.
+	if !plan.Order.IsUnknown() && !plan.Order.Equal(state.Order) {
+		modifyNatFirewallControlPolicyPosition := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyNatFirewallControlPolicyPositionRequest := &alicloudCloudfwClient.ModifyNatFirewallControlPolicyPositionRequest{
+				AclUuid:      tea.String(plan.AclUuid.ValueString()),
+				NatGatewayId: tea.String(plan.NatGatewayId.ValueString()),
+				Direction:    tea.String(cloudfwNatFirewallDirection),
+				NewOrder:     tea.String(fmt.Sprint(plan.Order.ValueInt64())),
+			}
+
+			if _, err := r.client.ModifyNatFirewallControlPolicyPositionWithOptions(modifyNatFirewallControlPolicyPositionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyNatFirewallControlPolicyPosition); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify NAT Firewall Control Policy Position.",
+				err.Error(),
+			)
+			return
+		}
+	}
+	if plan.Order.IsUnknown() {
+		plan.Order = state.Order
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the control policy.
+func (r *cloudfwNatFirewallControlPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudfwNatFirewallControlPolicyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteNatFirewallControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteNatFirewallControlPolicyRequest := &alicloudCloudfwClient.DeleteNatFirewallControlPolicyRequest{
+			AclUuid:      tea.String(state.AclUuid.ValueString()),
+			NatGatewayId: tea.String(state.NatGatewayId.ValueString()),
+			Direction:    tea.String(cloudfwNatFirewallDirection),
+		}
+
+		if _, err := r.client.DeleteNatFirewallControlPolicyWithOptions(deleteNatFirewallControlPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteNatFirewallControlPolicy); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete NAT Firewall Control Policy.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cloudfwNatFirewallControlPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <nat_gateway_id>:<acl_uuid>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nat_gateway_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acl_uuid"), parts[1])...)
+}
+
+// Function to find the control policy by the UUID, returns nil if the
+// control policy is not found.
+func (r *cloudfwNatFirewallControlPolicyResource) describeControlPolicy(natGatewayId, aclUuid string) (*alicloudCloudfwClient.DescribeNatFirewallControlPolicyResponseBodyPolicys, error) {
+	var describeNatFirewallControlPolicyResponse *alicloudCloudfwClient.DescribeNatFirewallControlPolicyResponse
+	describeNatFirewallControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeNatFirewallControlPolicyRequest := &alicloudCloudfwClient.DescribeNatFirewallControlPolicyRequest{
+			NatGatewayId: tea.String(natGatewayId),
+			Direction:    tea.String(cloudfwNatFirewallDirection),
+			AclUuid:      tea.String(aclUuid),
+			CurrentPage:  tea.String("1"),
+			PageSize:     tea.String("10"),
+		}
+
+		var err error
+		describeNatFirewallControlPolicyResponse, err = r.client.DescribeNatFirewallControlPolicyWithOptions(describeNatFirewallControlPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeNatFirewallControlPolicy); err != nil {
+		return nil, err
+	}
+
+	for _, policy := range describeNatFirewallControlPolicyResponse.Body.Policys {
+		if tea.StringValue(policy.AclUuid) == aclUuid {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,327 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cloudssoAccessAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &cloudssoAccessAssignmentResource{}
+	_ resource.ResourceWithImportState = &cloudssoAccessAssignmentResource{}
+)
+
+func NewCloudssoAccessAssignmentResource() resource.Resource {
+	return &cloudssoAccessAssignmentResource{}
+}
+
+type cloudssoAccessAssignmentResource struct {
+	client        *alicloudCloudssoClient.Client
+	adoptExisting bool
+}
+
+type cloudssoAccessAssignmentModel struct {
+	DirectoryId           types.String `tfsdk:"directory_id"`
+	AccessConfigurationId types.String `tfsdk:"access_configuration_id"`
+	TargetType            types.String `tfsdk:"target_type"`
+	TargetId              types.String `tfsdk:"target_id"`
+	PrincipalType         types.String `tfsdk:"principal_type"`
+	PrincipalId           types.String `tfsdk:"principal_id"`
+}
+
+// Metadata returns the Cloud SSO Access Assignment resource name.
+func (r *cloudssoAccessAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsso_access_assignment"
+}
+
+// Schema defines the schema for the Cloud SSO Access Assignment resource.
+func (r *cloudssoAccessAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assign an access configuration of Cloud SSO to a user or group on a member account of the " +
+			"resource directory. The access configuration is provisioned to the member account automatically, " +
+			"and deprovisioned when the last assignment of it on the member account is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description: "The ID of the directory.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_configuration_id": schema.StringAttribute{
+				Description: "The ID of the access configuration.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of the target, only `RD-Account` is supported. Default to `RD-Account`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("RD-Account"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("RD-Account"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Description: "The ID of the member account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The type of the principal, either `User` or `Group`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("User", "Group"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The ID of the user or group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudssoAccessAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudssoClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the access assignment and wait for the provisioning.
+func (r *cloudssoAccessAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudssoAccessAssignmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taskId string
+	createAccessAssignment := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createAccessAssignmentRequest := &alicloudCloudssoClient.CreateAccessAssignmentRequest{
+			DirectoryId:           tea.String(plan.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(plan.AccessConfigurationId.ValueString()),
+			TargetType:            tea.String(plan.TargetType.ValueString()),
+			TargetId:              tea.String(plan.TargetId.ValueString()),
+			PrincipalType:         tea.String(plan.PrincipalType.ValueString()),
+			PrincipalId:           tea.String(plan.PrincipalId.ValueString()),
+		}
+
+		createAccessAssignmentResponse, err := r.client.CreateAccessAssignmentWithOptions(createAccessAssignmentRequest, runtime)
+		if err != nil {
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+
+		if createAccessAssignmentResponse.Body.TaskStatus != nil {
+			taskId = tea.StringValue(createAccessAssignmentResponse.Body.TaskStatus.TaskId)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createAccessAssignment); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Access Assignment.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := waitCloudssoTask(r.client, plan.DirectoryId.ValueString(), taskId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Access Assignment to be Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the access assignment.
+func (r *cloudssoAccessAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudssoAccessAssignmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found bool
+	listAccessAssignments := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listAccessAssignmentsRequest := &alicloudCloudssoClient.ListAccessAssignmentsRequest{
+			DirectoryId:           tea.String(state.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(state.AccessConfigurationId.ValueString()),
+			TargetType:            tea.String(state.TargetType.ValueString()),
+			TargetId:              tea.String(state.TargetId.ValueString()),
+			PrincipalType:         tea.String(state.PrincipalType.ValueString()),
+			PrincipalId:           tea.String(state.PrincipalId.ValueString()),
+		}
+
+		listAccessAssignmentsResponse, err := r.client.ListAccessAssignmentsWithOptions(listAccessAssignmentsRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				found = false
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		found = len(listAccessAssignmentsResponse.Body.AccessAssignments) > 0
+		return nil
+	}
+
+	if err := retryAPICall(listAccessAssignments); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Access Assignments.",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing as all the attributes require replacement.
+func (r *cloudssoAccessAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *cloudssoAccessAssignmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the access assignment and wait for the deprovisioning.
+func (r *cloudssoAccessAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudssoAccessAssignmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taskId string
+	deleteAccessAssignment := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAccessAssignmentRequest := &alicloudCloudssoClient.DeleteAccessAssignmentRequest{
+			DirectoryId:           tea.String(state.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(state.AccessConfigurationId.ValueString()),
+			TargetType:            tea.String(state.TargetType.ValueString()),
+			TargetId:              tea.String(state.TargetId.ValueString()),
+			PrincipalType:         tea.String(state.PrincipalType.ValueString()),
+			PrincipalId:           tea.String(state.PrincipalId.ValueString()),
+			DeprovisionStrategy:   tea.String("DeprovisionForLastAccessAssignmentOnAccount"),
+		}
+
+		deleteAccessAssignmentResponse, err := r.client.DeleteAccessAssignmentWithOptions(deleteAccessAssignmentRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		if deleteAccessAssignmentResponse.Body.TaskStatus != nil {
+			taskId = tea.StringValue(deleteAccessAssignmentResponse.Body.TaskStatus.TaskId)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAccessAssignment); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Access Assignment.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := waitCloudssoTask(r.client, state.DirectoryId.ValueString(), taskId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Access Assignment to be Deleted.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the access assignment by the ID in the format of
+// <directory_id>:<access_configuration_id>:<target_type>:<target_id>:<principal_type>:<principal_id>.
+func (r *cloudssoAccessAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 6 || ids[0] == "" || ids[1] == "" || ids[2] == "" || ids[3] == "" || ids[4] == "" || ids[5] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: "+
+				"<directory_id>:<access_configuration_id>:<target_type>:<target_id>:<principal_type>:<principal_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_configuration_id"), ids[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_type"), ids[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_id"), ids[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), ids[4])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), ids[5])...)
+}
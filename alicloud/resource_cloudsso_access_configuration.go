@@ -0,0 +1,607 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// The name of the inline policy of the access configuration, Cloud SSO
+// allows only one inline policy per access configuration.
+const cloudssoInlinePolicyName = "InlinePolicy"
+
+var (
+	_ resource.Resource                = &cloudssoAccessConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &cloudssoAccessConfigurationResource{}
+	_ resource.ResourceWithImportState = &cloudssoAccessConfigurationResource{}
+)
+
+func NewCloudssoAccessConfigurationResource() resource.Resource {
+	return &cloudssoAccessConfigurationResource{}
+}
+
+type cloudssoAccessConfigurationResource struct {
+	client *alicloudCloudssoClient.Client
+}
+
+type cloudssoAccessConfigurationModel struct {
+	DirectoryId             types.String `tfsdk:"directory_id"`
+	AccessConfigurationId   types.String `tfsdk:"access_configuration_id"`
+	AccessConfigurationName types.String `tfsdk:"access_configuration_name"`
+	Description             types.String `tfsdk:"description"`
+	SessionDuration         types.Int64  `tfsdk:"session_duration"`
+	RelayState              types.String `tfsdk:"relay_state"`
+	SystemPolicies          types.List   `tfsdk:"system_policies"`
+	InlinePolicyDocument    types.String `tfsdk:"inline_policy_document"`
+}
+
+// Metadata returns the Cloud SSO Access Configuration resource name.
+func (r *cloudssoAccessConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsso_access_configuration"
+}
+
+// Schema defines the schema for the Cloud SSO Access Configuration resource.
+func (r *cloudssoAccessConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an access configuration of Cloud SSO, which defines the permissions of the users and " +
+			"groups assigned to the member accounts. The access configuration is provisioned again to all the " +
+			"member accounts after the policies are changed, so that the changes take effect.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description: "The ID of the directory.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_configuration_id": schema.StringAttribute{
+				Description: "The ID of the access configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_configuration_name": schema.StringAttribute{
+				Description: "The name of the access configuration.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the access configuration.",
+				Optional:    true,
+			},
+			"session_duration": schema.Int64Attribute{
+				Description: "The duration of the session in seconds after the user logs on to the member " +
+					"account, from 900 to 43200. Default to 3600.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"relay_state": schema.StringAttribute{
+				Description: "The URL of the console page that the user is redirected to after logging on to " +
+					"the member account.",
+				Optional: true,
+			},
+			"system_policies": schema.ListAttribute{
+				Description: "The names of the system policies attached to the access configuration, e.g. " +
+					"`AliyunECSReadOnlyAccess`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"inline_policy_document": schema.StringAttribute{
+				Description: "The document of the inline policy attached to the access configuration in JSON.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudssoAccessConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudssoClient
+}
+
+// Create a new access configuration and attach the policies.
+func (r *cloudssoAccessConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudssoAccessConfigurationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createAccessConfiguration := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createAccessConfigurationRequest := &alicloudCloudssoClient.CreateAccessConfigurationRequest{
+			DirectoryId:             tea.String(plan.DirectoryId.ValueString()),
+			AccessConfigurationName: tea.String(plan.AccessConfigurationName.ValueString()),
+			Description:             stringPointerOrNil(plan.Description),
+			SessionDuration:         tea.Int32(int32(plan.SessionDuration.ValueInt64())),
+			RelayState:              stringPointerOrNil(plan.RelayState),
+		}
+
+		createAccessConfigurationResponse, err := r.client.CreateAccessConfigurationWithOptions(createAccessConfigurationRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.AccessConfigurationId = types.StringValue(tea.StringValue(createAccessConfigurationResponse.Body.AccessConfiguration.AccessConfigurationId))
+		return nil
+	}
+
+	if err := retryAPICall(createAccessConfiguration); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Access Configuration.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updatePermissionPolicies(plan, nil); err != nil {
+		// Save the access configuration into the state, so that it is not
+		// leaked and the policies are attached again in the next apply.
+		plan.SystemPolicies = types.ListNull(types.StringType)
+		plan.InlinePolicyDocument = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Permission Policy to Access Configuration.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the access configuration and its policies.
+func (r *cloudssoAccessConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudssoAccessConfigurationModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accessConfiguration *alicloudCloudssoClient.GetAccessConfigurationResponseBodyAccessConfiguration
+	getAccessConfiguration := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getAccessConfigurationRequest := &alicloudCloudssoClient.GetAccessConfigurationRequest{
+			DirectoryId:           tea.String(state.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(state.AccessConfigurationId.ValueString()),
+		}
+
+		getAccessConfigurationResponse, err := r.client.GetAccessConfigurationWithOptions(getAccessConfigurationRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				accessConfiguration = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		accessConfiguration = getAccessConfigurationResponse.Body.AccessConfiguration
+		return nil
+	}
+
+	if err := retryAPICall(getAccessConfiguration); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Access Configuration.",
+			err.Error(),
+		)
+		return
+	}
+	if accessConfiguration == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.AccessConfigurationName = types.StringValue(tea.StringValue(accessConfiguration.AccessConfigurationName))
+	state.Description = stringValueOrNull(state.Description, accessConfiguration.Description)
+	state.SessionDuration = types.Int64Value(int64(tea.Int32Value(accessConfiguration.SessionDuration)))
+	state.RelayState = stringValueOrNull(state.RelayState, accessConfiguration.RelayState)
+
+	policies, err := r.listPermissionPolicies(state.DirectoryId.ValueString(), state.AccessConfigurationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Permission Policies in Access Configuration.",
+			err.Error(),
+		)
+		return
+	}
+
+	var systemPolicies []string
+	inlinePolicyDocument := ""
+	for _, policy := range policies {
+		switch tea.StringValue(policy.PermissionPolicyType) {
+		case "System":
+			systemPolicies = append(systemPolicies, tea.StringValue(policy.PermissionPolicyName))
+		case "Inline":
+			inlinePolicyDocument = tea.StringValue(policy.PermissionPolicyDocument)
+		}
+	}
+
+	if !(state.SystemPolicies.IsNull() && len(systemPolicies) == 0) {
+		// Keep the order of the configured policies.
+		statePolicies := convertListValueToStrings(state.SystemPolicies)
+		systemPolicies = append(convertStringsDifference(statePolicies, convertStringsDifference(statePolicies, systemPolicies)),
+			convertStringsDifference(systemPolicies, statePolicies)...)
+		state.SystemPolicies = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(systemPolicies)))
+	}
+
+	switch {
+	case inlinePolicyDocument == "":
+		state.InlinePolicyDocument = types.StringNull()
+	case state.InlinePolicyDocument.IsNull() || !isJsonStringEqual(state.InlinePolicyDocument.ValueString(), inlinePolicyDocument):
+		state.InlinePolicyDocument = types.StringValue(inlinePolicyDocument)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the access configuration and its policies, then provision it again
+// to the member accounts if the policies are changed.
+func (r *cloudssoAccessConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudssoAccessConfigurationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AccessConfigurationId = state.AccessConfigurationId
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.SessionDuration.Equal(state.SessionDuration) ||
+		!plan.RelayState.Equal(state.RelayState) {
+		updateAccessConfiguration := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateAccessConfigurationRequest := &alicloudCloudssoClient.UpdateAccessConfigurationRequest{
+				DirectoryId:           tea.String(state.DirectoryId.ValueString()),
+				AccessConfigurationId: tea.String(state.AccessConfigurationId.ValueString()),
+				NewDescription:        tea.String(plan.Description.ValueString()),
+				NewSessionDuration:    tea.Int32(int32(plan.SessionDuration.ValueInt64())),
+				NewRelayState:         tea.String(plan.RelayState.ValueString()),
+			}
+
+			if _, err := r.client.UpdateAccessConfigurationWithOptions(updateAccessConfigurationRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateAccessConfiguration); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Access Configuration.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.SystemPolicies.Equal(state.SystemPolicies) ||
+		plan.InlinePolicyDocument.IsNull() != state.InlinePolicyDocument.IsNull() ||
+		!isJsonStringEqual(plan.InlinePolicyDocument.ValueString(), state.InlinePolicyDocument.ValueString()) {
+		policies, err := r.listPermissionPolicies(state.DirectoryId.ValueString(), state.AccessConfigurationId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Permission Policies in Access Configuration.",
+				err.Error(),
+			)
+			return
+		}
+
+		if err := r.updatePermissionPolicies(plan, policies); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Permission Policies in Access Configuration.",
+				err.Error(),
+			)
+			return
+		}
+
+		if err := r.reprovisionAccessConfiguration(state.DirectoryId.ValueString(), state.AccessConfigurationId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Provision Access Configuration.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the access configuration with its policies. The access
+// configuration must be removed from all the access assignments before it is
+// deleted.
+func (r *cloudssoAccessConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudssoAccessConfigurationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteAccessConfiguration := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAccessConfigurationRequest := &alicloudCloudssoClient.DeleteAccessConfigurationRequest{
+			DirectoryId:                   tea.String(state.DirectoryId.ValueString()),
+			AccessConfigurationId:         tea.String(state.AccessConfigurationId.ValueString()),
+			ForceRemovePermissionPolicies: tea.Bool(true),
+		}
+
+		if _, err := r.client.DeleteAccessConfigurationWithOptions(deleteAccessConfigurationRequest, runtime); err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAccessConfiguration); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Access Configuration.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the access configuration by the ID in the format of
+// <directory_id>:<access_configuration_id>.
+func (r *cloudssoAccessConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <directory_id>:<access_configuration_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_configuration_id"), ids[1])...)
+}
+
+// Function to list the policies attached to the access configuration.
+func (r *cloudssoAccessConfigurationResource) listPermissionPolicies(directoryId, accessConfigurationId string) ([]*alicloudCloudssoClient.ListPermissionPoliciesInAccessConfigurationResponseBodyPermissionPolicies, error) {
+	var policies []*alicloudCloudssoClient.ListPermissionPoliciesInAccessConfigurationResponseBodyPermissionPolicies
+	listPermissionPolicies := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listPermissionPoliciesRequest := &alicloudCloudssoClient.ListPermissionPoliciesInAccessConfigurationRequest{
+			DirectoryId:           tea.String(directoryId),
+			AccessConfigurationId: tea.String(accessConfigurationId),
+		}
+
+		listPermissionPoliciesResponse, err := r.client.ListPermissionPoliciesInAccessConfigurationWithOptions(listPermissionPoliciesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		policies = listPermissionPoliciesResponse.Body.PermissionPolicies
+		return nil
+	}
+
+	if err := retryAPICall(listPermissionPolicies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Function to attach the policies of the plan to the access configuration and
+// detach the attached policies which are not in the plan.
+func (r *cloudssoAccessConfigurationResource) updatePermissionPolicies(plan *cloudssoAccessConfigurationModel, attached []*alicloudCloudssoClient.ListPermissionPoliciesInAccessConfigurationResponseBodyPermissionPolicies) error {
+	var attachedSystemPolicies []string
+	var attachedInlinePolicy *alicloudCloudssoClient.ListPermissionPoliciesInAccessConfigurationResponseBodyPermissionPolicies
+	for _, policy := range attached {
+		switch tea.StringValue(policy.PermissionPolicyType) {
+		case "System":
+			attachedSystemPolicies = append(attachedSystemPolicies, tea.StringValue(policy.PermissionPolicyName))
+		case "Inline":
+			attachedInlinePolicy = policy
+		}
+	}
+
+	planSystemPolicies := convertListValueToStrings(plan.SystemPolicies)
+	for _, policyName := range convertStringsDifference(attachedSystemPolicies, planSystemPolicies) {
+		if err := r.removePermissionPolicy(plan, "System", policyName); err != nil {
+			return err
+		}
+	}
+
+	// The inline policy is replaced as the document can not be updated.
+	inlinePolicyChanged := attachedInlinePolicy == nil ||
+		!isJsonStringEqual(tea.StringValue(attachedInlinePolicy.PermissionPolicyDocument), plan.InlinePolicyDocument.ValueString())
+	if attachedInlinePolicy != nil && (plan.InlinePolicyDocument.IsNull() || inlinePolicyChanged) {
+		if err := r.removePermissionPolicy(plan, "Inline", tea.StringValue(attachedInlinePolicy.PermissionPolicyName)); err != nil {
+			return err
+		}
+	}
+
+	for _, policyName := range convertStringsDifference(planSystemPolicies, attachedSystemPolicies) {
+		if err := r.addPermissionPolicy(plan, "System", policyName, nil); err != nil {
+			return err
+		}
+	}
+
+	if !plan.InlinePolicyDocument.IsNull() && inlinePolicyChanged {
+		if err := r.addPermissionPolicy(plan, "Inline", cloudssoInlinePolicyName, tea.String(plan.InlinePolicyDocument.ValueString())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *cloudssoAccessConfigurationResource) addPermissionPolicy(plan *cloudssoAccessConfigurationModel, policyType, policyName string, policyDocument *string) error {
+	addPermissionPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addPermissionPolicyRequest := &alicloudCloudssoClient.AddPermissionPolicyToAccessConfigurationRequest{
+			DirectoryId:           tea.String(plan.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(plan.AccessConfigurationId.ValueString()),
+			PermissionPolicyType:  tea.String(policyType),
+			PermissionPolicyName:  tea.String(policyName),
+			InlinePolicyDocument:  policyDocument,
+		}
+
+		if _, err := r.client.AddPermissionPolicyToAccessConfigurationWithOptions(addPermissionPolicyRequest, runtime); err != nil {
+			if isAlreadyExistsError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(addPermissionPolicy); err != nil {
+		return fmt.Errorf("policy %s: %w", policyName, err)
+	}
+	return nil
+}
+
+func (r *cloudssoAccessConfigurationResource) removePermissionPolicy(plan *cloudssoAccessConfigurationModel, policyType, policyName string) error {
+	removePermissionPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		removePermissionPolicyRequest := &alicloudCloudssoClient.RemovePermissionPolicyFromAccessConfigurationRequest{
+			DirectoryId:           tea.String(plan.DirectoryId.ValueString()),
+			AccessConfigurationId: tea.String(plan.AccessConfigurationId.ValueString()),
+			PermissionPolicyType:  tea.String(policyType),
+			PermissionPolicyName:  tea.String(policyName),
+		}
+
+		if _, err := r.client.RemovePermissionPolicyFromAccessConfigurationWithOptions(removePermissionPolicyRequest, runtime); err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(removePermissionPolicy); err != nil {
+		return fmt.Errorf("policy %s: %w", policyName, err)
+	}
+	return nil
+}
+
+// Function to provision the access configuration again to all the member
+// accounts it is provisioned to, and wait for the provisioning tasks.
+func (r *cloudssoAccessConfigurationResource) reprovisionAccessConfiguration(directoryId, accessConfigurationId string) error {
+	var provisionings []*alicloudCloudssoClient.ListAccessConfigurationProvisioningsResponseBodyAccessConfigurationProvisionings
+	var nextToken *string
+	for {
+		var listProvisioningsResponse *alicloudCloudssoClient.ListAccessConfigurationProvisioningsResponse
+		listProvisionings := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listProvisioningsRequest := &alicloudCloudssoClient.ListAccessConfigurationProvisioningsRequest{
+				DirectoryId:           tea.String(directoryId),
+				AccessConfigurationId: tea.String(accessConfigurationId),
+				MaxResults:            tea.Int32(100),
+				NextToken:             nextToken,
+			}
+
+			var err error
+			listProvisioningsResponse, err = r.client.ListAccessConfigurationProvisioningsWithOptions(listProvisioningsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listProvisionings); err != nil {
+			return err
+		}
+
+		provisionings = append(provisionings, listProvisioningsResponse.Body.AccessConfigurationProvisionings...)
+		if !tea.BoolValue(listProvisioningsResponse.Body.IsTruncated) {
+			break
+		}
+		nextToken = listProvisioningsResponse.Body.NextToken
+	}
+
+	for _, provisioning := range provisionings {
+		var tasks []*alicloudCloudssoClient.ProvisionAccessConfigurationResponseBodyTasks
+		provisionAccessConfiguration := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			provisionAccessConfigurationRequest := &alicloudCloudssoClient.ProvisionAccessConfigurationRequest{
+				DirectoryId:           tea.String(directoryId),
+				AccessConfigurationId: tea.String(accessConfigurationId),
+				TargetType:            provisioning.TargetType,
+				TargetId:              provisioning.TargetId,
+			}
+
+			provisionAccessConfigurationResponse, err := r.client.ProvisionAccessConfigurationWithOptions(provisionAccessConfigurationRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			tasks = provisionAccessConfigurationResponse.Body.Tasks
+			return nil
+		}
+
+		if err := retryAPICall(provisionAccessConfiguration); err != nil {
+			return fmt.Errorf("target %s: %w", tea.StringValue(provisioning.TargetId), err)
+		}
+
+		for _, task := range tasks {
+			if err := waitCloudssoTask(r.client, directoryId, tea.StringValue(task.TaskId)); err != nil {
+				return fmt.Errorf("target %s: %w", tea.StringValue(provisioning.TargetId), err)
+			}
+		}
+	}
+	return nil
+}
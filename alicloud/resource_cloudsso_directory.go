@@ -0,0 +1,346 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cloudssoDirectoryResource{}
+	_ resource.ResourceWithConfigure   = &cloudssoDirectoryResource{}
+	_ resource.ResourceWithImportState = &cloudssoDirectoryResource{}
+)
+
+func NewCloudssoDirectoryResource() resource.Resource {
+	return &cloudssoDirectoryResource{}
+}
+
+type cloudssoDirectoryResource struct {
+	client *alicloudCloudssoClient.Client
+}
+
+type cloudssoDirectoryModel struct {
+	Id            types.String `tfsdk:"id"`
+	DirectoryName types.String `tfsdk:"directory_name"`
+	Region        types.String `tfsdk:"region"`
+}
+
+// Metadata returns the Cloud SSO Directory resource name.
+func (r *cloudssoDirectoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsso_directory"
+}
+
+// Schema defines the schema for the Cloud SSO Directory resource.
+func (r *cloudssoDirectoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the directory of Cloud SSO, which holds the users, groups and access configurations " +
+			"of the single sign-on to the member accounts of the resource directory. Cloud SSO must be enabled " +
+			"in the management account, and only one directory is allowed per resource directory. The directory " +
+			"can only be deleted after all the users, groups and access configurations in it are deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the directory.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory_name": schema.StringAttribute{
+				Description: "The name of the directory, which is used in the user portal URL. The name must be " +
+					"2 to 64 characters, contains only lowercase letters, digits and hyphens, and can not start or " +
+					"end with a hyphen.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+						"must contain only lowercase letters, digits and hyphens, and can not start or end with a hyphen"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The region of the directory.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudssoDirectoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudssoClient
+}
+
+// Create a new directory.
+func (r *cloudssoDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudssoDirectoryModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createDirectory := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createDirectoryRequest := &alicloudCloudssoClient.CreateDirectoryRequest{
+			DirectoryName: stringPointerOrNil(plan.DirectoryName),
+		}
+
+		createDirectoryResponse, err := r.client.CreateDirectoryWithOptions(createDirectoryRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.Id = types.StringValue(tea.StringValue(createDirectoryResponse.Body.Directory.DirectoryId))
+		return nil
+	}
+
+	if err := retryAPICall(createDirectory); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Directory.",
+			err.Error(),
+		)
+		return
+	}
+
+	directory, err := r.getDirectory(plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Directory.",
+			err.Error(),
+		)
+		return
+	}
+	if directory != nil {
+		plan.DirectoryName = types.StringValue(tea.StringValue(directory.DirectoryName))
+		plan.Region = types.StringValue(tea.StringValue(directory.Region))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the directory.
+func (r *cloudssoDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudssoDirectoryModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directory, err := r.getDirectory(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Directory.",
+			err.Error(),
+		)
+		return
+	}
+	if directory == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.DirectoryName = types.StringValue(tea.StringValue(directory.DirectoryName))
+	state.Region = types.StringValue(tea.StringValue(directory.Region))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name of the directory.
+func (r *cloudssoDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudssoDirectoryModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DirectoryName.IsUnknown() && !plan.DirectoryName.Equal(state.DirectoryName) {
+		setDirectoryName := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			setDirectoryNameRequest := &alicloudCloudssoClient.SetDirectoryNameRequest{
+				DirectoryId:      tea.String(state.Id.ValueString()),
+				NewDirectoryName: tea.String(plan.DirectoryName.ValueString()),
+			}
+
+			if _, err := r.client.SetDirectoryNameWithOptions(setDirectoryNameRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(setDirectoryName); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Set Directory Name.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	plan.Region = state.Region
+	if plan.DirectoryName.IsUnknown() {
+		plan.DirectoryName = state.DirectoryName
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the directory.
+func (r *cloudssoDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudssoDirectoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteDirectory := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDirectoryRequest := &alicloudCloudssoClient.DeleteDirectoryRequest{
+			DirectoryId: tea.String(state.Id.ValueString()),
+		}
+
+		if _, err := r.client.DeleteDirectoryWithOptions(deleteDirectoryRequest, runtime); err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteDirectory); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Directory.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cloudssoDirectoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Function to get the directory, returns nil if the directory is not found.
+func (r *cloudssoDirectoryResource) getDirectory(directoryId string) (*alicloudCloudssoClient.GetDirectoryResponseBodyDirectory, error) {
+	var directory *alicloudCloudssoClient.GetDirectoryResponseBodyDirectory
+	getDirectory := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getDirectoryRequest := &alicloudCloudssoClient.GetDirectoryRequest{
+			DirectoryId: tea.String(directoryId),
+		}
+
+		getDirectoryResponse, err := r.client.GetDirectoryWithOptions(getDirectoryRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				directory = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		directory = getDirectoryResponse.Body.Directory
+		return nil
+	}
+
+	if err := retryAPICall(getDirectory); err != nil {
+		return nil, err
+	}
+	return directory, nil
+}
+
+// Function to check whether the error is caused by a Cloud SSO entity not
+// found, e.g. EntityNotExists.User and EntityNotExists.Group.
+func isCloudssoNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.HasPrefix(tea.StringValue(_t.Code), "EntityNotExists")
+	}
+	return false
+}
+
+// Function to wait for an asynchronous task of Cloud SSO, e.g. the access
+// assignment and the provisioning of the access configuration.
+func waitCloudssoTask(client *alicloudCloudssoClient.Client, directoryId, taskId string) error {
+	if taskId == "" {
+		return nil
+	}
+
+	waitForTask := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getTaskStatusRequest := &alicloudCloudssoClient.GetTaskStatusRequest{
+			DirectoryId: tea.String(directoryId),
+			TaskId:      tea.String(taskId),
+		}
+
+		getTaskStatusResponse, err := client.GetTaskStatusWithOptions(getTaskStatusRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		taskStatus := getTaskStatusResponse.Body.TaskStatus
+		switch tea.StringValue(taskStatus.Status) {
+		case "Success":
+			return nil
+		case "Failed":
+			return backoff.Permanent(fmt.Errorf("task %s failed: %s", taskId,
+				tea.StringValue(taskStatus.FailureReason)))
+		default:
+			return fmt.Errorf("task %s is still %s", taskId, tea.StringValue(taskStatus.Status))
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitForTask, waitBackoff)
+}
@@ -0,0 +1,458 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cloudssoGroupResource{}
+	_ resource.ResourceWithConfigure   = &cloudssoGroupResource{}
+	_ resource.ResourceWithImportState = &cloudssoGroupResource{}
+)
+
+func NewCloudssoGroupResource() resource.Resource {
+	return &cloudssoGroupResource{}
+}
+
+type cloudssoGroupResource struct {
+	client *alicloudCloudssoClient.Client
+}
+
+type cloudssoGroupModel struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+	GroupId     types.String `tfsdk:"group_id"`
+	GroupName   types.String `tfsdk:"group_name"`
+	Description types.String `tfsdk:"description"`
+	UserIds     types.List   `tfsdk:"user_ids"`
+}
+
+// Metadata returns the Cloud SSO Group resource name.
+func (r *cloudssoGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsso_group"
+}
+
+// Schema defines the schema for the Cloud SSO Group resource.
+func (r *cloudssoGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a group and optionally its members in the directory of Cloud SSO.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description: "The ID of the directory.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				Description: "The name of the group.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the group.",
+				Optional:    true,
+			},
+			"user_ids": schema.ListAttribute{
+				Description: "The IDs of the users in the group. The members of the group are not managed if " +
+					"this is not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudssoGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudssoClient
+}
+
+// Create a new group and add the users into it.
+func (r *cloudssoGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudssoGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createGroupRequest := &alicloudCloudssoClient.CreateGroupRequest{
+			DirectoryId: tea.String(plan.DirectoryId.ValueString()),
+			GroupName:   tea.String(plan.GroupName.ValueString()),
+			Description: stringPointerOrNil(plan.Description),
+		}
+
+		createGroupResponse, err := r.client.CreateGroupWithOptions(createGroupRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.GroupId = types.StringValue(tea.StringValue(createGroupResponse.Body.Group.GroupId))
+		return nil
+	}
+
+	if err := retryAPICall(createGroup); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the group into the state first, so that the group is not leaked if
+	// the users fail to be added.
+	userIds := plan.UserIds
+	plan.UserIds = types.ListNull(types.StringType)
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateGroupMembers(plan.DirectoryId.ValueString(), plan.GroupId.ValueString(), convertListValueToStrings(userIds), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add User to Group.",
+			err.Error(),
+		)
+		return
+	}
+	plan.UserIds = userIds
+
+	setStateDiags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the group and its members.
+func (r *cloudssoGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudssoGroupModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group *alicloudCloudssoClient.GetGroupResponseBodyGroup
+	getGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getGroupRequest := &alicloudCloudssoClient.GetGroupRequest{
+			DirectoryId: tea.String(state.DirectoryId.ValueString()),
+			GroupId:     tea.String(state.GroupId.ValueString()),
+		}
+
+		getGroupResponse, err := r.client.GetGroupWithOptions(getGroupRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				group = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		group = getGroupResponse.Body.Group
+		return nil
+	}
+
+	if err := retryAPICall(getGroup); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Group.",
+			err.Error(),
+		)
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.GroupName = types.StringValue(tea.StringValue(group.GroupName))
+	state.Description = stringValueOrNull(state.Description, group.Description)
+
+	if !state.UserIds.IsNull() {
+		userIds, err := r.listGroupMembers(state.DirectoryId.ValueString(), state.GroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Users in Group.",
+				err.Error(),
+			)
+			return
+		}
+
+		// Keep the order of the configured users.
+		stateUserIds := convertListValueToStrings(state.UserIds)
+		userIds = append(convertStringsDifference(stateUserIds, convertStringsDifference(stateUserIds, userIds)),
+			convertStringsDifference(userIds, stateUserIds)...)
+		state.UserIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(userIds)))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the group and its members.
+func (r *cloudssoGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudssoGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.GroupName.Equal(state.GroupName) || !plan.Description.Equal(state.Description) {
+		updateGroup := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateGroupRequest := &alicloudCloudssoClient.UpdateGroupRequest{
+				DirectoryId:    tea.String(state.DirectoryId.ValueString()),
+				GroupId:        tea.String(state.GroupId.ValueString()),
+				NewGroupName:   tea.String(plan.GroupName.ValueString()),
+				NewDescription: tea.String(plan.Description.ValueString()),
+			}
+
+			if _, err := r.client.UpdateGroupWithOptions(updateGroupRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateGroup); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Group.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Removing user_ids stops managing the members instead of removing all
+	// of them from the group.
+	if !plan.UserIds.IsNull() {
+		planUserIds := convertListValueToStrings(plan.UserIds)
+		stateUserIds := convertListValueToStrings(state.UserIds)
+		if state.UserIds.IsNull() {
+			var err error
+			stateUserIds, err = r.listGroupMembers(state.DirectoryId.ValueString(), state.GroupId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to List Users in Group.",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if err := r.updateGroupMembers(state.DirectoryId.ValueString(), state.GroupId.ValueString(),
+			convertStringsDifference(planUserIds, stateUserIds), convertStringsDifference(stateUserIds, planUserIds)); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Users in Group.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.GroupId = state.GroupId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the group after removing the managed users from it. The group must
+// be removed from all the access assignments before it is deleted.
+func (r *cloudssoGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudssoGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateGroupMembers(state.DirectoryId.ValueString(), state.GroupId.ValueString(), nil, convertListValueToStrings(state.UserIds)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Remove User from Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteGroupRequest := &alicloudCloudssoClient.DeleteGroupRequest{
+			DirectoryId: tea.String(state.DirectoryId.ValueString()),
+			GroupId:     tea.String(state.GroupId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteGroupWithOptions(deleteGroupRequest, runtime); err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteGroup); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Group.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the group by the ID in the format of
+// <directory_id>:<group_id>.
+func (r *cloudssoGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <directory_id>:<group_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), ids[1])...)
+}
+
+// Function to list the IDs of the users in the group.
+func (r *cloudssoGroupResource) listGroupMembers(directoryId, groupId string) ([]string, error) {
+	var userIds []string
+	var nextToken *string
+	for {
+		var listGroupMembersResponse *alicloudCloudssoClient.ListGroupMembersResponse
+		listGroupMembers := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listGroupMembersRequest := &alicloudCloudssoClient.ListGroupMembersRequest{
+				DirectoryId: tea.String(directoryId),
+				GroupId:     tea.String(groupId),
+				MaxResults:  tea.Int32(100),
+				NextToken:   nextToken,
+			}
+
+			var err error
+			listGroupMembersResponse, err = r.client.ListGroupMembersWithOptions(listGroupMembersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listGroupMembers); err != nil {
+			return nil, err
+		}
+
+		for _, member := range listGroupMembersResponse.Body.GroupMembers {
+			userIds = append(userIds, tea.StringValue(member.UserId))
+		}
+
+		if !tea.BoolValue(listGroupMembersResponse.Body.IsTruncated) {
+			break
+		}
+		nextToken = listGroupMembersResponse.Body.NextToken
+	}
+	return userIds, nil
+}
+
+// Function to add the users into and remove the users from the group.
+func (r *cloudssoGroupResource) updateGroupMembers(directoryId, groupId string, toAdd, toRemove []string) error {
+	for _, userId := range toAdd {
+		addUserToGroup := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			addUserToGroupRequest := &alicloudCloudssoClient.AddUserToGroupRequest{
+				DirectoryId: tea.String(directoryId),
+				GroupId:     tea.String(groupId),
+				UserId:      tea.String(userId),
+			}
+
+			if _, err := r.client.AddUserToGroupWithOptions(addUserToGroupRequest, runtime); err != nil {
+				if isAlreadyExistsError(err) {
+					return nil
+				}
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(addUserToGroup); err != nil {
+			return fmt.Errorf("user %s: %w", userId, err)
+		}
+	}
+
+	for _, userId := range toRemove {
+		removeUserFromGroup := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			removeUserFromGroupRequest := &alicloudCloudssoClient.RemoveUserFromGroupRequest{
+				DirectoryId: tea.String(directoryId),
+				GroupId:     tea.String(groupId),
+				UserId:      tea.String(userId),
+			}
+
+			if _, err := r.client.RemoveUserFromGroupWithOptions(removeUserFromGroupRequest, runtime); err != nil {
+				if isCloudssoNotFound(err) {
+					return nil
+				}
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(removeUserFromGroup); err != nil {
+			return fmt.Errorf("user %s: %w", userId, err)
+		}
+	}
+	return nil
+}
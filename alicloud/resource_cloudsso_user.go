@@ -0,0 +1,363 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCloudssoClient "github.com/alibabacloud-go/cloudsso-20210515/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cloudssoUserResource{}
+	_ resource.ResourceWithConfigure   = &cloudssoUserResource{}
+	_ resource.ResourceWithImportState = &cloudssoUserResource{}
+)
+
+func NewCloudssoUserResource() resource.Resource {
+	return &cloudssoUserResource{}
+}
+
+type cloudssoUserResource struct {
+	client *alicloudCloudssoClient.Client
+}
+
+type cloudssoUserModel struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+	UserId      types.String `tfsdk:"user_id"`
+	UserName    types.String `tfsdk:"user_name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Email       types.String `tfsdk:"email"`
+	FirstName   types.String `tfsdk:"first_name"`
+	LastName    types.String `tfsdk:"last_name"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// Metadata returns the Cloud SSO User resource name.
+func (r *cloudssoUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsso_user"
+}
+
+// Schema defines the schema for the Cloud SSO User resource.
+func (r *cloudssoUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a user in the directory of Cloud SSO.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description: "The ID of the directory.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description: "The name of the user, which is used to log on to the user portal.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the user.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user.",
+				Optional:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The first name of the user.",
+				Optional:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "The last name of the user.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the user.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the user, either `Enabled` or `Disabled`. Default to `Enabled`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Enabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Disabled"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudssoUserResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cloudssoClient
+}
+
+// Create a new user.
+func (r *cloudssoUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cloudssoUserModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createUser := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createUserRequest := &alicloudCloudssoClient.CreateUserRequest{
+			DirectoryId: tea.String(plan.DirectoryId.ValueString()),
+			UserName:    tea.String(plan.UserName.ValueString()),
+			DisplayName: stringPointerOrNil(plan.DisplayName),
+			Email:       stringPointerOrNil(plan.Email),
+			FirstName:   stringPointerOrNil(plan.FirstName),
+			LastName:    stringPointerOrNil(plan.LastName),
+			Description: stringPointerOrNil(plan.Description),
+			Status:      tea.String(plan.Status.ValueString()),
+		}
+
+		createUserResponse, err := r.client.CreateUserWithOptions(createUserRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.UserId = types.StringValue(tea.StringValue(createUserResponse.Body.User.UserId))
+		return nil
+	}
+
+	if err := retryAPICall(createUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create User.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the user.
+func (r *cloudssoUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cloudssoUserModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *alicloudCloudssoClient.GetUserResponseBodyUser
+	getUser := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getUserRequest := &alicloudCloudssoClient.GetUserRequest{
+			DirectoryId: tea.String(state.DirectoryId.ValueString()),
+			UserId:      tea.String(state.UserId.ValueString()),
+		}
+
+		getUserResponse, err := r.client.GetUserWithOptions(getUserRequest, runtime)
+		if err != nil {
+			if isCloudssoNotFound(err) {
+				user = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		user = getUserResponse.Body.User
+		return nil
+	}
+
+	if err := retryAPICall(getUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get User.",
+			err.Error(),
+		)
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.UserName = types.StringValue(tea.StringValue(user.UserName))
+	state.DisplayName = stringValueOrNull(state.DisplayName, user.DisplayName)
+	state.Email = stringValueOrNull(state.Email, user.Email)
+	state.FirstName = stringValueOrNull(state.FirstName, user.FirstName)
+	state.LastName = stringValueOrNull(state.LastName, user.LastName)
+	state.Description = stringValueOrNull(state.Description, user.Description)
+	state.Status = types.StringValue(tea.StringValue(user.Status))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the profile and the status of the user.
+func (r *cloudssoUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cloudssoUserModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DisplayName.Equal(state.DisplayName) ||
+		!plan.Email.Equal(state.Email) ||
+		!plan.FirstName.Equal(state.FirstName) ||
+		!plan.LastName.Equal(state.LastName) ||
+		!plan.Description.Equal(state.Description) {
+		updateUser := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateUserRequest := &alicloudCloudssoClient.UpdateUserRequest{
+				DirectoryId:    tea.String(state.DirectoryId.ValueString()),
+				UserId:         tea.String(state.UserId.ValueString()),
+				NewDisplayName: tea.String(plan.DisplayName.ValueString()),
+				NewEmail:       tea.String(plan.Email.ValueString()),
+				NewFirstName:   tea.String(plan.FirstName.ValueString()),
+				NewLastName:    tea.String(plan.LastName.ValueString()),
+				NewDescription: tea.String(plan.Description.ValueString()),
+			}
+
+			if _, err := r.client.UpdateUserWithOptions(updateUserRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateUser); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update User.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		updateUserStatus := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateUserStatusRequest := &alicloudCloudssoClient.UpdateUserStatusRequest{
+				DirectoryId: tea.String(state.DirectoryId.ValueString()),
+				UserId:      tea.String(state.UserId.ValueString()),
+				NewStatus:   tea.String(plan.Status.ValueString()),
+			}
+
+			if _, err := r.client.UpdateUserStatusWithOptions(updateUserStatusRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateUserStatus); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update User Status.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.UserId = state.UserId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the user. The user must be removed from all the groups and access
+// assignments before it is deleted.
+func (r *cloudssoUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cloudssoUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteUser := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteUserRequest := &alicloudCloudssoClient.DeleteUserRequest{
+			DirectoryId: tea.String(state.DirectoryId.ValueString()),
+			UserId:      tea.String(state.UserId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteUserWithOptions(deleteUserRequest, runtime); err != nil {
+			if isCloudssoNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete User.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the user by the ID in the format of
+// <directory_id>:<user_id>.
+func (r *cloudssoUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <directory_id>:<user_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), ids[1])...)
+}
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"strconv"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 
@@ -217,11 +216,7 @@ func (r *cmsAlarmRuleResource) Read(ctx context.Context, req resource.ReadReques
 		return nil
 	}
 
-	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-
-	err := backoff.Retry(readAlarmRule, reconnectBackoff)
+	err := retryAPICall(readAlarmRule)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Read CMS Group Metric Rule",
@@ -308,11 +303,7 @@ func (r *cmsAlarmRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return nil
 	}
 
-	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-
-	err := backoff.Retry(deleteAlarmRule, reconnectBackoff)
+	err := retryAPICall(deleteAlarmRule)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Delete CMS Group Metric Rule",
@@ -391,9 +382,7 @@ func (r *cmsAlarmRuleResource) setRule(ctx context.Context, plan *cmsAlarmRuleRe
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(setAlarmRule, reconnectBackoff)
+	err := retryAPICall(setAlarmRule)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,444 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cmsCustomMetricNamespaceResource{}
+	_ resource.ResourceWithConfigure   = &cmsCustomMetricNamespaceResource{}
+	_ resource.ResourceWithImportState = &cmsCustomMetricNamespaceResource{}
+)
+
+func NewCmsCustomMetricNamespaceResource() resource.Resource {
+	return &cmsCustomMetricNamespaceResource{}
+}
+
+type cmsCustomMetricNamespaceResource struct {
+	client *alicloudCmsClient.Client
+}
+
+type cmsCustomMetricNamespaceModel struct {
+	Namespace      types.String            `tfsdk:"namespace"`
+	Description    types.String            `tfsdk:"description"`
+	Spec           types.String            `tfsdk:"spec"`
+	InitialMetrics []*cmsCustomMetricModel `tfsdk:"initial_metrics"`
+}
+
+type cmsCustomMetricModel struct {
+	Name   types.String `tfsdk:"name"`
+	Labels types.Map    `tfsdk:"labels"`
+	Value  types.String `tfsdk:"value"`
+}
+
+// Metadata returns the CMS Custom Metric Namespace resource name.
+func (r *cmsCustomMetricNamespaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cms_custom_metric_namespace"
+}
+
+// Schema defines the schema for the CMS Custom Metric Namespace resource.
+func (r *cmsCustomMetricNamespaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provision a CloudMonitor (CMS) namespace for the custom metrics of the applications, " +
+			"and write the initial metric data so that the metrics are available for the dashboards and alarm " +
+			"rules before the applications report any data.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description: "The name of the namespace, which consists of lowercase letters, digits and hyphens.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the namespace.",
+				Optional:    true,
+			},
+			"spec": schema.StringAttribute{
+				Description: "The data retention spec of the namespace. Valid values: cms.s1.large (15 days), " +
+					"cms.s1.xlarge (32 days), cms.s1.2xlarge (63 days), cms.s1.3xlarge (93 days), " +
+					"cms.s1.6xlarge (185 days), cms.s1.12xlarge (376 days). Default to cms.s1.large.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("cms.s1.large"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("cms.s1.large", "cms.s1.xlarge", "cms.s1.2xlarge",
+						"cms.s1.3xlarge", "cms.s1.6xlarge", "cms.s1.12xlarge"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"initial_metrics": schema.ListNestedBlock{
+				Description: "List of metrics to be written into the namespace when the namespace is created, " +
+					"or when the metric is added. The metric data are not read back, and are not deleted when " +
+					"the metric is removed from the list.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the metric.",
+							Required:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "The labels of the metric.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The initial value of the metric. Default to \"0\".",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("0"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cmsCustomMetricNamespaceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cmsClient
+}
+
+// Create the namespace and write the initial metrics.
+func (r *cmsCustomMetricNamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cmsCustomMetricNamespaceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createHybridMonitorNamespace := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createHybridMonitorNamespaceRequest := &alicloudCmsClient.CreateHybridMonitorNamespaceRequest{
+			RegionId:    r.client.RegionId,
+			Namespace:   tea.String(plan.Namespace.ValueString()),
+			Description: tea.String(plan.Description.ValueString()),
+			Spec:        tea.String(plan.Spec.ValueString()),
+		}
+
+		createHybridMonitorNamespaceResponse, err := r.client.CreateHybridMonitorNamespaceWithOptions(createHybridMonitorNamespaceRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		body := createHybridMonitorNamespaceResponse.Body
+		return cmsResponseError(body.Success, body.Code, body.Message)
+	}
+
+	if err := retryAPICall(createHybridMonitorNamespace); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Custom Metric Namespace.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the namespace first, so that the namespace is not leaked if the
+	// initial metrics are failed to be written.
+	initialMetrics := plan.InitialMetrics
+	plan.InitialMetrics = nil
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putMetricData(ctx, plan.Namespace.ValueString(), initialMetrics); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Write Initial Metrics.",
+			err.Error(),
+		)
+		return
+	}
+	plan.InitialMetrics = initialMetrics
+
+	setStateDiags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the namespace. The initial metrics are kept as is in state.
+func (r *cmsCustomMetricNamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cmsCustomMetricNamespaceModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var namespace *alicloudCmsClient.DescribeHybridMonitorNamespaceListResponseBodyDescribeHybridMonitorNamespace
+	describeHybridMonitorNamespaceList := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeHybridMonitorNamespaceListRequest := &alicloudCmsClient.DescribeHybridMonitorNamespaceListRequest{
+			RegionId:  r.client.RegionId,
+			Namespace: tea.String(state.Namespace.ValueString()),
+		}
+
+		describeHybridMonitorNamespaceListResponse, err := r.client.DescribeHybridMonitorNamespaceListWithOptions(describeHybridMonitorNamespaceListRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		body := describeHybridMonitorNamespaceListResponse.Body
+		if err := cmsResponseError(body.Success, body.Code, body.Message); err != nil {
+			return err
+		}
+
+		// The namespaces are matched by keyword, find the exact one.
+		namespace = nil
+		for _, n := range body.DescribeHybridMonitorNamespace {
+			if tea.StringValue(n.Namespace) == state.Namespace.ValueString() && tea.Int32Value(n.IsDelete) == 0 {
+				namespace = n
+				break
+			}
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeHybridMonitorNamespaceList); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Custom Metric Namespace.",
+			err.Error(),
+		)
+		return
+	}
+
+	if namespace == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !state.Description.IsNull() || tea.StringValue(namespace.Description) != "" {
+		state.Description = types.StringValue(tea.StringValue(namespace.Description))
+	}
+	if namespace.Detail != nil {
+		state.Spec = types.StringValue(tea.StringValue(namespace.Detail.Spec))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the description and spec of the namespace, and write the added
+// initial metrics.
+func (r *cmsCustomMetricNamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cmsCustomMetricNamespaceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.Spec.Equal(state.Spec) {
+		modifyHybridMonitorNamespace := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyHybridMonitorNamespaceRequest := &alicloudCmsClient.ModifyHybridMonitorNamespaceRequest{
+				RegionId:    r.client.RegionId,
+				Namespace:   tea.String(plan.Namespace.ValueString()),
+				Description: tea.String(plan.Description.ValueString()),
+				Spec:        tea.String(plan.Spec.ValueString()),
+			}
+
+			modifyHybridMonitorNamespaceResponse, err := r.client.ModifyHybridMonitorNamespaceWithOptions(modifyHybridMonitorNamespaceRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			body := modifyHybridMonitorNamespaceResponse.Body
+			return cmsResponseError(body.Success, body.Code, body.Message)
+		}
+
+		if err := retryAPICall(modifyHybridMonitorNamespace); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Custom Metric Namespace.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	written := make(map[string]bool)
+	for _, metric := range state.InitialMetrics {
+		written[cmsCustomMetricKey(ctx, metric)] = true
+	}
+	var addedMetrics []*cmsCustomMetricModel
+	for _, metric := range plan.InitialMetrics {
+		if !written[cmsCustomMetricKey(ctx, metric)] {
+			addedMetrics = append(addedMetrics, metric)
+		}
+	}
+
+	if err := r.putMetricData(ctx, plan.Namespace.ValueString(), addedMetrics); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Write Initial Metrics.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the namespace.
+func (r *cmsCustomMetricNamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cmsCustomMetricNamespaceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteHybridMonitorNamespace := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteHybridMonitorNamespaceRequest := &alicloudCmsClient.DeleteHybridMonitorNamespaceRequest{
+			RegionId:  r.client.RegionId,
+			Namespace: tea.String(state.Namespace.ValueString()),
+		}
+
+		deleteHybridMonitorNamespaceResponse, err := r.client.DeleteHybridMonitorNamespaceWithOptions(deleteHybridMonitorNamespaceRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		body := deleteHybridMonitorNamespaceResponse.Body
+		return cmsResponseError(body.Success, body.Code, body.Message)
+	}
+
+	if err := retryAPICall(deleteHybridMonitorNamespace); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Custom Metric Namespace.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cmsCustomMetricNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("namespace"), req, resp)
+}
+
+// Function to write the data of the metrics into the namespace with the
+// current timestamp.
+func (r *cmsCustomMetricNamespaceResource) putMetricData(ctx context.Context, namespace string, metrics []*cmsCustomMetricModel) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	timestamp := time.Now().UnixMilli()
+	var metricList []*alicloudCmsClient.PutHybridMonitorMetricDataRequestMetricList
+	for _, metric := range metrics {
+		labels := make(map[string]string)
+		if !metric.Labels.IsNull() {
+			if diags := metric.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+				return fmt.Errorf("invalid labels of metric %s", metric.Name.ValueString())
+			}
+		}
+
+		metricData := &alicloudCmsClient.PutHybridMonitorMetricDataRequestMetricList{
+			Name:  tea.String(metric.Name.ValueString()),
+			Value: tea.String(metric.Value.ValueString()),
+			TS:    tea.Int64(timestamp),
+		}
+		for key, value := range labels {
+			metricData.Labels = append(metricData.Labels, &alicloudCmsClient.PutHybridMonitorMetricDataRequestMetricListLabels{
+				Key:   tea.String(key),
+				Value: tea.String(value),
+			})
+		}
+		metricList = append(metricList, metricData)
+	}
+
+	putHybridMonitorMetricData := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		putHybridMonitorMetricDataRequest := &alicloudCmsClient.PutHybridMonitorMetricDataRequest{
+			RegionId:   r.client.RegionId,
+			Namespace:  tea.String(namespace),
+			MetricList: metricList,
+		}
+
+		putHybridMonitorMetricDataResponse, err := r.client.PutHybridMonitorMetricDataWithOptions(putHybridMonitorMetricDataRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		var errorMessages []string
+		for _, errorDetail := range putHybridMonitorMetricDataResponse.Body.ErrorDetail {
+			name := fmt.Sprintf("metric %d", tea.Int64Value(errorDetail.Index))
+			if index := tea.Int64Value(errorDetail.Index); index >= 0 && index < int64(len(metricList)) {
+				name = tea.StringValue(metricList[index].Name)
+			}
+			errorMessages = append(errorMessages, fmt.Sprintf("%s: %s", name, tea.StringValue(errorDetail.ErrorMessage)))
+		}
+		if len(errorMessages) > 0 {
+			return backoff.Permanent(fmt.Errorf("failed to write metrics, %s", strings.Join(errorMessages, "; ")))
+		}
+		return nil
+	}
+
+	return retryAPICall(putHybridMonitorMetricData)
+}
+
+// Function to identify a metric by the name and labels.
+func cmsCustomMetricKey(ctx context.Context, metric *cmsCustomMetricModel) string {
+	labels := make(map[string]string)
+	if !metric.Labels.IsNull() {
+		metric.Labels.ElementsAs(ctx, &labels, false)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key, value := range labels {
+		keys = append(keys, key+"="+value)
+	}
+	sort.Strings(keys)
+	return metric.Name.ValueString() + "{" + strings.Join(keys, ",") + "}"
+}
+
+// Function to return the error of the CMS API which responds with a failed
+// result instead of an error.
+func cmsResponseError(success, code, message *string) error {
+	if success == nil || tea.StringValue(success) == "true" {
+		return nil
+	}
+	return backoff.Permanent(fmt.Errorf("code: %s, message: %s", tea.StringValue(code), tea.StringValue(message)))
+}
@@ -0,0 +1,385 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &cmsEventRuleResource{}
+	_ resource.ResourceWithConfigure   = &cmsEventRuleResource{}
+	_ resource.ResourceWithImportState = &cmsEventRuleResource{}
+)
+
+func NewCmsEventRuleResource() resource.Resource {
+	return &cmsEventRuleResource{}
+}
+
+type cmsEventRuleResource struct {
+	client *alicloudCmsClient.Client
+}
+
+type cmsEventRuleModel struct {
+	RuleName     types.String           `tfsdk:"rule_name"`
+	Description  types.String           `tfsdk:"description"`
+	GroupId      types.String           `tfsdk:"group_id"`
+	SilenceTime  types.Int64            `tfsdk:"silence_time"`
+	Enabled      types.Bool             `tfsdk:"enabled"`
+	EventPattern []*cmsEventRulePattern `tfsdk:"event_pattern"`
+}
+
+type cmsEventRulePattern struct {
+	Product    types.String `tfsdk:"product"`
+	EventTypes types.List   `tfsdk:"event_types"`
+	EventNames types.List   `tfsdk:"event_names"`
+	Levels     types.List   `tfsdk:"levels"`
+	Statuses   types.List   `tfsdk:"statuses"`
+}
+
+// Metadata returns the CMS Event Rule resource name.
+func (r *cmsEventRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cms_event_rule"
+}
+
+// Schema defines the schema for the CMS Event Rule resource.
+func (r *cmsEventRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an event-triggered alert rule of Cloud Monitor Service (CMS) for the system events " +
+			"of the cloud services.",
+		Attributes: map[string]schema.Attribute{
+			"rule_name": schema.StringAttribute{
+				Description: "The name of the event-triggered alert rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the event-triggered alert rule.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the application group which the event-triggered alert rule belongs to.",
+				Optional:    true,
+			},
+			"silence_time": schema.Int64Attribute{
+				Description: "The mute period in seconds during which the new alerts are not sent.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the event-triggered alert rule is enabled. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_pattern": schema.ListNestedBlock{
+				Description: "The event patterns which trigger the alert rule.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Description: "The abbreviation of the cloud service, e.g. ecs, rds.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"event_types": schema.ListAttribute{
+							Description: "The types of the events, e.g. StatusNotification, Exception. " +
+								"Default to all the event types.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"event_names": schema.ListAttribute{
+							Description: "The names of the events, e.g. Instance:StateChange. Default to all " +
+								"the events of the cloud service.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"levels": schema.ListAttribute{
+							Description: "The levels of the events, e.g. CRITICAL, WARN, INFO. Default to " +
+								"all the levels.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"statuses": schema.ListAttribute{
+							Description: "The statuses of the events, e.g. Failed, Executed. Default to all " +
+								"the statuses.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cmsEventRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cmsClient
+}
+
+// Create the event-triggered alert rule.
+func (r *cmsEventRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cmsEventRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putEventRule(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Event Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the event-triggered alert rule.
+func (r *cmsEventRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cmsEventRuleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventRule, err := r.describeEventRule(state.RuleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Event Rule List.",
+			err.Error(),
+		)
+		return
+	}
+	if eventRule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Description = stringValueOrNull(state.Description, eventRule.Description)
+	state.GroupId = stringValueOrNull(state.GroupId, eventRule.GroupId)
+	state.Enabled = types.BoolValue(tea.StringValue(eventRule.State) == "ENABLED")
+	// CMS sets the default silence time when it is not specified.
+	if !state.SilenceTime.IsNull() {
+		state.SilenceTime = types.Int64Value(tea.Int64Value(eventRule.SilenceTime))
+	}
+
+	var eventPatterns []*alicloudCmsClient.DescribeEventRuleListResponseBodyEventRulesEventRuleEventPatternEventPattern
+	if eventRule.EventPattern != nil {
+		eventPatterns = eventRule.EventPattern.EventPattern
+	}
+	readEventPattern := []*cmsEventRulePattern{}
+	for _, eventPattern := range eventPatterns {
+		readPattern := &cmsEventRulePattern{
+			Product:    types.StringValue(tea.StringValue(eventPattern.Product)),
+			EventTypes: types.ListNull(types.StringType),
+			EventNames: types.ListNull(types.StringType),
+			Levels:     types.ListNull(types.StringType),
+			Statuses:   types.ListNull(types.StringType),
+		}
+		if eventPattern.EventTypeList != nil && len(eventPattern.EventTypeList.EventTypeList) > 0 {
+			readPattern.EventTypes = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(eventPattern.EventTypeList.EventTypeList))
+		}
+		if eventPattern.NameList != nil && len(eventPattern.NameList.NameList) > 0 {
+			readPattern.EventNames = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(eventPattern.NameList.NameList))
+		}
+		if eventPattern.LevelList != nil && len(eventPattern.LevelList.LevelList) > 0 {
+			readPattern.Levels = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(eventPattern.LevelList.LevelList))
+		}
+		if eventPattern.StatusList != nil && len(eventPattern.StatusList.StatusList) > 0 {
+			readPattern.Statuses = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(eventPattern.StatusList.StatusList))
+		}
+		readEventPattern = append(readEventPattern, readPattern)
+	}
+	state.EventPattern = readEventPattern
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the event-triggered alert rule, PutEventRule overwrites the whole
+// rule including the event patterns.
+func (r *cmsEventRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *cmsEventRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putEventRule(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Event Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the event-triggered alert rule.
+func (r *cmsEventRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cmsEventRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteEventRules := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteEventRulesRequest := &alicloudCmsClient.DeleteEventRulesRequest{
+			RuleNames: []*string{tea.String(state.RuleName.ValueString())},
+		}
+
+		if _, err := r.client.DeleteEventRulesWithOptions(deleteEventRulesRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteEventRules); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Event Rules.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *cmsEventRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("rule_name"), req, resp)
+}
+
+// Function to create or overwrite the event-triggered alert rule.
+func (r *cmsEventRuleResource) putEventRule(model *cmsEventRuleModel) error {
+	state := "ENABLED"
+	if !model.Enabled.ValueBool() {
+		state = "DISABLED"
+	}
+
+	putEventRuleRequest := &alicloudCmsClient.PutEventRuleRequest{
+		RuleName:    tea.String(model.RuleName.ValueString()),
+		Description: stringPointerOrNil(model.Description),
+		GroupId:     stringPointerOrNil(model.GroupId),
+		EventType:   tea.String("SYSTEM"),
+		State:       tea.String(state),
+	}
+	if !model.SilenceTime.IsNull() {
+		putEventRuleRequest.SilenceTime = tea.Int64(model.SilenceTime.ValueInt64())
+	}
+	for _, eventPattern := range model.EventPattern {
+		putEventRuleRequest.EventPattern = append(putEventRuleRequest.EventPattern, &alicloudCmsClient.PutEventRuleRequestEventPattern{
+			Product:       tea.String(eventPattern.Product.ValueString()),
+			EventTypeList: tea.StringSlice(convertListValueToStrings(eventPattern.EventTypes)),
+			NameList:      tea.StringSlice(convertListValueToStrings(eventPattern.EventNames)),
+			LevelList:     tea.StringSlice(convertListValueToStrings(eventPattern.Levels)),
+			StatusList:    tea.StringSlice(convertListValueToStrings(eventPattern.Statuses)),
+		})
+	}
+
+	putEventRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		putEventRuleResponse, err := r.client.PutEventRuleWithOptions(putEventRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if !tea.BoolValue(putEventRuleResponse.Body.Success) {
+			return backoff.Permanent(fmt.Errorf("%s: %s",
+				tea.StringValue(putEventRuleResponse.Body.Code),
+				tea.StringValue(putEventRuleResponse.Body.Message)))
+		}
+		return nil
+	}
+
+	return retryAPICall(putEventRule)
+}
+
+// Function to read the event-triggered alert rule by name, nil is returned
+// if the rule does not exist. The API only supports filtering the rules by
+// name prefix, so the rules are matched by the exact name.
+func (r *cmsEventRuleResource) describeEventRule(ruleName string) (*alicloudCmsClient.DescribeEventRuleListResponseBodyEventRulesEventRule, error) {
+	pageNumber := 1
+	pageSize := 100
+	for {
+		var describeEventRuleListResponse *alicloudCmsClient.DescribeEventRuleListResponse
+		describeEventRuleList := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeEventRuleListRequest := &alicloudCmsClient.DescribeEventRuleListRequest{
+				NamePrefix: tea.String(ruleName),
+				PageNumber: tea.String(fmt.Sprint(pageNumber)),
+				PageSize:   tea.String(fmt.Sprint(pageSize)),
+			}
+
+			var err error
+			describeEventRuleListResponse, err = r.client.DescribeEventRuleListWithOptions(describeEventRuleListRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeEventRuleList); err != nil {
+			return nil, err
+		}
+
+		body := describeEventRuleListResponse.Body
+		if body.EventRules == nil || len(body.EventRules.EventRule) == 0 {
+			return nil, nil
+		}
+		for _, eventRule := range body.EventRules.EventRule {
+			if tea.StringValue(eventRule.Name) == ruleName {
+				return eventRule, nil
+			}
+		}
+
+		if pageNumber*pageSize >= int(tea.Int32Value(body.Total)) {
+			return nil, nil
+		}
+		pageNumber++
+	}
+}
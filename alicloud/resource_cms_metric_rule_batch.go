@@ -0,0 +1,619 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The resources of the metric rules which apply to all the resources of
+	// the namespace in the account.
+	cmsAllResources = `[{"resource":"_ALL"}]`
+
+	// The maximum number of metric rules to be put or deleted in a single
+	// request.
+	cmsMetricRuleBatchSize = 50
+)
+
+var (
+	_ resource.Resource              = &cmsMetricRuleBatchResource{}
+	_ resource.ResourceWithConfigure = &cmsMetricRuleBatchResource{}
+)
+
+func NewCmsMetricRuleBatchResource() resource.Resource {
+	return &cmsMetricRuleBatchResource{}
+}
+
+type cmsMetricRuleBatchResource struct {
+	client *alicloudCmsClient.Client
+}
+
+type cmsMetricRuleBatchModel struct {
+	Namespace         types.String     `tfsdk:"namespace"`
+	Resources         types.String     `tfsdk:"resources"`
+	ContactGroups     types.List       `tfsdk:"contact_groups"`
+	Webhook           types.String     `tfsdk:"webhook"`
+	EffectiveInterval types.String     `tfsdk:"effective_interval"`
+	Rules             []*cmsMetricRule `tfsdk:"rules"`
+}
+
+type cmsMetricRule struct {
+	RuleName    types.String             `tfsdk:"rule_name"`
+	MetricName  types.String             `tfsdk:"metric_name"`
+	Period      types.Int64              `tfsdk:"period"`
+	SilenceTime types.Int64              `tfsdk:"silence_time"`
+	Critical    *cmsMetricRuleEscalation `tfsdk:"critical"`
+	Warn        *cmsMetricRuleEscalation `tfsdk:"warn"`
+	Info        *cmsMetricRuleEscalation `tfsdk:"info"`
+	RuleId      types.String             `tfsdk:"rule_id"`
+}
+
+type cmsMetricRuleEscalation struct {
+	Statistics         types.String `tfsdk:"statistics"`
+	ComparisonOperator types.String `tfsdk:"comparison_operator"`
+	Threshold          types.String `tfsdk:"threshold"`
+	Times              types.Int64  `tfsdk:"times"`
+}
+
+// Metadata returns the CMS Metric Rule Batch resource name.
+func (r *cmsMetricRuleBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cms_metric_rule_batch"
+}
+
+// Schema defines the schema for the CMS Metric Rule Batch resource.
+func (r *cmsMetricRuleBatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a batch of Cloud Monitor Service (CMS) metric alarm rules of a namespace.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the cloud service, e.g. acs_ecs_dashboard.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resources": schema.StringAttribute{
+				Description: "The resources monitored by the metric rules in JSON, e.g. " +
+					"[{\"instanceId\":\"i-xxx\"}]. Default to all the resources of the namespace in the account.",
+				Optional: true,
+			},
+			"contact_groups": schema.ListAttribute{
+				Description: "List of the alert contact groups which the alarms are sent to.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"webhook": schema.StringAttribute{
+				Description: "The callback URL which the alarms are sent to.",
+				Optional:    true,
+			},
+			"effective_interval": schema.StringAttribute{
+				Description: "The period of time during which the metric rules are effective, e.g. 00:00-23:59.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rules": schema.ListNestedBlock{
+				Description: "List of metric rules of the batch. The metric rules are identified by name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_name": schema.StringAttribute{
+							Description: "The name of the metric rule, must be unique in the batch.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"metric_name": schema.StringAttribute{
+							Description: "The name of the metric, e.g. CPUUtilization.",
+							Required:    true,
+						},
+						"period": schema.Int64Attribute{
+							Description: "The aggregation period of the metric in seconds.",
+							Optional:    true,
+						},
+						"silence_time": schema.Int64Attribute{
+							Description: "The mute period in seconds during which the new alarms are not sent " +
+								"if the alarm is still not cleared.",
+							Optional: true,
+						},
+						"rule_id": schema.StringAttribute{
+							Description: "The ID of the metric rule.",
+							Computed:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"critical": cmsMetricRuleEscalationBlock("critical"),
+						"warn":     cmsMetricRuleEscalationBlock("warn"),
+						"info":     cmsMetricRuleEscalationBlock("info"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// The schema of an escalation level of the metric rule.
+func cmsMetricRuleEscalationBlock(level string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "The " + level + " level escalation of the metric rule.",
+		Attributes: map[string]schema.Attribute{
+			"statistics": schema.StringAttribute{
+				Description: "The statistical method of the metric, e.g. Average, Maximum, Minimum.",
+				Optional:    true,
+			},
+			"comparison_operator": schema.StringAttribute{
+				Description: "The comparison operator of the threshold. Accepted values: " +
+					"\"GreaterThanOrEqualToThreshold\", \"GreaterThanThreshold\", \"LessThanOrEqualToThreshold\", " +
+					"\"LessThanThreshold\", \"NotEqualToThreshold\", \"GreaterThanYesterday\", \"LessThanYesterday\", " +
+					"\"GreaterThanLastWeek\", \"LessThanLastWeek\", \"GreaterThanLastPeriod\", \"LessThanLastPeriod\".",
+				Optional: true,
+			},
+			"threshold": schema.StringAttribute{
+				Description: "The threshold of the metric.",
+				Optional:    true,
+			},
+			"times": schema.Int64Attribute{
+				Description: "The number of consecutive times the threshold is reached to trigger the alarm.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cmsMetricRuleBatchResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cmsClient
+}
+
+// Create all the metric rules of the batch.
+func (r *cmsMetricRuleBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cmsMetricRuleBatchModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range plan.Rules {
+		rule.RuleId = types.StringValue(uuid.New().String())
+	}
+
+	err := r.putMetricRules(plan, plan.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Resource Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the metric rules of the batch. The metric rules which are deleted
+// outside of Terraform are removed from state, so that they are created
+// again in the next apply.
+func (r *cmsMetricRuleBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cmsMetricRuleBatchModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, err := r.describeMetricRules(state.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Metric Rule List.",
+			err.Error(),
+		)
+		return
+	}
+
+	alarmsById := make(map[string]*alicloudCmsClient.DescribeMetricRuleListResponseBodyAlarmsAlarm)
+	for _, alarm := range alarms {
+		alarmsById[tea.StringValue(alarm.RuleId)] = alarm
+	}
+
+	readRules := []*cmsMetricRule{}
+	for _, stateRule := range state.Rules {
+		alarm, ok := alarmsById[stateRule.RuleId.ValueString()]
+		if !ok {
+			continue
+		}
+		readRules = append(readRules, flattenCmsMetricRule(alarm, stateRule))
+
+		// The batch level attributes are read from the first metric rule,
+		// as they are set on every metric rule of the batch.
+		if len(readRules) == 1 {
+			if tea.StringValue(alarm.Resources) != "" && !(state.Resources.IsNull() && isCmsAllResources(tea.StringValue(alarm.Resources))) {
+				state.Resources = types.StringValue(tea.StringValue(alarm.Resources))
+			}
+			state.Webhook = stringValueOrNull(state.Webhook, alarm.Webhook)
+			state.EffectiveInterval = stringValueOrNull(state.EffectiveInterval, alarm.EffectiveInterval)
+		}
+	}
+
+	if len(readRules) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Rules = readRules
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the removed metric rules, and put the added and changed metric
+// rules. All the metric rules are put again when any batch level attribute
+// is changed.
+func (r *cmsMetricRuleBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cmsMetricRuleBatchModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRules := make(map[string]*cmsMetricRule)
+	for _, rule := range plan.Rules {
+		planRules[rule.RuleName.ValueString()] = rule
+	}
+	stateRules := make(map[string]*cmsMetricRule)
+	for _, rule := range state.Rules {
+		stateRules[rule.RuleName.ValueString()] = rule
+	}
+
+	var removedRuleIds []string
+	for _, stateRule := range state.Rules {
+		if _, exists := planRules[stateRule.RuleName.ValueString()]; !exists {
+			removedRuleIds = append(removedRuleIds, stateRule.RuleId.ValueString())
+		}
+	}
+	if err := r.deleteMetricRules(removedRuleIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	batchChanged := !plan.Resources.Equal(state.Resources) ||
+		!plan.ContactGroups.Equal(state.ContactGroups) ||
+		!plan.Webhook.Equal(state.Webhook) ||
+		!plan.EffectiveInterval.Equal(state.EffectiveInterval)
+
+	var changedRules []*cmsMetricRule
+	for _, planRule := range plan.Rules {
+		stateRule, exists := stateRules[planRule.RuleName.ValueString()]
+		if !exists {
+			planRule.RuleId = types.StringValue(uuid.New().String())
+			changedRules = append(changedRules, planRule)
+			continue
+		}
+
+		planRule.RuleId = stateRule.RuleId
+		if batchChanged || !planRule.equal(stateRule) {
+			changedRules = append(changedRules, planRule)
+		}
+	}
+	if err := r.putMetricRules(plan, changedRules); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Resource Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete all the metric rules of the batch.
+func (r *cmsMetricRuleBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cmsMetricRuleBatchModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleIds []string
+	for _, rule := range state.Rules {
+		ruleIds = append(ruleIds, rule.RuleId.ValueString())
+	}
+
+	if err := r.deleteMetricRules(ruleIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to create or overwrite the metric rules in batches.
+func (r *cmsMetricRuleBatchResource) putMetricRules(model *cmsMetricRuleBatchModel, rules []*cmsMetricRule) error {
+	resources := cmsAllResources
+	if !model.Resources.IsNull() {
+		resources = model.Resources.ValueString()
+	}
+
+	var requestRules []*alicloudCmsClient.PutResourceMetricRulesRequestRules
+	for _, rule := range rules {
+		requestRules = append(requestRules, expandCmsMetricRule(rule, rule.RuleId.ValueString(), model.Namespace.ValueString(),
+			resources, model.ContactGroups, model.Webhook, model.EffectiveInterval))
+	}
+	return putCmsMetricRules(r.client, requestRules)
+}
+
+// Function to delete the metric rules in batches.
+func (r *cmsMetricRuleBatchResource) deleteMetricRules(ruleIds []string) error {
+	return deleteCmsMetricRules(r.client, ruleIds)
+}
+
+// Function to read all the metric rules of the namespace.
+func (r *cmsMetricRuleBatchResource) describeMetricRules(namespace string) ([]*alicloudCmsClient.DescribeMetricRuleListResponseBodyAlarmsAlarm, error) {
+	return describeCmsMetricRules(r.client, namespace)
+}
+
+// Compare the configurable attributes of two metric rules.
+func (rule *cmsMetricRule) equal(other *cmsMetricRule) bool {
+	return rule.RuleName.Equal(other.RuleName) &&
+		rule.MetricName.Equal(other.MetricName) &&
+		rule.Period.Equal(other.Period) &&
+		rule.SilenceTime.Equal(other.SilenceTime) &&
+		rule.Critical.equal(other.Critical) &&
+		rule.Warn.equal(other.Warn) &&
+		rule.Info.equal(other.Info)
+}
+
+// Compare two escalations, which may be nil if the level is not set.
+func (escalation *cmsMetricRuleEscalation) equal(other *cmsMetricRuleEscalation) bool {
+	if escalation == nil || other == nil {
+		return escalation == nil && other == nil
+	}
+	return escalation.Statistics.Equal(other.Statistics) &&
+		escalation.ComparisonOperator.Equal(other.ComparisonOperator) &&
+		escalation.Threshold.Equal(other.Threshold) &&
+		escalation.Times.Equal(other.Times)
+}
+
+// Convert the metric rule from AliCloud API into the model. The optional
+// attributes not set in the previous model are kept null when AliCloud
+// returns the default values, to avoid unnecessary diff.
+func flattenCmsMetricRule(alarm *alicloudCmsClient.DescribeMetricRuleListResponseBodyAlarmsAlarm, previous *cmsMetricRule) *cmsMetricRule {
+	flattened := &cmsMetricRule{
+		RuleName:    types.StringValue(tea.StringValue(alarm.RuleName)),
+		MetricName:  types.StringValue(tea.StringValue(alarm.MetricName)),
+		Period:      types.Int64Null(),
+		SilenceTime: int64ValueOrNull(previous.SilenceTime, alarm.SilenceTime),
+		RuleId:      types.StringValue(tea.StringValue(alarm.RuleId)),
+	}
+	if period, err := strconv.ParseInt(tea.StringValue(alarm.Period), 10, 64); err == nil && !previous.Period.IsNull() {
+		flattened.Period = types.Int64Value(period)
+	}
+
+	if escalations := alarm.Escalations; escalations != nil {
+		if critical := escalations.Critical; critical != nil && tea.StringValue(critical.Threshold) != "" {
+			flattened.Critical = flattenCmsMetricRuleEscalation(critical.Statistics, critical.ComparisonOperator, critical.Threshold, critical.Times, previous.Critical)
+		}
+		if warn := escalations.Warn; warn != nil && tea.StringValue(warn.Threshold) != "" {
+			flattened.Warn = flattenCmsMetricRuleEscalation(warn.Statistics, warn.ComparisonOperator, warn.Threshold, warn.Times, previous.Warn)
+		}
+		if info := escalations.Info; info != nil && tea.StringValue(info.Threshold) != "" {
+			flattened.Info = flattenCmsMetricRuleEscalation(info.Statistics, info.ComparisonOperator, info.Threshold, info.Times, previous.Info)
+		}
+	}
+	return flattened
+}
+
+func flattenCmsMetricRuleEscalation(statistics, comparisonOperator, threshold *string, times *int32, previous *cmsMetricRuleEscalation) *cmsMetricRuleEscalation {
+	if previous == nil {
+		previous = &cmsMetricRuleEscalation{}
+	}
+	return &cmsMetricRuleEscalation{
+		Statistics:         stringValueOrNull(previous.Statistics, statistics),
+		ComparisonOperator: stringValueOrNull(previous.ComparisonOperator, comparisonOperator),
+		Threshold:          types.StringValue(tea.StringValue(threshold)),
+		Times:              int64ValueOrNull(previous.Times, times),
+	}
+}
+
+// Returns whether the resources of a metric rule are all the resources of
+// the namespace.
+func isCmsAllResources(resources string) bool {
+	return strings.ReplaceAll(resources, " ", "") == cmsAllResources
+}
+
+// Convert the metric rule into the request of PutResourceMetricRules, with
+// the batch level attributes set on the metric rule.
+func expandCmsMetricRule(rule *cmsMetricRule, ruleId, namespace, resources string, contactGroups types.List, webhook, effectiveInterval types.String) *alicloudCmsClient.PutResourceMetricRulesRequestRules {
+	requestRule := &alicloudCmsClient.PutResourceMetricRulesRequestRules{
+		RuleId:            tea.String(ruleId),
+		RuleName:          tea.String(rule.RuleName.ValueString()),
+		Namespace:         tea.String(namespace),
+		MetricName:        tea.String(rule.MetricName.ValueString()),
+		Resources:         tea.String(resources),
+		ContactGroups:     tea.String(strings.Join(convertListValueToStrings(contactGroups), ",")),
+		Webhook:           stringPointerOrNil(webhook),
+		EffectiveInterval: stringPointerOrNil(effectiveInterval),
+		SilenceTime:       int32PointerOrNil(rule.SilenceTime),
+		Escalations:       &alicloudCmsClient.PutResourceMetricRulesRequestRulesEscalations{},
+	}
+	if !rule.Period.IsNull() {
+		requestRule.Period = tea.String(strconv.FormatInt(rule.Period.ValueInt64(), 10))
+	}
+	if rule.Critical != nil {
+		requestRule.Escalations.Critical = &alicloudCmsClient.PutResourceMetricRulesRequestRulesEscalationsCritical{
+			Statistics:         stringPointerOrNil(rule.Critical.Statistics),
+			ComparisonOperator: stringPointerOrNil(rule.Critical.ComparisonOperator),
+			Threshold:          stringPointerOrNil(rule.Critical.Threshold),
+			Times:              int32PointerOrNil(rule.Critical.Times),
+		}
+	}
+	if rule.Warn != nil {
+		requestRule.Escalations.Warn = &alicloudCmsClient.PutResourceMetricRulesRequestRulesEscalationsWarn{
+			Statistics:         stringPointerOrNil(rule.Warn.Statistics),
+			ComparisonOperator: stringPointerOrNil(rule.Warn.ComparisonOperator),
+			Threshold:          stringPointerOrNil(rule.Warn.Threshold),
+			Times:              int32PointerOrNil(rule.Warn.Times),
+		}
+	}
+	if rule.Info != nil {
+		requestRule.Escalations.Info = &alicloudCmsClient.PutResourceMetricRulesRequestRulesEscalationsInfo{
+			Statistics:         stringPointerOrNil(rule.Info.Statistics),
+			ComparisonOperator: stringPointerOrNil(rule.Info.ComparisonOperator),
+			Threshold:          stringPointerOrNil(rule.Info.Threshold),
+			Times:              int32PointerOrNil(rule.Info.Times),
+		}
+	}
+	return requestRule
+}
+
+// Function to create or overwrite the metric rules in batches of
+// cmsMetricRuleBatchSize.
+func putCmsMetricRules(client *alicloudCmsClient.Client, requestRules []*alicloudCmsClient.PutResourceMetricRulesRequestRules) error {
+	for start := 0; start < len(requestRules); start += cmsMetricRuleBatchSize {
+		end := start + cmsMetricRuleBatchSize
+		if end > len(requestRules) {
+			end = len(requestRules)
+		}
+
+		putResourceMetricRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			putResourceMetricRulesRequest := &alicloudCmsClient.PutResourceMetricRulesRequest{
+				Rules: requestRules[start:end],
+			}
+
+			putResourceMetricRulesResponse, err := client.PutResourceMetricRulesWithOptions(putResourceMetricRulesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			if !tea.BoolValue(putResourceMetricRulesResponse.Body.Success) {
+				return backoff.Permanent(fmt.Errorf("%s", tea.StringValue(putResourceMetricRulesResponse.Body.Message)))
+			}
+			return nil
+		}
+
+		if err := retryAPICall(putResourceMetricRules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to delete the metric rules in batches of cmsMetricRuleBatchSize.
+func deleteCmsMetricRules(client *alicloudCmsClient.Client, ruleIds []string) error {
+	for start := 0; start < len(ruleIds); start += cmsMetricRuleBatchSize {
+		end := start + cmsMetricRuleBatchSize
+		if end > len(ruleIds) {
+			end = len(ruleIds)
+		}
+
+		deleteMetricRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			deleteMetricRulesRequest := &alicloudCmsClient.DeleteMetricRulesRequest{
+				Id: tea.StringSlice(ruleIds[start:end]),
+			}
+
+			if _, err := client.DeleteMetricRulesWithOptions(deleteMetricRulesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(deleteMetricRules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to read all the metric rules of the namespace.
+func describeCmsMetricRules(client *alicloudCmsClient.Client, namespace string) ([]*alicloudCmsClient.DescribeMetricRuleListResponseBodyAlarmsAlarm, error) {
+	var alarms []*alicloudCmsClient.DescribeMetricRuleListResponseBodyAlarmsAlarm
+	page := int32(1)
+	pageSize := int32(100)
+
+	for {
+		var describeMetricRuleListResponse *alicloudCmsClient.DescribeMetricRuleListResponse
+		describeMetricRuleList := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeMetricRuleListRequest := &alicloudCmsClient.DescribeMetricRuleListRequest{
+				Namespace: tea.String(namespace),
+				Page:      tea.Int32(page),
+				PageSize:  tea.Int32(pageSize),
+			}
+
+			var err error
+			describeMetricRuleListResponse, err = client.DescribeMetricRuleListWithOptions(describeMetricRuleListRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeMetricRuleList); err != nil {
+			return nil, err
+		}
+
+		body := describeMetricRuleListResponse.Body
+		if body.Alarms != nil {
+			alarms = append(alarms, body.Alarms.Alarm...)
+		}
+
+		total, _ := strconv.ParseInt(tea.StringValue(body.Total), 10, 64)
+		if int64(page*pageSize) >= total {
+			break
+		}
+		page++
+	}
+	return alarms, nil
+}
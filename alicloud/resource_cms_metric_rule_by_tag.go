@@ -0,0 +1,534 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
+	alicloudTagClient "github.com/alibabacloud-go/tag-20180828/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource               = &cmsMetricRuleByTagResource{}
+	_ resource.ResourceWithConfigure  = &cmsMetricRuleByTagResource{}
+	_ resource.ResourceWithModifyPlan = &cmsMetricRuleByTagResource{}
+)
+
+func NewCmsMetricRuleByTagResource() resource.Resource {
+	return &cmsMetricRuleByTagResource{}
+}
+
+type cmsMetricRuleByTagResource struct {
+	client    *alicloudCmsClient.Client
+	tagClient *alicloudTagClient.Client
+}
+
+type cmsMetricRuleByTagModel struct {
+	Id                types.String             `tfsdk:"id"`
+	Namespace         types.String             `tfsdk:"namespace"`
+	ResourceType      types.String             `tfsdk:"resource_type"`
+	Tags              types.Map                `tfsdk:"tags"`
+	DimensionKey      types.String             `tfsdk:"dimension_key"`
+	ContactGroups     types.List               `tfsdk:"contact_groups"`
+	Webhook           types.String             `tfsdk:"webhook"`
+	EffectiveInterval types.String             `tfsdk:"effective_interval"`
+	InstanceIds       types.List               `tfsdk:"instance_ids"`
+	Rules             []*cmsMetricRuleTemplate `tfsdk:"rules"`
+}
+
+type cmsMetricRuleTemplate struct {
+	RuleName    types.String             `tfsdk:"rule_name"`
+	MetricName  types.String             `tfsdk:"metric_name"`
+	Period      types.Int64              `tfsdk:"period"`
+	SilenceTime types.Int64              `tfsdk:"silence_time"`
+	Critical    *cmsMetricRuleEscalation `tfsdk:"critical"`
+	Warn        *cmsMetricRuleEscalation `tfsdk:"warn"`
+	Info        *cmsMetricRuleEscalation `tfsdk:"info"`
+}
+
+// Metadata returns the CMS Metric Rule By Tag resource name.
+func (r *cmsMetricRuleByTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cms_metric_rule_by_tag"
+}
+
+// Schema defines the schema for the CMS Metric Rule By Tag resource.
+func (r *cmsMetricRuleByTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the Cloud Monitor Service (CMS) metric alarm rules for every instance matching the " +
+			"tags, so that thousands of per-instance alarm rules are managed by a single resource. The metric " +
+			"rules are created for each pair of rule and instance, and put or deleted in batches. The matching " +
+			"instances are resolved again in every plan, the instances tagged or untagged outside of Terraform " +
+			"are shown as the changes of instance_ids.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, which is used to generate the IDs of the metric rules.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the cloud service, e.g. acs_ecs_dashboard.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The resource type of the instances in the Tag API, e.g. ALIYUN::ECS::INSTANCE.",
+				Required:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "The tags that the instances must carry, all the tags must match.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"dimension_key": schema.StringAttribute{
+				Description: "The key of the instance ID in the resources of the metric rules. Default to `instanceId`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("instanceId"),
+			},
+			"contact_groups": schema.ListAttribute{
+				Description: "List of the alert contact groups which the alarms are sent to.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"webhook": schema.StringAttribute{
+				Description: "The callback URL which the alarms are sent to.",
+				Optional:    true,
+			},
+			"effective_interval": schema.StringAttribute{
+				Description: "The period of time during which the metric rules are effective, e.g. 00:00-23:59.",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				Description: "The IDs of the instances matching the tags, which the metric rules are created for.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rules": schema.ListNestedBlock{
+				Description: "List of metric rules created for every instance. The metric rules are identified by name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_name": schema.StringAttribute{
+							Description: "The name of the metric rule, must be unique in the resource.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"metric_name": schema.StringAttribute{
+							Description: "The name of the metric, e.g. CPUUtilization.",
+							Required:    true,
+						},
+						"period": schema.Int64Attribute{
+							Description: "The aggregation period of the metric in seconds.",
+							Optional:    true,
+						},
+						"silence_time": schema.Int64Attribute{
+							Description: "The mute period in seconds during which the new alarms are not sent " +
+								"if the alarm is still not cleared.",
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"critical": cmsMetricRuleEscalationBlock("critical"),
+						"warn":     cmsMetricRuleEscalationBlock("warn"),
+						"info":     cmsMetricRuleEscalationBlock("info"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cmsMetricRuleByTagResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).cmsClient
+	r.tagClient = req.ProviderData.(alicloudClients).tagClient
+}
+
+// ModifyPlan resolves the instances matching the tags, so that the instances
+// tagged or untagged outside of Terraform are shown in the plan.
+func (r *cmsMetricRuleByTagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *cmsMetricRuleByTagModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ResourceType.IsUnknown() || plan.Tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("instance_ids"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	instanceIds, err := r.listInstancesByTags(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Resources By Tag.",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("instance_ids"),
+		types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(instanceIds))))...)
+}
+
+// Create the metric rules for every instance matching the tags.
+func (r *cmsMetricRuleByTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *cmsMetricRuleByTagModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(uuid.New().String())
+	if plan.InstanceIds.IsUnknown() {
+		instanceIds, err := r.listInstancesByTags(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Resources By Tag.",
+				err.Error(),
+			)
+			return
+		}
+		plan.InstanceIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(instanceIds)))
+	}
+
+	if err := r.putMetricRules(plan, plan.Rules, convertListValueToStrings(plan.InstanceIds)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Resource Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the metric rules. The instances missing any of the metric rules, e.g.
+// deleted outside of Terraform, are removed from instance_ids, so that the
+// metric rules are created again in the next apply.
+func (r *cmsMetricRuleByTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *cmsMetricRuleByTagModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, err := describeCmsMetricRules(r.client, state.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Metric Rule List.",
+			err.Error(),
+		)
+		return
+	}
+
+	ruleIds := make(map[string]struct{})
+	for _, alarm := range alarms {
+		ruleIds[tea.StringValue(alarm.RuleId)] = struct{}{}
+	}
+
+	instanceIds := []string{}
+	for _, instanceId := range convertListValueToStrings(state.InstanceIds) {
+		complete := true
+		for _, rule := range state.Rules {
+			if _, ok := ruleIds[cmsMetricRuleByTagRuleId(state.Id.ValueString(), rule.RuleName.ValueString(), instanceId)]; !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			instanceIds = append(instanceIds, instanceId)
+		}
+	}
+	state.InstanceIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(instanceIds)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the metric rules of the removed rules and instances, and put the
+// metric rules of the added and changed rules and instances. All the metric
+// rules are put again when any resource level attribute is changed.
+func (r *cmsMetricRuleByTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *cmsMetricRuleByTagModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	if plan.InstanceIds.IsUnknown() {
+		instanceIds, err := r.listInstancesByTags(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Resources By Tag.",
+				err.Error(),
+			)
+			return
+		}
+		plan.InstanceIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(instanceIds)))
+	}
+
+	planInstanceIds := convertListValueToStrings(plan.InstanceIds)
+	stateInstanceIds := convertListValueToStrings(state.InstanceIds)
+	planRules := make(map[string]*cmsMetricRuleTemplate)
+	for _, rule := range plan.Rules {
+		planRules[rule.RuleName.ValueString()] = rule
+	}
+	stateRules := make(map[string]*cmsMetricRuleTemplate)
+	for _, rule := range state.Rules {
+		stateRules[rule.RuleName.ValueString()] = rule
+	}
+
+	// The metric rules of the instances removed from instance_ids by Read are
+	// also deleted, in case only some of the rules are missing.
+	removedInstanceIds := make(map[string]struct{})
+	for _, instanceId := range convertStringsDifference(stateInstanceIds, planInstanceIds) {
+		removedInstanceIds[instanceId] = struct{}{}
+	}
+	var removedRuleIds []string
+	for _, rule := range state.Rules {
+		_, ruleExists := planRules[rule.RuleName.ValueString()]
+		for _, instanceId := range stateInstanceIds {
+			if _, removed := removedInstanceIds[instanceId]; removed || !ruleExists {
+				removedRuleIds = append(removedRuleIds, cmsMetricRuleByTagRuleId(state.Id.ValueString(), rule.RuleName.ValueString(), instanceId))
+			}
+		}
+	}
+	if err := deleteCmsMetricRules(r.client, removedRuleIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	batchChanged := !plan.DimensionKey.Equal(state.DimensionKey) ||
+		!plan.ContactGroups.Equal(state.ContactGroups) ||
+		!plan.Webhook.Equal(state.Webhook) ||
+		!plan.EffectiveInterval.Equal(state.EffectiveInterval)
+
+	var changedRules, unchangedRules []*cmsMetricRuleTemplate
+	for _, planRule := range plan.Rules {
+		stateRule, exists := stateRules[planRule.RuleName.ValueString()]
+		if batchChanged || !exists || !planRule.toMetricRule().equal(stateRule.toMetricRule()) {
+			changedRules = append(changedRules, planRule)
+		} else {
+			unchangedRules = append(unchangedRules, planRule)
+		}
+	}
+
+	// The changed rules are put for all the instances, and the unchanged
+	// rules are put for the added instances only.
+	if err := r.putMetricRules(plan, changedRules, planInstanceIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Resource Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+	if err := r.putMetricRules(plan, unchangedRules, convertStringsDifference(planInstanceIds, stateInstanceIds)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Resource Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the metric rules of all the instances.
+func (r *cmsMetricRuleByTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *cmsMetricRuleByTagModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleIds []string
+	for _, rule := range state.Rules {
+		for _, instanceId := range convertListValueToStrings(state.InstanceIds) {
+			ruleIds = append(ruleIds, cmsMetricRuleByTagRuleId(state.Id.ValueString(), rule.RuleName.ValueString(), instanceId))
+		}
+	}
+
+	if err := deleteCmsMetricRules(r.client, ruleIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Metric Rules.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to put the metric rules for each pair of rule and instance.
+func (r *cmsMetricRuleByTagResource) putMetricRules(model *cmsMetricRuleByTagModel, rules []*cmsMetricRuleTemplate, instanceIds []string) error {
+	var requestRules []*alicloudCmsClient.PutResourceMetricRulesRequestRules
+	for _, instanceId := range instanceIds {
+		resources, err := json.Marshal([]map[string]string{{model.DimensionKey.ValueString(): instanceId}})
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			requestRules = append(requestRules, expandCmsMetricRule(rule.toMetricRule(),
+				cmsMetricRuleByTagRuleId(model.Id.ValueString(), rule.RuleName.ValueString(), instanceId),
+				model.Namespace.ValueString(), string(resources), model.ContactGroups, model.Webhook, model.EffectiveInterval))
+		}
+	}
+	return putCmsMetricRules(r.client, requestRules)
+}
+
+// Function to list the IDs of the instances carrying all the tags. The
+// instances are listed by the first tag, and filtered by the other tags.
+func (r *cmsMetricRuleByTagResource) listInstancesByTags(model *cmsMetricRuleByTagModel) ([]string, error) {
+	tags := make(map[string]string)
+	var tagKeys []string
+	for key, value := range model.Tags.Elements() {
+		if v, ok := value.(types.String); ok {
+			tags[key] = v.ValueString()
+			tagKeys = append(tagKeys, key)
+		}
+	}
+	sort.Strings(tagKeys)
+
+	instanceIds := []string{}
+	var nextToken *string
+	for {
+		var listResourcesByTagResponse *alicloudTagClient.ListResourcesByTagResponse
+		listResourcesByTag := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listResourcesByTagRequest := &alicloudTagClient.ListResourcesByTagRequest{
+				RegionId:     r.tagClient.RegionId,
+				ResourceType: tea.String(model.ResourceType.ValueString()),
+				TagFilter: &alicloudTagClient.ListResourcesByTagRequestTagFilter{
+					Key:   tea.String(tagKeys[0]),
+					Value: tea.String(tags[tagKeys[0]]),
+				},
+				IncludeAllTags: tea.Bool(true),
+				MaxResult:      tea.Int32(1000),
+				NextToken:      nextToken,
+			}
+
+			var err error
+			listResourcesByTagResponse, err = r.tagClient.ListResourcesByTagWithOptions(listResourcesByTagRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listResourcesByTag); err != nil {
+			return nil, err
+		}
+
+		for _, tagResource := range listResourcesByTagResponse.Body.Resources {
+			resourceTags := make(map[string]string)
+			for _, tag := range tagResource.Tags {
+				resourceTags[tea.StringValue(tag.Key)] = tea.StringValue(tag.Value)
+			}
+
+			matched := true
+			for key, value := range tags {
+				if resourceValue, ok := resourceTags[key]; !ok || resourceValue != value {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				instanceIds = append(instanceIds, tea.StringValue(tagResource.ResourceId))
+			}
+		}
+
+		if tea.StringValue(listResourcesByTagResponse.Body.NextToken) == "" {
+			break
+		}
+		nextToken = listResourcesByTagResponse.Body.NextToken
+	}
+
+	sort.Strings(instanceIds)
+	return instanceIds, nil
+}
+
+// Convert the rule template into the metric rule of an instance.
+func (rule *cmsMetricRuleTemplate) toMetricRule() *cmsMetricRule {
+	return &cmsMetricRule{
+		RuleName:    rule.RuleName,
+		MetricName:  rule.MetricName,
+		Period:      rule.Period,
+		SilenceTime: rule.SilenceTime,
+		Critical:    rule.Critical,
+		Warn:        rule.Warn,
+		Info:        rule.Info,
+	}
+}
+
+// Returns the ID of the metric rule of the instance, which is derived from
+// the resource ID, so that the IDs of thousands of metric rules are not
+// stored in state.
+func cmsMetricRuleByTagRuleId(id, ruleName, instanceId string) string {
+	return uuid.NewSHA1(uuid.MustParse(id), []byte(ruleName+"/"+instanceId)).String()
+}
@@ -2,20 +2,28 @@ package alicloud
 
 import (
 	"context"
-	"time"
+	"hash/crc32"
+	"strconv"
+	"strings"
 
 	alicloudCmsClient "github.com/alibabacloud-go/cms-20190101/v8/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &cmsSystemEventContactGroupAttachmentResource{}
-	_ resource.ResourceWithConfigure = &cmsSystemEventContactGroupAttachmentResource{}
+	_ resource.Resource                     = &cmsSystemEventContactGroupAttachmentResource{}
+	_ resource.ResourceWithConfigure        = &cmsSystemEventContactGroupAttachmentResource{}
+	_ resource.ResourceWithConfigValidators = &cmsSystemEventContactGroupAttachmentResource{}
 )
 
 func NewCmsSystemEventContactGroupAttachmentResource() resource.Resource {
@@ -23,15 +31,43 @@ func NewCmsSystemEventContactGroupAttachmentResource() resource.Resource {
 }
 
 type cmsSystemEventContactGroupAttachmentResource struct {
-	client *alicloudCmsClient.Client
+	client        *alicloudCmsClient.Client
+	adoptExisting bool
 }
 
 type cmsSystemEventContactGroupAttachmentResourceModel struct {
-	RuleName         types.String `tfsdk:"rule_name"`
+	RuleName          types.String                 `tfsdk:"rule_name"`
+	ContactGroupName  types.String                 `tfsdk:"contact_group_name"`
+	Level             types.String                 `tfsdk:"level"`
+	ContactParameters []*cmsEventRuleContactTarget `tfsdk:"contact_parameters"`
+	WebhookParameters []*cmsEventRuleWebhookTarget `tfsdk:"webhook_parameters"`
+	MnsParameters     []*cmsEventRuleMnsTarget     `tfsdk:"mns_parameters"`
+	FcParameters      []*cmsEventRuleFcTarget      `tfsdk:"fc_parameters"`
+	TargetIds         types.Map                    `tfsdk:"target_ids"`
+}
+
+type cmsEventRuleContactTarget struct {
 	ContactGroupName types.String `tfsdk:"contact_group_name"`
 	Level            types.String `tfsdk:"level"`
 }
 
+type cmsEventRuleWebhookTarget struct {
+	Url      types.String `tfsdk:"url"`
+	Protocol types.String `tfsdk:"protocol"`
+	Method   types.String `tfsdk:"method"`
+}
+
+type cmsEventRuleMnsTarget struct {
+	Region types.String `tfsdk:"region"`
+	Queue  types.String `tfsdk:"queue"`
+}
+
+type cmsEventRuleFcTarget struct {
+	Region       types.String `tfsdk:"region"`
+	ServiceName  types.String `tfsdk:"service_name"`
+	FunctionName types.String `tfsdk:"function_name"`
+}
+
 func (r *cmsSystemEventContactGroupAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cms_system_event_contact_group_attachment"
 }
@@ -43,24 +79,135 @@ func (r *cmsSystemEventContactGroupAttachmentResource) Schema(_ context.Context,
 			"rule_name": schema.StringAttribute{
 				Description: "The name of the event-triggered alert rule.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"contact_group_name": schema.StringAttribute{
-				Description: "The name of the alert contact group.",
-				Required:    true,
+				Description:        "The name of the alert contact group.",
+				Optional:           true,
+				DeprecationMessage: "Use contact_parameters block instead.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("level")),
+				},
 			},
 			"level": schema.StringAttribute{
-				Description: "The alert notification methods.",
-				Required:    true,
+				Description:        "The alert notification methods.",
+				Optional:           true,
+				DeprecationMessage: "Use contact_parameters block instead.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("contact_group_name")),
+				},
+			},
+			"target_ids": schema.MapAttribute{
+				Description: "The IDs of the targets in the event rule, keyed by the type and the identifying " +
+					"attributes of the targets, e.g. contact:ops-team or mns:cn-hongkong/alert-queue.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"contact_parameters": schema.ListNestedBlock{
+				Description: "The alert contact groups which the alerts are sent to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_group_name": schema.StringAttribute{
+							Description: "The name of the alert contact group.",
+							Required:    true,
+						},
+						"level": schema.StringAttribute{
+							Description: "The alert notification methods. Valid values: 2 (phone calls, text " +
+								"messages, emails and DingTalk chatbots), 3 (text messages, emails and DingTalk " +
+								"chatbots), 4 (emails and DingTalk chatbots).",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("2", "3", "4"),
+							},
+						},
+					},
+				},
+			},
+			"webhook_parameters": schema.ListNestedBlock{
+				Description: "The callback URLs which the alerts are sent to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "The callback URL.",
+							Required:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The protocol of the callback. Valid values: http, telnet, ping.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("http", "telnet", "ping"),
+							},
+						},
+						"method": schema.StringAttribute{
+							Description: "The HTTP method of the callback. Valid values: get, post.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("get", "post"),
+							},
+						},
+					},
+				},
+			},
+			"mns_parameters": schema.ListNestedBlock{
+				Description: "The Message Service (MNS) queues which the events are sent to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Description: "The region of the MNS queue.",
+							Required:    true,
+						},
+						"queue": schema.StringAttribute{
+							Description: "The name of the MNS queue.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"fc_parameters": schema.ListNestedBlock{
+				Description: "The Function Compute (FC) functions which are triggered by the events.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Description: "The region of the FC service.",
+							Required:    true,
+						},
+						"service_name": schema.StringAttribute{
+							Description: "The name of the FC service.",
+							Required:    true,
+						},
+						"function_name": schema.StringAttribute{
+							Description: "The name of the FC function.",
+							Required:    true,
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+func (r *cmsSystemEventContactGroupAttachmentResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("contact_group_name"),
+			path.MatchRoot("contact_parameters"),
+			path.MatchRoot("webhook_parameters"),
+			path.MatchRoot("mns_parameters"),
+			path.MatchRoot("fc_parameters"),
+		),
+	}
+}
+
 func (r *cmsSystemEventContactGroupAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(alicloudClients).cmsClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
 }
 
 func (r *cmsSystemEventContactGroupAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -71,20 +218,32 @@ func (r *cmsSystemEventContactGroupAttachmentResource) Create(ctx context.Contex
 		return
 	}
 
-	if err := r.bindSystemEventGroup(plan); err != nil {
+	// The existing targets are adopted by overwriting them with their IDs,
+	// instead of adding the same targets again.
+	existingTargetIds := map[string]string{}
+	if r.adoptExisting {
+		body, err := r.describeEventRuleTargets(plan.RuleName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Event Rule Target List.",
+				err.Error(),
+			)
+			return
+		}
+		existingTargetIds = cmsEventRuleTargetIds(body)
+	}
+
+	targetIds, err := r.bindSystemEventGroup(plan, existingTargetIds)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Bind System Event Group.",
 			err.Error(),
 		)
 		return
 	}
+	plan.TargetIds = convertStringMapToMapValue(targetIds)
 
-	state := &cmsSystemEventContactGroupAttachmentResourceModel{}
-	state.RuleName = plan.RuleName
-	state.ContactGroupName = plan.ContactGroupName
-	state.Level = plan.Level
-
-	setStateDiags := resp.State.Set(ctx, &state)
+	setStateDiags := resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(setStateDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -99,81 +258,195 @@ func (r *cmsSystemEventContactGroupAttachmentResource) Read(ctx context.Context,
 		return
 	}
 
-	readSystemEventGroup := func() error {
-		runtime := &util.RuntimeOptions{}
+	// Only the targets managed by this resource are read, the other targets
+	// of the event rule are ignored.
+	stateTargetIds, err := r.stateTargetIds(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Event Rule Target List.",
+			err.Error(),
+		)
+		return
+	}
 
-		readSystemEventGroupRequest := &alicloudCmsClient.DescribeEventRuleTargetListRequest{
-			RuleName: tea.String(state.RuleName.ValueString()),
+	body, err := r.describeEventRuleTargets(state.RuleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Event Rule Target List.",
+			err.Error(),
+		)
+		return
+	}
+
+	remoteContacts := map[string]*alicloudCmsClient.DescribeEventRuleTargetListResponseBodyContactParametersContactParameter{}
+	if body.ContactParameters != nil {
+		for _, contact := range body.ContactParameters.ContactParameter {
+			remoteContacts[tea.StringValue(contact.Id)] = contact
 		}
+	}
+	remoteWebhooks := map[string]*alicloudCmsClient.DescribeEventRuleTargetListResponseBodyWebhookParametersWebhookParameter{}
+	if body.WebhookParameters != nil {
+		for _, webhook := range body.WebhookParameters.WebhookParameter {
+			remoteWebhooks[tea.StringValue(webhook.Id)] = webhook
+		}
+	}
+	remoteMns := map[string]*alicloudCmsClient.DescribeEventRuleTargetListResponseBodyMnsParametersMnsParameter{}
+	if body.MnsParameters != nil {
+		for _, mns := range body.MnsParameters.MnsParameter {
+			remoteMns[tea.StringValue(mns.Id)] = mns
+		}
+	}
+	remoteFcs := map[string]*alicloudCmsClient.DescribeEventRuleTargetListResponseBodyFcParametersFCParameter{}
+	if body.FcParameters != nil {
+		for _, fc := range body.FcParameters.FCParameter {
+			remoteFcs[tea.StringValue(fc.Id)] = fc
+		}
+	}
 
-		readSystemEventGroupResponse, err := r.client.DescribeEventRuleTargetListWithOptions(readSystemEventGroupRequest, runtime)
-		if err != nil {
-			if _t, ok := err.(*tea.SDKError); ok {
-				if isAbleToRetry(*_t.Code) {
-					return err
-				} else {
-					return backoff.Permanent(err)
-				}
-			} else {
-				return err
-			}
+	// The targets are matched by their IDs and read in the order of state,
+	// the targets which are removed from the event rule are dropped.
+	readTargetIds := map[string]string{}
+
+	legacyContactFound := false
+	if !state.ContactGroupName.IsNull() {
+		targetId := stateTargetIds[cmsEventRuleTargetKey("contact", state.ContactGroupName.ValueString())]
+		if contact, ok := remoteContacts[targetId]; ok && targetId != "" {
+			state.ContactGroupName = types.StringValue(tea.StringValue(contact.ContactGroupName))
+			state.Level = types.StringValue(tea.StringValue(contact.Level))
+			readTargetIds[cmsEventRuleTargetKey("contact", tea.StringValue(contact.ContactGroupName))] = targetId
+			legacyContactFound = true
 		}
+	}
 
-		if readSystemEventGroupResponse.Body.ContactParameters != nil {
-			for _, contactGroup := range readSystemEventGroupResponse.Body.ContactParameters.ContactParameter {
-				state.ContactGroupName = types.StringValue(*contactGroup.ContactGroupName)
-				state.Level = types.StringValue(*contactGroup.Level)
-			}
+	readContactParameters := []*cmsEventRuleContactTarget{}
+	for _, stateContact := range state.ContactParameters {
+		targetId := stateTargetIds[cmsEventRuleTargetKey("contact", stateContact.ContactGroupName.ValueString())]
+		contact, ok := remoteContacts[targetId]
+		if !ok || targetId == "" {
+			continue
+		}
+		readContactParameters = append(readContactParameters, &cmsEventRuleContactTarget{
+			ContactGroupName: types.StringValue(tea.StringValue(contact.ContactGroupName)),
+			Level:            types.StringValue(tea.StringValue(contact.Level)),
+		})
+		readTargetIds[cmsEventRuleTargetKey("contact", tea.StringValue(contact.ContactGroupName))] = targetId
+	}
 
-			setStateDiags := resp.State.Set(ctx, &state)
-			resp.Diagnostics.Append(setStateDiags...)
-			if resp.Diagnostics.HasError() {
-				resp.Diagnostics.AddError(
-					"[API ERROR] Failed to Set Read CMS System Event Group to State",
-					err.Error(),
-				)
-			}
-		} else {
-			resp.State.RemoveResource(ctx)
+	readWebhookParameters := []*cmsEventRuleWebhookTarget{}
+	for _, stateWebhook := range state.WebhookParameters {
+		targetId := stateTargetIds[cmsEventRuleTargetKey("webhook", stateWebhook.Url.ValueString())]
+		webhook, ok := remoteWebhooks[targetId]
+		if !ok || targetId == "" {
+			continue
 		}
+		readWebhookParameters = append(readWebhookParameters, &cmsEventRuleWebhookTarget{
+			Url:      types.StringValue(tea.StringValue(webhook.Url)),
+			Protocol: types.StringValue(tea.StringValue(webhook.Protocol)),
+			Method:   types.StringValue(tea.StringValue(webhook.Method)),
+		})
+		readTargetIds[cmsEventRuleTargetKey("webhook", tea.StringValue(webhook.Url))] = targetId
+	}
 
-		return nil
+	readMnsParameters := []*cmsEventRuleMnsTarget{}
+	for _, stateMns := range state.MnsParameters {
+		targetId := stateTargetIds[cmsEventRuleTargetKey("mns", stateMns.Region.ValueString(), stateMns.Queue.ValueString())]
+		mns, ok := remoteMns[targetId]
+		if !ok || targetId == "" {
+			continue
+		}
+		readMnsParameters = append(readMnsParameters, &cmsEventRuleMnsTarget{
+			Region: types.StringValue(tea.StringValue(mns.Region)),
+			Queue:  types.StringValue(tea.StringValue(mns.Queue)),
+		})
+		readTargetIds[cmsEventRuleTargetKey("mns", tea.StringValue(mns.Region), tea.StringValue(mns.Queue))] = targetId
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(readSystemEventGroup, reconnectBackoff)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"[API ERROR] Failed to Read Users for Group",
-			err.Error(),
-		)
+	readFcParameters := []*cmsEventRuleFcTarget{}
+	for _, stateFc := range state.FcParameters {
+		targetId := stateTargetIds[cmsEventRuleTargetKey("fc", stateFc.Region.ValueString(), stateFc.ServiceName.ValueString(), stateFc.FunctionName.ValueString())]
+		fc, ok := remoteFcs[targetId]
+		if !ok || targetId == "" {
+			continue
+		}
+		readFcParameters = append(readFcParameters, &cmsEventRuleFcTarget{
+			Region:       types.StringValue(tea.StringValue(fc.Region)),
+			ServiceName:  types.StringValue(tea.StringValue(fc.ServiceName)),
+			FunctionName: types.StringValue(tea.StringValue(fc.FunctionName)),
+		})
+		readTargetIds[cmsEventRuleTargetKey("fc", tea.StringValue(fc.Region), tea.StringValue(fc.ServiceName), tea.StringValue(fc.FunctionName))] = targetId
+	}
+
+	if len(readTargetIds) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !legacyContactFound {
+		state.ContactGroupName = types.StringNull()
+		state.Level = types.StringNull()
+	}
+	state.ContactParameters = readContactParameters
+	state.WebhookParameters = readWebhookParameters
+	state.MnsParameters = readMnsParameters
+	state.FcParameters = readFcParameters
+	state.TargetIds = convertStringMapToMapValue(readTargetIds)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *cmsSystemEventContactGroupAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan *cmsSystemEventContactGroupAttachmentResourceModel
+	var plan, state *cmsSystemEventContactGroupAttachmentResourceModel
 	getPlanDiags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(getPlanDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.bindSystemEventGroup(plan); err != nil {
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateTargetIds, err := r.stateTargetIds(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Event Rule Target List.",
+			err.Error(),
+		)
+		return
+	}
+
+	planTargetIds, err := r.bindSystemEventGroup(plan, stateTargetIds)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Bind System Event Group.",
 			err.Error(),
 		)
 		return
 	}
+	plan.TargetIds = convertStringMapToMapValue(planTargetIds)
 
-	state := &cmsSystemEventContactGroupAttachmentResourceModel{}
-	state.RuleName = plan.RuleName
-	state.ContactGroupName = plan.ContactGroupName
-	state.Level = plan.Level
+	// The targets are overwritten by ID, remove the targets which are no
+	// longer in the plan.
+	var staleTargetIds []string
+	for key, targetId := range stateTargetIds {
+		if _, ok := planTargetIds[key]; !ok {
+			staleTargetIds = append(staleTargetIds, targetId)
+		}
+	}
+	if err := r.unbindSystemEventGroup(plan.RuleName.ValueString(), staleTargetIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Event Rule Targets.",
+			err.Error(),
+		)
+		return
+	}
 
-	setStateDiags := resp.State.Set(ctx, &state)
+	setStateDiags := resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(setStateDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,38 +454,219 @@ func (r *cmsSystemEventContactGroupAttachmentResource) Update(ctx context.Contex
 }
 
 func (r *cmsSystemEventContactGroupAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Since Alicloud does not provide an sdk for unbinding contact groups, the delete function will not be implemented.
-}
+	var state *cmsSystemEventContactGroupAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func (r *cmsSystemEventContactGroupAttachmentResource) bindSystemEventGroup(plan *cmsSystemEventContactGroupAttachmentResourceModel) (err error) {
-	contactParameters := &alicloudCmsClient.PutEventRuleTargetsRequestContactParameters{
-		ContactGroupName: tea.String(plan.ContactGroupName.ValueString()),
-		Level:            tea.String(plan.Level.ValueString()),
+	stateTargetIds, err := r.stateTargetIds(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Event Rule Target List.",
+			err.Error(),
+		)
+		return
 	}
 
-	bindSystemEventGroupRequest := &alicloudCmsClient.PutEventRuleTargetsRequest{
-		RuleName:          tea.String(plan.RuleName.ValueString()),
-		ContactParameters: []*alicloudCmsClient.PutEventRuleTargetsRequestContactParameters{contactParameters},
+	var targetIds []string
+	for _, targetId := range stateTargetIds {
+		targetIds = append(targetIds, targetId)
 	}
+	if err := r.unbindSystemEventGroup(state.RuleName.ValueString(), targetIds); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Event Rule Targets.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to put the targets in the plan to the event rule, returns the IDs
+// of the targets. The targets in existingTargetIds are overwritten with their
+// IDs.
+func (r *cmsSystemEventContactGroupAttachmentResource) bindSystemEventGroup(plan *cmsSystemEventContactGroupAttachmentResourceModel, existingTargetIds map[string]string) (map[string]string, error) {
+	bindSystemEventGroupRequest, targetIds := newEventRuleTargetsRequest(plan, existingTargetIds)
 
 	bindSystemEventGroup := func() error {
 		runtime := &util.RuntimeOptions{}
 
 		if _, err := r.client.PutEventRuleTargetsWithOptions(bindSystemEventGroupRequest, runtime); err != nil {
-			if _t, ok := err.(*tea.SDKError); ok {
-				if isAbleToRetry(*_t.Code) {
-					return err
-				} else {
-					return backoff.Permanent(err)
-				}
-			} else {
-				return err
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(bindSystemEventGroup); err != nil {
+		return nil, err
+	}
+	return targetIds, nil
+}
+
+// Function to describe the targets of the event rule.
+func (r *cmsSystemEventContactGroupAttachmentResource) describeEventRuleTargets(ruleName string) (*alicloudCmsClient.DescribeEventRuleTargetListResponseBody, error) {
+	var describeEventRuleTargetListResponse *alicloudCmsClient.DescribeEventRuleTargetListResponse
+	describeEventRuleTargetList := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeEventRuleTargetListRequest := &alicloudCmsClient.DescribeEventRuleTargetListRequest{
+			RuleName: tea.String(ruleName),
+		}
+
+		var err error
+		describeEventRuleTargetListResponse, err = r.client.DescribeEventRuleTargetListWithOptions(describeEventRuleTargetListRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeEventRuleTargetList); err != nil {
+		return nil, err
+	}
+	return describeEventRuleTargetListResponse.Body, nil
+}
+
+// Function to get the IDs of the targets in state. The state written by the
+// older versions has no IDs, the IDs are read from the event rule by matching
+// the targets in state instead.
+func (r *cmsSystemEventContactGroupAttachmentResource) stateTargetIds(state *cmsSystemEventContactGroupAttachmentResourceModel) (map[string]string, error) {
+	targetIds := map[string]string{}
+	if !state.TargetIds.IsNull() && !state.TargetIds.IsUnknown() {
+		for key, value := range state.TargetIds.Elements() {
+			if targetId, ok := value.(types.String); ok {
+				targetIds[key] = targetId.ValueString()
 			}
 		}
+		return targetIds, nil
+	}
+
+	body, err := r.describeEventRuleTargets(state.RuleName.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	remoteTargetIds := cmsEventRuleTargetIds(body)
+	_, stateKeys := newEventRuleTargetsRequest(state, nil)
+	for key := range stateKeys {
+		if targetId, ok := remoteTargetIds[key]; ok {
+			targetIds[key] = targetId
+		}
+	}
+	return targetIds, nil
+}
+
+func (r *cmsSystemEventContactGroupAttachmentResource) unbindSystemEventGroup(ruleName string, targetIds []string) (err error) {
+	if len(targetIds) == 0 {
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return backoff.Retry(bindSystemEventGroup, reconnectBackoff)
+	unbindSystemEventGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		unbindSystemEventGroupRequest := &alicloudCmsClient.DeleteEventRuleTargetsRequest{
+			RuleName: tea.String(ruleName),
+			Ids:      tea.StringSlice(targetIds),
+		}
+
+		if _, err := r.client.DeleteEventRuleTargetsWithOptions(unbindSystemEventGroupRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(unbindSystemEventGroup)
+}
+
+// Function to build the PutEventRuleTargets request of all the targets in
+// the model, returns the IDs of the targets keyed by cmsEventRuleTargetKey.
+// Every target requires an ID which is unique in the rule, the targets in
+// existingTargetIds keep their IDs so that they are overwritten, and the new
+// targets get the IDs derived from their keys, which do not change when the
+// other targets are added or removed.
+func newEventRuleTargetsRequest(model *cmsSystemEventContactGroupAttachmentResourceModel, existingTargetIds map[string]string) (*alicloudCmsClient.PutEventRuleTargetsRequest, map[string]string) {
+	request := &alicloudCmsClient.PutEventRuleTargetsRequest{
+		RuleName: tea.String(model.RuleName.ValueString()),
+	}
+	targetIds := map[string]string{}
+	targetId := func(key string) *string {
+		id, ok := existingTargetIds[key]
+		if !ok {
+			id = strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(key))), 10)
+		}
+		targetIds[key] = id
+		return tea.String(id)
+	}
+
+	if !model.ContactGroupName.IsNull() {
+		request.ContactParameters = append(request.ContactParameters, &alicloudCmsClient.PutEventRuleTargetsRequestContactParameters{
+			Id:               targetId(cmsEventRuleTargetKey("contact", model.ContactGroupName.ValueString())),
+			ContactGroupName: tea.String(model.ContactGroupName.ValueString()),
+			Level:            tea.String(model.Level.ValueString()),
+		})
+	}
+	for _, contact := range model.ContactParameters {
+		request.ContactParameters = append(request.ContactParameters, &alicloudCmsClient.PutEventRuleTargetsRequestContactParameters{
+			Id:               targetId(cmsEventRuleTargetKey("contact", contact.ContactGroupName.ValueString())),
+			ContactGroupName: tea.String(contact.ContactGroupName.ValueString()),
+			Level:            tea.String(contact.Level.ValueString()),
+		})
+	}
+	for _, webhook := range model.WebhookParameters {
+		request.WebhookParameters = append(request.WebhookParameters, &alicloudCmsClient.PutEventRuleTargetsRequestWebhookParameters{
+			Id:       targetId(cmsEventRuleTargetKey("webhook", webhook.Url.ValueString())),
+			Url:      tea.String(webhook.Url.ValueString()),
+			Protocol: tea.String(webhook.Protocol.ValueString()),
+			Method:   tea.String(webhook.Method.ValueString()),
+		})
+	}
+	for _, mns := range model.MnsParameters {
+		request.MnsParameters = append(request.MnsParameters, &alicloudCmsClient.PutEventRuleTargetsRequestMnsParameters{
+			Id:     targetId(cmsEventRuleTargetKey("mns", mns.Region.ValueString(), mns.Queue.ValueString())),
+			Region: tea.String(mns.Region.ValueString()),
+			Queue:  tea.String(mns.Queue.ValueString()),
+		})
+	}
+	for _, fc := range model.FcParameters {
+		request.FcParameters = append(request.FcParameters, &alicloudCmsClient.PutEventRuleTargetsRequestFcParameters{
+			Id:           targetId(cmsEventRuleTargetKey("fc", fc.Region.ValueString(), fc.ServiceName.ValueString(), fc.FunctionName.ValueString())),
+			Region:       tea.String(fc.Region.ValueString()),
+			ServiceName:  tea.String(fc.ServiceName.ValueString()),
+			FunctionName: tea.String(fc.FunctionName.ValueString()),
+		})
+	}
+	return request, targetIds
+}
+
+// Function to read the IDs of the targets of the event rule, keyed by
+// cmsEventRuleTargetKey.
+func cmsEventRuleTargetIds(body *alicloudCmsClient.DescribeEventRuleTargetListResponseBody) map[string]string {
+	targetIds := map[string]string{}
+	if body.ContactParameters != nil {
+		for _, contact := range body.ContactParameters.ContactParameter {
+			targetIds[cmsEventRuleTargetKey("contact", tea.StringValue(contact.ContactGroupName))] = tea.StringValue(contact.Id)
+		}
+	}
+	if body.WebhookParameters != nil {
+		for _, webhook := range body.WebhookParameters.WebhookParameter {
+			targetIds[cmsEventRuleTargetKey("webhook", tea.StringValue(webhook.Url))] = tea.StringValue(webhook.Id)
+		}
+	}
+	if body.MnsParameters != nil {
+		for _, mns := range body.MnsParameters.MnsParameter {
+			targetIds[cmsEventRuleTargetKey("mns", tea.StringValue(mns.Region), tea.StringValue(mns.Queue))] = tea.StringValue(mns.Id)
+		}
+	}
+	if body.FcParameters != nil {
+		for _, fc := range body.FcParameters.FCParameter {
+			targetIds[cmsEventRuleTargetKey("fc", tea.StringValue(fc.Region), tea.StringValue(fc.ServiceName), tea.StringValue(fc.FunctionName))] = tea.StringValue(fc.Id)
+		}
+	}
+	return targetIds
+}
+
+// The key which identifies the target in the event rule, the other attributes
+// of the target are overwritten in place.
+func cmsEventRuleTargetKey(targetType string, values ...string) string {
+	return targetType + ":" + strings.Join(values, "/")
 }
@@ -0,0 +1,409 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudConfigClient "github.com/alibabacloud-go/config-20200907/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &configCompliancePackResource{}
+	_ resource.ResourceWithConfigure   = &configCompliancePackResource{}
+	_ resource.ResourceWithImportState = &configCompliancePackResource{}
+)
+
+func NewConfigCompliancePackResource() resource.Resource {
+	return &configCompliancePackResource{}
+}
+
+type configCompliancePackResource struct {
+	client *alicloudConfigClient.Client
+}
+
+type configCompliancePackModel struct {
+	CompliancePackId   types.String `tfsdk:"compliance_pack_id"`
+	CompliancePackName types.String `tfsdk:"compliance_pack_name"`
+	Description        types.String `tfsdk:"description"`
+	RiskLevel          types.Int64  `tfsdk:"risk_level"`
+	ConfigRuleIds      types.List   `tfsdk:"config_rule_ids"`
+}
+
+// Metadata returns the Config Compliance Pack resource name.
+func (r *configCompliancePackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_compliance_pack"
+}
+
+// Schema defines the schema for the Config Compliance Pack resource.
+func (r *configCompliancePackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a compliance pack of Cloud Config, which groups the rules of a compliance baseline.",
+		Attributes: map[string]schema.Attribute{
+			"compliance_pack_id": schema.StringAttribute{
+				Description: "The ID of the compliance pack.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compliance_pack_name": schema.StringAttribute{
+				Description: "The name of the compliance pack.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the compliance pack.",
+				Optional:    true,
+			},
+			"risk_level": schema.Int64Attribute{
+				Description: "The risk level of the compliance pack, `1` for high, `2` for medium and `3` for low. " +
+					"Default to `1`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2, 3),
+				},
+			},
+			"config_rule_ids": schema.ListAttribute{
+				Description: "The IDs of the rules in the compliance pack, e.g. the rules of st-alicloud_config_rule.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *configCompliancePackResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).configClient
+}
+
+// Create a new compliance pack with the rules, and wait for the compliance
+// pack to be active.
+func (r *configCompliancePackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *configCompliancePackModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCompliancePack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var configRules []*alicloudConfigClient.CreateCompliancePackRequestConfigRules
+		for _, configRuleId := range convertListValueToStrings(plan.ConfigRuleIds) {
+			configRules = append(configRules, &alicloudConfigClient.CreateCompliancePackRequestConfigRules{
+				ConfigRuleId: tea.String(configRuleId),
+			})
+		}
+
+		createCompliancePackRequest := &alicloudConfigClient.CreateCompliancePackRequest{
+			CompliancePackName: tea.String(plan.CompliancePackName.ValueString()),
+			Description:        stringPointerOrNil(plan.Description),
+			RiskLevel:          int32PointerOrNil(plan.RiskLevel),
+			ConfigRules:        configRules,
+		}
+
+		createCompliancePackResponse, err := r.client.CreateCompliancePackWithOptions(createCompliancePackRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.CompliancePackId = types.StringValue(tea.StringValue(createCompliancePackResponse.Body.CompliancePackId))
+		return nil
+	}
+
+	if err := retryAPICall(createCompliancePack); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Compliance Pack.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.waitForCompliancePackActive(plan.CompliancePackId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Compliance Pack to be Active.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the compliance pack.
+func (r *configCompliancePackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *configCompliancePackModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compliancePack, err := r.getCompliancePack(state.CompliancePackId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Compliance Pack.",
+			err.Error(),
+		)
+		return
+	}
+	if compliancePack == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.CompliancePackName = types.StringValue(tea.StringValue(compliancePack.CompliancePackName))
+	state.Description = stringValueOrNull(state.Description, compliancePack.Description)
+	state.RiskLevel = types.Int64Value(int64(tea.Int32Value(compliancePack.RiskLevel)))
+
+	// Keep the order of the rules in state, and append the rules added
+	// outside of Terraform.
+	var remoteConfigRuleIds []string
+	for _, configRule := range compliancePack.ConfigRules {
+		remoteConfigRuleIds = append(remoteConfigRuleIds, tea.StringValue(configRule.ConfigRuleId))
+	}
+	stateConfigRuleIds := convertListValueToStrings(state.ConfigRuleIds)
+	configRuleIds := convertStringsDifference(stateConfigRuleIds, convertStringsDifference(stateConfigRuleIds, remoteConfigRuleIds))
+	configRuleIds = append(configRuleIds, convertStringsDifference(remoteConfigRuleIds, stateConfigRuleIds)...)
+	state.ConfigRuleIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(configRuleIds)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the compliance pack, and attach or detach the rules.
+func (r *configCompliancePackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *configCompliancePackModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compliancePackId := state.CompliancePackId.ValueString()
+
+	if !plan.CompliancePackName.Equal(state.CompliancePackName) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.RiskLevel.Equal(state.RiskLevel) {
+		updateCompliancePack := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateCompliancePackRequest := &alicloudConfigClient.UpdateCompliancePackRequest{
+				CompliancePackId:   tea.String(compliancePackId),
+				CompliancePackName: tea.String(plan.CompliancePackName.ValueString()),
+				Description:        tea.String(plan.Description.ValueString()),
+				RiskLevel:          int32PointerOrNil(plan.RiskLevel),
+			}
+
+			if _, err := r.client.UpdateCompliancePackWithOptions(updateCompliancePackRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateCompliancePack); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Compliance Pack.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	planConfigRuleIds := convertListValueToStrings(plan.ConfigRuleIds)
+	stateConfigRuleIds := convertListValueToStrings(state.ConfigRuleIds)
+
+	if detachConfigRuleIds := convertStringsDifference(stateConfigRuleIds, planConfigRuleIds); len(detachConfigRuleIds) > 0 {
+		detachConfigRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			detachConfigRuleToCompliancePackRequest := &alicloudConfigClient.DetachConfigRuleToCompliancePackRequest{
+				CompliancePackId: tea.String(compliancePackId),
+				ConfigRuleIds:    tea.String(strings.Join(detachConfigRuleIds, ",")),
+			}
+
+			if _, err := r.client.DetachConfigRuleToCompliancePackWithOptions(detachConfigRuleToCompliancePackRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(detachConfigRules); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Detach Config Rules from Compliance Pack.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if attachConfigRuleIds := convertStringsDifference(planConfigRuleIds, stateConfigRuleIds); len(attachConfigRuleIds) > 0 {
+		attachConfigRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			attachConfigRuleToCompliancePackRequest := &alicloudConfigClient.AttachConfigRuleToCompliancePackRequest{
+				CompliancePackId: tea.String(compliancePackId),
+				ConfigRuleIds:    tea.String(strings.Join(attachConfigRuleIds, ",")),
+			}
+
+			if _, err := r.client.AttachConfigRuleToCompliancePackWithOptions(attachConfigRuleToCompliancePackRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(attachConfigRules); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Attach Config Rules to Compliance Pack.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.CompliancePackId = state.CompliancePackId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the compliance pack. The rules are kept, as they are managed by
+// their own resources.
+func (r *configCompliancePackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *configCompliancePackModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteCompliancePacks := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteCompliancePacksRequest := &alicloudConfigClient.DeleteCompliancePacksRequest{
+			CompliancePackIds: tea.String(state.CompliancePackId.ValueString()),
+			DeleteRule:        tea.Bool(false),
+		}
+
+		if _, err := r.client.DeleteCompliancePacksWithOptions(deleteCompliancePacksRequest, runtime); err != nil {
+			if isConfigNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteCompliancePacks); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Compliance Pack.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the compliance pack by the compliance pack ID.
+func (r *configCompliancePackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("compliance_pack_id"), req, resp)
+}
+
+// Function to get the compliance pack, returns nil if it is not found.
+func (r *configCompliancePackResource) getCompliancePack(compliancePackId string) (*alicloudConfigClient.GetCompliancePackResponseBodyCompliancePack, error) {
+	var compliancePack *alicloudConfigClient.GetCompliancePackResponseBodyCompliancePack
+	getCompliancePack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getCompliancePackRequest := &alicloudConfigClient.GetCompliancePackRequest{
+			CompliancePackId: tea.String(compliancePackId),
+		}
+
+		getCompliancePackResponse, err := r.client.GetCompliancePackWithOptions(getCompliancePackRequest, runtime)
+		if err != nil {
+			if isConfigNotFound(err) {
+				compliancePack = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		compliancePack = getCompliancePackResponse.Body.CompliancePack
+		return nil
+	}
+
+	err := retryAPICall(getCompliancePack)
+	return compliancePack, err
+}
+
+// Function to wait for the compliance pack to be active, as the rules of the
+// compliance pack are created asynchronously.
+func (r *configCompliancePackResource) waitForCompliancePackActive(compliancePackId string) error {
+	waitForActive := func() error {
+		compliancePack, err := r.getCompliancePack(compliancePackId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if compliancePack == nil {
+			return fmt.Errorf("compliance pack %s is not found", compliancePackId)
+		}
+
+		switch status := tea.StringValue(compliancePack.Status); status {
+		case "ACTIVE":
+			return nil
+		case "CREATING":
+			return fmt.Errorf("compliance pack %s is still %s", compliancePackId, status)
+		default:
+			return backoff.Permanent(fmt.Errorf("compliance pack %s is %s", compliancePackId, status))
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitForActive, waitBackoff)
+}
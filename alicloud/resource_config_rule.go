@@ -0,0 +1,419 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudConfigClient "github.com/alibabacloud-go/config-20200907/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &configRuleResource{}
+	_ resource.ResourceWithConfigure   = &configRuleResource{}
+	_ resource.ResourceWithImportState = &configRuleResource{}
+)
+
+func NewConfigRuleResource() resource.Resource {
+	return &configRuleResource{}
+}
+
+type configRuleResource struct {
+	client *alicloudConfigClient.Client
+}
+
+type configRuleModel struct {
+	ConfigRuleId              types.String `tfsdk:"config_rule_id"`
+	RuleName                  types.String `tfsdk:"rule_name"`
+	Description               types.String `tfsdk:"description"`
+	SourceOwner               types.String `tfsdk:"source_owner"`
+	SourceIdentifier          types.String `tfsdk:"source_identifier"`
+	InputParameters           types.Map    `tfsdk:"input_parameters"`
+	ConfigRuleTriggerTypes    types.String `tfsdk:"config_rule_trigger_types"`
+	MaximumExecutionFrequency types.String `tfsdk:"maximum_execution_frequency"`
+	ResourceTypesScope        types.List   `tfsdk:"resource_types_scope"`
+	TagKeyScope               types.String `tfsdk:"tag_key_scope"`
+	TagValueScope             types.String `tfsdk:"tag_value_scope"`
+	ExcludeResourceIdsScope   types.List   `tfsdk:"exclude_resource_ids_scope"`
+	RiskLevel                 types.Int64  `tfsdk:"risk_level"`
+}
+
+// Metadata returns the Config Rule resource name.
+func (r *configRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_rule"
+}
+
+// Schema defines the schema for the Config Rule resource.
+func (r *configRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a managed or custom rule of Cloud Config, which evaluates the compliance of the resources in the scope.",
+		Attributes: map[string]schema.Attribute{
+			"config_rule_id": schema.StringAttribute{
+				Description: "The ID of the rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_name": schema.StringAttribute{
+				Description: "The name of the rule.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the rule.",
+				Optional:    true,
+			},
+			"source_owner": schema.StringAttribute{
+				Description: "The type of the rule, either `ALIYUN` for the managed rule or `CUSTOM_FC` for the custom " +
+					"rule backed by a Function Compute function.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALIYUN", "CUSTOM_FC"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_identifier": schema.StringAttribute{
+				Description: "The identifier of the managed rule, e.g. `required-tags`, or the ARN of the function " +
+					"of the custom rule.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_parameters": schema.MapAttribute{
+				Description: "The input parameters of the rule, e.g. `tag1Key = \"CostCenter\"` for `required-tags`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"config_rule_trigger_types": schema.StringAttribute{
+				Description: "The trigger type of the rule, either `ConfigurationItemChangeNotification` to evaluate " +
+					"the resources when the configurations are changed, or `ScheduledNotification` to evaluate the " +
+					"resources periodically.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ConfigurationItemChangeNotification", "ScheduledNotification"),
+				},
+			},
+			"maximum_execution_frequency": schema.StringAttribute{
+				Description: "The frequency of the periodic evaluation, required when config_rule_trigger_types is " +
+					"`ScheduledNotification`. Accepted values: `One_Hour`, `Three_Hours`, `Six_Hours`, " +
+					"`Twelve_Hours`, `TwentyFour_Hours`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours"),
+				},
+			},
+			"resource_types_scope": schema.ListAttribute{
+				Description: "The types of the resources evaluated by the rule, e.g. `ACS::ECS::Instance`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"tag_key_scope": schema.StringAttribute{
+				Description: "The tag key of the resources evaluated by the rule.",
+				Optional:    true,
+			},
+			"tag_value_scope": schema.StringAttribute{
+				Description: "The tag value of the resources evaluated by the rule, must be used with tag_key_scope.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tag_key_scope")),
+				},
+			},
+			"exclude_resource_ids_scope": schema.ListAttribute{
+				Description: "The IDs of the resources excluded from the evaluation.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"risk_level": schema.Int64Attribute{
+				Description: "The risk level of the resources not compliant with the rule, `1` for high, `2` for " +
+					"medium and `3` for low. Default to `1`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2, 3),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *configRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).configClient
+}
+
+// Create a new rule.
+func (r *configRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *configRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createConfigRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createConfigRuleRequest := &alicloudConfigClient.CreateConfigRuleRequest{
+			ConfigRuleName:            tea.String(plan.RuleName.ValueString()),
+			Description:               stringPointerOrNil(plan.Description),
+			SourceOwner:               tea.String(plan.SourceOwner.ValueString()),
+			SourceIdentifier:          tea.String(plan.SourceIdentifier.ValueString()),
+			InputParameters:           expandConfigRuleInputParameters(plan.InputParameters),
+			ConfigRuleTriggerTypes:    tea.String(plan.ConfigRuleTriggerTypes.ValueString()),
+			MaximumExecutionFrequency: stringPointerOrNil(plan.MaximumExecutionFrequency),
+			ResourceTypesScope:        tea.StringSlice(convertListValueToStrings(plan.ResourceTypesScope)),
+			TagKeyScope:               stringPointerOrNil(plan.TagKeyScope),
+			TagValueScope:             stringPointerOrNil(plan.TagValueScope),
+			ExcludeResourceIdsScope:   tea.String(strings.Join(convertListValueToStrings(plan.ExcludeResourceIdsScope), ",")),
+			RiskLevel:                 int32PointerOrNil(plan.RiskLevel),
+		}
+
+		createConfigRuleResponse, err := r.client.CreateConfigRuleWithOptions(createConfigRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.ConfigRuleId = types.StringValue(tea.StringValue(createConfigRuleResponse.Body.ConfigRuleId))
+		return nil
+	}
+
+	if err := retryAPICall(createConfigRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Config Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the rule.
+func (r *configRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *configRuleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configRule *alicloudConfigClient.GetConfigRuleResponseBodyConfigRule
+	getConfigRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getConfigRuleRequest := &alicloudConfigClient.GetConfigRuleRequest{
+			ConfigRuleId: tea.String(state.ConfigRuleId.ValueString()),
+		}
+
+		getConfigRuleResponse, err := r.client.GetConfigRuleWithOptions(getConfigRuleRequest, runtime)
+		if err != nil {
+			if isConfigNotFound(err) {
+				configRule = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		configRule = getConfigRuleResponse.Body.ConfigRule
+		return nil
+	}
+
+	if err := retryAPICall(getConfigRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Config Rule.",
+			err.Error(),
+		)
+		return
+	}
+	if configRule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.RuleName = types.StringValue(tea.StringValue(configRule.ConfigRuleName))
+	state.Description = stringValueOrNull(state.Description, configRule.Description)
+	if configRule.Source != nil {
+		state.SourceOwner = types.StringValue(tea.StringValue(configRule.Source.Owner))
+		state.SourceIdentifier = types.StringValue(tea.StringValue(configRule.Source.Identifier))
+	}
+	if !state.InputParameters.IsNull() || len(configRule.InputParameters) > 0 {
+		inputParameters := make(map[string]string)
+		for key, value := range configRule.InputParameters {
+			inputParameters[key] = fmt.Sprint(value)
+		}
+		state.InputParameters = convertStringMapToMapValue(inputParameters)
+	}
+	state.ConfigRuleTriggerTypes = types.StringValue(tea.StringValue(configRule.ConfigRuleTriggerTypes))
+	state.MaximumExecutionFrequency = stringValueOrNull(state.MaximumExecutionFrequency, configRule.MaximumExecutionFrequency)
+	if configRule.Scope != nil {
+		state.ResourceTypesScope = types.ListValueMust(types.StringType,
+			convertStringPointersToAttrValues(configRule.Scope.ComplianceResourceTypes))
+	}
+	state.TagKeyScope = stringValueOrNull(state.TagKeyScope, configRule.TagKeyScope)
+	state.TagValueScope = stringValueOrNull(state.TagValueScope, configRule.TagValueScope)
+	if excludeResourceIds := tea.StringValue(configRule.ExcludeResourceIdsScope); excludeResourceIds != "" {
+		state.ExcludeResourceIdsScope = types.ListValueMust(types.StringType,
+			convertStringPointersToAttrValues(tea.StringSlice(strings.Split(excludeResourceIds, ","))))
+	} else if !state.ExcludeResourceIdsScope.IsNull() {
+		state.ExcludeResourceIdsScope = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	state.RiskLevel = types.Int64Value(int64(tea.Int32Value(configRule.RiskLevel)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the rule.
+func (r *configRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *configRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateConfigRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateConfigRuleRequest := &alicloudConfigClient.UpdateConfigRuleRequest{
+			ConfigRuleId:              tea.String(state.ConfigRuleId.ValueString()),
+			ConfigRuleName:            tea.String(plan.RuleName.ValueString()),
+			Description:               tea.String(plan.Description.ValueString()),
+			InputParameters:           expandConfigRuleInputParameters(plan.InputParameters),
+			ConfigRuleTriggerTypes:    tea.String(plan.ConfigRuleTriggerTypes.ValueString()),
+			MaximumExecutionFrequency: stringPointerOrNil(plan.MaximumExecutionFrequency),
+			ResourceTypesScope:        tea.StringSlice(convertListValueToStrings(plan.ResourceTypesScope)),
+			TagKeyScope:               tea.String(plan.TagKeyScope.ValueString()),
+			TagValueScope:             tea.String(plan.TagValueScope.ValueString()),
+			ExcludeResourceIdsScope:   tea.String(strings.Join(convertListValueToStrings(plan.ExcludeResourceIdsScope), ",")),
+			RiskLevel:                 int32PointerOrNil(plan.RiskLevel),
+		}
+
+		if _, err := r.client.UpdateConfigRuleWithOptions(updateConfigRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateConfigRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Config Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ConfigRuleId = state.ConfigRuleId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the rule.
+func (r *configRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *configRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteConfigRules := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteConfigRulesRequest := &alicloudConfigClient.DeleteConfigRulesRequest{
+			ConfigRuleIds: tea.String(state.ConfigRuleId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteConfigRulesWithOptions(deleteConfigRulesRequest, runtime); err != nil {
+			if isConfigNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteConfigRules); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Config Rule.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the rule by the rule ID.
+func (r *configRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("config_rule_id"), req, resp)
+}
+
+// Function to convert the input parameters into the request of the rule.
+func expandConfigRuleInputParameters(inputParameters types.Map) map[string]interface{} {
+	if inputParameters.IsNull() || inputParameters.IsUnknown() {
+		return nil
+	}
+
+	parameters := make(map[string]interface{})
+	for key, value := range inputParameters.Elements() {
+		if v, ok := value.(types.String); ok {
+			parameters[key] = v.ValueString()
+		}
+	}
+	return parameters
+}
+
+// Returns true if the rule or the compliance pack is not found.
+func isConfigNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(_t.Code), "NotExist")
+	}
+	return false
+}
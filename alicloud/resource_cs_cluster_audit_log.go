@@ -0,0 +1,351 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v5/client"
+	alicloudSlsClient "github.com/alibabacloud-go/sls-20201230/v5/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                   = &csClusterAuditLogResource{}
+	_ resource.ResourceWithConfigure      = &csClusterAuditLogResource{}
+	_ resource.ResourceWithImportState    = &csClusterAuditLogResource{}
+	_ resource.ResourceWithValidateConfig = &csClusterAuditLogResource{}
+)
+
+func NewCsClusterAuditLogResource() resource.Resource {
+	return &csClusterAuditLogResource{}
+}
+
+type csClusterAuditLogResource struct {
+	client    *alicloudCsClient.Client
+	slsClient *alicloudSlsClient.Client
+}
+
+type csClusterAuditLogModel struct {
+	ClusterId       types.String `tfsdk:"cluster_id"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	SlsProjectName  types.String `tfsdk:"sls_project_name"`
+	SlsLogstoreName types.String `tfsdk:"sls_logstore_name"`
+}
+
+// Metadata returns the CS Cluster Audit Log resource name.
+func (r *csClusterAuditLogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cs_cluster_audit_log"
+}
+
+// Schema defines the schema for the CS Cluster Audit Log resource.
+func (r *csClusterAuditLogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable or disable the API server audit log of an ACK cluster and deliver it to a SLS project.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the ACK cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the API server audit log is enabled. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"sls_project_name": schema.StringAttribute{
+				Description: "The SLS project that the audit log is delivered to. " +
+					"The project will be created if it does not exist. " +
+					"If not set, the default project of the cluster will be used.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sls_logstore_name": schema.StringAttribute{
+				Description: "The SLS logstore that stores the audit log, which is created by ACK in the SLS project. " +
+					"ACK always delivers the audit log to the logstore audit-<cluster_id>, so only this name is accepted. " +
+					"Default to audit-<cluster_id>.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *csClusterAuditLogResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).csClient
+	r.slsClient = req.ProviderData.(alicloudClients).slsClient
+}
+
+// ValidateConfig validates that the logstore is the one ACK delivers the audit
+// log to.
+func (r *csClusterAuditLogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *csClusterAuditLogModel
+	getConfigDiags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(getConfigDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ClusterId.IsUnknown() || config.SlsLogstoreName.IsNull() || config.SlsLogstoreName.IsUnknown() {
+		return
+	}
+
+	if expected := csClusterAuditLogstoreName(config.ClusterId.ValueString()); config.SlsLogstoreName.ValueString() != expected {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sls_logstore_name"),
+			"Invalid SLS Logstore Name.",
+			fmt.Sprintf("ACK delivers the audit log of the cluster to the logstore %s, got %s.", expected, config.SlsLogstoreName.ValueString()),
+		)
+	}
+}
+
+// Configure the audit log of the cluster.
+func (r *csClusterAuditLogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *csClusterAuditLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.ensureSlsProject(plan.SlsProjectName); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create SLS Project.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updateClusterAuditLogConfig(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Cluster Audit Log Config.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.readClusterAuditProject(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Cluster Audit Project.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the audit log config of the cluster.
+func (r *csClusterAuditLogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *csClusterAuditLogModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readClusterAuditProject(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Cluster Audit Project.",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the audit log config of the cluster.
+func (r *csClusterAuditLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *csClusterAuditLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.ensureSlsProject(plan.SlsProjectName); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create SLS Project.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updateClusterAuditLogConfig(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Cluster Audit Log Config.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.readClusterAuditProject(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Cluster Audit Project.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the audit log of the cluster. The SLS project and logstore are
+// kept, so that the collected audit log is not lost.
+func (r *csClusterAuditLogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *csClusterAuditLogModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Enabled = types.BoolValue(false)
+	if err := r.updateClusterAuditLogConfig(state); err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ErrorClusterNotFound" {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Cluster Audit Log.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *csClusterAuditLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// Create the SLS project if it is specified and does not exist.
+func (r *csClusterAuditLogResource) ensureSlsProject(projectName types.String) error {
+	if projectName.IsNull() || projectName.IsUnknown() || projectName.ValueString() == "" {
+		return nil
+	}
+
+	ensureSlsProject := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		_, err := r.slsClient.GetProjectWithOptions(tea.String(projectName.ValueString()), headers, runtime)
+		if err == nil {
+			return nil
+		}
+		if _t, ok := err.(*tea.SDKError); !ok || tea.StringValue(_t.Code) != "ProjectNotExist" {
+			return handleAPIError(err)
+		}
+
+		createProjectRequest := &alicloudSlsClient.CreateProjectRequest{
+			ProjectName: tea.String(projectName.ValueString()),
+			Description: tea.String("Audit log of ACK clusters."),
+		}
+
+		if _, err := r.slsClient.CreateProjectWithOptions(createProjectRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(ensureSlsProject)
+}
+
+// Enable or disable the audit log of the cluster.
+func (r *csClusterAuditLogResource) updateClusterAuditLogConfig(model *csClusterAuditLogModel) error {
+	updateClusterAuditLogConfig := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		updateClusterAuditLogConfigRequest := &alicloudCsClient.UpdateClusterAuditLogConfigRequest{
+			Disable: tea.Bool(!model.Enabled.ValueBool()),
+		}
+		if !model.SlsProjectName.IsNull() && !model.SlsProjectName.IsUnknown() && model.SlsProjectName.ValueString() != "" {
+			updateClusterAuditLogConfigRequest.SlsProjectName = tea.String(model.SlsProjectName.ValueString())
+		}
+
+		if _, err := r.client.UpdateClusterAuditLogConfigWithOptions(tea.String(model.ClusterId.ValueString()), updateClusterAuditLogConfigRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateClusterAuditLogConfig)
+}
+
+// Read the audit log status and SLS project of the cluster into the model,
+// returns false if the cluster is not found.
+func (r *csClusterAuditLogResource) readClusterAuditProject(model *csClusterAuditLogModel) (bool, error) {
+	var getClusterAuditProjectResponse *alicloudCsClient.GetClusterAuditProjectResponse
+	getClusterAuditProject := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		var err error
+		getClusterAuditProjectResponse, err = r.client.GetClusterAuditProjectWithOptions(tea.String(model.ClusterId.ValueString()), headers, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ErrorClusterNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getClusterAuditProject); err != nil {
+		return false, err
+	}
+	if getClusterAuditProjectResponse == nil {
+		return false, nil
+	}
+
+	model.Enabled = types.BoolValue(tea.BoolValue(getClusterAuditProjectResponse.Body.AuditEnabled))
+	model.SlsProjectName = types.StringValue(tea.StringValue(getClusterAuditProjectResponse.Body.SlsProjectName))
+	if model.SlsLogstoreName.IsNull() || model.SlsLogstoreName.IsUnknown() || model.SlsLogstoreName.ValueString() == "" {
+		model.SlsLogstoreName = types.StringValue(csClusterAuditLogstoreName(model.ClusterId.ValueString()))
+	}
+	return true, nil
+}
+
+// Function to get the name of the logstore that ACK delivers the audit log of
+// the cluster to.
+func csClusterAuditLogstoreName(clusterId string) string {
+	return fmt.Sprintf("audit-%s", clusterId)
+}
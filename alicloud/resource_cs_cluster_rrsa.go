@@ -0,0 +1,294 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v5/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &csClusterRrsaResource{}
+	_ resource.ResourceWithConfigure   = &csClusterRrsaResource{}
+	_ resource.ResourceWithImportState = &csClusterRrsaResource{}
+)
+
+func NewCsClusterRrsaResource() resource.Resource {
+	return &csClusterRrsaResource{}
+}
+
+type csClusterRrsaResource struct {
+	client *alicloudCsClient.Client
+}
+
+type csClusterRrsaModel struct {
+	ClusterId        types.String `tfsdk:"cluster_id"`
+	IssuerUrl        types.String `tfsdk:"issuer_url"`
+	Audience         types.String `tfsdk:"audience"`
+	OidcProviderName types.String `tfsdk:"oidc_provider_name"`
+	OidcProviderArn  types.String `tfsdk:"oidc_provider_arn"`
+}
+
+// The RRSA config in the meta data of the cluster.
+type csClusterRrsaConfig struct {
+	Enabled  bool   `json:"enabled"`
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	OidcName string `json:"oidc_name"`
+	OidcArn  string `json:"oidc_arn"`
+}
+
+// Metadata returns the CS Cluster RRSA resource name.
+func (r *csClusterRrsaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cs_cluster_rrsa"
+}
+
+// Schema defines the schema for the CS Cluster RRSA resource.
+func (r *csClusterRrsaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable the RAM Roles for Service Accounts (RRSA) of an ACK cluster. The OIDC provider of the " +
+			"cluster is created in RAM by ACK when RRSA is enabled, and the OIDC provider ARN can be used in the " +
+			"trust policy of the RAM roles assumed by the pods. RRSA is disabled when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the ACK cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issuer_url": schema.StringAttribute{
+				Description: "The issuer URL of the OIDC tokens of the service accounts.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audience": schema.StringAttribute{
+				Description: "The audience of the OIDC tokens of the service accounts.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oidc_provider_name": schema.StringAttribute{
+				Description: "The name of the OIDC provider in RAM.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oidc_provider_arn": schema.StringAttribute{
+				Description: "The ARN of the OIDC provider in RAM.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *csClusterRrsaResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).csClient
+}
+
+// Enable the RRSA of the cluster.
+func (r *csClusterRrsaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *csClusterRrsaModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.modifyClusterRrsa(plan.ClusterId.ValueString(), true); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Cluster RRSA.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.waitClusterRrsa(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Cluster RRSA to be Enabled.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the RRSA config of the cluster.
+func (r *csClusterRrsaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *csClusterRrsaModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rrsaConfig, err := r.describeClusterRrsa(state.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Cluster Detail.",
+			err.Error(),
+		)
+		return
+	}
+	if rrsaConfig == nil || !rrsaConfig.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	setClusterRrsaModel(state, rrsaConfig)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing as the cluster ID requires replacement.
+func (r *csClusterRrsaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *csClusterRrsaModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the RRSA of the cluster. The OIDC provider is removed by ACK.
+func (r *csClusterRrsaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *csClusterRrsaModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.modifyClusterRrsa(state.ClusterId.ValueString(), false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Cluster RRSA.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *csClusterRrsaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// Enable or disable the RRSA of the cluster.
+func (r *csClusterRrsaResource) modifyClusterRrsa(clusterId string, enabled bool) error {
+	modifyCluster := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		modifyClusterRequest := &alicloudCsClient.ModifyClusterRequest{
+			EnableRrsa: tea.Bool(enabled),
+		}
+
+		if _, err := r.client.ModifyClusterWithOptions(tea.String(clusterId), modifyClusterRequest, headers, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && !enabled && tea.StringValue(_t.Code) == "ErrorClusterNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyCluster)
+}
+
+// Wait until the RRSA is enabled and the OIDC provider is created by ACK.
+func (r *csClusterRrsaResource) waitClusterRrsa(model *csClusterRrsaModel) error {
+	waitClusterRrsa := func() error {
+		rrsaConfig, err := r.describeClusterRrsa(model.ClusterId.ValueString())
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if rrsaConfig == nil || !rrsaConfig.Enabled || rrsaConfig.OidcArn == "" {
+			return fmt.Errorf("RRSA of cluster %s is not enabled yet", model.ClusterId.ValueString())
+		}
+
+		setClusterRrsaModel(model, rrsaConfig)
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitClusterRrsa, reconnectBackoff)
+}
+
+// Function to get the RRSA config from the meta data of the cluster, returns
+// nil if the cluster is not found or RRSA has never been enabled.
+func (r *csClusterRrsaResource) describeClusterRrsa(clusterId string) (*csClusterRrsaConfig, error) {
+	var describeClusterDetailResponse *alicloudCsClient.DescribeClusterDetailResponse
+	describeClusterDetail := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		var err error
+		describeClusterDetailResponse, err = r.client.DescribeClusterDetailWithOptions(tea.String(clusterId), headers, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "ErrorClusterNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeClusterDetail); err != nil {
+		return nil, err
+	}
+	if describeClusterDetailResponse == nil || describeClusterDetailResponse.Body.MetaData == nil {
+		return nil, nil
+	}
+
+	var metaData struct {
+		RRSAConfig *csClusterRrsaConfig `json:"RRSAConfig"`
+	}
+	if err := json.Unmarshal([]byte(tea.StringValue(describeClusterDetailResponse.Body.MetaData)), &metaData); err != nil {
+		return nil, fmt.Errorf("failed to parse the meta data of cluster %s: %w", clusterId, err)
+	}
+	return metaData.RRSAConfig, nil
+}
+
+func setClusterRrsaModel(model *csClusterRrsaModel, rrsaConfig *csClusterRrsaConfig) {
+	model.IssuerUrl = types.StringValue(rrsaConfig.Issuer)
+	model.Audience = types.StringValue(rrsaConfig.Audience)
+	model.OidcProviderName = types.StringValue(rrsaConfig.OidcName)
+	model.OidcProviderArn = types.StringValue(rrsaConfig.OidcArn)
+}
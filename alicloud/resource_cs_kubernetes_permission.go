@@ -2,25 +2,27 @@ package alicloud
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strings"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v4/client"
+	alicloudCsClient "github.com/alibabacloud-go/cs-20151215/v5/client"
 )
 
 var (
-	_ resource.Resource              = &csKubernetesPermissionsResource{}
-	_ resource.ResourceWithConfigure = &csKubernetesPermissionsResource{}
+	_ resource.Resource                = &csKubernetesPermissionsResource{}
+	_ resource.ResourceWithConfigure   = &csKubernetesPermissionsResource{}
+	_ resource.ResourceWithImportState = &csKubernetesPermissionsResource{}
 )
 
 func NewCsKubernetesPermissionsResource() resource.Resource {
@@ -195,8 +197,8 @@ func (r *csKubernetesPermissionsResource) Update(ctx context.Context, req resour
 
 	// Only remove the permissions from terraform state.
 	var updatedPermission []*alicloudCsClient.GrantPermissionsRequestBody
-	var isExist []bool
 	for _, extPerm := range existing_perms {
+		isExist := []bool{}
 		for _, perm := range convertPermissionsValueToGrantPermissionsRequestBody(state.Permissions) {
 			isExist = append(isExist, reflect.DeepEqual(extPerm, perm))
 		}
@@ -254,8 +256,8 @@ func (r *csKubernetesPermissionsResource) Delete(ctx context.Context, req resour
 
 	// Only remove the permissions from terraform state.
 	var preserved_perms []*alicloudCsClient.GrantPermissionsRequestBody
-	var isExist []bool
 	for _, extPerm := range existing_perms {
+		isExist := []bool{}
 		for _, perm := range convertPermissionsValueToGrantPermissionsRequestBody(state.Permissions) {
 			isExist = append(isExist, reflect.DeepEqual(extPerm, perm))
 		}
@@ -275,6 +277,57 @@ func (r *csKubernetesPermissionsResource) Delete(ctx context.Context, req resour
 	}
 }
 
+// ImportState imports the existing permissions of a RAM user by the ID in
+// the format of <uid> or <uid>:<cluster_id>[,<cluster_id>...], the latter
+// only imports the permissions of the given clusters.
+func (r *csKubernetesPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	uid, clusters, err := parsePermissionImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <uid> or <uid>:<cluster_id>[,<cluster_id>...]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	existingPerms, err := r.describeUserPermission(uid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to query user's existing permission.",
+			err.Error(),
+		)
+		return
+	}
+
+	importedPerms := []*permissions{}
+	for _, perm := range existingPerms {
+		if len(clusters) > 0 && !clusters[tea.StringValue(perm.Cluster)] {
+			continue
+		}
+		importedPerm := &permissions{
+			Cluster:   types.StringValue(tea.StringValue(perm.Cluster)),
+			IsCustom:  types.BoolNull(),
+			RoleName:  types.StringValue(tea.StringValue(perm.RoleName)),
+			RoleType:  types.StringValue(tea.StringValue(perm.RoleType)),
+			Namespace: types.StringNull(),
+			IsRamRole: types.BoolNull(),
+		}
+		if tea.BoolValue(perm.IsCustom) {
+			importedPerm.IsCustom = types.BoolValue(true)
+		}
+		if tea.StringValue(perm.Namespace) != "" {
+			importedPerm.Namespace = types.StringValue(tea.StringValue(perm.Namespace))
+		}
+		if tea.BoolValue(perm.IsRamRole) {
+			importedPerm.IsRamRole = types.BoolValue(true)
+		}
+		importedPerms = append(importedPerms, importedPerm)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), uid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permissions"), importedPerms)...)
+}
+
 func allFalse(list []bool) bool {
 	for _, value := range list {
 		if value == true {
@@ -328,9 +381,7 @@ func (r *csKubernetesPermissionsResource) describeUserPermission(uid string) ([]
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeUserPermission, reconnectBackoff)
+	err = retryAPICall(describeUserPermission)
 	if err != nil {
 		return permissions, err
 	}
@@ -408,9 +459,7 @@ func (r *csKubernetesPermissionsResource) grantPermissions(uid string, request [
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(grantPermissions, reconnectBackoff)
+	err = retryAPICall(grantPermissions)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,387 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDataworksClient "github.com/alibabacloud-go/dataworks-public-20200518/v5/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &dataworksProjectMemberResource{}
+	_ resource.ResourceWithConfigure   = &dataworksProjectMemberResource{}
+	_ resource.ResourceWithImportState = &dataworksProjectMemberResource{}
+)
+
+func NewDataworksProjectMemberResource() resource.Resource {
+	return &dataworksProjectMemberResource{}
+}
+
+type dataworksProjectMemberResource struct {
+	client        *alicloudDataworksClient.Client
+	adoptExisting bool
+}
+
+type dataworksProjectMemberModel struct {
+	ProjectId types.Int64  `tfsdk:"project_id"`
+	UserId    types.String `tfsdk:"user_id"`
+	RoleCodes types.List   `tfsdk:"role_codes"`
+	UserName  types.String `tfsdk:"user_name"`
+}
+
+// Metadata returns the DataWorks project member resource name.
+func (r *dataworksProjectMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataworks_project_member"
+}
+
+// Schema defines the schema for the DataWorks project member resource.
+func (r *dataworksProjectMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a RAM user as the member of a DataWorks project and the roles bound to the member.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Description: "The ID of the DataWorks project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the RAM user to be added to the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_codes": schema.ListAttribute{
+				Description: "The codes of the roles bound to the member, e.g. role_project_admin, " +
+					"role_project_dev, role_project_pe, role_project_deploy, role_project_guest, " +
+					"role_project_security or the code of a custom role.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description: "The name of the member in the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dataworksProjectMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dataworksClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the project member and bind the roles.
+func (r *dataworksProjectMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *dataworksProjectMemberModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleCodes := convertListValueToStrings(plan.RoleCodes)
+	createProjectMember := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createProjectMemberRequest := &alicloudDataworksClient.CreateProjectMemberRequest{
+			ProjectId: tea.Int64(plan.ProjectId.ValueInt64()),
+			UserId:    tea.String(plan.UserId.ValueString()),
+			RoleCode:  tea.String(roleCodes[0]),
+		}
+
+		if _, err := r.client.CreateProjectMemberWithOptions(createProjectMemberRequest, runtime); err != nil {
+			// The user is already a member of the project.
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createProjectMember); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create DataWorks Project Member.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The adopted member may have the other roles bound already.
+	member, err := r.describeProjectMember(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List DataWorks Project Members.",
+			err.Error(),
+		)
+		return
+	}
+	var boundRoleCodes []string
+	if member != nil {
+		boundRoleCodes = dataworksMemberRoleCodes(member)
+		plan.UserName = types.StringValue(tea.StringValue(member.ProjectMemberName))
+	} else {
+		boundRoleCodes = roleCodes[:1]
+		plan.UserName = types.StringNull()
+	}
+
+	if err := r.updateRoles(plan, boundRoleCodes, roleCodes); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update DataWorks Project Member Roles.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the project member and the bound roles.
+func (r *dataworksProjectMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *dataworksProjectMemberModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.describeProjectMember(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List DataWorks Project Members.",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the order of the roles in the state, the roles bound outside of
+	// Terraform are appended at the end.
+	boundRoleCodes := dataworksMemberRoleCodes(member)
+	stateRoleCodes := convertListValueToStrings(state.RoleCodes)
+	roleCodes := convertStringsDifference(stateRoleCodes, convertStringsDifference(stateRoleCodes, boundRoleCodes))
+	roleCodes = append(roleCodes, convertStringsDifference(boundRoleCodes, stateRoleCodes)...)
+
+	state.RoleCodes = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(roleCodes)))
+	state.UserName = types.StringValue(tea.StringValue(member.ProjectMemberName))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the roles bound to the project member.
+func (r *dataworksProjectMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *dataworksProjectMemberModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateRoles(plan, convertListValueToStrings(state.RoleCodes), convertListValueToStrings(plan.RoleCodes)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update DataWorks Project Member Roles.",
+			err.Error(),
+		)
+		return
+	}
+	plan.UserName = state.UserName
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the project member, the roles are unbound together.
+func (r *dataworksProjectMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *dataworksProjectMemberModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteProjectMember := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteProjectMemberRequest := &alicloudDataworksClient.DeleteProjectMemberRequest{
+			ProjectId: tea.Int64(state.ProjectId.ValueInt64()),
+			UserId:    tea.String(state.UserId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteProjectMemberWithOptions(deleteProjectMemberRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteProjectMember); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete DataWorks Project Member.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the project member with the ID in the format of
+// <project_id>:<user_id>.
+func (r *dataworksProjectMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <project_id>:<user_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId, err := strconv.ParseInt(idParts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("The project ID must be a number. Got: %q", idParts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_codes"), types.ListValueMust(types.StringType, nil))...)
+}
+
+// Function to bind the roles which are not bound yet and unbind the roles
+// which are no longer desired.
+func (r *dataworksProjectMemberResource) updateRoles(plan *dataworksProjectMemberModel, boundRoleCodes, roleCodes []string) error {
+	updateRoles := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		for _, roleCode := range convertStringsDifference(roleCodes, boundRoleCodes) {
+			addProjectMemberToRoleRequest := &alicloudDataworksClient.AddProjectMemberToRoleRequest{
+				ProjectId: tea.Int64(plan.ProjectId.ValueInt64()),
+				UserId:    tea.String(plan.UserId.ValueString()),
+				RoleCode:  tea.String(roleCode),
+			}
+
+			if _, err := r.client.AddProjectMemberToRoleWithOptions(addProjectMemberToRoleRequest, runtime); err != nil {
+				if isAlreadyExistsError(err) {
+					continue
+				}
+				return handleAPIError(err)
+			}
+		}
+
+		for _, roleCode := range convertStringsDifference(boundRoleCodes, roleCodes) {
+			removeProjectMemberFromRoleRequest := &alicloudDataworksClient.RemoveProjectMemberFromRoleRequest{
+				ProjectId: tea.Int64(plan.ProjectId.ValueInt64()),
+				UserId:    tea.String(plan.UserId.ValueString()),
+				RoleCode:  tea.String(roleCode),
+			}
+
+			if _, err := r.client.RemoveProjectMemberFromRoleWithOptions(removeProjectMemberFromRoleRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+		return nil
+	}
+
+	return retryAPICall(updateRoles)
+}
+
+// Function to find the project member of the user, returns nil if the user is
+// not a member of the project.
+func (r *dataworksProjectMemberResource) describeProjectMember(model *dataworksProjectMemberModel) (*alicloudDataworksClient.ListProjectMembersResponseBodyDataProjectMemberList, error) {
+	var member *alicloudDataworksClient.ListProjectMembersResponseBodyDataProjectMemberList
+	listProjectMembers := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		member = nil
+		pageNumber := int32(1)
+		for {
+			listProjectMembersRequest := &alicloudDataworksClient.ListProjectMembersRequest{
+				ProjectId:  tea.Int64(model.ProjectId.ValueInt64()),
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(100),
+			}
+
+			listProjectMembersResponse, err := r.client.ListProjectMembersWithOptions(listProjectMembersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			data := listProjectMembersResponse.Body.Data
+			if data == nil {
+				return nil
+			}
+			for _, m := range data.ProjectMemberList {
+				if tea.StringValue(m.ProjectMemberId) == model.UserId.ValueString() {
+					member = m
+					return nil
+				}
+			}
+			if len(data.ProjectMemberList) == 0 || pageNumber*100 >= tea.Int32Value(data.TotalCount) {
+				return nil
+			}
+			pageNumber++
+		}
+	}
+
+	err := retryAPICall(listProjectMembers)
+	return member, err
+}
+
+// Function to get the codes of the roles bound to the project member.
+func dataworksMemberRoleCodes(member *alicloudDataworksClient.ListProjectMembersResponseBodyDataProjectMemberList) []string {
+	roleCodes := []string{}
+	for _, role := range member.ProjectRoleList {
+		roleCodes = append(roleCodes, tea.StringValue(role.ProjectRoleCode))
+	}
+	return roleCodes
+}
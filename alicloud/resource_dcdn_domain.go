@@ -0,0 +1,704 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudDcdnClient "github.com/alibabacloud-go/dcdn-20180115/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	dcdnFunctionWebsocket = "websocket"
+	dcdnFunctionDynamic   = "dynamic"
+
+	dcdnSceneStatic  = "static"
+	dcdnSceneDynamic = "dynamic"
+	dcdnSceneApi     = "api"
+)
+
+// The functions of the domain config managed by the resource, the functions
+// of the CDN domain are supported by DCDN as well.
+var dcdnDomainFunctionNames = append([]string{
+	dcdnFunctionWebsocket,
+	dcdnFunctionDynamic,
+}, cdnDomainFunctionNames...)
+
+var (
+	_ resource.Resource                = &dcdnDomainResource{}
+	_ resource.ResourceWithConfigure   = &dcdnDomainResource{}
+	_ resource.ResourceWithImportState = &dcdnDomainResource{}
+)
+
+func NewDcdnDomainResource() resource.Resource {
+	return &dcdnDomainResource{}
+}
+
+type dcdnDomainResource struct {
+	client *alicloudDcdnClient.Client
+}
+
+type dcdnDomainModel struct {
+	DomainName       types.String             `tfsdk:"domain_name"`
+	Scope            types.String             `tfsdk:"scope"`
+	ResourceGroupId  types.String             `tfsdk:"resource_group_id"`
+	Cname            types.String             `tfsdk:"cname"`
+	Scene            types.String             `tfsdk:"scene"`
+	Sources          []*cdnDomainSource       `tfsdk:"sources"`
+	CacheTtlRules    []*cdnDomainCacheTtlRule `tfsdk:"cache_ttl_rules"`
+	Https            *cdnDomainHttps          `tfsdk:"https"`
+	Referer          *cdnDomainReferer        `tfsdk:"referer"`
+	IpAcl            *cdnDomainIpAcl          `tfsdk:"ip_acl"`
+	Websocket        *dcdnDomainWebsocket     `tfsdk:"websocket"`
+	RangeOrigin      types.String             `tfsdk:"range_origin"`
+	BackToOriginHost types.String             `tfsdk:"back_to_origin_host"`
+}
+
+type dcdnDomainWebsocket struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+// Metadata returns the DCDN domain resource name.
+func (r *dcdnDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dcdn_domain"
+}
+
+// Schema defines the schema for the DCDN domain resource.
+func (r *dcdnDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	blocks := cdnDomainConfigBlocks("The HTTPS settings of the domain.")
+	blocks["websocket"] = schema.SingleNestedBlock{
+		Description: "The WebSocket settings of the domain.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to enable WebSocket.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The timeout of the idle WebSocket connections in seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a DCDN (full-site acceleration) domain together with its configs, mirroring " +
+			"st-alicloud_cdn_domain, with the WebSocket settings and the scene templates in addition.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Description: "The accelerated domain name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description: "The acceleration region. Valid values: domestic, overseas, global.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("domestic", "overseas", "global"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_group_id": schema.StringAttribute{
+				Description: "The ID of the resource group.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cname": schema.StringAttribute{
+				Description: "The CNAME assigned to the domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scene": schema.StringAttribute{
+				Description: "The scene template of the domain. Valid values: static (static content " +
+					"only), dynamic (the static content is cached and the dynamic requests are routed " +
+					"to the origin), api (all the requests are routed to the origin).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dcdnSceneStatic, dcdnSceneDynamic, dcdnSceneApi),
+				},
+			},
+			"range_origin": schema.StringAttribute{
+				Description: "Whether to fetch the object from the origin by range. Valid values: on, off, force.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "force"),
+				},
+			},
+			"back_to_origin_host": schema.StringAttribute{
+				Description: "The Host header of the back-to-origin requests.",
+				Optional:    true,
+			},
+		},
+		Blocks: blocks,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dcdnDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).dcdnClient
+}
+
+// Create the DCDN domain and set its configs.
+func (r *dcdnDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *dcdnDomainModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := newCdnDomainSources(plan.Sources)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal DCDN Domain Sources.",
+			err.Error(),
+		)
+		return
+	}
+
+	addDcdnDomain := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addDcdnDomainRequest := &alicloudDcdnClient.AddDcdnDomainRequest{
+			DomainName:      tea.String(plan.DomainName.ValueString()),
+			Sources:         tea.String(sources),
+			Scope:           stringPointerOrNil(plan.Scope),
+			ResourceGroupId: stringPointerOrNil(plan.ResourceGroupId),
+		}
+
+		if _, err := r.client.AddDcdnDomainWithOptions(addDcdnDomainRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(addDcdnDomain); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add DCDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := r.waitDcdnDomainConfigurable(plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait DCDN Domain to be Online.",
+			err.Error(),
+		)
+		return
+	}
+	plan.Cname = types.StringValue(tea.StringValue(domain.Cname))
+	plan.Scope = types.StringValue(tea.StringValue(domain.Scope))
+	plan.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+
+	if err := r.setDcdnDomainConfigs(plan.DomainName.ValueString(), nil, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set DCDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the DCDN domain and its configs.
+func (r *dcdnDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *dcdnDomainModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.describeDcdnDomain(state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe DCDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+	if domain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Scope = types.StringValue(tea.StringValue(domain.Scope))
+	state.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+	state.Cname = types.StringValue(tea.StringValue(domain.Cname))
+
+	sources := []*cdnDomainSource{}
+	if domain.Sources != nil {
+		for _, source := range domain.Sources.Source {
+			priority, _ := strconv.ParseInt(tea.StringValue(source.Priority), 10, 64)
+			weight, _ := strconv.ParseInt(tea.StringValue(source.Weight), 10, 64)
+			sources = append(sources, &cdnDomainSource{
+				Type:     types.StringValue(tea.StringValue(source.Type)),
+				Content:  types.StringValue(tea.StringValue(source.Content)),
+				Port:     types.Int64Value(int64(tea.Int32Value(source.Port))),
+				Priority: types.Int64Value(priority),
+				Weight:   types.Int64Value(weight),
+			})
+		}
+	}
+	state.Sources = sources
+
+	domainConfigs, err := r.describeDcdnDomainConfigs(state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe DCDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	functions := map[string][]map[string]string{}
+	for _, domainConfig := range domainConfigs {
+		args := map[string]string{}
+		if domainConfig.FunctionArgs != nil {
+			for _, arg := range domainConfig.FunctionArgs.FunctionArg {
+				args[tea.StringValue(arg.ArgName)] = tea.StringValue(arg.ArgValue)
+			}
+		}
+		functionName := tea.StringValue(domainConfig.FunctionName)
+		functions[functionName] = append(functions[functionName], args)
+	}
+
+	resp.Diagnostics.Append(state.flattenDcdnDomainFunctions(functions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the DCDN domain and the changed configs.
+func (r *dcdnDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *dcdnDomainModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !reflect.DeepEqual(plan.Sources, state.Sources) ||
+		(!plan.ResourceGroupId.IsUnknown() && !plan.ResourceGroupId.Equal(state.ResourceGroupId)) {
+		sources, err := newCdnDomainSources(plan.Sources)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Marshal DCDN Domain Sources.",
+				err.Error(),
+			)
+			return
+		}
+
+		updateDcdnDomain := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateDcdnDomainRequest := &alicloudDcdnClient.UpdateDcdnDomainRequest{
+				DomainName:      tea.String(plan.DomainName.ValueString()),
+				Sources:         tea.String(sources),
+				ResourceGroupId: stringPointerOrNil(plan.ResourceGroupId),
+			}
+
+			if _, err := r.client.UpdateDcdnDomainWithOptions(updateDcdnDomainRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateDcdnDomain); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update DCDN Domain.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Scope.IsUnknown() && !plan.Scope.Equal(state.Scope) {
+		modifyDcdnDomainSchdmByProperty := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyDcdnDomainSchdmByPropertyRequest := &alicloudDcdnClient.ModifyDCdnDomainSchdmByPropertyRequest{
+				DomainName: tea.String(plan.DomainName.ValueString()),
+				Property:   tea.String(fmt.Sprintf(`{"coverage":"%s"}`, plan.Scope.ValueString())),
+			}
+
+			if _, err := r.client.ModifyDCdnDomainSchdmByPropertyWithOptions(modifyDcdnDomainSchdmByPropertyRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyDcdnDomainSchdmByProperty); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify DCDN Domain Scope.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if err := r.setDcdnDomainConfigs(plan.DomainName.ValueString(), state, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set DCDN Domain Configs.",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := r.describeDcdnDomain(plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe DCDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+	if domain != nil {
+		plan.Cname = types.StringValue(tea.StringValue(domain.Cname))
+		plan.Scope = types.StringValue(tea.StringValue(domain.Scope))
+		plan.ResourceGroupId = types.StringValue(tea.StringValue(domain.ResourceGroupId))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the DCDN domain.
+func (r *dcdnDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *dcdnDomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteDcdnDomain := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDcdnDomainRequest := &alicloudDcdnClient.DeleteDcdnDomainRequest{
+			DomainName: tea.String(state.DomainName.ValueString()),
+		}
+
+		if _, err := r.client.DeleteDcdnDomainWithOptions(deleteDcdnDomainRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteDcdnDomain); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete DCDN Domain.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dcdnDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain_name"), req, resp)
+}
+
+// Function to describe the DCDN domain, returns nil if the domain is not found.
+func (r *dcdnDomainResource) describeDcdnDomain(domainName string) (*alicloudDcdnClient.DescribeDcdnDomainDetailResponseBodyDomainDetail, error) {
+	var domain *alicloudDcdnClient.DescribeDcdnDomainDetailResponseBodyDomainDetail
+	describeDcdnDomainDetail := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDcdnDomainDetailRequest := &alicloudDcdnClient.DescribeDcdnDomainDetailRequest{
+			DomainName: tea.String(domainName),
+		}
+
+		describeDcdnDomainDetailResponse, err := r.client.DescribeDcdnDomainDetailWithOptions(describeDcdnDomainDetailRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == cdnDomainNotFoundErrCode {
+				domain = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		domain = describeDcdnDomainDetailResponse.Body.DomainDetail
+		return nil
+	}
+
+	err := retryAPICall(describeDcdnDomainDetail)
+	return domain, err
+}
+
+// Function to wait until the DCDN domain is able to be configured.
+func (r *dcdnDomainResource) waitDcdnDomainConfigurable(domainName string) (*alicloudDcdnClient.DescribeDcdnDomainDetailResponseBodyDomainDetail, error) {
+	var domain *alicloudDcdnClient.DescribeDcdnDomainDetailResponseBodyDomainDetail
+	waitDcdnDomainConfigurable := func() error {
+		var err error
+		domain, err = r.describeDcdnDomain(domainName)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if domain == nil {
+			return fmt.Errorf("DCDN domain %s is not found", domainName)
+		}
+		switch status := tea.StringValue(domain.DomainStatus); status {
+		case "online", "configuring":
+			return nil
+		case "check_failed", "configure_failed":
+			return backoff.Permanent(fmt.Errorf("DCDN domain %s is %s", domainName, status))
+		default:
+			return fmt.Errorf("DCDN domain %s is %s", domainName, status)
+		}
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitDcdnDomainConfigurable, reconnectBackoff)
+	return domain, err
+}
+
+// Function to describe the configs of the managed functions of the domain.
+func (r *dcdnDomainResource) describeDcdnDomainConfigs(domainName string) ([]*alicloudDcdnClient.DescribeDcdnDomainConfigsResponseBodyDomainConfigsDomainConfig, error) {
+	var domainConfigs []*alicloudDcdnClient.DescribeDcdnDomainConfigsResponseBodyDomainConfigsDomainConfig
+	describeDcdnDomainConfigs := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDcdnDomainConfigsRequest := &alicloudDcdnClient.DescribeDcdnDomainConfigsRequest{
+			DomainName:    tea.String(domainName),
+			FunctionNames: tea.String(strings.Join(dcdnDomainFunctionNames, ",")),
+		}
+
+		describeDcdnDomainConfigsResponse, err := r.client.DescribeDcdnDomainConfigsWithOptions(describeDcdnDomainConfigsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		domainConfigs = nil
+		if describeDcdnDomainConfigsResponse.Body.DomainConfigs != nil {
+			domainConfigs = describeDcdnDomainConfigsResponse.Body.DomainConfigs.DomainConfig
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeDcdnDomainConfigs)
+	return domainConfigs, err
+}
+
+// Function to apply the changed functions between the state and the plan,
+// same as st-alicloud_cdn_domain.
+func (r *dcdnDomainResource) setDcdnDomainConfigs(domainName string, state, plan *dcdnDomainModel) error {
+	oldFunctions := map[string][]*cdnDomainFunction{}
+	if state != nil {
+		oldFunctions = state.dcdnDomainFunctions()
+	}
+	newFunctions := plan.dcdnDomainFunctions()
+
+	changedFunctionNames := map[string]bool{}
+	for _, functionName := range dcdnDomainFunctionNames {
+		if !reflect.DeepEqual(oldFunctions[functionName], newFunctions[functionName]) {
+			changedFunctionNames[functionName] = true
+		}
+	}
+	if len(changedFunctionNames) == 0 {
+		return nil
+	}
+
+	if state != nil {
+		domainConfigs, err := r.describeDcdnDomainConfigs(domainName)
+		if err != nil {
+			return err
+		}
+		for _, domainConfig := range domainConfigs {
+			if !changedFunctionNames[tea.StringValue(domainConfig.FunctionName)] {
+				continue
+			}
+			if err := r.deleteDcdnDomainConfig(domainName, tea.StringValue(domainConfig.ConfigId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	functions := []*cdnDomainFunction{}
+	for _, functionName := range dcdnDomainFunctionNames {
+		if changedFunctionNames[functionName] {
+			functions = append(functions, newFunctions[functionName]...)
+		}
+	}
+	if len(functions) == 0 {
+		return nil
+	}
+
+	functionsJson, err := json.Marshal(functions)
+	if err != nil {
+		return err
+	}
+
+	batchSetDcdnDomainConfigs := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		batchSetDcdnDomainConfigsRequest := &alicloudDcdnClient.BatchSetDcdnDomainConfigsRequest{
+			DomainNames: tea.String(domainName),
+			Functions:   tea.String(string(functionsJson)),
+		}
+
+		if _, err := r.client.BatchSetDcdnDomainConfigsWithOptions(batchSetDcdnDomainConfigsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(batchSetDcdnDomainConfigs)
+}
+
+// Function to delete a config of the domain.
+func (r *dcdnDomainResource) deleteDcdnDomainConfig(domainName, configId string) error {
+	deleteDcdnSpecificConfig := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDcdnSpecificConfigRequest := &alicloudDcdnClient.DeleteDcdnSpecificConfigRequest{
+			DomainName: tea.String(domainName),
+			ConfigId:   tea.String(configId),
+		}
+
+		if _, err := r.client.DeleteDcdnSpecificConfigWithOptions(deleteDcdnSpecificConfigRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteDcdnSpecificConfig)
+}
+
+// Function to get the configs shared with the CDN domain.
+func (m *dcdnDomainModel) cdnDomainModel() *cdnDomainModel {
+	return &cdnDomainModel{
+		CacheTtlRules:    m.CacheTtlRules,
+		Https:            m.Https,
+		Referer:          m.Referer,
+		IpAcl:            m.IpAcl,
+		RangeOrigin:      m.RangeOrigin,
+		BackToOriginHost: m.BackToOriginHost,
+	}
+}
+
+// Function to convert the configs of the model into the functions of the
+// domain config grouped by the function name.
+func (m *dcdnDomainModel) dcdnDomainFunctions() map[string][]*cdnDomainFunction {
+	functions := m.cdnDomainModel().cdnDomainFunctions()
+
+	if m.Websocket != nil && !m.Websocket.Enabled.IsNull() {
+		args := []string{"enabled", cdnSwitchValue(m.Websocket.Enabled.ValueBool())}
+		if !m.Websocket.Timeout.IsNull() {
+			args = append(args, "timeout", strconv.FormatInt(m.Websocket.Timeout.ValueInt64(), 10))
+		}
+		functions[dcdnFunctionWebsocket] = []*cdnDomainFunction{newCdnDomainFunction(dcdnFunctionWebsocket, args...)}
+	}
+
+	switch m.Scene.ValueString() {
+	case dcdnSceneStatic:
+		functions[dcdnFunctionDynamic] = []*cdnDomainFunction{
+			newCdnDomainFunction(dcdnFunctionDynamic, "enable", "off"),
+		}
+	case dcdnSceneDynamic:
+		functions[dcdnFunctionDynamic] = []*cdnDomainFunction{
+			newCdnDomainFunction(dcdnFunctionDynamic, "enable", "on", "static_route_type", "default"),
+		}
+	case dcdnSceneApi:
+		functions[dcdnFunctionDynamic] = []*cdnDomainFunction{
+			newCdnDomainFunction(dcdnFunctionDynamic, "enable", "on", "static_route_type", "off"),
+		}
+	}
+
+	return functions
+}
+
+// Function to flatten the functions of the domain config into the model.
+func (m *dcdnDomainModel) flattenDcdnDomainFunctions(functions map[string][]map[string]string) diag.Diagnostics {
+	cdnModel := m.cdnDomainModel()
+	diags := cdnModel.flattenCdnDomainFunctions(functions)
+	m.CacheTtlRules = cdnModel.CacheTtlRules
+	m.Https = cdnModel.Https
+	m.Referer = cdnModel.Referer
+	m.IpAcl = cdnModel.IpAcl
+	m.RangeOrigin = cdnModel.RangeOrigin
+	m.BackToOriginHost = cdnModel.BackToOriginHost
+
+	// WebSocket settings.
+	if args := cdnFirstFunctionArgs(functions, dcdnFunctionWebsocket); args != nil {
+		websocket := &dcdnDomainWebsocket{
+			Enabled: types.BoolValue(args["enabled"] == "on"),
+			Timeout: types.Int64Null(),
+		}
+		if timeout, err := strconv.ParseInt(args["timeout"], 10, 64); err == nil && m.Websocket != nil && !m.Websocket.Timeout.IsNull() {
+			websocket.Timeout = types.Int64Value(timeout)
+		}
+		m.Websocket = websocket
+	} else if m.Websocket != nil {
+		m.Websocket.Enabled = types.BoolNull()
+	}
+
+	// Scene template.
+	if args := cdnFirstFunctionArgs(functions, dcdnFunctionDynamic); args != nil {
+		switch {
+		case args["enable"] != "on":
+			m.Scene = types.StringValue(dcdnSceneStatic)
+		case args["static_route_type"] == "off":
+			m.Scene = types.StringValue(dcdnSceneApi)
+		default:
+			m.Scene = types.StringValue(dcdnSceneDynamic)
+		}
+	} else {
+		m.Scene = types.StringNull()
+	}
+
+	return diags
+}
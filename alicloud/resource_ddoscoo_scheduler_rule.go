@@ -0,0 +1,390 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAntiddosClient "github.com/alibabacloud-go/ddoscoo-20200101/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ddoscooSchedulerRuleResource{}
+	_ resource.ResourceWithConfigure   = &ddoscooSchedulerRuleResource{}
+	_ resource.ResourceWithImportState = &ddoscooSchedulerRuleResource{}
+)
+
+func NewDdosCooSchedulerRuleResource() resource.Resource {
+	return &ddoscooSchedulerRuleResource{}
+}
+
+type ddoscooSchedulerRuleResource struct {
+	client *alicloudAntiddosClient.Client
+}
+
+type ddoscooSchedulerRuleModel struct {
+	RuleName types.String                 `tfsdk:"rule_name"`
+	RuleType types.Int64                  `tfsdk:"rule_type"`
+	Param    types.String                 `tfsdk:"param"`
+	Rules    []*ddoscooSchedulerRuleEntry `tfsdk:"rules"`
+	Cname    types.String                 `tfsdk:"cname"`
+}
+
+type ddoscooSchedulerRuleEntry struct {
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+	ValueType types.Int64  `tfsdk:"value_type"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	RegionId  types.String `tfsdk:"region_id"`
+}
+
+// The rule of the scheduler rule in the format of AliCloud API.
+type ddoscooSchedulerRuleConfig struct {
+	Type      string `json:"Type"`
+	Value     string `json:"Value"`
+	ValueType int64  `json:"ValueType"`
+	Priority  int64  `json:"Priority"`
+	RegionId  string `json:"RegionId,omitempty"`
+}
+
+// Metadata returns the Anti-DDoS scheduler rule resource name.
+func (r *ddoscooSchedulerRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ddoscoo_scheduler_rule"
+}
+
+// Schema defines the schema for the Anti-DDoS scheduler rule resource.
+func (r *ddoscooSchedulerRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a scheduling rule of Anti-DDoS Sec-Traffic Manager, which switches the traffic of " +
+			"a CNAME between the normal and the scrubbing paths.",
+		Attributes: map[string]schema.Attribute{
+			"rule_name": schema.StringAttribute{
+				Description: "The name of the scheduling rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_type": schema.Int64Attribute{
+				Description: "The type of the scheduling rule. Valid values: 2 (tiered protection), 3 (network " +
+					"acceleration), 5 (CDN interaction), 6 (cloud service interaction), 8 (secure acceleration).",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2, 3, 5, 6, 8),
+				},
+			},
+			"param": schema.StringAttribute{
+				Description: "The extra parameters of the scheduling rule in JSON, e.g. the CDN interaction " +
+					"config of the rule type 5.",
+				Optional: true,
+			},
+			"cname": schema.StringAttribute{
+				Description: "The CNAME assigned to the scheduling rule, which the domain name is resolved to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rules": schema.ListNestedBlock{
+				Description: "The addresses to schedule the traffic to. The traffic is scheduled to the address " +
+					"with the highest priority, and is switched to the Anti-DDoS address when an attack occurs.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the address. Valid values: A, CNAME.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("A", "CNAME"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The IP address or the domain name.",
+							Required:    true,
+						},
+						"value_type": schema.Int64Attribute{
+							Description: "The type of the resource of the address. Valid values: 1 (Anti-DDoS " +
+								"Pro or Premium IP), 2 (tiered protection IP), 3 (acceleration line IP), 5 " +
+								"(CDN domain name), 6 (cloud resource IP), 8 (secure acceleration IP).",
+							Required: true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "The priority of the address, from 0 to 100. The larger value has " +
+								"the higher priority.",
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"region_id": schema.StringAttribute{
+							Description: "The region of the cloud resource of the address.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ddoscooSchedulerRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).antiddosClient
+}
+
+// Create the scheduling rule.
+func (r *ddoscooSchedulerRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ddoscooSchedulerRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := newDdoscooSchedulerRules(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal Scheduler Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	var createSchedulerRuleResponse *alicloudAntiddosClient.CreateSchedulerRuleResponse
+	createSchedulerRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createSchedulerRuleRequest := &alicloudAntiddosClient.CreateSchedulerRuleRequest{
+			RuleName: tea.String(plan.RuleName.ValueString()),
+			RuleType: tea.Int32(int32(plan.RuleType.ValueInt64())),
+			Rules:    tea.String(rules),
+			Param:    stringPointerOrNil(plan.Param),
+		}
+
+		var err error
+		createSchedulerRuleResponse, err = r.client.CreateSchedulerRuleWithOptions(createSchedulerRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createSchedulerRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Scheduler Rule.",
+			err.Error(),
+		)
+		return
+	}
+	plan.Cname = types.StringValue(tea.StringValue(createSchedulerRuleResponse.Body.Cname))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the scheduling rule.
+func (r *ddoscooSchedulerRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ddoscooSchedulerRuleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeSchedulerRulesResponse *alicloudAntiddosClient.DescribeSchedulerRulesResponse
+	describeSchedulerRules := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeSchedulerRulesRequest := &alicloudAntiddosClient.DescribeSchedulerRulesRequest{
+			RuleName:   tea.String(state.RuleName.ValueString()),
+			PageNumber: tea.String("1"),
+			PageSize:   tea.String("10"),
+		}
+
+		var err error
+		describeSchedulerRulesResponse, err = r.client.DescribeSchedulerRulesWithOptions(describeSchedulerRulesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeSchedulerRules); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scheduler Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The rule name is matched fuzzily by AliCloud API.
+	var schedulerRule *alicloudAntiddosClient.DescribeSchedulerRulesResponseBodySchedulerRules
+	for _, rule := range describeSchedulerRulesResponse.Body.SchedulerRules {
+		if tea.StringValue(rule.RuleName) == state.RuleName.ValueString() {
+			schedulerRule = rule
+			break
+		}
+	}
+	if schedulerRule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ruleType, _ := strconv.ParseInt(tea.StringValue(schedulerRule.RuleType), 10, 64)
+	state.RuleType = types.Int64Value(ruleType)
+	state.Cname = types.StringValue(tea.StringValue(schedulerRule.Cname))
+	if !state.Param.IsNull() || tea.StringValue(schedulerRule.Param) != "" {
+		state.Param = types.StringValue(tea.StringValue(schedulerRule.Param))
+	}
+
+	readRules := []*ddoscooSchedulerRuleEntry{}
+	for _, rule := range schedulerRule.Rules {
+		valueType, _ := strconv.ParseInt(tea.StringValue(rule.ValueType), 10, 64)
+		priority, _ := strconv.ParseInt(tea.StringValue(rule.Priority), 10, 64)
+		readRule := &ddoscooSchedulerRuleEntry{
+			Type:      types.StringValue(tea.StringValue(rule.Type)),
+			Value:     types.StringValue(tea.StringValue(rule.Value)),
+			ValueType: types.Int64Value(valueType),
+			Priority:  types.Int64Value(priority),
+			RegionId:  types.StringNull(),
+		}
+		if tea.StringValue(rule.RegionId) != "" {
+			readRule.RegionId = types.StringValue(tea.StringValue(rule.RegionId))
+		}
+		readRules = append(readRules, readRule)
+	}
+	state.Rules = readRules
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the scheduling rule.
+func (r *ddoscooSchedulerRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ddoscooSchedulerRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := newDdoscooSchedulerRules(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal Scheduler Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	modifySchedulerRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifySchedulerRuleRequest := &alicloudAntiddosClient.ModifySchedulerRuleRequest{
+			RuleName: tea.String(plan.RuleName.ValueString()),
+			RuleType: tea.Int32(int32(plan.RuleType.ValueInt64())),
+			Rules:    tea.String(rules),
+			Param:    stringPointerOrNil(plan.Param),
+		}
+
+		if _, err := r.client.ModifySchedulerRuleWithOptions(modifySchedulerRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifySchedulerRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scheduler Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the scheduling rule.
+func (r *ddoscooSchedulerRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ddoscooSchedulerRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteSchedulerRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteSchedulerRuleRequest := &alicloudAntiddosClient.DeleteSchedulerRuleRequest{
+			RuleName: tea.String(state.RuleName.ValueString()),
+		}
+
+		if _, err := r.client.DeleteSchedulerRuleWithOptions(deleteSchedulerRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteSchedulerRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Scheduler Rule.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ddoscooSchedulerRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("rule_name"), req, resp)
+}
+
+// Function to convert the rules of the model into the JSON of AliCloud API.
+func newDdoscooSchedulerRules(model *ddoscooSchedulerRuleModel) (string, error) {
+	rules := []*ddoscooSchedulerRuleConfig{}
+	for _, rule := range model.Rules {
+		rules = append(rules, &ddoscooSchedulerRuleConfig{
+			Type:      rule.Type.ValueString(),
+			Value:     rule.Value.ValueString(),
+			ValueType: rule.ValueType.ValueInt64(),
+			Priority:  rule.Priority.ValueInt64(),
+			RegionId:  rule.RegionId.ValueString(),
+		})
+	}
+
+	rulesJson, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	return string(rulesJson), nil
+}
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 	"fmt"
 
 	"github.com/cenkalti/backoff/v4"
@@ -200,10 +199,7 @@ func (r *ddoscooWebAIProtectConfigResource) Read(ctx context.Context, req resour
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-
-	err := backoff.Retry(readWebAIProtectMode, reconnectBackoff)
+	err := retryAPICall(readWebAIProtectMode)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Read Antiddos AI Protection Mode",
@@ -335,15 +331,12 @@ func (r *ddoscooWebAIProtectConfigResource) modifyAIProtectMode(plan *ddoscooWeb
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-	err := backoff.Retry(enableAIProtectConfig, reconnectBackoff)
+	err := retryAPICall(enableAIProtectConfig)
 	if err != nil {
 		return err
 	}
 
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-	err = backoff.Retry(modifyAIProtectConfig, reconnectBackoff)
+	err = retryAPICall(modifyAIProtectConfig)
 	if err != nil {
 		return err
 	}
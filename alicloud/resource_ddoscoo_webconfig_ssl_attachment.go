@@ -188,10 +188,7 @@ func (r *ddoscooWebconfigSslAttachmentResource) Read(ctx context.Context, req re
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-
-	err := backoff.Retry(readWebRules, reconnectBackoff)
+	err := retryAPICall(readWebRules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Read domain and SSL cert",
@@ -304,14 +301,12 @@ func (r *ddoscooWebconfigSslAttachmentResource) bindCert(plan *ddoscooWebconfigS
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 60 * time.Second
-	err := backoff.Retry(bindSSLCert, reconnectBackoff)
+	err := retryAPICall(bindSSLCert)
 	if err != nil {
 		return err
 	}
 
-	err = backoff.Retry(modifySSLCert, reconnectBackoff)
+	err = retryAPICall(modifySSLCert)
 	if err != nil {
 		return err
 	}
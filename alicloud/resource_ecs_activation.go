@@ -0,0 +1,340 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ecsActivationResource{}
+	_ resource.ResourceWithConfigure   = &ecsActivationResource{}
+	_ resource.ResourceWithImportState = &ecsActivationResource{}
+)
+
+func NewEcsActivationResource() resource.Resource {
+	return &ecsActivationResource{}
+}
+
+type ecsActivationResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsActivationModel struct {
+	ActivationId      types.String `tfsdk:"activation_id"`
+	ActivationCode    types.String `tfsdk:"activation_code"`
+	Description       types.String `tfsdk:"description"`
+	InstanceName      types.String `tfsdk:"instance_name"`
+	InstanceCount     types.Int64  `tfsdk:"instance_count"`
+	TimeToLiveInHours types.Int64  `tfsdk:"time_to_live_in_hours"`
+	IpAddressRange    types.String `tfsdk:"ip_address_range"`
+}
+
+// Metadata returns the ECS Activation resource name.
+func (r *ecsActivationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_activation"
+}
+
+// Schema defines the schema for the ECS Activation resource.
+func (r *ecsActivationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a Cloud Assistant activation code, which is used to register on-premises servers or " +
+			"servers of other clouds as managed instances of Cloud Assistant. All the attributes cannot be " +
+			"changed once the activation code is created.",
+		Attributes: map[string]schema.Attribute{
+			"activation_id": schema.StringAttribute{
+				Description: "The ID of the activation code.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"activation_code": schema.StringAttribute{
+				Description: "The activation code, which is only returned when the activation code is created. " +
+					"It is null if the resource is imported.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the activation code.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Description: "The default name prefix of the managed instances registered with the activation code.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_count": schema.Int64Attribute{
+				Description: "The maximum number of the managed instances which can be registered with the " +
+					"activation code. Default to 10.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"time_to_live_in_hours": schema.Int64Attribute{
+				Description: "The validity period of the activation code in hours. Default to 4.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 876000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address_range": schema.StringAttribute{
+				Description: "The IP addresses of the servers which are allowed to use the activation code, in " +
+					"the format of an IP address, a CIDR block or an IP address range such as " +
+					"192.168.1.1-192.168.1.255. Default to all the IP addresses.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsActivationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Create the activation code.
+func (r *ecsActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsActivationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createActivationResponse *alicloudEcsClient.CreateActivationResponse
+	createActivation := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createActivationRequest := &alicloudEcsClient.CreateActivationRequest{
+			RegionId:          r.client.RegionId,
+			Description:       ecsStringPointer(plan.Description),
+			InstanceName:      ecsStringPointer(plan.InstanceName),
+			InstanceCount:     ecsInt32Pointer(plan.InstanceCount),
+			TimeToLiveInHours: tea.Int64(plan.TimeToLiveInHours.ValueInt64()),
+			IpAddressRange:    ecsStringPointer(plan.IpAddressRange),
+		}
+
+		var err error
+		createActivationResponse, err = r.client.CreateActivationWithOptions(createActivationRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createActivation); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Activation.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ActivationId = types.StringValue(tea.StringValue(createActivationResponse.Body.ActivationId))
+	plan.ActivationCode = types.StringValue(tea.StringValue(createActivationResponse.Body.ActivationCode))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the activation code. The activation code which is disabled outside of
+// Terraform is removed from state, so that a new activation code is created
+// in the next apply.
+func (r *ecsActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsActivationModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activation, err := r.describeActivation(state.ActivationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Activations.",
+			err.Error(),
+		)
+		return
+	}
+	if activation == nil || tea.BoolValue(activation.Disabled) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Description = ecsStringValue(state.Description, activation.Description)
+	state.InstanceName = ecsStringValue(state.InstanceName, activation.InstanceName)
+	state.InstanceCount = types.Int64Value(int64(tea.Int32Value(activation.InstanceCount)))
+	state.TimeToLiveInHours = types.Int64Value(tea.Int64Value(activation.TimeToLiveInHours))
+	state.IpAddressRange = ecsStringValue(state.IpAddressRange, activation.IpAddressRange)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is not supported, all the attributes require replacement.
+func (r *ecsActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Disable the activation code, and delete it if there is no managed instance
+// registered with it. The activation code with registered managed instances
+// is kept disabled, since it can only be deleted after all the managed
+// instances are deregistered.
+func (r *ecsActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsActivationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activation, err := r.describeActivation(state.ActivationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Activations.",
+			err.Error(),
+		)
+		return
+	}
+	if activation == nil {
+		return
+	}
+
+	if !tea.BoolValue(activation.Disabled) {
+		disableActivation := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			disableActivationRequest := &alicloudEcsClient.DisableActivationRequest{
+				RegionId:     r.client.RegionId,
+				ActivationId: activation.ActivationId,
+			}
+
+			if _, err := r.client.DisableActivationWithOptions(disableActivationRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(disableActivation); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Disable Activation.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	registeredCount := tea.Int32Value(activation.RegisteredCount) - tea.Int32Value(activation.DeregisteredCount)
+	if registeredCount > 0 {
+		resp.Diagnostics.AddWarning(
+			"Activation Is Disabled But Not Deleted.",
+			fmt.Sprintf("The activation %s still has %d registered managed instances, it is disabled and "+
+				"can be deleted after all the managed instances are deregistered.",
+				state.ActivationId.ValueString(), registeredCount),
+		)
+		return
+	}
+
+	deleteActivation := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteActivationRequest := &alicloudEcsClient.DeleteActivationRequest{
+			RegionId:     r.client.RegionId,
+			ActivationId: activation.ActivationId,
+		}
+
+		if _, err := r.client.DeleteActivationWithOptions(deleteActivationRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteActivation); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Activation.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ecsActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("activation_id"), req, resp)
+}
+
+// Function to read the activation code, returns nil if the activation code
+// is not found.
+func (r *ecsActivationResource) describeActivation(activationId string) (*alicloudEcsClient.DescribeActivationsResponseBodyActivationList, error) {
+	var describeActivationsResponse *alicloudEcsClient.DescribeActivationsResponse
+	describeActivations := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeActivationsRequest := &alicloudEcsClient.DescribeActivationsRequest{
+			RegionId:     r.client.RegionId,
+			ActivationId: tea.String(activationId),
+		}
+
+		var err error
+		describeActivationsResponse, err = r.client.DescribeActivationsWithOptions(describeActivationsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeActivations); err != nil {
+		return nil, err
+	}
+
+	for _, activation := range describeActivationsResponse.Body.ActivationList {
+		if tea.StringValue(activation.ActivationId) == activationId {
+			return activation, nil
+		}
+	}
+	return nil, nil
+}
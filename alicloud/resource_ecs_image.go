@@ -0,0 +1,411 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ecsImageResource{}
+	_ resource.ResourceWithConfigure   = &ecsImageResource{}
+	_ resource.ResourceWithImportState = &ecsImageResource{}
+)
+
+func NewEcsImageResource() resource.Resource {
+	return &ecsImageResource{}
+}
+
+type ecsImageResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsImageModel struct {
+	ImageId      types.String `tfsdk:"image_id"`
+	InstanceId   types.String `tfsdk:"instance_id"`
+	SnapshotId   types.String `tfsdk:"snapshot_id"`
+	ImageName    types.String `tfsdk:"image_name"`
+	Description  types.String `tfsdk:"description"`
+	ImageFamily  types.String `tfsdk:"image_family"`
+	ImageVersion types.String `tfsdk:"image_version"`
+	Architecture types.String `tfsdk:"architecture"`
+	Platform     types.String `tfsdk:"platform"`
+}
+
+// Metadata returns the ECS Image resource name.
+func (r *ecsImageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_image"
+}
+
+// Schema defines the schema for the ECS Image resource.
+func (r *ecsImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a custom image from an ECS instance or a system disk snapshot, and wait until the " +
+			"image is available.",
+		Attributes: map[string]schema.Attribute{
+			"image_id": schema.StringAttribute{
+				Description: "The ID of the image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the instance to create the image from. Conflicts with snapshot_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("snapshot_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the system disk snapshot to create the image from.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_name": schema.StringAttribute{
+				Description: "The name of the image.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the image.",
+				Optional:    true,
+			},
+			"image_family": schema.StringAttribute{
+				Description: "The image family of the image.",
+				Optional:    true,
+			},
+			"image_version": schema.StringAttribute{
+				Description: "The version of the image.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				Description: "The architecture of the image, only used when creating from a snapshot. " +
+					"Accepted values: \"x86_64\", \"arm64\".",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("x86_64", "arm64"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description: "The OS distribution of the image, only used when creating from a snapshot, e.g. Ubuntu.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsImageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Create the image and wait until the image is available.
+func (r *ecsImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsImageModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createImageResponse *alicloudEcsClient.CreateImageResponse
+	createImage := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createImageRequest := &alicloudEcsClient.CreateImageRequest{
+			RegionId:     r.client.RegionId,
+			InstanceId:   ecsStringPointer(plan.InstanceId),
+			SnapshotId:   ecsStringPointer(plan.SnapshotId),
+			ImageName:    tea.String(plan.ImageName.ValueString()),
+			Description:  ecsStringPointer(plan.Description),
+			ImageFamily:  ecsStringPointer(plan.ImageFamily),
+			ImageVersion: ecsStringPointer(plan.ImageVersion),
+			Architecture: ecsStringPointer(plan.Architecture),
+			Platform:     ecsStringPointer(plan.Platform),
+		}
+
+		var err error
+		createImageResponse, err = r.client.CreateImageWithOptions(createImageRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createImage); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Image.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ImageId = types.StringValue(tea.StringValue(createImageResponse.Body.ImageId))
+	if plan.Architecture.IsUnknown() {
+		plan.Architecture = types.StringNull()
+	}
+	if plan.Platform.IsUnknown() {
+		plan.Platform = types.StringNull()
+	}
+
+	// Save the image into state before waiting, so that the image is not
+	// leaked if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := waitEcsImageAvailable(r.client, tea.StringValue(r.client.RegionId), plan.ImageId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Image Available.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Architecture = types.StringValue(tea.StringValue(image.Architecture))
+	plan.Platform = types.StringValue(tea.StringValue(image.Platform))
+
+	setStateDiags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the image.
+func (r *ecsImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsImageModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := describeEcsImage(r.client, tea.StringValue(r.client.RegionId), state.ImageId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Images.",
+			err.Error(),
+		)
+		return
+	}
+	if image == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ImageName = types.StringValue(tea.StringValue(image.ImageName))
+	state.Description = ecsStringValue(state.Description, image.Description)
+	state.ImageFamily = ecsStringValue(state.ImageFamily, image.ImageFamily)
+	state.ImageVersion = ecsStringValue(state.ImageVersion, image.ImageVersion)
+	state.Architecture = types.StringValue(tea.StringValue(image.Architecture))
+	state.Platform = types.StringValue(tea.StringValue(image.Platform))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name, description and image family of the image.
+func (r *ecsImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ecsImageModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := modifyEcsImageAttribute(r.client, tea.StringValue(r.client.RegionId), state.ImageId.ValueString(),
+		plan.ImageName, plan.Description, plan.ImageFamily)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Image Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ImageId = state.ImageId
+	plan.Architecture = state.Architecture
+	plan.Platform = state.Platform
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the image.
+func (r *ecsImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsImageModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteEcsImage(r.client, tea.StringValue(r.client.RegionId), state.ImageId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Image.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ecsImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("image_id"), req, resp)
+}
+
+// Function to read the custom image in the region, returns nil if the image
+// is not found. The images being created are also returned.
+func describeEcsImage(client *alicloudEcsClient.Client, regionId, imageId string) (*alicloudEcsClient.DescribeImagesResponseBodyImagesImage, error) {
+	var describeImagesResponse *alicloudEcsClient.DescribeImagesResponse
+	describeImages := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeImagesRequest := &alicloudEcsClient.DescribeImagesRequest{
+			RegionId:        tea.String(regionId),
+			ImageId:         tea.String(imageId),
+			ImageOwnerAlias: tea.String("self"),
+			Status:          tea.String("Creating,Waiting,Available,UnAvailable,CreateFailed,Deprecated"),
+		}
+
+		var err error
+		describeImagesResponse, err = client.DescribeImagesWithOptions(describeImagesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeImages); err != nil {
+		return nil, err
+	}
+
+	if describeImagesResponse.Body.Images == nil || len(describeImagesResponse.Body.Images.Image) == 0 {
+		return nil, nil
+	}
+	return describeImagesResponse.Body.Images.Image[0], nil
+}
+
+// Function to wait until the custom image in the region is available, the
+// last progress of the image is returned in the error if it times out.
+func waitEcsImageAvailable(client *alicloudEcsClient.Client, regionId, imageId string) (*alicloudEcsClient.DescribeImagesResponseBodyImagesImage, error) {
+	var image *alicloudEcsClient.DescribeImagesResponseBodyImagesImage
+	waitImageAvailable := func() error {
+		var err error
+		image, err = describeEcsImage(client, regionId, imageId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if image == nil {
+			return fmt.Errorf("image %s is not found in %s", imageId, regionId)
+		}
+
+		switch status := tea.StringValue(image.Status); status {
+		case "Available":
+			return nil
+		case "CreateFailed", "UnAvailable":
+			return backoff.Permanent(fmt.Errorf("image %s in %s is %s", imageId, regionId, status))
+		default:
+			return fmt.Errorf("image %s in %s is %s, progress: %s", imageId, regionId, status, tea.StringValue(image.Progress))
+		}
+	}
+
+	if err := backoff.Retry(waitImageAvailable, newEcsProgressBackOff()); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// Function to modify the name, description and image family of the custom
+// image in the region.
+func modifyEcsImageAttribute(client *alicloudEcsClient.Client, regionId, imageId string, imageName, description, imageFamily types.String) error {
+	modifyImageAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyImageAttributeRequest := &alicloudEcsClient.ModifyImageAttributeRequest{
+			RegionId:    tea.String(regionId),
+			ImageId:     tea.String(imageId),
+			ImageName:   ecsStringPointer(imageName),
+			Description: tea.String(description.ValueString()),
+			ImageFamily: tea.String(imageFamily.ValueString()),
+		}
+
+		if _, err := client.ModifyImageAttributeWithOptions(modifyImageAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyImageAttribute)
+}
+
+// Function to delete the custom image in the region.
+func deleteEcsImage(client *alicloudEcsClient.Client, regionId, imageId string) error {
+	deleteImage := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteImageRequest := &alicloudEcsClient.DeleteImageRequest{
+			RegionId: tea.String(regionId),
+			ImageId:  tea.String(imageId),
+		}
+
+		if _, err := client.DeleteImageWithOptions(deleteImageRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidImageId.NotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteImage)
+}
@@ -0,0 +1,298 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ecsImageCopyResource{}
+	_ resource.ResourceWithConfigure   = &ecsImageCopyResource{}
+	_ resource.ResourceWithImportState = &ecsImageCopyResource{}
+)
+
+func NewEcsImageCopyResource() resource.Resource {
+	return &ecsImageCopyResource{}
+}
+
+type ecsImageCopyResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsImageCopyModel struct {
+	ImageId             types.String `tfsdk:"image_id"`
+	SourceImageId       types.String `tfsdk:"source_image_id"`
+	SourceRegionId      types.String `tfsdk:"source_region_id"`
+	DestinationRegionId types.String `tfsdk:"destination_region_id"`
+	ImageName           types.String `tfsdk:"image_name"`
+	Description         types.String `tfsdk:"description"`
+	Encrypted           types.Bool   `tfsdk:"encrypted"`
+	KmsKeyId            types.String `tfsdk:"kms_key_id"`
+}
+
+// Metadata returns the ECS Image Copy resource name.
+func (r *ecsImageCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_image_copy"
+}
+
+// Schema defines the schema for the ECS Image Copy resource.
+func (r *ecsImageCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copy a custom image to another region, and wait until the copied image is available. " +
+			"The copied image is deleted from the destination region when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"image_id": schema.StringAttribute{
+				Description: "The ID of the copied image in the destination region.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_image_id": schema.StringAttribute{
+				Description: "The ID of the custom image to copy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_region_id": schema.StringAttribute{
+				Description: "The region of the custom image to copy. Default to the region configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_region_id": schema.StringAttribute{
+				Description: "The region to copy the image to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_name": schema.StringAttribute{
+				Description: "The name of the copied image.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the copied image.",
+				Optional:    true,
+			},
+			"encrypted": schema.BoolAttribute{
+				Description: "Whether to encrypt the copied image.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_key_id": schema.StringAttribute{
+				Description: "The ID of the KMS key in the destination region to encrypt the copied image.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsImageCopyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Copy the image to the destination region and wait until the copied image
+// is available.
+func (r *ecsImageCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsImageCopyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceRegionId.IsUnknown() || plan.SourceRegionId.IsNull() {
+		plan.SourceRegionId = types.StringValue(tea.StringValue(r.client.RegionId))
+	}
+
+	var copyImageResponse *alicloudEcsClient.CopyImageResponse
+	copyImage := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		copyImageRequest := &alicloudEcsClient.CopyImageRequest{
+			RegionId:               tea.String(plan.SourceRegionId.ValueString()),
+			ImageId:                tea.String(plan.SourceImageId.ValueString()),
+			DestinationRegionId:    tea.String(plan.DestinationRegionId.ValueString()),
+			DestinationImageName:   tea.String(plan.ImageName.ValueString()),
+			DestinationDescription: ecsStringPointer(plan.Description),
+			KMSKeyId:               ecsStringPointer(plan.KmsKeyId),
+		}
+		if !plan.Encrypted.IsNull() {
+			copyImageRequest.Encrypted = tea.Bool(plan.Encrypted.ValueBool())
+		}
+
+		var err error
+		copyImageResponse, err = r.client.CopyImageWithOptions(copyImageRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(copyImage); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Copy Image.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ImageId = types.StringValue(tea.StringValue(copyImageResponse.Body.ImageId))
+
+	// Save the copied image into state before waiting, so that the image is
+	// not leaked if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := waitEcsImageAvailable(r.client, plan.DestinationRegionId.ValueString(), plan.ImageId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Copied Image Available.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the copied image in the destination region.
+func (r *ecsImageCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsImageCopyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := describeEcsImage(r.client, state.DestinationRegionId.ValueString(), state.ImageId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Images.",
+			err.Error(),
+		)
+		return
+	}
+	if image == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ImageName = types.StringValue(tea.StringValue(image.ImageName))
+	state.Description = ecsStringValue(state.Description, image.Description)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name and description of the copied image.
+func (r *ecsImageCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ecsImageCopyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := describeEcsImage(r.client, state.DestinationRegionId.ValueString(), state.ImageId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Images.",
+			err.Error(),
+		)
+		return
+	}
+	imageFamily := types.StringNull()
+	if image != nil {
+		imageFamily = types.StringValue(tea.StringValue(image.ImageFamily))
+	}
+
+	err = modifyEcsImageAttribute(r.client, state.DestinationRegionId.ValueString(), state.ImageId.ValueString(),
+		plan.ImageName, plan.Description, imageFamily)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Image Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ImageId = state.ImageId
+	plan.SourceRegionId = state.SourceRegionId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the copied image from the destination region.
+func (r *ecsImageCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsImageCopyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteEcsImage(r.client, state.DestinationRegionId.ValueString(), state.ImageId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Image.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the copied image by the ID in the format of
+// <destination_region_id>:<image_id>.
+func (r *ecsImageCopyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <destination_region_id>:<image_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_region_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_id"), ids[1])...)
+}
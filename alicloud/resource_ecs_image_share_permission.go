@@ -0,0 +1,328 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The maximum number of accounts to be added or removed in a single
+	// ModifyImageSharePermission request.
+	ecsImageSharePermissionBatchSize = 10
+)
+
+var (
+	_ resource.Resource                = &ecsImageSharePermissionResource{}
+	_ resource.ResourceWithConfigure   = &ecsImageSharePermissionResource{}
+	_ resource.ResourceWithImportState = &ecsImageSharePermissionResource{}
+)
+
+func NewEcsImageSharePermissionResource() resource.Resource {
+	return &ecsImageSharePermissionResource{}
+}
+
+type ecsImageSharePermissionResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsImageSharePermissionModel struct {
+	ImageId    types.String `tfsdk:"image_id"`
+	RegionId   types.String `tfsdk:"region_id"`
+	AccountIds types.List   `tfsdk:"account_ids"`
+}
+
+// Metadata returns the ECS Image Share Permission resource name.
+func (r *ecsImageSharePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_image_share_permission"
+}
+
+// Schema defines the schema for the ECS Image Share Permission resource.
+func (r *ecsImageSharePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the full list of accounts which a custom image is shared with. The image is unshared " +
+			"from the accounts which are not in the list.",
+		Attributes: map[string]schema.Attribute{
+			"image_id": schema.StringAttribute{
+				Description: "The ID of the custom image to share.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				Description: "The region of the custom image. Default to the region configured in the provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_ids": schema.ListAttribute{
+				Description: "List of AliCloud account IDs to share the image with.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsImageSharePermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Share the image with the accounts.
+func (r *ecsImageSharePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsImageSharePermissionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RegionId.IsUnknown() || plan.RegionId.IsNull() {
+		plan.RegionId = types.StringValue(tea.StringValue(r.client.RegionId))
+	}
+
+	err := r.modifyImageSharePermission(plan, convertListValueToStrings(plan.AccountIds), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Share Image with Accounts.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the accounts which the image is shared with. The accounts in state are
+// kept in the same order, and the accounts shared outside of Terraform are
+// appended, so that they are unshared in the next apply.
+func (r *ecsImageSharePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsImageSharePermissionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RegionId.IsNull() {
+		state.RegionId = types.StringValue(tea.StringValue(r.client.RegionId))
+	}
+
+	sharedAccountIds, err := r.describeImageSharePermission(state.RegionId.ValueString(), state.ImageId.ValueString())
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidImageId.NotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Image Share Permission.",
+			err.Error(),
+		)
+		return
+	}
+
+	shared := make(map[string]bool)
+	for _, accountId := range sharedAccountIds {
+		shared[accountId] = true
+	}
+
+	accountIds := []attr.Value{}
+	for _, accountId := range convertListValueToStrings(state.AccountIds) {
+		if shared[accountId] {
+			accountIds = append(accountIds, types.StringValue(accountId))
+			delete(shared, accountId)
+		}
+	}
+	for _, accountId := range sharedAccountIds {
+		if shared[accountId] {
+			accountIds = append(accountIds, types.StringValue(accountId))
+		}
+	}
+
+	if len(accountIds) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.AccountIds = types.ListValueMust(types.StringType, accountIds)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Share the image with the added accounts and unshare the image from the
+// removed accounts.
+func (r *ecsImageSharePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ecsImageSharePermissionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.RegionId = state.RegionId
+	planAccountIds := convertListValueToStrings(plan.AccountIds)
+	stateAccountIds := convertListValueToStrings(state.AccountIds)
+
+	err := r.modifyImageSharePermission(
+		plan,
+		convertStringsDifference(planAccountIds, stateAccountIds),
+		convertStringsDifference(stateAccountIds, planAccountIds),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Image Share Permission.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Unshare the image from all the accounts.
+func (r *ecsImageSharePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsImageSharePermissionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.modifyImageSharePermission(state, nil, convertListValueToStrings(state.AccountIds))
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidImageId.NotFound" {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Unshare Image from Accounts.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ecsImageSharePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("image_id"), req, resp)
+}
+
+// Function to list the IDs of the accounts which the image is shared with.
+func (r *ecsImageSharePermissionResource) describeImageSharePermission(regionId, imageId string) ([]string, error) {
+	accountIds := []string{}
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeImageSharePermissionResponse *alicloudEcsClient.DescribeImageSharePermissionResponse
+		describeImageSharePermission := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeImageSharePermissionRequest := &alicloudEcsClient.DescribeImageSharePermissionRequest{
+				RegionId:   tea.String(regionId),
+				ImageId:    tea.String(imageId),
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+
+			var err error
+			describeImageSharePermissionResponse, err = r.client.DescribeImageSharePermissionWithOptions(describeImageSharePermissionRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeImageSharePermission); err != nil {
+			return nil, err
+		}
+
+		if describeImageSharePermissionResponse.Body.Accounts != nil {
+			for _, account := range describeImageSharePermissionResponse.Body.Accounts.Account {
+				accountIds = append(accountIds, tea.StringValue(account.AliyunId))
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeImageSharePermissionResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return accountIds, nil
+}
+
+// Function to add and remove the accounts of the image share permission in
+// batches.
+func (r *ecsImageSharePermissionResource) modifyImageSharePermission(model *ecsImageSharePermissionModel, addAccountIds, removeAccountIds []string) error {
+	for len(addAccountIds) > 0 || len(removeAccountIds) > 0 {
+		addBatch, removeBatch := addAccountIds, removeAccountIds
+		if len(addBatch) > ecsImageSharePermissionBatchSize {
+			addBatch = addBatch[:ecsImageSharePermissionBatchSize]
+		}
+		if len(removeBatch) > ecsImageSharePermissionBatchSize {
+			removeBatch = removeBatch[:ecsImageSharePermissionBatchSize]
+		}
+		addAccountIds = addAccountIds[len(addBatch):]
+		removeAccountIds = removeAccountIds[len(removeBatch):]
+
+		modifyImageSharePermission := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyImageSharePermissionRequest := &alicloudEcsClient.ModifyImageSharePermissionRequest{
+				RegionId:      tea.String(model.RegionId.ValueString()),
+				ImageId:       tea.String(model.ImageId.ValueString()),
+				AddAccount:    tea.StringSlice(addBatch),
+				RemoveAccount: tea.StringSlice(removeBatch),
+			}
+
+			if _, err := r.client.ModifyImageSharePermissionWithOptions(modifyImageSharePermissionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyImageSharePermission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ecsNetworkInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &ecsNetworkInterfaceResource{}
+	_ resource.ResourceWithImportState = &ecsNetworkInterfaceResource{}
+)
+
+func NewEcsNetworkInterfaceResource() resource.Resource {
+	return &ecsNetworkInterfaceResource{}
+}
+
+type ecsNetworkInterfaceResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsNetworkInterfaceModel struct {
+	NetworkInterfaceId          types.String `tfsdk:"network_interface_id"`
+	VSwitchId                   types.String `tfsdk:"vswitch_id"`
+	SecurityGroupIds            types.List   `tfsdk:"security_group_ids"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	PrimaryIpAddress            types.String `tfsdk:"primary_ip_address"`
+	SecondaryPrivateIpAddresses types.List   `tfsdk:"secondary_private_ip_addresses"`
+	MacAddress                  types.String `tfsdk:"mac_address"`
+}
+
+// Metadata returns the ECS network interface resource name.
+func (r *ecsNetworkInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_network_interface"
+}
+
+// Schema defines the schema for the ECS network interface resource.
+func (r *ecsNetworkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an elastic network interface (ENI) with the static private IP addresses, so that the " +
+			"IP addresses are kept when the ENI is attached to the replaced instances.",
+		Attributes: map[string]schema.Attribute{
+			"network_interface_id": schema.StringAttribute{
+				Description: "The ID of the ENI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vswitch_id": schema.StringAttribute{
+				Description: "The ID of the vSwitch of the ENI.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_ids": schema.ListAttribute{
+				Description: "The IDs of the security groups of the ENI.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the ENI.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the ENI.",
+				Optional:    true,
+			},
+			"primary_ip_address": schema.StringAttribute{
+				Description: "The primary private IP address of the ENI. Default to be assigned from the vSwitch.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_private_ip_addresses": schema.ListAttribute{
+				Description: "The secondary private IP addresses of the ENI.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"mac_address": schema.StringAttribute{
+				Description: "The MAC address of the ENI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsNetworkInterfaceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Create the ENI and wait until it is available.
+func (r *ecsNetworkInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsNetworkInterfaceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createNetworkInterfaceResponse *alicloudEcsClient.CreateNetworkInterfaceResponse
+	createNetworkInterface := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createNetworkInterfaceRequest := &alicloudEcsClient.CreateNetworkInterfaceRequest{
+			RegionId:             r.client.RegionId,
+			VSwitchId:            tea.String(plan.VSwitchId.ValueString()),
+			SecurityGroupIds:     tea.StringSlice(convertListValueToStrings(plan.SecurityGroupIds)),
+			NetworkInterfaceName: ecsStringPointer(plan.Name),
+			Description:          ecsStringPointer(plan.Description),
+			PrimaryIpAddress:     ecsStringPointer(plan.PrimaryIpAddress),
+		}
+		if ips := convertListValueToStrings(plan.SecondaryPrivateIpAddresses); len(ips) > 0 {
+			createNetworkInterfaceRequest.PrivateIpAddress = tea.StringSlice(ips)
+		}
+
+		var err error
+		createNetworkInterfaceResponse, err = r.client.CreateNetworkInterfaceWithOptions(createNetworkInterfaceRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createNetworkInterface); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Network Interface.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.NetworkInterfaceId = types.StringValue(tea.StringValue(createNetworkInterfaceResponse.Body.NetworkInterfaceId))
+	plan.PrimaryIpAddress = types.StringValue(tea.StringValue(createNetworkInterfaceResponse.Body.PrimaryIpAddress))
+	plan.MacAddress = types.StringValue(tea.StringValue(createNetworkInterfaceResponse.Body.MacAddress))
+
+	// Save the ENI into state before waiting, so that the ENI is not leaked
+	// if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitEcsNetworkInterfaceStatus(r.client, plan.NetworkInterfaceId.ValueString(), "Available"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Network Interface Available.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the ENI.
+func (r *ecsNetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsNetworkInterfaceModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterface, err := describeEcsNetworkInterface(r.client, state.NetworkInterfaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Network Interfaces.",
+			err.Error(),
+		)
+		return
+	}
+	if networkInterface == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.VSwitchId = types.StringValue(tea.StringValue(networkInterface.VSwitchId))
+	state.Name = ecsStringValue(state.Name, networkInterface.NetworkInterfaceName)
+	state.Description = ecsStringValue(state.Description, networkInterface.Description)
+	state.PrimaryIpAddress = types.StringValue(tea.StringValue(networkInterface.PrivateIpAddress))
+	state.MacAddress = types.StringValue(tea.StringValue(networkInterface.MacAddress))
+	if networkInterface.SecurityGroupIds != nil {
+		state.SecurityGroupIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(networkInterface.SecurityGroupIds.SecurityGroupId))
+	}
+
+	secondaryIps := []*string{}
+	if networkInterface.PrivateIpSets != nil {
+		for _, privateIp := range networkInterface.PrivateIpSets.PrivateIpSet {
+			if !tea.BoolValue(privateIp.Primary) {
+				secondaryIps = append(secondaryIps, privateIp.PrivateIpAddress)
+			}
+		}
+	}
+	if len(secondaryIps) == 0 && state.SecondaryPrivateIpAddresses.IsNull() {
+		state.SecondaryPrivateIpAddresses = types.ListNull(types.StringType)
+	} else {
+		state.SecondaryPrivateIpAddresses = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(secondaryIps))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the attributes, the security groups and the secondary private IP
+// addresses of the ENI.
+func (r *ecsNetworkInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ecsNetworkInterfaceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterfaceId := state.NetworkInterfaceId.ValueString()
+	oldIps := convertListValueToStrings(state.SecondaryPrivateIpAddresses)
+	newIps := convertListValueToStrings(plan.SecondaryPrivateIpAddresses)
+
+	updateNetworkInterface := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+			modifyNetworkInterfaceAttributeRequest := &alicloudEcsClient.ModifyNetworkInterfaceAttributeRequest{
+				RegionId:             r.client.RegionId,
+				NetworkInterfaceId:   tea.String(networkInterfaceId),
+				NetworkInterfaceName: ecsStringPointer(plan.Name),
+				Description:          ecsStringPointer(plan.Description),
+				SecurityGroupId:      tea.StringSlice(convertListValueToStrings(plan.SecurityGroupIds)),
+			}
+
+			if _, err := r.client.ModifyNetworkInterfaceAttributeWithOptions(modifyNetworkInterfaceAttributeRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+
+		if ips := convertStringsDifference(oldIps, newIps); len(ips) > 0 {
+			unassignPrivateIpAddressesRequest := &alicloudEcsClient.UnassignPrivateIpAddressesRequest{
+				RegionId:           r.client.RegionId,
+				NetworkInterfaceId: tea.String(networkInterfaceId),
+				PrivateIpAddress:   tea.StringSlice(ips),
+			}
+
+			if _, err := r.client.UnassignPrivateIpAddressesWithOptions(unassignPrivateIpAddressesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			oldIps = convertStringsDifference(oldIps, ips)
+		}
+
+		if ips := convertStringsDifference(newIps, oldIps); len(ips) > 0 {
+			assignPrivateIpAddressesRequest := &alicloudEcsClient.AssignPrivateIpAddressesRequest{
+				RegionId:           r.client.RegionId,
+				NetworkInterfaceId: tea.String(networkInterfaceId),
+				PrivateIpAddress:   tea.StringSlice(ips),
+			}
+
+			if _, err := r.client.AssignPrivateIpAddressesWithOptions(assignPrivateIpAddressesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateNetworkInterface); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Network Interface.",
+			err.Error(),
+		)
+		return
+	}
+	plan.NetworkInterfaceId = state.NetworkInterfaceId
+	plan.PrimaryIpAddress = state.PrimaryIpAddress
+	plan.MacAddress = state.MacAddress
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the ENI.
+func (r *ecsNetworkInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsNetworkInterfaceModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteNetworkInterface := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteNetworkInterfaceRequest := &alicloudEcsClient.DeleteNetworkInterfaceRequest{
+			RegionId:           r.client.RegionId,
+			NetworkInterfaceId: tea.String(state.NetworkInterfaceId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteNetworkInterfaceWithOptions(deleteNetworkInterfaceRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteNetworkInterface); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Network Interface.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ecsNetworkInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("network_interface_id"), req, resp)
+}
+
+// Function to read the ENI, returns nil if the ENI is not found.
+func describeEcsNetworkInterface(client *alicloudEcsClient.Client, networkInterfaceId string) (*alicloudEcsClient.DescribeNetworkInterfacesResponseBodyNetworkInterfaceSetsNetworkInterfaceSet, error) {
+	var describeNetworkInterfacesResponse *alicloudEcsClient.DescribeNetworkInterfacesResponse
+	describeNetworkInterfaces := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeNetworkInterfacesRequest := &alicloudEcsClient.DescribeNetworkInterfacesRequest{
+			RegionId:           client.RegionId,
+			NetworkInterfaceId: []*string{tea.String(networkInterfaceId)},
+		}
+
+		var err error
+		describeNetworkInterfacesResponse, err = client.DescribeNetworkInterfacesWithOptions(describeNetworkInterfacesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeNetworkInterfaces); err != nil {
+		return nil, err
+	}
+
+	networkInterfaceSets := describeNetworkInterfacesResponse.Body.NetworkInterfaceSets
+	if networkInterfaceSets == nil || len(networkInterfaceSets.NetworkInterfaceSet) == 0 {
+		return nil, nil
+	}
+	return networkInterfaceSets.NetworkInterfaceSet[0], nil
+}
+
+// Function to wait until the ENI is in the status, e.g. Available after it is
+// created or detached, InUse after it is attached.
+func waitEcsNetworkInterfaceStatus(client *alicloudEcsClient.Client, networkInterfaceId, status string) error {
+	waitNetworkInterfaceStatus := func() error {
+		networkInterface, err := describeEcsNetworkInterface(client, networkInterfaceId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if networkInterface == nil {
+			return backoff.Permanent(fmt.Errorf("network interface %s is not found", networkInterfaceId))
+		}
+		if currentStatus := tea.StringValue(networkInterface.Status); currentStatus != status {
+			return fmt.Errorf("network interface %s is %s, waiting for %s", networkInterfaceId, currentStatus, status)
+		}
+		return nil
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitNetworkInterfaceStatus, waitBackoff)
+}
@@ -0,0 +1,222 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &ecsNetworkInterfaceAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &ecsNetworkInterfaceAttachmentResource{}
+	_ resource.ResourceWithImportState = &ecsNetworkInterfaceAttachmentResource{}
+)
+
+func NewEcsNetworkInterfaceAttachmentResource() resource.Resource {
+	return &ecsNetworkInterfaceAttachmentResource{}
+}
+
+type ecsNetworkInterfaceAttachmentResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsNetworkInterfaceAttachmentModel struct {
+	NetworkInterfaceId types.String `tfsdk:"network_interface_id"`
+	InstanceId         types.String `tfsdk:"instance_id"`
+}
+
+// Metadata returns the ECS network interface attachment resource name.
+func (r *ecsNetworkInterfaceAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_network_interface_attachment"
+}
+
+// Schema defines the schema for the ECS network interface attachment resource.
+func (r *ecsNetworkInterfaceAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach an elastic network interface (ENI) to an ECS instance.",
+		Attributes: map[string]schema.Attribute{
+			"network_interface_id": schema.StringAttribute{
+				Description: "The ID of the ENI.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the ECS instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsNetworkInterfaceAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Attach the ENI to the instance and wait until it is in use.
+func (r *ecsNetworkInterfaceAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsNetworkInterfaceAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachNetworkInterface := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		attachNetworkInterfaceRequest := &alicloudEcsClient.AttachNetworkInterfaceRequest{
+			RegionId:           r.client.RegionId,
+			NetworkInterfaceId: tea.String(plan.NetworkInterfaceId.ValueString()),
+			InstanceId:         tea.String(plan.InstanceId.ValueString()),
+		}
+
+		if _, err := r.client.AttachNetworkInterfaceWithOptions(attachNetworkInterfaceRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(attachNetworkInterface); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Attach Network Interface.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitEcsNetworkInterfaceStatus(r.client, plan.NetworkInterfaceId.ValueString(), "InUse"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Network Interface In Use.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the instance which the ENI is attached to.
+func (r *ecsNetworkInterfaceAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsNetworkInterfaceAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterface, err := describeEcsNetworkInterface(r.client, state.NetworkInterfaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Network Interfaces.",
+			err.Error(),
+		)
+		return
+	}
+	if networkInterface == nil || tea.StringValue(networkInterface.InstanceId) != state.InstanceId.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// All the attributes require replacement, there is nothing to update.
+func (r *ecsNetworkInterfaceAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ecsNetworkInterfaceAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Detach the ENI from the instance and wait until it is available.
+func (r *ecsNetworkInterfaceAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsNetworkInterfaceAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detachNetworkInterface := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		detachNetworkInterfaceRequest := &alicloudEcsClient.DetachNetworkInterfaceRequest{
+			RegionId:           r.client.RegionId,
+			NetworkInterfaceId: tea.String(state.NetworkInterfaceId.ValueString()),
+			InstanceId:         tea.String(state.InstanceId.ValueString()),
+		}
+
+		if _, err := r.client.DetachNetworkInterfaceWithOptions(detachNetworkInterfaceRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(detachNetworkInterface); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Detach Network Interface.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := waitEcsNetworkInterfaceStatus(r.client, state.NetworkInterfaceId.ValueString(), "Available"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Network Interface Available.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the attachment with the ID in the format of
+// <network_interface_id>:<instance_id>.
+func (r *ecsNetworkInterfaceAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <network_interface_id>:<instance_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_interface_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+}
@@ -0,0 +1,369 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEcsClient "github.com/alibabacloud-go/ecs-20140526/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The maximum time to wait for a snapshot or an image to be created.
+	ecsSnapshotImageCreationTimeout = 2 * time.Hour
+)
+
+var (
+	_ resource.Resource                = &ecsSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &ecsSnapshotResource{}
+	_ resource.ResourceWithImportState = &ecsSnapshotResource{}
+)
+
+func NewEcsSnapshotResource() resource.Resource {
+	return &ecsSnapshotResource{}
+}
+
+type ecsSnapshotResource struct {
+	client *alicloudEcsClient.Client
+}
+
+type ecsSnapshotModel struct {
+	SnapshotId    types.String `tfsdk:"snapshot_id"`
+	DiskId        types.String `tfsdk:"disk_id"`
+	SnapshotName  types.String `tfsdk:"snapshot_name"`
+	Description   types.String `tfsdk:"description"`
+	RetentionDays types.Int64  `tfsdk:"retention_days"`
+}
+
+// Metadata returns the ECS Snapshot resource name.
+func (r *ecsSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecs_snapshot"
+}
+
+// Schema defines the schema for the ECS Snapshot resource.
+func (r *ecsSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a snapshot from an ECS disk, and wait until the snapshot is completed.",
+		Attributes: map[string]schema.Attribute{
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_id": schema.StringAttribute{
+				Description: "The ID of the disk to create the snapshot from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Description: "The name of the snapshot.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the snapshot.",
+				Optional:    true,
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "The number of days to retain the snapshot, the snapshot is released automatically " +
+					"after the retention period. Retain permanently if not set.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65536),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ecsSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ecsClient
+}
+
+// Create the snapshot and wait until the snapshot is completed.
+func (r *ecsSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ecsSnapshotModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createSnapshotResponse *alicloudEcsClient.CreateSnapshotResponse
+	createSnapshot := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createSnapshotRequest := &alicloudEcsClient.CreateSnapshotRequest{
+			DiskId:        tea.String(plan.DiskId.ValueString()),
+			SnapshotName:  ecsStringPointer(plan.SnapshotName),
+			Description:   ecsStringPointer(plan.Description),
+			RetentionDays: ecsInt32Pointer(plan.RetentionDays),
+		}
+
+		var err error
+		createSnapshotResponse, err = r.client.CreateSnapshotWithOptions(createSnapshotRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createSnapshot); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Snapshot.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.SnapshotId = types.StringValue(tea.StringValue(createSnapshotResponse.Body.SnapshotId))
+
+	// Save the snapshot into state before waiting, so that the snapshot is
+	// not leaked if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.waitSnapshotAccomplished(plan.SnapshotId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Snapshot Accomplished.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the snapshot.
+func (r *ecsSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ecsSnapshotModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.describeSnapshot(state.SnapshotId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Snapshots.",
+			err.Error(),
+		)
+		return
+	}
+	if snapshot == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DiskId = types.StringValue(tea.StringValue(snapshot.SourceDiskId))
+	state.SnapshotName = ecsStringValue(state.SnapshotName, snapshot.SnapshotName)
+	state.Description = ecsStringValue(state.Description, snapshot.Description)
+	if tea.Int32Value(snapshot.RetentionDays) == 0 {
+		state.RetentionDays = types.Int64Null()
+	} else {
+		state.RetentionDays = types.Int64Value(int64(tea.Int32Value(snapshot.RetentionDays)))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name, description and retention days of the snapshot.
+func (r *ecsSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ecsSnapshotModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifySnapshotAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifySnapshotAttributeRequest := &alicloudEcsClient.ModifySnapshotAttributeRequest{
+			SnapshotId:   tea.String(state.SnapshotId.ValueString()),
+			SnapshotName: tea.String(plan.SnapshotName.ValueString()),
+			Description:  tea.String(plan.Description.ValueString()),
+			// Retention days of -1 retains the snapshot permanently.
+			RetentionDays: tea.Int32(-1),
+		}
+		if !plan.RetentionDays.IsNull() {
+			modifySnapshotAttributeRequest.RetentionDays = tea.Int32(int32(plan.RetentionDays.ValueInt64()))
+		}
+
+		if _, err := r.client.ModifySnapshotAttributeWithOptions(modifySnapshotAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifySnapshotAttribute); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Snapshot Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.SnapshotId = state.SnapshotId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the snapshot.
+func (r *ecsSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ecsSnapshotModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteSnapshot := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteSnapshotRequest := &alicloudEcsClient.DeleteSnapshotRequest{
+			SnapshotId: tea.String(state.SnapshotId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteSnapshotWithOptions(deleteSnapshotRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidSnapshotId.NotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteSnapshot); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Snapshot.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ecsSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("snapshot_id"), req, resp)
+}
+
+// Function to read the snapshot, returns nil if the snapshot is not found.
+func (r *ecsSnapshotResource) describeSnapshot(snapshotId string) (*alicloudEcsClient.DescribeSnapshotsResponseBodySnapshotsSnapshot, error) {
+	var describeSnapshotsResponse *alicloudEcsClient.DescribeSnapshotsResponse
+	describeSnapshots := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeSnapshotsRequest := &alicloudEcsClient.DescribeSnapshotsRequest{
+			RegionId:    r.client.RegionId,
+			SnapshotIds: tea.String(fmt.Sprintf("[%q]", snapshotId)),
+		}
+
+		var err error
+		describeSnapshotsResponse, err = r.client.DescribeSnapshotsWithOptions(describeSnapshotsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeSnapshots); err != nil {
+		return nil, err
+	}
+
+	if describeSnapshotsResponse.Body.Snapshots == nil || len(describeSnapshotsResponse.Body.Snapshots.Snapshot) == 0 {
+		return nil, nil
+	}
+	return describeSnapshotsResponse.Body.Snapshots.Snapshot[0], nil
+}
+
+// Function to wait until the snapshot is accomplished, the last progress of
+// the snapshot is returned in the error if it times out.
+func (r *ecsSnapshotResource) waitSnapshotAccomplished(snapshotId string) error {
+	waitSnapshotAccomplished := func() error {
+		snapshot, err := r.describeSnapshot(snapshotId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if snapshot == nil {
+			return backoff.Permanent(fmt.Errorf("snapshot %s is not found", snapshotId))
+		}
+
+		switch status := tea.StringValue(snapshot.Status); status {
+		case "accomplished":
+			return nil
+		case "failed":
+			return backoff.Permanent(fmt.Errorf("snapshot %s is failed", snapshotId))
+		default:
+			return fmt.Errorf("snapshot %s is %s, progress: %s", snapshotId, status, tea.StringValue(snapshot.Progress))
+		}
+	}
+
+	return backoff.Retry(waitSnapshotAccomplished, newEcsProgressBackOff())
+}
+
+// The backoff to poll the progress of the snapshots and images, the interval
+// is capped at 30 seconds as the creation may take hours.
+func newEcsProgressBackOff() backoff.BackOff {
+	progressBackoff := backoff.NewExponentialBackOff()
+	progressBackoff.MaxInterval = 30 * time.Second
+	progressBackoff.MaxElapsedTime = ecsSnapshotImageCreationTimeout
+	return progressBackoff
+}
+
+func ecsStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.String(value.ValueString())
+}
+
+func ecsInt32Pointer(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Int32(int32(value.ValueInt64()))
+}
+
+// Keep the optional value null if it is not configured and AliCloud API
+// returns an empty value.
+func ecsStringValue(prev types.String, value *string) types.String {
+	if prev.IsNull() && tea.StringValue(value) == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(tea.StringValue(value))
+}
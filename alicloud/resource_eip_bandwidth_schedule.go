@@ -0,0 +1,580 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOosClient "github.com/alibabacloud-go/oos-20190601/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	eipBandwidthScheduleInstanceTypeEip                    = "EIP"
+	eipBandwidthScheduleInstanceTypeCommonBandwidthPackage = "CommonBandwidthPackage"
+
+	oosTemplateNotFoundErrCode = "EntityNotExists.Template"
+)
+
+var (
+	_ resource.Resource                = &eipBandwidthScheduleResource{}
+	_ resource.ResourceWithConfigure   = &eipBandwidthScheduleResource{}
+	_ resource.ResourceWithImportState = &eipBandwidthScheduleResource{}
+)
+
+func NewEipBandwidthScheduleResource() resource.Resource {
+	return &eipBandwidthScheduleResource{}
+}
+
+type eipBandwidthScheduleResource struct {
+	client *alicloudOosClient.Client
+}
+
+type eipBandwidthScheduleModel struct {
+	Name               types.String `tfsdk:"name"`
+	InstanceType       types.String `tfsdk:"instance_type"`
+	InstanceId         types.String `tfsdk:"instance_id"`
+	UpgradeBandwidth   types.Int64  `tfsdk:"upgrade_bandwidth"`
+	UpgradeCron        types.String `tfsdk:"upgrade_cron"`
+	RestoreBandwidth   types.Int64  `tfsdk:"restore_bandwidth"`
+	RestoreCron        types.String `tfsdk:"restore_cron"`
+	Timezone           types.String `tfsdk:"timezone"`
+	EndDate            types.String `tfsdk:"end_date"`
+	AssumeRole         types.String `tfsdk:"assume_role"`
+	UpgradeExecutionId types.String `tfsdk:"upgrade_execution_id"`
+	RestoreExecutionId types.String `tfsdk:"restore_execution_id"`
+}
+
+// Metadata returns the EIP bandwidth schedule resource name.
+func (r *eipBandwidthScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eip_bandwidth_schedule"
+}
+
+// Schema defines the schema for the EIP bandwidth schedule resource.
+func (r *eipBandwidthScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Schedule the temporary bandwidth upgrade of an EIP or a common bandwidth package, " +
+			"e.g. during the sale events. An OOS template is created with the name, and two timer " +
+			"triggered executions upgrade and restore the bandwidth on the cron schedules.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the OOS template of the schedule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Description: "The type of the instance. Valid values: EIP, CommonBandwidthPackage.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						eipBandwidthScheduleInstanceTypeEip,
+						eipBandwidthScheduleInstanceTypeCommonBandwidthPackage,
+					),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the EIP or the common bandwidth package.",
+				Required:    true,
+			},
+			"upgrade_bandwidth": schema.Int64Attribute{
+				Description: "The bandwidth in Mbps during the upgrade.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"upgrade_cron": schema.StringAttribute{
+				Description: "The cron expression to upgrade the bandwidth, e.g. 0 0 20 ? * * *.",
+				Required:    true,
+			},
+			"restore_bandwidth": schema.Int64Attribute{
+				Description: "The bandwidth in Mbps to restore after the upgrade.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"restore_cron": schema.StringAttribute{
+				Description: "The cron expression to restore the bandwidth, e.g. 0 0 2 ? * * *.",
+				Required:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The timezone of the cron expressions. Default to Asia/Shanghai.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Asia/Shanghai"),
+			},
+			"end_date": schema.StringAttribute{
+				Description: "The time to stop the schedule in RFC3339 format, e.g. 2024-11-12T00:00:00Z. " +
+					"Default to run the schedule until the resource is destroyed.",
+				Optional: true,
+			},
+			"assume_role": schema.StringAttribute{
+				Description: "The RAM role assumed by OOS to modify the bandwidth. Default to OOSServiceRole.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("OOSServiceRole"),
+			},
+			"upgrade_execution_id": schema.StringAttribute{
+				Description: "The ID of the OOS execution which upgrades the bandwidth.",
+				Computed:    true,
+			},
+			"restore_execution_id": schema.StringAttribute{
+				Description: "The ID of the OOS execution which restores the bandwidth.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *eipBandwidthScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).oosClient
+}
+
+// Create the OOS template and start the upgrade and restore executions.
+func (r *eipBandwidthScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *eipBandwidthScheduleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := eipBandwidthScheduleTemplate(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Build OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+
+	createTemplate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createTemplateRequest := &alicloudOosClient.CreateTemplateRequest{
+			TemplateName: tea.String(plan.Name.ValueString()),
+			Content:      tea.String(content),
+		}
+
+		if _, err := r.client.CreateTemplateWithOptions(createTemplateRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createTemplate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+
+	startExecutionsDiags := r.startExecutions(plan)
+	resp.Diagnostics.Append(startExecutionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the OOS template and the executions of the schedule.
+func (r *eipBandwidthScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *eipBandwidthScheduleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var getTemplateResponse *alicloudOosClient.GetTemplateResponse
+	getTemplate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getTemplateRequest := &alicloudOosClient.GetTemplateRequest{
+			TemplateName: tea.String(state.Name.ValueString()),
+		}
+
+		var err error
+		getTemplateResponse, err = r.client.GetTemplateWithOptions(getTemplateRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == oosTemplateNotFoundErrCode {
+				getTemplateResponse = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getTemplate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+
+	if getTemplateResponse == nil || getTemplateResponse.Body.Template == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Same as st-alicloud_ram_policy, the cron expressions are set to null if
+	// any of the executions is stopped, e.g. cancelled manually or failed, so
+	// that the executions are started again by the next apply.
+	for _, executionId := range []types.String{state.UpgradeExecutionId, state.RestoreExecutionId} {
+		// The executions are unknown after importing the schedule.
+		if executionId.IsNull() {
+			continue
+		}
+
+		status, err := r.describeExecutionStatus(executionId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List OOS Executions.",
+				err.Error(),
+			)
+			return
+		}
+
+		if status != "Running" && status != "Waiting" && status != "Started" {
+			resp.Diagnostics.AddWarning(
+				"OOS Execution Is Stopped.",
+				fmt.Sprintf("The OOS execution %s of the schedule %s is %s, the executions will be started again by the next apply.",
+					executionId.ValueString(), state.Name.ValueString(), status),
+			)
+			state.UpgradeCron = types.StringNull()
+			state.RestoreCron = types.StringNull()
+			break
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the OOS template and restart the executions with the new schedule.
+func (r *eipBandwidthScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *eipBandwidthScheduleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cancelExecutionsDiags := r.cancelExecutions(state)
+	resp.Diagnostics.Append(cancelExecutionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := eipBandwidthScheduleTemplate(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Build OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+
+	updateTemplate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateTemplateRequest := &alicloudOosClient.UpdateTemplateRequest{
+			TemplateName: tea.String(plan.Name.ValueString()),
+			Content:      tea.String(content),
+		}
+
+		if _, err := r.client.UpdateTemplateWithOptions(updateTemplateRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateTemplate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+
+	startExecutionsDiags := r.startExecutions(plan)
+	resp.Diagnostics.Append(startExecutionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Cancel the executions and delete the OOS template.
+func (r *eipBandwidthScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *eipBandwidthScheduleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cancelExecutionsDiags := r.cancelExecutions(state)
+	resp.Diagnostics.Append(cancelExecutionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTemplate := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteTemplateRequest := &alicloudOosClient.DeleteTemplateRequest{
+			TemplateName:         tea.String(state.Name.ValueString()),
+			AutoDeleteExecutions: tea.Bool(true),
+		}
+
+		if _, err := r.client.DeleteTemplateWithOptions(deleteTemplateRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == oosTemplateNotFoundErrCode {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteTemplate); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete OOS Template.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the schedule by the name of the OOS template, the other attributes
+// are set by the next apply.
+func (r *eipBandwidthScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Function to start the timer triggered executions to upgrade and restore the
+// bandwidth.
+func (r *eipBandwidthScheduleResource) startExecutions(plan *eipBandwidthScheduleModel) diag.Diagnostics {
+	var err error
+	executions := []struct {
+		bandwidth   types.Int64
+		cron        types.String
+		executionId *types.String
+	}{
+		{plan.UpgradeBandwidth, plan.UpgradeCron, &plan.UpgradeExecutionId},
+		{plan.RestoreBandwidth, plan.RestoreCron, &plan.RestoreExecutionId},
+	}
+
+	for _, execution := range executions {
+		parameters, _ := json.Marshal(map[string]interface{}{
+			"bandwidth":      execution.bandwidth.ValueInt64(),
+			"cronExpression": execution.cron.ValueString(),
+		})
+
+		var executionId string
+		startExecution := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			startExecutionRequest := &alicloudOosClient.StartExecutionRequest{
+				TemplateName: tea.String(plan.Name.ValueString()),
+				Parameters:   tea.String(string(parameters)),
+				Mode:         tea.String("Automatic"),
+			}
+
+			startExecutionResponse, err := r.client.StartExecutionWithOptions(startExecutionRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			executionId = tea.StringValue(startExecutionResponse.Body.Execution.ExecutionId)
+			return nil
+		}
+
+		err = retryAPICall(startExecution)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"[API ERROR] Failed to Start OOS Execution.",
+					err.Error(),
+				),
+			}
+		}
+		*execution.executionId = types.StringValue(executionId)
+	}
+
+	return nil
+}
+
+// Function to cancel the executions of the schedule.
+func (r *eipBandwidthScheduleResource) cancelExecutions(state *eipBandwidthScheduleModel) diag.Diagnostics {
+	for _, executionId := range []types.String{state.UpgradeExecutionId, state.RestoreExecutionId} {
+		if executionId.IsNull() || executionId.ValueString() == "" {
+			continue
+		}
+
+		cancelExecution := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			cancelExecutionRequest := &alicloudOosClient.CancelExecutionRequest{
+				ExecutionId: tea.String(executionId.ValueString()),
+			}
+
+			if _, err := r.client.CancelExecutionWithOptions(cancelExecutionRequest, runtime); err != nil {
+				// The stopped executions are not able to be cancelled.
+				if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "EntityNotExists.Execution" {
+					return nil
+				}
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(cancelExecution); err != nil {
+			return diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"[API ERROR] Failed to Cancel OOS Execution.",
+					err.Error(),
+				),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Function to describe the status of the execution, an empty status is
+// returned if the execution is not found.
+func (r *eipBandwidthScheduleResource) describeExecutionStatus(executionId string) (status string, err error) {
+	listExecutions := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listExecutionsRequest := &alicloudOosClient.ListExecutionsRequest{
+			ExecutionId: tea.String(executionId),
+		}
+
+		listExecutionsResponse, err := r.client.ListExecutionsWithOptions(listExecutionsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		for _, execution := range listExecutionsResponse.Body.Executions {
+			status = tea.StringValue(execution.Status)
+		}
+		return nil
+	}
+
+	err = retryAPICall(listExecutions)
+	return
+}
+
+// Function to build the OOS template which modifies the bandwidth of the
+// instance on the cron schedule.
+func eipBandwidthScheduleTemplate(plan *eipBandwidthScheduleModel) (string, error) {
+	modifyBandwidthAPI := "ModifyEipAddressAttribute"
+	modifyBandwidthParameters := map[string]interface{}{
+		"RegionId":     "{{ ACS::RegionId }}",
+		"AllocationId": plan.InstanceId.ValueString(),
+		"Bandwidth":    "{{ bandwidth }}",
+	}
+	if plan.InstanceType.ValueString() == eipBandwidthScheduleInstanceTypeCommonBandwidthPackage {
+		modifyBandwidthAPI = "ModifyCommonBandwidthPackageSpec"
+		modifyBandwidthParameters = map[string]interface{}{
+			"RegionId":           "{{ ACS::RegionId }}",
+			"BandwidthPackageId": plan.InstanceId.ValueString(),
+			"Bandwidth":          "{{ bandwidth }}",
+		}
+	}
+
+	timerTriggerProperties := map[string]interface{}{
+		"Type":       "cron",
+		"Expression": "{{ cronExpression }}",
+		"Timezone":   plan.Timezone.ValueString(),
+	}
+	if !plan.EndDate.IsNull() && plan.EndDate.ValueString() != "" {
+		endDate, err := time.Parse(time.RFC3339, plan.EndDate.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("invalid end_date %q: %w", plan.EndDate.ValueString(), err)
+		}
+		timerTriggerProperties["EndDate"] = endDate.UTC().Format(time.RFC3339)
+	}
+
+	template := map[string]interface{}{
+		"FormatVersion": "OOS-2019-06-01",
+		"Description":   fmt.Sprintf("Modify the bandwidth of %s on schedule.", plan.InstanceId.ValueString()),
+		"Parameters": map[string]interface{}{
+			"bandwidth": map[string]interface{}{
+				"Type":        "Number",
+				"Description": "The bandwidth in Mbps.",
+			},
+			"cronExpression": map[string]interface{}{
+				"Type":        "String",
+				"Description": "The cron expression of the schedule.",
+			},
+		},
+		"RamRole": plan.AssumeRole.ValueString(),
+		"Tasks": []interface{}{
+			map[string]interface{}{
+				"Name":       "timerTrigger",
+				"Action":     "ACS::TimerTrigger",
+				"Properties": timerTriggerProperties,
+			},
+			map[string]interface{}{
+				"Name":   "modifyBandwidth",
+				"Action": "ACS::ExecuteAPI",
+				"Properties": map[string]interface{}{
+					"Service":    "VPC",
+					"API":        modifyBandwidthAPI,
+					"Parameters": modifyBandwidthParameters,
+				},
+			},
+		},
+	}
+
+	content, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
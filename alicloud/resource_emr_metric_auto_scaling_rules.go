@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"context"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 
@@ -244,9 +243,7 @@ func (r *emrMetricAutoScalingRulesResource) Read(ctx context.Context, req resour
 		return nil
 	}
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(readAutoScalingRules, reconnectBackoff)
+	err = retryAPICall(readAutoScalingRules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[ERROR] Failed to read auto scaling rules.",
@@ -371,9 +368,7 @@ func (r *emrMetricAutoScalingRulesResource) Delete(ctx context.Context, req reso
 		return nil
 	}
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(deleteAutoScalingRules, reconnectBackoff)
+	err := retryAPICall(deleteAutoScalingRules)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[ERROR] Failed to delete auto scaling rules.",
@@ -411,9 +406,7 @@ func (r *emrMetricAutoScalingRulesResource) getNodeGroup(plan *emrMetricAutoScal
 		return nil
 	}
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(listNodeGroup, reconnectBackoff)
+	err = retryAPICall(listNodeGroup)
 	if err != nil {
 		return "", err
 	}
@@ -494,9 +487,7 @@ func (r *emrMetricAutoScalingRulesResource) putRule(plan *emrMetricAutoScalingRu
 		return nil
 	}
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(putRule, reconnectBackoff)
+	err := retryAPICall(putRule)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,575 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &essAttachAlbServerGroupResource{}
+	_ resource.ResourceWithConfigure   = &essAttachAlbServerGroupResource{}
+	_ resource.ResourceWithImportState = &essAttachAlbServerGroupResource{}
+)
+
+func NewEssAttachAlbServerGroupResource() resource.Resource {
+	return &essAttachAlbServerGroupResource{}
+}
+
+type essAttachAlbServerGroupResource struct {
+	client    *alicloudEssClient.Client
+	albClient *alicloudAlbClient.Client
+}
+
+type essAttachAlbServerGroupModel struct {
+	ScalingGroupId  types.String      `tfsdk:"scaling_group_id"`
+	AlbServerGroups []*albServerGroup `tfsdk:"alb_server_groups"`
+}
+
+type albServerGroup struct {
+	AlbServerGroupId types.String `tfsdk:"alb_server_group_id"`
+	Port             types.Int64  `tfsdk:"port"`
+	Weight           types.Int64  `tfsdk:"weight"`
+}
+
+// Metadata returns the ESS Attach ALB Server Group resource name.
+func (r *essAttachAlbServerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_attach_alb_server_group"
+}
+
+// Schema defines the schema for the ESS Attach ALB Server Group resource.
+func (r *essAttachAlbServerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach an auto scaling group (ESS) with a list of application load balancer (ALB) server groups.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alb_server_groups": schema.ListNestedBlock{
+				Description: "List of ALB server groups to be attached with the scaling group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"alb_server_group_id": schema.StringAttribute{
+							Description: "ALB server group ID.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port used by the ECS instances of the scaling group in the ALB server group.",
+							Required:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the ECS instances of the scaling group in the ALB server group.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essAttachAlbServerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+	r.albClient = req.ProviderData.(alicloudClients).albClient
+}
+
+// Attach scaling group with ALB server groups.
+func (r *essAttachAlbServerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essAttachAlbServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.attachAlbServerGroups(plan.ScalingGroupId.ValueString(), plan.AlbServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to attach scaling group with ALB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the attached ALB server groups of the scaling group. The port and
+// weight of each server group are reconstructed from the servers of the
+// scaling group's instances in the server group, so that drift on any of
+// the server groups is detected.
+func (r *essAttachAlbServerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essAttachAlbServerGroupModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingGroup, err := r.describeScalingGroup(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get attached ALB server groups from scaling group.",
+			err.Error(),
+		)
+		return
+	}
+	if scalingGroup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	instanceIds, err := r.describeScalingInstanceIds(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get instances of scaling group.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Start from the attachment of the scaling group, as a server group may
+	// be imported or attached outside of Terraform.
+	serverGroups := []*albServerGroup{}
+	for _, attached := range scalingGroup.AlbServerGroups {
+		serverGroup := &albServerGroup{
+			AlbServerGroupId: types.StringValue(tea.StringValue(attached.AlbServerGroupId)),
+			Port:             types.Int64Value(int64(tea.Int32Value(attached.Port))),
+			Weight:           types.Int64Value(int64(tea.Int32Value(attached.Weight))),
+		}
+
+		servers, err := r.listServerGroupServers(tea.StringValue(attached.AlbServerGroupId))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list servers of ALB server group.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, server := range servers {
+			if _, ok := instanceIds[tea.StringValue(server.ServerId)]; !ok {
+				continue
+			}
+			if tea.Int32Value(server.Port) != tea.Int32Value(attached.Port) {
+				continue
+			}
+			serverGroup.Weight = types.Int64Value(int64(tea.Int32Value(server.Weight)))
+			break
+		}
+		serverGroups = append(serverGroups, serverGroup)
+	}
+
+	// Keep the order of the server groups in state to avoid unnecessary diff.
+	ordered := []*albServerGroup{}
+	for _, stateServerGroup := range state.AlbServerGroups {
+		for i, serverGroup := range serverGroups {
+			if serverGroup != nil && serverGroup.AlbServerGroupId.Equal(stateServerGroup.AlbServerGroupId) && serverGroup.Port.Equal(stateServerGroup.Port) {
+				ordered = append(ordered, serverGroup)
+				serverGroups[i] = nil
+				break
+			}
+		}
+	}
+	for _, serverGroup := range serverGroups {
+		if serverGroup != nil {
+			ordered = append(ordered, serverGroup)
+		}
+	}
+	state.AlbServerGroups = ordered
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Attach the added ALB server groups, detach the removed ALB server groups
+// and update the weight of the scaling group's instances in the remaining
+// ALB server groups.
+func (r *essAttachAlbServerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essAttachAlbServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A server group is identified by server group ID and port, as the
+	// same server group may be attached with different ports.
+	serverGroupKey := func(serverGroup *albServerGroup) string {
+		return fmt.Sprintf("%s:%d", serverGroup.AlbServerGroupId.ValueString(), serverGroup.Port.ValueInt64())
+	}
+
+	planServerGroups := make(map[string]*albServerGroup)
+	for _, serverGroup := range plan.AlbServerGroups {
+		planServerGroups[serverGroupKey(serverGroup)] = serverGroup
+	}
+	stateServerGroups := make(map[string]*albServerGroup)
+	for _, serverGroup := range state.AlbServerGroups {
+		stateServerGroups[serverGroupKey(serverGroup)] = serverGroup
+	}
+
+	var attachServerGroups, detachServerGroups, updateServerGroups []*albServerGroup
+	for _, serverGroup := range plan.AlbServerGroups {
+		if stateServerGroup, exists := stateServerGroups[serverGroupKey(serverGroup)]; !exists {
+			attachServerGroups = append(attachServerGroups, serverGroup)
+		} else if !serverGroup.Weight.Equal(stateServerGroup.Weight) {
+			updateServerGroups = append(updateServerGroups, serverGroup)
+		}
+	}
+	for _, serverGroup := range state.AlbServerGroups {
+		if _, exists := planServerGroups[serverGroupKey(serverGroup)]; !exists {
+			detachServerGroups = append(detachServerGroups, serverGroup)
+		}
+	}
+
+	// Detach before attach, so that the same server group can be attached
+	// with another port.
+	err := r.detachAlbServerGroups(plan.ScalingGroupId.ValueString(), detachServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to detach scaling group with ALB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.attachAlbServerGroups(plan.ScalingGroupId.ValueString(), attachServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to attach scaling group with ALB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(updateServerGroups) > 0 {
+		// ESS keeps the weight of the attachment for the instances added
+		// later, so re-attach the server groups with the new weight. The
+		// instances are kept in the server groups to avoid interrupting the
+		// traffic, and their weight is updated on the ALB side instead.
+		err = r.detachAlbServerGroups(plan.ScalingGroupId.ValueString(), updateServerGroups, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to detach scaling group with ALB server groups.",
+				err.Error(),
+			)
+			return
+		}
+
+		err = r.attachAlbServerGroups(plan.ScalingGroupId.ValueString(), updateServerGroups, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to attach scaling group with ALB server groups.",
+				err.Error(),
+			)
+			return
+		}
+
+		instanceIds, err := r.describeScalingInstanceIds(plan.ScalingGroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get instances of scaling group.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, serverGroup := range updateServerGroups {
+			err := r.updateServerGroupServersWeight(serverGroup, instanceIds)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to update weight of servers in ALB server group.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Detach scaling group with ALB server groups.
+func (r *essAttachAlbServerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essAttachAlbServerGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.detachAlbServerGroups(state.ScalingGroupId.ValueString(), state.AlbServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to detach scaling group with ALB server groups.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essAttachAlbServerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to read the scaling group with the attached ALB server groups,
+// returns nil if the scaling group is not found.
+func (r *essAttachAlbServerGroupResource) describeScalingGroup(scalingGroupId string) (*alicloudEssClient.DescribeScalingGroupsResponseBodyScalingGroups, error) {
+	var describeScalingGroupsResponse *alicloudEssClient.DescribeScalingGroupsResponse
+	describeScalingGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingGroupsRequest := &alicloudEssClient.DescribeScalingGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupIds: []*string{tea.String(scalingGroupId)},
+		}
+
+		var err error
+		describeScalingGroupsResponse, err = r.client.DescribeScalingGroupsWithOptions(describeScalingGroupsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingGroups); err != nil {
+		return nil, err
+	}
+
+	if len(describeScalingGroupsResponse.Body.ScalingGroups) == 0 {
+		return nil, nil
+	}
+	return describeScalingGroupsResponse.Body.ScalingGroups[0], nil
+}
+
+// Function to read the IDs of all instances in the scaling group.
+func (r *essAttachAlbServerGroupResource) describeScalingInstanceIds(scalingGroupId string) (map[string]struct{}, error) {
+	instanceIds := make(map[string]struct{})
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeScalingInstancesResponse *alicloudEssClient.DescribeScalingInstancesResponse
+		describeScalingInstances := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeScalingInstancesRequest := &alicloudEssClient.DescribeScalingInstancesRequest{
+				RegionId:       r.client.RegionId,
+				ScalingGroupId: tea.String(scalingGroupId),
+				PageNumber:     tea.Int32(pageNumber),
+				PageSize:       tea.Int32(pageSize),
+			}
+
+			var err error
+			describeScalingInstancesResponse, err = r.client.DescribeScalingInstancesWithOptions(describeScalingInstancesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeScalingInstances); err != nil {
+			return nil, err
+		}
+
+		for _, instance := range describeScalingInstancesResponse.Body.ScalingInstances {
+			instanceIds[tea.StringValue(instance.InstanceId)] = struct{}{}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeScalingInstancesResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return instanceIds, nil
+}
+
+// Function to list all servers in the ALB server group.
+func (r *essAttachAlbServerGroupResource) listServerGroupServers(serverGroupId string) ([]*alicloudAlbClient.ListServerGroupServersResponseBodyServers, error) {
+	var servers []*alicloudAlbClient.ListServerGroupServersResponseBodyServers
+	var nextToken *string
+
+	for {
+		var listServerGroupServersResponse *alicloudAlbClient.ListServerGroupServersResponse
+		listServerGroupServers := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listServerGroupServersRequest := &alicloudAlbClient.ListServerGroupServersRequest{
+				ServerGroupId: tea.String(serverGroupId),
+				MaxResults:    tea.Int32(100),
+				NextToken:     nextToken,
+			}
+
+			var err error
+			listServerGroupServersResponse, err = r.albClient.ListServerGroupServersWithOptions(listServerGroupServersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listServerGroupServers); err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, listServerGroupServersResponse.Body.Servers...)
+
+		nextToken = listServerGroupServersResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return servers, nil
+}
+
+// Function to update the weight of the scaling group's instances in the ALB
+// server group.
+func (r *essAttachAlbServerGroupResource) updateServerGroupServersWeight(serverGroup *albServerGroup, instanceIds map[string]struct{}) error {
+	servers, err := r.listServerGroupServers(serverGroup.AlbServerGroupId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var updateServers []*alicloudAlbClient.UpdateServerGroupServersAttributeRequestServers
+	for _, server := range servers {
+		if _, ok := instanceIds[tea.StringValue(server.ServerId)]; !ok {
+			continue
+		}
+		if int64(tea.Int32Value(server.Port)) != serverGroup.Port.ValueInt64() {
+			continue
+		}
+		updateServers = append(updateServers, &alicloudAlbClient.UpdateServerGroupServersAttributeRequestServers{
+			ServerId:   server.ServerId,
+			ServerType: server.ServerType,
+			Port:       server.Port,
+			Weight:     tea.Int32(int32(serverGroup.Weight.ValueInt64())),
+		})
+	}
+	if len(updateServers) == 0 {
+		return nil
+	}
+
+	updateServerGroupServersAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateServerGroupServersAttributeRequest := &alicloudAlbClient.UpdateServerGroupServersAttributeRequest{
+			ServerGroupId: tea.String(serverGroup.AlbServerGroupId.ValueString()),
+			Servers:       updateServers,
+		}
+
+		if _, err := r.albClient.UpdateServerGroupServersAttributeWithOptions(updateServerGroupServersAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateServerGroupServersAttribute)
+}
+
+// Function to attach scaling group with ALB server groups, the existing
+// instances of the scaling group are added to the server groups if
+// forceAttach is true.
+func (r *essAttachAlbServerGroupResource) attachAlbServerGroups(scalingGroupId string, serverGroups []*albServerGroup, forceAttach bool) error {
+	if len(serverGroups) == 0 {
+		return nil
+	}
+
+	var albServerGroups []*alicloudEssClient.AttachAlbServerGroupsRequestAlbServerGroups
+	for _, serverGroup := range serverGroups {
+		albServerGroups = append(albServerGroups, &alicloudEssClient.AttachAlbServerGroupsRequestAlbServerGroups{
+			AlbServerGroupId: tea.String(serverGroup.AlbServerGroupId.ValueString()),
+			Port:             tea.Int32(int32(serverGroup.Port.ValueInt64())),
+			Weight:           tea.Int32(int32(serverGroup.Weight.ValueInt64())),
+		})
+	}
+
+	attachAlbServerGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		attachAlbServerGroupsRequest := &alicloudEssClient.AttachAlbServerGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupId:  tea.String(scalingGroupId),
+			AlbServerGroups: albServerGroups,
+			ForceAttach:     tea.Bool(forceAttach),
+		}
+
+		if _, err := r.client.AttachAlbServerGroupsWithOptions(attachAlbServerGroupsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(attachAlbServerGroups)
+}
+
+// Function to detach scaling group with ALB server groups, the instances of
+// the scaling group are removed from the server groups if forceDetach is true.
+func (r *essAttachAlbServerGroupResource) detachAlbServerGroups(scalingGroupId string, serverGroups []*albServerGroup, forceDetach bool) error {
+	if len(serverGroups) == 0 {
+		return nil
+	}
+
+	var albServerGroups []*alicloudEssClient.DetachAlbServerGroupsRequestAlbServerGroups
+	for _, serverGroup := range serverGroups {
+		albServerGroups = append(albServerGroups, &alicloudEssClient.DetachAlbServerGroupsRequestAlbServerGroups{
+			AlbServerGroupId: tea.String(serverGroup.AlbServerGroupId.ValueString()),
+			Port:             tea.Int32(int32(serverGroup.Port.ValueInt64())),
+		})
+	}
+
+	detachAlbServerGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		detachAlbServerGroupsRequest := &alicloudEssClient.DetachAlbServerGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupId:  tea.String(scalingGroupId),
+			AlbServerGroups: albServerGroups,
+			ForceDetach:     tea.Bool(forceDetach),
+		}
+
+		if _, err := r.client.DetachAlbServerGroupsWithOptions(detachAlbServerGroupsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(detachAlbServerGroups)
+}
@@ -0,0 +1,587 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	alicloudNlbClient "github.com/alibabacloud-go/nlb-20220430/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &essAttachNlbServerGroupResource{}
+	_ resource.ResourceWithConfigure   = &essAttachNlbServerGroupResource{}
+	_ resource.ResourceWithImportState = &essAttachNlbServerGroupResource{}
+)
+
+func NewEssAttachNlbServerGroupResource() resource.Resource {
+	return &essAttachNlbServerGroupResource{}
+}
+
+type essAttachNlbServerGroupResource struct {
+	client    *alicloudEssClient.Client
+	nlbClient *alicloudNlbClient.Client
+}
+
+// The type of the NLB server groups in the ESS server group APIs.
+const essNlbServerGroupType = "NLB"
+
+type essAttachNlbServerGroupModel struct {
+	ScalingGroupId  types.String      `tfsdk:"scaling_group_id"`
+	NlbServerGroups []*nlbServerGroup `tfsdk:"nlb_server_groups"`
+}
+
+type nlbServerGroup struct {
+	NlbServerGroupId types.String `tfsdk:"nlb_server_group_id"`
+	Port             types.Int64  `tfsdk:"port"`
+	Weight           types.Int64  `tfsdk:"weight"`
+}
+
+// Metadata returns the ESS Attach NLB Server Group resource name.
+func (r *essAttachNlbServerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_attach_nlb_server_group"
+}
+
+// Schema defines the schema for the ESS Attach NLB Server Group resource.
+func (r *essAttachNlbServerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach an auto scaling group (ESS) with a list of network load balancer (NLB) server groups.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"nlb_server_groups": schema.ListNestedBlock{
+				Description: "List of NLB server groups to be attached with the scaling group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"nlb_server_group_id": schema.StringAttribute{
+							Description: "NLB server group ID.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port used by the ECS instances of the scaling group in the NLB server group.",
+							Required:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the ECS instances of the scaling group in the NLB server group.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essAttachNlbServerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+	r.nlbClient = req.ProviderData.(alicloudClients).nlbClient
+}
+
+// Attach scaling group with NLB server groups.
+func (r *essAttachNlbServerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essAttachNlbServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.attachNlbServerGroups(plan.ScalingGroupId.ValueString(), plan.NlbServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to attach scaling group with NLB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the attached NLB server groups of the scaling group. The port and
+// weight of each server group are reconstructed from the servers of the
+// scaling group's instances in the server group, so that drift on any of
+// the server groups is detected.
+func (r *essAttachNlbServerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essAttachNlbServerGroupModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingGroup, err := r.describeScalingGroup(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get attached NLB server groups from scaling group.",
+			err.Error(),
+		)
+		return
+	}
+	if scalingGroup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	instanceIds, err := r.describeScalingInstanceIds(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to get instances of scaling group.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Start from the attachment of the scaling group, as a server group may
+	// be imported or attached outside of Terraform.
+	// The server groups of all load balancer types are returned together,
+	// only the NLB server groups are managed by this resource.
+	serverGroups := []*nlbServerGroup{}
+	for _, attached := range scalingGroup.ServerGroups {
+		if tea.StringValue(attached.Type) != essNlbServerGroupType {
+			continue
+		}
+		serverGroup := &nlbServerGroup{
+			NlbServerGroupId: types.StringValue(tea.StringValue(attached.ServerGroupId)),
+			Port:             types.Int64Value(int64(tea.Int32Value(attached.Port))),
+			Weight:           types.Int64Value(int64(tea.Int32Value(attached.Weight))),
+		}
+
+		servers, err := r.listServerGroupServers(tea.StringValue(attached.ServerGroupId))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to list servers of NLB server group.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, server := range servers {
+			if _, ok := instanceIds[tea.StringValue(server.ServerId)]; !ok {
+				continue
+			}
+			if tea.Int32Value(server.Port) != tea.Int32Value(attached.Port) {
+				continue
+			}
+			serverGroup.Weight = types.Int64Value(int64(tea.Int32Value(server.Weight)))
+			break
+		}
+		serverGroups = append(serverGroups, serverGroup)
+	}
+
+	// Keep the order of the server groups in state to avoid unnecessary diff.
+	ordered := []*nlbServerGroup{}
+	for _, stateServerGroup := range state.NlbServerGroups {
+		for i, serverGroup := range serverGroups {
+			if serverGroup != nil && serverGroup.NlbServerGroupId.Equal(stateServerGroup.NlbServerGroupId) && serverGroup.Port.Equal(stateServerGroup.Port) {
+				ordered = append(ordered, serverGroup)
+				serverGroups[i] = nil
+				break
+			}
+		}
+	}
+	for _, serverGroup := range serverGroups {
+		if serverGroup != nil {
+			ordered = append(ordered, serverGroup)
+		}
+	}
+	state.NlbServerGroups = ordered
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Attach the added NLB server groups, detach the removed NLB server groups
+// and update the weight of the scaling group's instances in the remaining
+// NLB server groups.
+func (r *essAttachNlbServerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essAttachNlbServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A server group is identified by server group ID and port, as the
+	// same server group may be attached with different ports.
+	serverGroupKey := func(serverGroup *nlbServerGroup) string {
+		return fmt.Sprintf("%s:%d", serverGroup.NlbServerGroupId.ValueString(), serverGroup.Port.ValueInt64())
+	}
+
+	planServerGroups := make(map[string]*nlbServerGroup)
+	for _, serverGroup := range plan.NlbServerGroups {
+		planServerGroups[serverGroupKey(serverGroup)] = serverGroup
+	}
+	stateServerGroups := make(map[string]*nlbServerGroup)
+	for _, serverGroup := range state.NlbServerGroups {
+		stateServerGroups[serverGroupKey(serverGroup)] = serverGroup
+	}
+
+	var attachServerGroups, detachServerGroups, updateServerGroups []*nlbServerGroup
+	for _, serverGroup := range plan.NlbServerGroups {
+		if stateServerGroup, exists := stateServerGroups[serverGroupKey(serverGroup)]; !exists {
+			attachServerGroups = append(attachServerGroups, serverGroup)
+		} else if !serverGroup.Weight.Equal(stateServerGroup.Weight) {
+			updateServerGroups = append(updateServerGroups, serverGroup)
+		}
+	}
+	for _, serverGroup := range state.NlbServerGroups {
+		if _, exists := planServerGroups[serverGroupKey(serverGroup)]; !exists {
+			detachServerGroups = append(detachServerGroups, serverGroup)
+		}
+	}
+
+	// Detach before attach, so that the same server group can be attached
+	// with another port.
+	err := r.detachNlbServerGroups(plan.ScalingGroupId.ValueString(), detachServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to detach scaling group with NLB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.attachNlbServerGroups(plan.ScalingGroupId.ValueString(), attachServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to attach scaling group with NLB server groups.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(updateServerGroups) > 0 {
+		// ESS keeps the weight of the attachment for the instances added
+		// later, so re-attach the server groups with the new weight. The
+		// instances are kept in the server groups to avoid interrupting the
+		// traffic, and their weight is updated on the NLB side instead.
+		err = r.detachNlbServerGroups(plan.ScalingGroupId.ValueString(), updateServerGroups, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to detach scaling group with NLB server groups.",
+				err.Error(),
+			)
+			return
+		}
+
+		err = r.attachNlbServerGroups(plan.ScalingGroupId.ValueString(), updateServerGroups, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to attach scaling group with NLB server groups.",
+				err.Error(),
+			)
+			return
+		}
+
+		instanceIds, err := r.describeScalingInstanceIds(plan.ScalingGroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to get instances of scaling group.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, serverGroup := range updateServerGroups {
+			err := r.updateServerGroupServersWeight(serverGroup, instanceIds)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to update weight of servers in NLB server group.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Detach scaling group with NLB server groups.
+func (r *essAttachNlbServerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essAttachNlbServerGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.detachNlbServerGroups(state.ScalingGroupId.ValueString(), state.NlbServerGroups, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to detach scaling group with NLB server groups.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essAttachNlbServerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to read the scaling group with the attached server groups,
+// returns nil if the scaling group is not found.
+func (r *essAttachNlbServerGroupResource) describeScalingGroup(scalingGroupId string) (*alicloudEssClient.DescribeScalingGroupsResponseBodyScalingGroups, error) {
+	var describeScalingGroupsResponse *alicloudEssClient.DescribeScalingGroupsResponse
+	describeScalingGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingGroupsRequest := &alicloudEssClient.DescribeScalingGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupIds: []*string{tea.String(scalingGroupId)},
+		}
+
+		var err error
+		describeScalingGroupsResponse, err = r.client.DescribeScalingGroupsWithOptions(describeScalingGroupsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingGroups); err != nil {
+		return nil, err
+	}
+
+	if len(describeScalingGroupsResponse.Body.ScalingGroups) == 0 {
+		return nil, nil
+	}
+	return describeScalingGroupsResponse.Body.ScalingGroups[0], nil
+}
+
+// Function to read the IDs of all instances in the scaling group.
+func (r *essAttachNlbServerGroupResource) describeScalingInstanceIds(scalingGroupId string) (map[string]struct{}, error) {
+	instanceIds := make(map[string]struct{})
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeScalingInstancesResponse *alicloudEssClient.DescribeScalingInstancesResponse
+		describeScalingInstances := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeScalingInstancesRequest := &alicloudEssClient.DescribeScalingInstancesRequest{
+				RegionId:       r.client.RegionId,
+				ScalingGroupId: tea.String(scalingGroupId),
+				PageNumber:     tea.Int32(pageNumber),
+				PageSize:       tea.Int32(pageSize),
+			}
+
+			var err error
+			describeScalingInstancesResponse, err = r.client.DescribeScalingInstancesWithOptions(describeScalingInstancesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeScalingInstances); err != nil {
+			return nil, err
+		}
+
+		for _, instance := range describeScalingInstancesResponse.Body.ScalingInstances {
+			instanceIds[tea.StringValue(instance.InstanceId)] = struct{}{}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeScalingInstancesResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return instanceIds, nil
+}
+
+// Function to list all servers in the NLB server group.
+func (r *essAttachNlbServerGroupResource) listServerGroupServers(serverGroupId string) ([]*alicloudNlbClient.ListServerGroupServersResponseBodyServers, error) {
+	var servers []*alicloudNlbClient.ListServerGroupServersResponseBodyServers
+	var nextToken *string
+
+	for {
+		var listServerGroupServersResponse *alicloudNlbClient.ListServerGroupServersResponse
+		listServerGroupServers := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listServerGroupServersRequest := &alicloudNlbClient.ListServerGroupServersRequest{
+				RegionId:      r.client.RegionId,
+				ServerGroupId: tea.String(serverGroupId),
+				MaxResults:    tea.Int32(100),
+				NextToken:     nextToken,
+			}
+
+			var err error
+			listServerGroupServersResponse, err = r.nlbClient.ListServerGroupServersWithOptions(listServerGroupServersRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listServerGroupServers); err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, listServerGroupServersResponse.Body.Servers...)
+
+		nextToken = listServerGroupServersResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return servers, nil
+}
+
+// Function to update the weight of the scaling group's instances in the NLB
+// server group.
+func (r *essAttachNlbServerGroupResource) updateServerGroupServersWeight(serverGroup *nlbServerGroup, instanceIds map[string]struct{}) error {
+	servers, err := r.listServerGroupServers(serverGroup.NlbServerGroupId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var updateServers []*alicloudNlbClient.UpdateServerGroupServersAttributeRequestServers
+	for _, server := range servers {
+		if _, ok := instanceIds[tea.StringValue(server.ServerId)]; !ok {
+			continue
+		}
+		if int64(tea.Int32Value(server.Port)) != serverGroup.Port.ValueInt64() {
+			continue
+		}
+		updateServers = append(updateServers, &alicloudNlbClient.UpdateServerGroupServersAttributeRequestServers{
+			ServerId:   server.ServerId,
+			ServerType: server.ServerType,
+			Port:       server.Port,
+			Weight:     tea.Int32(int32(serverGroup.Weight.ValueInt64())),
+		})
+	}
+	if len(updateServers) == 0 {
+		return nil
+	}
+
+	updateServerGroupServersAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateServerGroupServersAttributeRequest := &alicloudNlbClient.UpdateServerGroupServersAttributeRequest{
+			RegionId:      r.client.RegionId,
+			ServerGroupId: tea.String(serverGroup.NlbServerGroupId.ValueString()),
+			Servers:       updateServers,
+		}
+
+		if _, err := r.nlbClient.UpdateServerGroupServersAttributeWithOptions(updateServerGroupServersAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateServerGroupServersAttribute)
+}
+
+// Function to attach scaling group with NLB server groups, the existing
+// instances of the scaling group are added to the server groups if
+// forceAttach is true.
+func (r *essAttachNlbServerGroupResource) attachNlbServerGroups(scalingGroupId string, serverGroups []*nlbServerGroup, forceAttach bool) error {
+	if len(serverGroups) == 0 {
+		return nil
+	}
+
+	var nlbServerGroups []*alicloudEssClient.AttachServerGroupsRequestServerGroups
+	for _, serverGroup := range serverGroups {
+		nlbServerGroups = append(nlbServerGroups, &alicloudEssClient.AttachServerGroupsRequestServerGroups{
+			ServerGroupId: tea.String(serverGroup.NlbServerGroupId.ValueString()),
+			Port:          tea.Int32(int32(serverGroup.Port.ValueInt64())),
+			Weight:        tea.Int32(int32(serverGroup.Weight.ValueInt64())),
+			Type:          tea.String(essNlbServerGroupType),
+		})
+	}
+
+	attachNlbServerGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		attachNlbServerGroupsRequest := &alicloudEssClient.AttachServerGroupsRequest{
+			RegionId:       r.client.RegionId,
+			ScalingGroupId: tea.String(scalingGroupId),
+			ServerGroups:   nlbServerGroups,
+			ForceAttach:    tea.Bool(forceAttach),
+		}
+
+		if _, err := r.client.AttachServerGroupsWithOptions(attachNlbServerGroupsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(attachNlbServerGroups)
+}
+
+// Function to detach scaling group with NLB server groups, the instances of
+// the scaling group are removed from the server groups if forceDetach is true.
+func (r *essAttachNlbServerGroupResource) detachNlbServerGroups(scalingGroupId string, serverGroups []*nlbServerGroup, forceDetach bool) error {
+	if len(serverGroups) == 0 {
+		return nil
+	}
+
+	var nlbServerGroups []*alicloudEssClient.DetachServerGroupsRequestServerGroups
+	for _, serverGroup := range serverGroups {
+		nlbServerGroups = append(nlbServerGroups, &alicloudEssClient.DetachServerGroupsRequestServerGroups{
+			ServerGroupId: tea.String(serverGroup.NlbServerGroupId.ValueString()),
+			Port:          tea.Int32(int32(serverGroup.Port.ValueInt64())),
+			Type:          tea.String(essNlbServerGroupType),
+		})
+	}
+
+	detachNlbServerGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		detachNlbServerGroupsRequest := &alicloudEssClient.DetachServerGroupsRequest{
+			RegionId:       r.client.RegionId,
+			ScalingGroupId: tea.String(scalingGroupId),
+			ServerGroups:   nlbServerGroups,
+			ForceDetach:    tea.Bool(forceDetach),
+		}
+
+		if _, err := r.client.DetachServerGroupsWithOptions(detachNlbServerGroupsRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(detachNlbServerGroups)
+}
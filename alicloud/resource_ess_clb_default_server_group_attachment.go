@@ -3,7 +3,6 @@ package alicloud
 import (
 	"context"
 	"fmt"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
@@ -298,9 +297,7 @@ func (r *essClbDefaultServerGroupAttachmentResource) getLoadBalancersFromScaling
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeScalingGroups, reconnectBackoff)
+	err = retryAPICall(describeScalingGroups)
 	if err != nil {
 		return loadBalancers, scalingGroupId, err
 	}
@@ -347,9 +344,7 @@ func (r *essClbDefaultServerGroupAttachmentResource) attachLoadBalancers(model *
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(attachLoadBalancers, reconnectBackoff)
+	err := retryAPICall(attachLoadBalancers)
 	if err != nil {
 		return err
 	}
@@ -388,9 +383,7 @@ func (r *essClbDefaultServerGroupAttachmentResource) detachLoadBalancers(model *
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(detachLoadBalancers, reconnectBackoff)
+	err := retryAPICall(detachLoadBalancers)
 	if err != nil {
 		return err
 	}
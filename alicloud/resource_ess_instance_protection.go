@@ -0,0 +1,330 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The lifecycle state of the instances which are protected from scale in.
+	essInstanceProtectedState = "Protected"
+
+	// The maximum number of instances to be set in a single
+	// SetInstancesProtection request.
+	essInstanceProtectionBatchSize = 20
+)
+
+var (
+	_ resource.Resource                = &essInstanceProtectionResource{}
+	_ resource.ResourceWithConfigure   = &essInstanceProtectionResource{}
+	_ resource.ResourceWithImportState = &essInstanceProtectionResource{}
+)
+
+func NewEssInstanceProtectionResource() resource.Resource {
+	return &essInstanceProtectionResource{}
+}
+
+type essInstanceProtectionResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essInstanceProtectionModel struct {
+	ScalingGroupId types.String `tfsdk:"scaling_group_id"`
+	InstanceIds    types.List   `tfsdk:"instance_ids"`
+}
+
+// Metadata returns the ESS Instance Protection resource name.
+func (r *essInstanceProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_instance_protection"
+}
+
+// Schema defines the schema for the ESS Instance Protection resource.
+func (r *essInstanceProtectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Protect a list of ECS instances in an auto scaling group (ESS) from being removed during scale in.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_ids": schema.ListAttribute{
+				Description: "List of ECS instance IDs in the scaling group to be protected from scale in.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essInstanceProtectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Protect the instances from scale in.
+func (r *essInstanceProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essInstanceProtectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setInstancesProtection(plan.ScalingGroupId.ValueString(), convertListValueToStrings(plan.InstanceIds), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Protect Instances from Scale In.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the protected instances of the scaling group. The instances which are
+// unprotected or removed from the scaling group are removed from state. All
+// the protected instances are read when importing.
+func (r *essInstanceProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essInstanceProtectionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protectedInstanceIds, err := r.describeProtectedInstanceIds(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Instances.",
+			err.Error(),
+		)
+		return
+	}
+
+	instanceIds := []attr.Value{}
+	if state.InstanceIds.IsNull() {
+		for _, instanceId := range protectedInstanceIds {
+			instanceIds = append(instanceIds, types.StringValue(instanceId))
+		}
+	} else {
+		protected := make(map[string]struct{})
+		for _, instanceId := range protectedInstanceIds {
+			protected[instanceId] = struct{}{}
+		}
+		for _, instanceId := range convertListValueToStrings(state.InstanceIds) {
+			if _, ok := protected[instanceId]; ok {
+				instanceIds = append(instanceIds, types.StringValue(instanceId))
+			}
+		}
+	}
+
+	if len(instanceIds) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.InstanceIds = types.ListValueMust(types.StringType, instanceIds)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Unprotect the removed instances and protect the added instances.
+func (r *essInstanceProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essInstanceProtectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planInstanceIds := convertListValueToStrings(plan.InstanceIds)
+	stateInstanceIds := convertListValueToStrings(state.InstanceIds)
+
+	removedInstanceIds := convertStringsDifference(stateInstanceIds, planInstanceIds)
+	if len(removedInstanceIds) > 0 {
+		err := r.unprotectInstances(state.ScalingGroupId.ValueString(), removedInstanceIds)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Unprotect Instances from Scale In.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	addedInstanceIds := convertStringsDifference(planInstanceIds, stateInstanceIds)
+	if len(addedInstanceIds) > 0 {
+		err := r.setInstancesProtection(plan.ScalingGroupId.ValueString(), addedInstanceIds, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Protect Instances from Scale In.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Unprotect the instances from scale in.
+func (r *essInstanceProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essInstanceProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.unprotectInstances(state.ScalingGroupId.ValueString(), convertListValueToStrings(state.InstanceIds))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Unprotect Instances from Scale In.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essInstanceProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to unprotect the instances which are still protected in the
+// scaling group. The instances which are already removed from the scaling
+// group are skipped, as they can not be unprotected anymore.
+func (r *essInstanceProtectionResource) unprotectInstances(scalingGroupId string, instanceIds []string) error {
+	protectedInstanceIds, err := r.describeProtectedInstanceIds(scalingGroupId)
+	if err != nil {
+		return err
+	}
+
+	protected := make(map[string]struct{})
+	for _, instanceId := range protectedInstanceIds {
+		protected[instanceId] = struct{}{}
+	}
+
+	var unprotectInstanceIds []string
+	for _, instanceId := range instanceIds {
+		if _, ok := protected[instanceId]; ok {
+			unprotectInstanceIds = append(unprotectInstanceIds, instanceId)
+		}
+	}
+	return r.setInstancesProtection(scalingGroupId, unprotectInstanceIds, false)
+}
+
+// Function to set or unset the instance protection in batches.
+func (r *essInstanceProtectionResource) setInstancesProtection(scalingGroupId string, instanceIds []string, protected bool) error {
+	for start := 0; start < len(instanceIds); start += essInstanceProtectionBatchSize {
+		end := start + essInstanceProtectionBatchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+
+		setInstancesProtection := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			setInstancesProtectionRequest := &alicloudEssClient.SetInstancesProtectionRequest{
+				ScalingGroupId:       tea.String(scalingGroupId),
+				InstanceIds:          tea.StringSlice(instanceIds[start:end]),
+				ProtectedFromScaleIn: tea.Bool(protected),
+			}
+
+			if _, err := r.client.SetInstancesProtectionWithOptions(setInstancesProtectionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(setInstancesProtection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to read the IDs of the protected instances in the scaling group.
+func (r *essInstanceProtectionResource) describeProtectedInstanceIds(scalingGroupId string) ([]string, error) {
+	instanceIds := []string{}
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeScalingInstancesResponse *alicloudEssClient.DescribeScalingInstancesResponse
+		describeScalingInstances := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeScalingInstancesRequest := &alicloudEssClient.DescribeScalingInstancesRequest{
+				RegionId:       r.client.RegionId,
+				ScalingGroupId: tea.String(scalingGroupId),
+				LifecycleState: tea.String(essInstanceProtectedState),
+				PageNumber:     tea.Int32(pageNumber),
+				PageSize:       tea.Int32(pageSize),
+			}
+
+			var err error
+			describeScalingInstancesResponse, err = r.client.DescribeScalingInstancesWithOptions(describeScalingInstancesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeScalingInstances); err != nil {
+			return nil, err
+		}
+
+		for _, instance := range describeScalingInstancesResponse.Body.ScalingInstances {
+			if tea.StringValue(instance.LifecycleState) == essInstanceProtectedState {
+				instanceIds = append(instanceIds, tea.StringValue(instance.InstanceId))
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeScalingInstancesResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return instanceIds, nil
+}
@@ -0,0 +1,425 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	alicloudStsClient "github.com/alibabacloud-go/sts-20150401/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &essLifecycleHookResource{}
+	_ resource.ResourceWithConfigure   = &essLifecycleHookResource{}
+	_ resource.ResourceWithImportState = &essLifecycleHookResource{}
+)
+
+func NewEssLifecycleHookResource() resource.Resource {
+	return &essLifecycleHookResource{}
+}
+
+type essLifecycleHookResource struct {
+	client    *alicloudEssClient.Client
+	stsClient *alicloudStsClient.Client
+}
+
+type essLifecycleHookModel struct {
+	LifecycleHookId      types.String                  `tfsdk:"lifecycle_hook_id"`
+	ScalingGroupId       types.String                  `tfsdk:"scaling_group_id"`
+	LifecycleHookName    types.String                  `tfsdk:"lifecycle_hook_name"`
+	LifecycleTransition  types.String                  `tfsdk:"lifecycle_transition"`
+	HeartbeatTimeout     types.Int64                   `tfsdk:"heartbeat_timeout"`
+	DefaultResult        types.String                  `tfsdk:"default_result"`
+	NotificationMetadata types.String                  `tfsdk:"notification_metadata"`
+	NotificationArn      types.String                  `tfsdk:"notification_arn"`
+	NotificationTarget   *essLifecycleHookNotification `tfsdk:"notification_target"`
+}
+
+type essLifecycleHookNotification struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+// Metadata returns the ESS Lifecycle Hook resource name.
+func (r *essLifecycleHookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_lifecycle_hook"
+}
+
+// Schema defines the schema for the ESS Lifecycle Hook resource.
+func (r *essLifecycleHookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a lifecycle hook of an auto scaling group (ESS), which puts the instances into the pending " +
+			"state during scale-out or scale-in and optionally notifies a MNS queue, MNS topic or OOS template.",
+		Attributes: map[string]schema.Attribute{
+			"lifecycle_hook_id": schema.StringAttribute{
+				Description: "The ID of the lifecycle hook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lifecycle_hook_name": schema.StringAttribute{
+				Description: "The name of the lifecycle hook.",
+				Required:    true,
+			},
+			"lifecycle_transition": schema.StringAttribute{
+				Description: "The scaling activity to which the lifecycle hook applies. Accepted values: \"SCALE_OUT\", \"SCALE_IN\".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SCALE_OUT", "SCALE_IN"),
+				},
+			},
+			"heartbeat_timeout": schema.Int64Attribute{
+				Description: "The period in seconds that the instances are kept in the pending state. Default to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+				Validators: []validator.Int64{
+					int64validator.Between(30, 21600),
+				},
+			},
+			"default_result": schema.StringAttribute{
+				Description: "The action to perform when the heartbeat timeout is reached. " +
+					"Accepted values: \"CONTINUE\", \"ABANDON\", \"ROLLBACK\". Default to CONTINUE.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("CONTINUE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("CONTINUE", "ABANDON", "ROLLBACK"),
+				},
+			},
+			"notification_metadata": schema.StringAttribute{
+				Description: "The fixed string included in the notification sent to the notification target.",
+				Optional:    true,
+			},
+			"notification_arn": schema.StringAttribute{
+				Description: "The ARN of the notification target.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"notification_target": schema.SingleNestedBlock{
+				Description: "The target to be notified when the lifecycle hook is triggered.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The type of the notification target. Accepted values: \"queue\" for MNS queue, " +
+							"\"topic\" for MNS topic, \"oos\" for OOS template.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("queue", "topic", "oos"),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the MNS queue, MNS topic or OOS template.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essLifecycleHookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+	r.stsClient = req.ProviderData.(alicloudClients).stsClient
+}
+
+// Create a new lifecycle hook.
+func (r *essLifecycleHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essLifecycleHookModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationArn, err := r.buildNotificationArn(plan.NotificationTarget)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Caller Identity.",
+			err.Error(),
+		)
+		return
+	}
+
+	var createLifecycleHookResponse *alicloudEssClient.CreateLifecycleHookResponse
+	createLifecycleHook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createLifecycleHookRequest := &alicloudEssClient.CreateLifecycleHookRequest{
+			ScalingGroupId:      tea.String(plan.ScalingGroupId.ValueString()),
+			LifecycleHookName:   tea.String(plan.LifecycleHookName.ValueString()),
+			LifecycleTransition: tea.String(plan.LifecycleTransition.ValueString()),
+			HeartbeatTimeout:    tea.Int32(int32(plan.HeartbeatTimeout.ValueInt64())),
+			DefaultResult:       tea.String(plan.DefaultResult.ValueString()),
+		}
+		if !plan.NotificationMetadata.IsNull() {
+			createLifecycleHookRequest.NotificationMetadata = tea.String(plan.NotificationMetadata.ValueString())
+		}
+		if notificationArn != "" {
+			createLifecycleHookRequest.NotificationArn = tea.String(notificationArn)
+		}
+
+		var err error
+		createLifecycleHookResponse, err = r.client.CreateLifecycleHookWithOptions(createLifecycleHookRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createLifecycleHook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Lifecycle Hook.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.LifecycleHookId = types.StringValue(tea.StringValue(createLifecycleHookResponse.Body.LifecycleHookId))
+	plan.NotificationArn = types.StringValue(notificationArn)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the lifecycle hook.
+func (r *essLifecycleHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essLifecycleHookModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeLifecycleHooksResponse *alicloudEssClient.DescribeLifecycleHooksResponse
+	describeLifecycleHooks := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeLifecycleHooksRequest := &alicloudEssClient.DescribeLifecycleHooksRequest{
+			RegionId:         r.client.RegionId,
+			LifecycleHookIds: []*string{tea.String(state.LifecycleHookId.ValueString())},
+		}
+
+		var err error
+		describeLifecycleHooksResponse, err = r.client.DescribeLifecycleHooksWithOptions(describeLifecycleHooksRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeLifecycleHooks); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Lifecycle Hooks.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(describeLifecycleHooksResponse.Body.LifecycleHooks) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	lifecycleHook := describeLifecycleHooksResponse.Body.LifecycleHooks[0]
+	state.ScalingGroupId = types.StringValue(tea.StringValue(lifecycleHook.ScalingGroupId))
+	state.LifecycleHookName = types.StringValue(tea.StringValue(lifecycleHook.LifecycleHookName))
+	state.LifecycleTransition = types.StringValue(tea.StringValue(lifecycleHook.LifecycleTransition))
+	state.HeartbeatTimeout = types.Int64Value(int64(tea.Int32Value(lifecycleHook.HeartbeatTimeout)))
+	state.DefaultResult = types.StringValue(tea.StringValue(lifecycleHook.DefaultResult))
+	if tea.StringValue(lifecycleHook.NotificationMetadata) != "" {
+		state.NotificationMetadata = types.StringValue(tea.StringValue(lifecycleHook.NotificationMetadata))
+	} else {
+		state.NotificationMetadata = types.StringNull()
+	}
+
+	notificationArn := tea.StringValue(lifecycleHook.NotificationArn)
+	state.NotificationArn = types.StringValue(notificationArn)
+	state.NotificationTarget = parseEssNotificationArn(notificationArn)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the lifecycle hook.
+func (r *essLifecycleHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essLifecycleHookModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationArn, err := r.buildNotificationArn(plan.NotificationTarget)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Caller Identity.",
+			err.Error(),
+		)
+		return
+	}
+
+	modifyLifecycleHook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyLifecycleHookRequest := &alicloudEssClient.ModifyLifecycleHookRequest{
+			RegionId:            r.client.RegionId,
+			ScalingGroupId:      tea.String(plan.ScalingGroupId.ValueString()),
+			LifecycleHookId:     tea.String(state.LifecycleHookId.ValueString()),
+			LifecycleHookName:   tea.String(plan.LifecycleHookName.ValueString()),
+			LifecycleTransition: tea.String(plan.LifecycleTransition.ValueString()),
+			HeartbeatTimeout:    tea.Int32(int32(plan.HeartbeatTimeout.ValueInt64())),
+			DefaultResult:       tea.String(plan.DefaultResult.ValueString()),
+			// Empty values are sent to clear the notification of the lifecycle hook.
+			NotificationMetadata: tea.String(plan.NotificationMetadata.ValueString()),
+			NotificationArn:      tea.String(notificationArn),
+		}
+
+		if _, err := r.client.ModifyLifecycleHookWithOptions(modifyLifecycleHookRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyLifecycleHook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Lifecycle Hook.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.LifecycleHookId = state.LifecycleHookId
+	plan.NotificationArn = types.StringValue(notificationArn)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the lifecycle hook.
+func (r *essLifecycleHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essLifecycleHookModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteLifecycleHook := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteLifecycleHookRequest := &alicloudEssClient.DeleteLifecycleHookRequest{
+			RegionId:        r.client.RegionId,
+			LifecycleHookId: tea.String(state.LifecycleHookId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteLifecycleHookWithOptions(deleteLifecycleHookRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteLifecycleHook); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Lifecycle Hook.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essLifecycleHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("lifecycle_hook_id"), req, resp)
+}
+
+// Function to build the notification ARN in the format of
+// acs:ess:<region>:<account-id>:<type>/<name>, returns empty string if no
+// notification target is configured.
+func (r *essLifecycleHookResource) buildNotificationArn(target *essLifecycleHookNotification) (string, error) {
+	if target == nil || target.Type.IsNull() || target.Name.IsNull() {
+		return "", nil
+	}
+
+	var getCallerIdentityResponse *alicloudStsClient.GetCallerIdentityResponse
+	getCallerIdentity := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		getCallerIdentityResponse, err = r.stsClient.GetCallerIdentityWithOptions(runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getCallerIdentity); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("acs:ess:%s:%s:%s/%s",
+		tea.StringValue(r.client.RegionId),
+		tea.StringValue(getCallerIdentityResponse.Body.AccountId),
+		target.Type.ValueString(),
+		target.Name.ValueString(),
+	), nil
+}
+
+// Parse the notification target from the notification ARN, returns nil if
+// the lifecycle hook does not notify any target.
+func parseEssNotificationArn(notificationArn string) *essLifecycleHookNotification {
+	parts := strings.SplitN(notificationArn, ":", 5)
+	if len(parts) != 5 {
+		return nil
+	}
+
+	target := strings.SplitN(parts[4], "/", 2)
+	if len(target) != 2 {
+		return nil
+	}
+	return &essLifecycleHookNotification{
+		Type: types.StringValue(target[0]),
+		Name: types.StringValue(target[1]),
+	}
+}
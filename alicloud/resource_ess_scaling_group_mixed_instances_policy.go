@@ -0,0 +1,548 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The spot strategy of the scaling configuration without the spot
+	// instances.
+	essSpotStrategyNoSpot = "NoSpot"
+)
+
+var (
+	_ resource.Resource                = &essScalingGroupMixedInstancesPolicyResource{}
+	_ resource.ResourceWithConfigure   = &essScalingGroupMixedInstancesPolicyResource{}
+	_ resource.ResourceWithImportState = &essScalingGroupMixedInstancesPolicyResource{}
+)
+
+func NewEssScalingGroupMixedInstancesPolicyResource() resource.Resource {
+	return &essScalingGroupMixedInstancesPolicyResource{}
+}
+
+type essScalingGroupMixedInstancesPolicyResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScalingGroupMixedInstancesPolicyModel struct {
+	ScalingGroupId                      types.String         `tfsdk:"scaling_group_id"`
+	ScalingConfigurationId              types.String         `tfsdk:"scaling_configuration_id"`
+	InstanceTypes                       types.List           `tfsdk:"instance_types"`
+	SpotStrategy                        types.String         `tfsdk:"spot_strategy"`
+	SpotPriceLimits                     []*essSpotPriceLimit `tfsdk:"spot_price_limits"`
+	MultiAZPolicy                       types.String         `tfsdk:"multi_az_policy"`
+	OnDemandBaseCapacity                types.Int64          `tfsdk:"on_demand_base_capacity"`
+	OnDemandPercentageAboveBaseCapacity types.Int64          `tfsdk:"on_demand_percentage_above_base_capacity"`
+	SpotInstancePools                   types.Int64          `tfsdk:"spot_instance_pools"`
+	SpotInstanceRemedy                  types.Bool           `tfsdk:"spot_instance_remedy"`
+	CompensateWithOnDemand              types.Bool           `tfsdk:"compensate_with_on_demand"`
+}
+
+type essSpotPriceLimit struct {
+	InstanceType types.String  `tfsdk:"instance_type"`
+	PriceLimit   types.Float64 `tfsdk:"price_limit"`
+}
+
+// Metadata returns the ESS Scaling Group Mixed Instances Policy resource name.
+func (r *essScalingGroupMixedInstancesPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scaling_group_mixed_instances_policy"
+}
+
+// Schema defines the schema for the ESS Scaling Group Mixed Instances Policy
+// resource.
+func (r *essScalingGroupMixedInstancesPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure the mix of the spot and on-demand instances of multiple instance types for an " +
+			"auto scaling group (ESS). The instance types and the spot strategy are set to the scaling " +
+			"configuration, and the on-demand base capacity and the allocation of the spot instances are set " +
+			"to the scaling group. The scaling group is reverted to the on-demand instances only when the " +
+			"resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scaling_configuration_id": schema.StringAttribute{
+				Description: "The ID of the scaling configuration to set the instance types and the spot " +
+					"strategy. Default to the active scaling configuration of the scaling group.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_types": schema.ListAttribute{
+				Description: "The instance types of the scaling configuration, in the order of the priority " +
+					"when the multi_az_policy is PRIORITY.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"spot_strategy": schema.StringAttribute{
+				Description: "The spot strategy of the scaling configuration. Valid values: SpotAsPriceGo, " +
+					"SpotWithPriceLimit. Default to SpotAsPriceGo.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("SpotAsPriceGo"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("SpotAsPriceGo", "SpotWithPriceLimit"),
+				},
+			},
+			"multi_az_policy": schema.StringAttribute{
+				Description: "The allocation strategy of the instances of the scaling group. Valid values: " +
+					"COST_OPTIMIZED, PRIORITY, BALANCE. The on-demand base capacity, the on-demand percentage " +
+					"and the spot instance pools take effect only with COST_OPTIMIZED, which creates the " +
+					"instances with the lowest price. Default to COST_OPTIMIZED.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("COST_OPTIMIZED"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("COST_OPTIMIZED", "PRIORITY", "BALANCE"),
+				},
+			},
+			"on_demand_base_capacity": schema.Int64Attribute{
+				Description: "The minimum number of the on-demand instances in the scaling group. Default to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1000),
+				},
+			},
+			"on_demand_percentage_above_base_capacity": schema.Int64Attribute{
+				Description: "The percentage of the on-demand instances in the instances exceeding the on-demand " +
+					"base capacity. Default to 0.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"spot_instance_pools": schema.Int64Attribute{
+				Description: "The number of the instance types with the lowest price to create the spot " +
+					"instances. Default to 2.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"spot_instance_remedy": schema.BoolAttribute{
+				Description: "Whether to replace the spot instances before they are reclaimed. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"compensate_with_on_demand": schema.BoolAttribute{
+				Description: "Whether to create the on-demand instances when the spot instances can not be " +
+					"created due to the price or the inventory. Default to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"spot_price_limits": schema.ListNestedBlock{
+				Description: "The maximum hourly prices of the spot instances, required by SpotWithPriceLimit.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_type": schema.StringAttribute{
+							Description: "The instance type of the spot instances.",
+							Required:    true,
+						},
+						"price_limit": schema.Float64Attribute{
+							Description: "The maximum hourly price of the spot instances.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essScalingGroupMixedInstancesPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Set the mixed instances policy to the scaling group and the scaling
+// configuration.
+func (r *essScalingGroupMixedInstancesPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essScalingGroupMixedInstancesPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ScalingConfigurationId.IsUnknown() || plan.ScalingConfigurationId.IsNull() {
+		scalingGroup, err := r.describeScalingGroup(plan.ScalingGroupId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Scaling Groups.",
+				err.Error(),
+			)
+			return
+		}
+		if scalingGroup == nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Scaling Groups.",
+				"Scaling group "+plan.ScalingGroupId.ValueString()+" is not found.",
+			)
+			return
+		}
+		plan.ScalingConfigurationId = types.StringValue(tea.StringValue(scalingGroup.ActiveScalingConfigurationId))
+	}
+
+	if err := r.modifyScalingConfiguration(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Configuration.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.modifyScalingGroup(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the mixed instances policy from the scaling group and the scaling
+// configuration.
+func (r *essScalingGroupMixedInstancesPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essScalingGroupMixedInstancesPolicyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingGroup, err := r.describeScalingGroup(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Groups.",
+			err.Error(),
+		)
+		return
+	}
+	if scalingGroup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The active scaling configuration is used on import.
+	if state.ScalingConfigurationId.IsNull() || state.ScalingConfigurationId.ValueString() == "" {
+		state.ScalingConfigurationId = types.StringValue(tea.StringValue(scalingGroup.ActiveScalingConfigurationId))
+	}
+
+	scalingConfiguration, err := r.describeScalingConfiguration(state.ScalingGroupId.ValueString(), state.ScalingConfigurationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Configurations.",
+			err.Error(),
+		)
+		return
+	}
+	if scalingConfiguration == nil || tea.StringValue(scalingConfiguration.SpotStrategy) == essSpotStrategyNoSpot {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	instanceTypes, diags := types.ListValueFrom(ctx, types.StringType, scalingConfiguration.InstanceTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.InstanceTypes = instanceTypes
+	state.SpotStrategy = types.StringValue(tea.StringValue(scalingConfiguration.SpotStrategy))
+
+	previousPriceLimits := make(map[string]types.Float64)
+	for _, spotPriceLimit := range state.SpotPriceLimits {
+		previousPriceLimits[spotPriceLimit.InstanceType.ValueString()] = spotPriceLimit.PriceLimit
+	}
+	state.SpotPriceLimits = nil
+	for _, spotPriceLimit := range scalingConfiguration.SpotPriceLimits {
+		instanceType := tea.StringValue(spotPriceLimit.InstanceType)
+		previousPriceLimit, ok := previousPriceLimits[instanceType]
+		if !ok {
+			previousPriceLimit = types.Float64Null()
+		}
+		state.SpotPriceLimits = append(state.SpotPriceLimits, &essSpotPriceLimit{
+			InstanceType: types.StringValue(instanceType),
+			PriceLimit:   essFloat64Value(previousPriceLimit, spotPriceLimit.PriceLimit),
+		})
+	}
+
+	state.MultiAZPolicy = types.StringValue(tea.StringValue(scalingGroup.MultiAZPolicy))
+	state.OnDemandBaseCapacity = types.Int64Value(int64(tea.Int32Value(scalingGroup.OnDemandBaseCapacity)))
+	state.OnDemandPercentageAboveBaseCapacity = types.Int64Value(int64(tea.Int32Value(scalingGroup.OnDemandPercentageAboveBaseCapacity)))
+	state.SpotInstancePools = types.Int64Value(int64(tea.Int32Value(scalingGroup.SpotInstancePools)))
+	state.SpotInstanceRemedy = types.BoolValue(tea.BoolValue(scalingGroup.SpotInstanceRemedy))
+	state.CompensateWithOnDemand = types.BoolValue(tea.BoolValue(scalingGroup.CompensateWithOnDemand))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the mixed instances policy of the scaling group and the scaling
+// configuration.
+func (r *essScalingGroupMixedInstancesPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essScalingGroupMixedInstancesPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ScalingConfigurationId = state.ScalingConfigurationId
+
+	if err := r.modifyScalingConfiguration(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Configuration.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.modifyScalingGroup(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Revert the scaling group to the on-demand instances only. The instance
+// types of the scaling configuration are kept, and the existing spot
+// instances are replaced during the next scale in and scale out.
+func (r *essScalingGroupMixedInstancesPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essScalingGroupMixedInstancesPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyScalingGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingGroupRequest := &alicloudEssClient.ModifyScalingGroupRequest{
+			RegionId:                            r.client.RegionId,
+			ScalingGroupId:                      tea.String(state.ScalingGroupId.ValueString()),
+			OnDemandBaseCapacity:                tea.Int32(0),
+			OnDemandPercentageAboveBaseCapacity: tea.Int32(100),
+		}
+
+		if _, err := r.client.ModifyScalingGroupWithOptions(modifyScalingGroupRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyScalingGroup); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	modifyScalingConfiguration := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingConfigurationRequest := &alicloudEssClient.ModifyScalingConfigurationRequest{
+			ScalingConfigurationId: tea.String(state.ScalingConfigurationId.ValueString()),
+			SpotStrategy:           tea.String(essSpotStrategyNoSpot),
+		}
+
+		if _, err := r.client.ModifyScalingConfigurationWithOptions(modifyScalingConfigurationRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyScalingConfiguration); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Configuration.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essScalingGroupMixedInstancesPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to set the instance types and the spot strategy to the scaling
+// configuration.
+func (r *essScalingGroupMixedInstancesPolicyResource) modifyScalingConfiguration(model *essScalingGroupMixedInstancesPolicyModel) error {
+	spotPriceLimits := make([]*alicloudEssClient.ModifyScalingConfigurationRequestSpotPriceLimits, 0)
+	for _, spotPriceLimit := range model.SpotPriceLimits {
+		spotPriceLimits = append(spotPriceLimits, &alicloudEssClient.ModifyScalingConfigurationRequestSpotPriceLimits{
+			InstanceType: stringPointerOrNil(spotPriceLimit.InstanceType),
+			PriceLimit:   essFloat32Pointer(spotPriceLimit.PriceLimit),
+		})
+	}
+
+	modifyScalingConfiguration := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingConfigurationRequest := &alicloudEssClient.ModifyScalingConfigurationRequest{
+			ScalingConfigurationId: tea.String(model.ScalingConfigurationId.ValueString()),
+			InstanceTypes:          tea.StringSlice(convertListValueToStrings(model.InstanceTypes)),
+			SpotStrategy:           tea.String(model.SpotStrategy.ValueString()),
+			SpotPriceLimits:        spotPriceLimits,
+		}
+
+		if _, err := r.client.ModifyScalingConfigurationWithOptions(modifyScalingConfigurationRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyScalingConfiguration)
+}
+
+// Function to set the allocation of the spot and on-demand instances to the
+// scaling group.
+func (r *essScalingGroupMixedInstancesPolicyResource) modifyScalingGroup(model *essScalingGroupMixedInstancesPolicyModel) error {
+	modifyScalingGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingGroupRequest := &alicloudEssClient.ModifyScalingGroupRequest{
+			RegionId:                            r.client.RegionId,
+			ScalingGroupId:                      tea.String(model.ScalingGroupId.ValueString()),
+			MultiAZPolicy:                       stringPointerOrNil(model.MultiAZPolicy),
+			OnDemandBaseCapacity:                int32PointerOrNil(model.OnDemandBaseCapacity),
+			OnDemandPercentageAboveBaseCapacity: int32PointerOrNil(model.OnDemandPercentageAboveBaseCapacity),
+			SpotInstancePools:                   int32PointerOrNil(model.SpotInstancePools),
+			SpotInstanceRemedy:                  essBoolPointer(model.SpotInstanceRemedy),
+			CompensateWithOnDemand:              essBoolPointer(model.CompensateWithOnDemand),
+		}
+
+		if _, err := r.client.ModifyScalingGroupWithOptions(modifyScalingGroupRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyScalingGroup)
+}
+
+// Function to read the scaling group, returns nil if the scaling group is not
+// found.
+func (r *essScalingGroupMixedInstancesPolicyResource) describeScalingGroup(scalingGroupId string) (*alicloudEssClient.DescribeScalingGroupsResponseBodyScalingGroups, error) {
+	var describeScalingGroupsResponse *alicloudEssClient.DescribeScalingGroupsResponse
+	describeScalingGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingGroupsRequest := &alicloudEssClient.DescribeScalingGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupIds: []*string{tea.String(scalingGroupId)},
+		}
+
+		var err error
+		describeScalingGroupsResponse, err = r.client.DescribeScalingGroupsWithOptions(describeScalingGroupsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingGroups); err != nil {
+		return nil, err
+	}
+
+	if len(describeScalingGroupsResponse.Body.ScalingGroups) == 0 {
+		return nil, nil
+	}
+	return describeScalingGroupsResponse.Body.ScalingGroups[0], nil
+}
+
+// Function to read the scaling configuration, returns nil if the scaling
+// configuration is not found.
+func (r *essScalingGroupMixedInstancesPolicyResource) describeScalingConfiguration(scalingGroupId, scalingConfigurationId string) (*alicloudEssClient.DescribeScalingConfigurationsResponseBodyScalingConfigurations, error) {
+	var describeScalingConfigurationsResponse *alicloudEssClient.DescribeScalingConfigurationsResponse
+	describeScalingConfigurations := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingConfigurationsRequest := &alicloudEssClient.DescribeScalingConfigurationsRequest{
+			RegionId:                r.client.RegionId,
+			ScalingGroupId:          tea.String(scalingGroupId),
+			ScalingConfigurationIds: []*string{tea.String(scalingConfigurationId)},
+		}
+
+		var err error
+		describeScalingConfigurationsResponse, err = r.client.DescribeScalingConfigurationsWithOptions(describeScalingConfigurationsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingConfigurations); err != nil {
+		return nil, err
+	}
+
+	if len(describeScalingConfigurationsResponse.Body.ScalingConfigurations) == 0 {
+		return nil, nil
+	}
+	return describeScalingConfigurationsResponse.Body.ScalingConfigurations[0], nil
+}
@@ -0,0 +1,405 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The resource type of the scaling groups in the ESS tag APIs.
+	essScalingGroupResourceType = "scalinggroup"
+
+	// The maximum number of tags to be set in a single TagResources request.
+	essTagResourcesBatchSize = 20
+
+	// The prefix of the tags reserved by AliCloud, which can not be set by
+	// the users.
+	essSystemTagPrefix = "acs:"
+)
+
+var (
+	_ resource.Resource                = &essScalingGroupTagsResource{}
+	_ resource.ResourceWithConfigure   = &essScalingGroupTagsResource{}
+	_ resource.ResourceWithImportState = &essScalingGroupTagsResource{}
+)
+
+func NewEssScalingGroupTagsResource() resource.Resource {
+	return &essScalingGroupTagsResource{}
+}
+
+type essScalingGroupTagsResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScalingGroupTagsModel struct {
+	ScalingGroupId       types.String `tfsdk:"scaling_group_id"`
+	Tags                 types.Map    `tfsdk:"tags"`
+	PropagateToInstances types.Bool   `tfsdk:"propagate_to_instances"`
+}
+
+// Metadata returns the ESS Scaling Group Tags resource name.
+func (r *essScalingGroupTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scaling_group_tags"
+}
+
+// Schema defines the schema for the ESS Scaling Group Tags resource.
+func (r *essScalingGroupTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the tags of an auto scaling group (ESS), and optionally propagate the tags to the ECS " +
+			"instances launched by the scaling group.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags to be set on the scaling group. Only the tags in the map are managed, " +
+					"the other tags of the scaling group are not affected.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"propagate_to_instances": schema.BoolAttribute{
+				Description: "Whether to propagate the tags to the ECS instances launched by the scaling group. " +
+					"Only the instances launched after the tags are set are tagged. Default to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essScalingGroupTagsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Set the tags on the scaling group.
+func (r *essScalingGroupTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essScalingGroupTagsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make(map[string]string)
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.tagScalingGroup(plan.ScalingGroupId.ValueString(), tags, plan.PropagateToInstances.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Tag Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the managed tags of the scaling group. The tags which are removed
+// from the scaling group are removed from state. All the tags except the
+// system tags are read when importing.
+func (r *essScalingGroupTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essScalingGroupTagsModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingGroupTags, err := r.listScalingGroupTags(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Scaling Group Tags.",
+			err.Error(),
+		)
+		return
+	}
+
+	managedTags := make(map[string]string)
+	if state.Tags.IsNull() {
+		for key := range scalingGroupTags {
+			if !strings.HasPrefix(key, essSystemTagPrefix) {
+				managedTags[key] = ""
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &managedTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The tags are only propagated when all the managed tags are propagated,
+	// so that any tag which is not propagated is detected as drift.
+	tags := make(map[string]attr.Value)
+	propagateToInstances := true
+	for key := range managedTags {
+		tag, ok := scalingGroupTags[key]
+		if !ok {
+			continue
+		}
+		tags[key] = types.StringValue(tea.StringValue(tag.TagValue))
+		propagateToInstances = propagateToInstances && tea.BoolValue(tag.Propagate)
+	}
+
+	if len(tags) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Tags = types.MapValueMust(types.StringType, tags)
+	state.PropagateToInstances = types.BoolValue(propagateToInstances)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the tags which are removed from the map, and set the added and
+// changed tags. All the tags are set again when propagate_to_instances is
+// changed.
+func (r *essScalingGroupTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essScalingGroupTagsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planTags := make(map[string]string)
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &planTags, false)...)
+	stateTags := make(map[string]string)
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &stateTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removedTagKeys []string
+	for key := range stateTags {
+		if _, ok := planTags[key]; !ok {
+			removedTagKeys = append(removedTagKeys, key)
+		}
+	}
+	if len(removedTagKeys) > 0 {
+		err := r.untagScalingGroup(state.ScalingGroupId.ValueString(), removedTagKeys)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Untag Scaling Group.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	changedTags := make(map[string]string)
+	for key, value := range planTags {
+		if stateValue, ok := stateTags[key]; !ok || stateValue != value ||
+			!plan.PropagateToInstances.Equal(state.PropagateToInstances) {
+			changedTags[key] = value
+		}
+	}
+	if len(changedTags) > 0 {
+		err := r.tagScalingGroup(plan.ScalingGroupId.ValueString(), changedTags, plan.PropagateToInstances.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Tag Scaling Group.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the managed tags from the scaling group.
+func (r *essScalingGroupTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essScalingGroupTagsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateTags := make(map[string]string)
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &stateTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagKeys []string
+	for key := range stateTags {
+		tagKeys = append(tagKeys, key)
+	}
+
+	err := r.untagScalingGroup(state.ScalingGroupId.ValueString(), tagKeys)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Untag Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essScalingGroupTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to set the tags on the scaling group in batches. The value of the
+// existing tags are overwritten.
+func (r *essScalingGroupTagsResource) tagScalingGroup(scalingGroupId string, tags map[string]string, propagate bool) error {
+	var requestTags []*alicloudEssClient.TagResourcesRequestTags
+	for key, value := range tags {
+		requestTags = append(requestTags, &alicloudEssClient.TagResourcesRequestTags{
+			Key:       tea.String(key),
+			Value:     tea.String(value),
+			Propagate: tea.Bool(propagate),
+		})
+	}
+
+	for start := 0; start < len(requestTags); start += essTagResourcesBatchSize {
+		end := start + essTagResourcesBatchSize
+		if end > len(requestTags) {
+			end = len(requestTags)
+		}
+
+		tagResources := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			tagResourcesRequest := &alicloudEssClient.TagResourcesRequest{
+				RegionId:     r.client.RegionId,
+				ResourceType: tea.String(essScalingGroupResourceType),
+				ResourceIds:  []*string{tea.String(scalingGroupId)},
+				Tags:         requestTags[start:end],
+			}
+
+			if _, err := r.client.TagResourcesWithOptions(tagResourcesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(tagResources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to remove the tags from the scaling group in batches.
+func (r *essScalingGroupTagsResource) untagScalingGroup(scalingGroupId string, tagKeys []string) error {
+	for start := 0; start < len(tagKeys); start += essTagResourcesBatchSize {
+		end := start + essTagResourcesBatchSize
+		if end > len(tagKeys) {
+			end = len(tagKeys)
+		}
+
+		untagResources := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			untagResourcesRequest := &alicloudEssClient.UntagResourcesRequest{
+				RegionId:     r.client.RegionId,
+				ResourceType: tea.String(essScalingGroupResourceType),
+				ResourceIds:  []*string{tea.String(scalingGroupId)},
+				TagKeys:      tea.StringSlice(tagKeys[start:end]),
+			}
+
+			if _, err := r.client.UntagResourcesWithOptions(untagResourcesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(untagResources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to list all the tags of the scaling group by tag key.
+func (r *essScalingGroupTagsResource) listScalingGroupTags(scalingGroupId string) (map[string]*alicloudEssClient.ListTagResourcesResponseBodyTagResources, error) {
+	tags := make(map[string]*alicloudEssClient.ListTagResourcesResponseBodyTagResources)
+
+	var nextToken *string
+	for {
+		var listTagResourcesResponse *alicloudEssClient.ListTagResourcesResponse
+		listTagResources := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listTagResourcesRequest := &alicloudEssClient.ListTagResourcesRequest{
+				RegionId:     r.client.RegionId,
+				ResourceType: tea.String(essScalingGroupResourceType),
+				ResourceIds:  []*string{tea.String(scalingGroupId)},
+				NextToken:    nextToken,
+			}
+
+			var err error
+			listTagResourcesResponse, err = r.client.ListTagResourcesWithOptions(listTagResourcesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listTagResources); err != nil {
+			return nil, err
+		}
+
+		for _, tag := range listTagResourcesResponse.Body.TagResources {
+			tags[tea.StringValue(tag.TagKey)] = tag
+		}
+
+		nextToken = listTagResourcesResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return tags, nil
+}
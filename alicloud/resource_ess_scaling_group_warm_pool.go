@@ -0,0 +1,432 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The lifecycle state of the instances which are kept in the warm pool.
+	essWarmPoolStoppedState = "Stopped"
+
+	// The scaling policy of the scaling group without the warm pool, the
+	// instances are released during scale in.
+	essScalingPolicyRelease = "release"
+)
+
+var (
+	_ resource.Resource                = &essScalingGroupWarmPoolResource{}
+	_ resource.ResourceWithConfigure   = &essScalingGroupWarmPoolResource{}
+	_ resource.ResourceWithImportState = &essScalingGroupWarmPoolResource{}
+)
+
+func NewEssScalingGroupWarmPoolResource() resource.Resource {
+	return &essScalingGroupWarmPoolResource{}
+}
+
+type essScalingGroupWarmPoolResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScalingGroupWarmPoolModel struct {
+	ScalingGroupId      types.String `tfsdk:"scaling_group_id"`
+	MinSize             types.Int64  `tfsdk:"min_size"`
+	InstanceReusePolicy types.String `tfsdk:"instance_reuse_policy"`
+	PoolState           types.String `tfsdk:"pool_state"`
+	PoolSize            types.Int64  `tfsdk:"pool_size"`
+}
+
+// Metadata returns the ESS Scaling Group Warm Pool resource name.
+func (r *essScalingGroupWarmPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scaling_group_warm_pool"
+}
+
+// Schema defines the schema for the ESS Scaling Group Warm Pool resource.
+func (r *essScalingGroupWarmPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure a warm pool for an auto scaling group (ESS) with the economical mode, the instances " +
+			"are stopped instead of released during scale in, and started again during scale out to reduce the " +
+			"scale out latency. The warm pool is filled up to min_size by scaling out and scaling in the scaling " +
+			"group, so the max size of the scaling group must be large enough for the extra instances.",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_size": schema.Int64Attribute{
+				Description: "The minimum number of the stopped instances kept in the warm pool. Default to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1000),
+				},
+			},
+			"instance_reuse_policy": schema.StringAttribute{
+				Description: "The policy to put the instances into the warm pool during scale in. Valid values: " +
+					"recycle, forceRecycle. The instances are released instead if they can not be stopped " +
+					"without being charged with recycle, and always stopped with forceRecycle. Default to recycle.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("recycle"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("recycle", "forceRecycle"),
+				},
+			},
+			"pool_state": schema.StringAttribute{
+				Description: "The state of the instances in the warm pool. Only Stopped is supported, as the " +
+					"hibernated instances are not supported by ESS. Default to Stopped.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(essWarmPoolStoppedState),
+				Validators: []validator.String{
+					stringvalidator.OneOf(essWarmPoolStoppedState),
+				},
+			},
+			"pool_size": schema.Int64Attribute{
+				Description: "The number of the stopped instances currently in the warm pool.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essScalingGroupWarmPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Enable the economical mode of the scaling group and fill up the warm pool.
+func (r *essScalingGroupWarmPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essScalingGroupWarmPoolModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.modifyScalingPolicy(plan.ScalingGroupId.ValueString(), plan.InstanceReusePolicy.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	poolSize, err := r.fillWarmPool(plan.ScalingGroupId.ValueString(), plan.MinSize.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Fill Warm Pool.",
+			err.Error(),
+		)
+		return
+	}
+	plan.PoolSize = types.Int64Value(poolSize)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the scaling policy of the scaling group and the size of the warm pool.
+// The resource is removed from state if the economical mode is disabled
+// outside of Terraform.
+func (r *essScalingGroupWarmPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essScalingGroupWarmPoolModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingPolicy, err := r.describeScalingPolicy(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Groups.",
+			err.Error(),
+		)
+		return
+	}
+	if scalingPolicy != "recycle" && scalingPolicy != "forceRecycle" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	poolSize, err := r.describeWarmPoolSize(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Instances.",
+			err.Error(),
+		)
+		return
+	}
+
+	state.InstanceReusePolicy = types.StringValue(scalingPolicy)
+	state.PoolState = types.StringValue(essWarmPoolStoppedState)
+	state.PoolSize = types.Int64Value(poolSize)
+	if state.MinSize.IsNull() {
+		state.MinSize = types.Int64Value(0)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the scaling policy of the scaling group and fill up the warm pool.
+func (r *essScalingGroupWarmPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essScalingGroupWarmPoolModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.InstanceReusePolicy.Equal(state.InstanceReusePolicy) {
+		if err := r.modifyScalingPolicy(plan.ScalingGroupId.ValueString(), plan.InstanceReusePolicy.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify Scaling Group.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	poolSize, err := r.fillWarmPool(plan.ScalingGroupId.ValueString(), plan.MinSize.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Fill Warm Pool.",
+			err.Error(),
+		)
+		return
+	}
+	plan.PoolSize = types.Int64Value(poolSize)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the economical mode of the scaling group. The stopped instances are
+// kept in the scaling group, and released during the next scale in.
+func (r *essScalingGroupWarmPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essScalingGroupWarmPoolModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.modifyScalingPolicy(state.ScalingGroupId.ValueString(), essScalingPolicyRelease); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scaling Group.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essScalingGroupWarmPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to fill up the warm pool to the minimum size, returns the size of
+// the warm pool. The scaling group is scaled out by the minimum size, which
+// starts the stopped instances and creates the missing ones, and then scaled
+// in by the same size to stop them.
+func (r *essScalingGroupWarmPoolResource) fillWarmPool(scalingGroupId string, minSize int64) (int64, error) {
+	poolSize, err := r.describeWarmPoolSize(scalingGroupId)
+	if err != nil {
+		return 0, err
+	}
+	if poolSize >= minSize {
+		return poolSize, nil
+	}
+
+	for _, adjustment := range []int64{minSize, -minSize} {
+		scalingActivityId, err := r.scaleWithAdjustment(scalingGroupId, adjustment)
+		if err != nil {
+			return 0, err
+		}
+		if err := r.waitScalingActivity(scalingGroupId, scalingActivityId); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.describeWarmPoolSize(scalingGroupId)
+}
+
+// Function to set the scaling policy of the scaling group.
+func (r *essScalingGroupWarmPoolResource) modifyScalingPolicy(scalingGroupId, scalingPolicy string) error {
+	modifyScalingGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingGroupRequest := &alicloudEssClient.ModifyScalingGroupRequest{
+			RegionId:       r.client.RegionId,
+			ScalingGroupId: tea.String(scalingGroupId),
+			ScalingPolicy:  tea.String(scalingPolicy),
+		}
+
+		if _, err := r.client.ModifyScalingGroupWithOptions(modifyScalingGroupRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyScalingGroup)
+}
+
+// Function to read the scaling policy of the scaling group, returns empty if
+// the scaling group is not found.
+func (r *essScalingGroupWarmPoolResource) describeScalingPolicy(scalingGroupId string) (string, error) {
+	var describeScalingGroupsResponse *alicloudEssClient.DescribeScalingGroupsResponse
+	describeScalingGroups := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingGroupsRequest := &alicloudEssClient.DescribeScalingGroupsRequest{
+			RegionId:        r.client.RegionId,
+			ScalingGroupIds: []*string{tea.String(scalingGroupId)},
+		}
+
+		var err error
+		describeScalingGroupsResponse, err = r.client.DescribeScalingGroupsWithOptions(describeScalingGroupsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingGroups); err != nil {
+		return "", err
+	}
+
+	if len(describeScalingGroupsResponse.Body.ScalingGroups) == 0 {
+		return "", nil
+	}
+	return tea.StringValue(describeScalingGroupsResponse.Body.ScalingGroups[0].ScalingPolicy), nil
+}
+
+// Function to count the stopped instances in the scaling group.
+func (r *essScalingGroupWarmPoolResource) describeWarmPoolSize(scalingGroupId string) (int64, error) {
+	var describeScalingInstancesResponse *alicloudEssClient.DescribeScalingInstancesResponse
+	describeScalingInstances := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingInstancesRequest := &alicloudEssClient.DescribeScalingInstancesRequest{
+			RegionId:       r.client.RegionId,
+			ScalingGroupId: tea.String(scalingGroupId),
+			LifecycleState: tea.String(essWarmPoolStoppedState),
+			PageNumber:     tea.Int32(1),
+			PageSize:       tea.Int32(1),
+		}
+
+		var err error
+		describeScalingInstancesResponse, err = r.client.DescribeScalingInstancesWithOptions(describeScalingInstancesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScalingInstances); err != nil {
+		return 0, err
+	}
+	return int64(tea.Int32Value(describeScalingInstancesResponse.Body.TotalCount)), nil
+}
+
+// Function to scale the scaling group by the number of instances, returns
+// the ID of the scaling activity.
+func (r *essScalingGroupWarmPoolResource) scaleWithAdjustment(scalingGroupId string, adjustment int64) (string, error) {
+	var scalingActivityId string
+	scaleWithAdjustment := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		scaleWithAdjustmentRequest := &alicloudEssClient.ScaleWithAdjustmentRequest{
+			ScalingGroupId:  tea.String(scalingGroupId),
+			AdjustmentType:  tea.String("QuantityChangeInCapacity"),
+			AdjustmentValue: tea.Int32(int32(adjustment)),
+		}
+
+		scaleWithAdjustmentResponse, err := r.client.ScaleWithAdjustmentWithOptions(scaleWithAdjustmentRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		scalingActivityId = tea.StringValue(scaleWithAdjustmentResponse.Body.ScalingActivityId)
+		return nil
+	}
+
+	err := retryAPICall(scaleWithAdjustment)
+	return scalingActivityId, err
+}
+
+// Function to wait until the scaling activity is finished.
+func (r *essScalingGroupWarmPoolResource) waitScalingActivity(scalingGroupId, scalingActivityId string) error {
+	waitScalingActivity := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScalingActivitiesRequest := &alicloudEssClient.DescribeScalingActivitiesRequest{
+			RegionId:           r.client.RegionId,
+			ScalingGroupId:     tea.String(scalingGroupId),
+			ScalingActivityIds: []*string{tea.String(scalingActivityId)},
+		}
+
+		describeScalingActivitiesResponse, err := r.client.DescribeScalingActivitiesWithOptions(describeScalingActivitiesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		if len(describeScalingActivitiesResponse.Body.ScalingActivities) == 0 {
+			return fmt.Errorf("scaling activity %s is not found", scalingActivityId)
+		}
+
+		scalingActivity := describeScalingActivitiesResponse.Body.ScalingActivities[0]
+		switch status := tea.StringValue(scalingActivity.StatusCode); status {
+		case "Successful", "Warning":
+			return nil
+		case "Failed", "Rejected":
+			return backoff.Permanent(fmt.Errorf("scaling activity %s is %s: %s", scalingActivityId, status,
+				tea.StringValue(scalingActivity.StatusMessage)))
+		default:
+			return fmt.Errorf("scaling activity %s is %s", scalingActivityId, status)
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 30 * time.Minute
+	return backoff.Retry(waitScalingActivity, waitBackoff)
+}
@@ -0,0 +1,599 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &essScalingRuleBatchResource{}
+	_ resource.ResourceWithConfigure   = &essScalingRuleBatchResource{}
+	_ resource.ResourceWithImportState = &essScalingRuleBatchResource{}
+)
+
+func NewEssScalingRuleBatchResource() resource.Resource {
+	return &essScalingRuleBatchResource{}
+}
+
+type essScalingRuleBatchResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScalingRuleBatchModel struct {
+	ScalingGroupId types.String      `tfsdk:"scaling_group_id"`
+	ScalingRules   []*essScalingRule `tfsdk:"scaling_rules"`
+}
+
+type essScalingRule struct {
+	ScalingRuleName         types.String         `tfsdk:"scaling_rule_name"`
+	ScalingRuleType         types.String         `tfsdk:"scaling_rule_type"`
+	AdjustmentType          types.String         `tfsdk:"adjustment_type"`
+	AdjustmentValue         types.Int64          `tfsdk:"adjustment_value"`
+	MinAdjustmentMagnitude  types.Int64          `tfsdk:"min_adjustment_magnitude"`
+	Cooldown                types.Int64          `tfsdk:"cooldown"`
+	MetricName              types.String         `tfsdk:"metric_name"`
+	TargetValue             types.Float64        `tfsdk:"target_value"`
+	DisableScaleIn          types.Bool           `tfsdk:"disable_scale_in"`
+	EstimatedInstanceWarmup types.Int64          `tfsdk:"estimated_instance_warmup"`
+	StepAdjustments         []*essStepAdjustment `tfsdk:"step_adjustments"`
+	ScalingRuleId           types.String         `tfsdk:"scaling_rule_id"`
+	ScalingRuleAri          types.String         `tfsdk:"scaling_rule_ari"`
+}
+
+type essStepAdjustment struct {
+	MetricIntervalLowerBound types.Float64 `tfsdk:"metric_interval_lower_bound"`
+	MetricIntervalUpperBound types.Float64 `tfsdk:"metric_interval_upper_bound"`
+	ScalingAdjustment        types.Int64   `tfsdk:"scaling_adjustment"`
+}
+
+// Metadata returns the ESS Scaling Rule Batch resource name.
+func (r *essScalingRuleBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scaling_rule_batch"
+}
+
+// Schema defines the schema for the ESS Scaling Rule Batch resource.
+func (r *essScalingRuleBatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a batch of scaling rules of an auto scaling group (ESS).",
+		Attributes: map[string]schema.Attribute{
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"scaling_rules": schema.ListNestedBlock{
+				Description: "List of scaling rules of the scaling group. The scaling rules are identified by name.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scaling_rule_name": schema.StringAttribute{
+							Description: "The name of the scaling rule, must be unique in the scaling group.",
+							Required:    true,
+						},
+						"scaling_rule_type": schema.StringAttribute{
+							Description: "The type of the scaling rule. Changing the type recreates the scaling rule. " +
+								"Accepted values: \"SimpleScalingRule\", \"TargetTrackingScalingRule\", \"StepScalingRule\".",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("SimpleScalingRule", "TargetTrackingScalingRule", "StepScalingRule"),
+							},
+						},
+						"adjustment_type": schema.StringAttribute{
+							Description: "The adjustment method of a simple or step scaling rule. " +
+								"Accepted values: \"QuantityChangeInCapacity\", \"PercentChangeInCapacity\", \"TotalCapacity\".",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("QuantityChangeInCapacity", "PercentChangeInCapacity", "TotalCapacity"),
+							},
+						},
+						"adjustment_value": schema.Int64Attribute{
+							Description: "The adjustment value of a simple scaling rule.",
+							Optional:    true,
+						},
+						"min_adjustment_magnitude": schema.Int64Attribute{
+							Description: "The minimum number of instances to adjust when the adjustment type is PercentChangeInCapacity.",
+							Optional:    true,
+						},
+						"cooldown": schema.Int64Attribute{
+							Description: "The cooldown time in seconds of a simple scaling rule.",
+							Optional:    true,
+						},
+						"metric_name": schema.StringAttribute{
+							Description: "The predefined metric of a target tracking scaling rule, e.g. CpuUtilization.",
+							Optional:    true,
+						},
+						"target_value": schema.Float64Attribute{
+							Description: "The target value of the metric of a target tracking scaling rule.",
+							Optional:    true,
+						},
+						"disable_scale_in": schema.BoolAttribute{
+							Description: "Whether to disable scale-in of a target tracking scaling rule.",
+							Optional:    true,
+						},
+						"estimated_instance_warmup": schema.Int64Attribute{
+							Description: "The warmup period in seconds of the instances of a target tracking or step scaling rule.",
+							Optional:    true,
+						},
+						"scaling_rule_id": schema.StringAttribute{
+							Description: "The ID of the scaling rule.",
+							Computed:    true,
+						},
+						"scaling_rule_ari": schema.StringAttribute{
+							Description: "The unique identifier of the scaling rule, used by the event-triggered and scheduled tasks.",
+							Computed:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"step_adjustments": schema.ListNestedBlock{
+							Description: "The step adjustments of a step scaling rule.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"metric_interval_lower_bound": schema.Float64Attribute{
+										Description: "The lower bound of the difference between the metric and the alarm threshold.",
+										Optional:    true,
+									},
+									"metric_interval_upper_bound": schema.Float64Attribute{
+										Description: "The upper bound of the difference between the metric and the alarm threshold.",
+										Optional:    true,
+									},
+									"scaling_adjustment": schema.Int64Attribute{
+										Description: "The number of instances to adjust in the step.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essScalingRuleBatchResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Create all the scaling rules of the scaling group.
+func (r *essScalingRuleBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essScalingRuleBatchModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, scalingRule := range plan.ScalingRules {
+		if err := r.createScalingRule(plan.ScalingGroupId.ValueString(), scalingRule); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Create Scaling Rule.",
+				err.Error(),
+			)
+			// Keep the created scaling rules in state, so that they can be
+			// cleaned up or retried in the next apply.
+			r.setCreatedScalingRules(ctx, plan, resp)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the scaling rules of the scaling group. Only the scaling rules with
+// the names in state are read, all the scaling rules of the scaling group
+// are read on import.
+func (r *essScalingRuleBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essScalingRuleBatchModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scalingRules, err := r.describeScalingRules(state.ScalingGroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scaling Rules.",
+			err.Error(),
+		)
+		return
+	}
+
+	scalingRulesByName := make(map[string]*alicloudEssClient.DescribeScalingRulesResponseBodyScalingRules)
+	for _, scalingRule := range scalingRules {
+		scalingRulesByName[tea.StringValue(scalingRule.ScalingRuleName)] = scalingRule
+	}
+
+	readScalingRules := []*essScalingRule{}
+	if len(state.ScalingRules) == 0 {
+		for _, scalingRule := range scalingRules {
+			readScalingRules = append(readScalingRules, flattenEssScalingRule(scalingRule, &essScalingRule{}))
+		}
+	}
+	for _, stateScalingRule := range state.ScalingRules {
+		scalingRule, ok := scalingRulesByName[stateScalingRule.ScalingRuleName.ValueString()]
+		if !ok {
+			continue
+		}
+		readScalingRules = append(readScalingRules, flattenEssScalingRule(scalingRule, stateScalingRule))
+	}
+
+	if len(readScalingRules) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ScalingRules = readScalingRules
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create the added scaling rules, delete the removed scaling rules and
+// modify the changed scaling rules. The scaling rules with changed type are
+// recreated, as the type of a scaling rule can not be modified.
+func (r *essScalingRuleBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essScalingRuleBatchModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planScalingRules := make(map[string]*essScalingRule)
+	for _, scalingRule := range plan.ScalingRules {
+		planScalingRules[scalingRule.ScalingRuleName.ValueString()] = scalingRule
+	}
+	stateScalingRules := make(map[string]*essScalingRule)
+	for _, scalingRule := range state.ScalingRules {
+		stateScalingRules[scalingRule.ScalingRuleName.ValueString()] = scalingRule
+	}
+
+	// Delete first to release the names of the recreated scaling rules.
+	for _, stateScalingRule := range state.ScalingRules {
+		planScalingRule, exists := planScalingRules[stateScalingRule.ScalingRuleName.ValueString()]
+		if exists && planScalingRule.ScalingRuleType.Equal(stateScalingRule.ScalingRuleType) {
+			continue
+		}
+		if err := r.deleteScalingRule(stateScalingRule.ScalingRuleId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Scaling Rule.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, planScalingRule := range plan.ScalingRules {
+		stateScalingRule, exists := stateScalingRules[planScalingRule.ScalingRuleName.ValueString()]
+		if !exists || !planScalingRule.ScalingRuleType.Equal(stateScalingRule.ScalingRuleType) {
+			if err := r.createScalingRule(plan.ScalingGroupId.ValueString(), planScalingRule); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Create Scaling Rule.",
+					err.Error(),
+				)
+				return
+			}
+			continue
+		}
+
+		planScalingRule.ScalingRuleId = stateScalingRule.ScalingRuleId
+		planScalingRule.ScalingRuleAri = stateScalingRule.ScalingRuleAri
+		if planScalingRule.equal(stateScalingRule) {
+			continue
+		}
+		if err := r.modifyScalingRule(planScalingRule); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify Scaling Rule.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete all the scaling rules of the batch.
+func (r *essScalingRuleBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essScalingRuleBatchModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, scalingRule := range state.ScalingRules {
+		if err := r.deleteScalingRule(scalingRule.ScalingRuleId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Scaling Rule.",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *essScalingRuleBatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scaling_group_id"), req, resp)
+}
+
+// Function to keep only the created scaling rules in state when the creation
+// of the batch is partially failed.
+func (r *essScalingRuleBatchResource) setCreatedScalingRules(ctx context.Context, plan *essScalingRuleBatchModel, resp *resource.CreateResponse) {
+	createdScalingRules := []*essScalingRule{}
+	for _, scalingRule := range plan.ScalingRules {
+		if !scalingRule.ScalingRuleId.IsUnknown() {
+			createdScalingRules = append(createdScalingRules, scalingRule)
+		}
+	}
+	if len(createdScalingRules) == 0 {
+		return
+	}
+
+	plan.ScalingRules = createdScalingRules
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+}
+
+// Function to read all the scaling rules of the scaling group.
+func (r *essScalingRuleBatchResource) describeScalingRules(scalingGroupId string) ([]*alicloudEssClient.DescribeScalingRulesResponseBodyScalingRules, error) {
+	var scalingRules []*alicloudEssClient.DescribeScalingRulesResponseBodyScalingRules
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeScalingRulesResponse *alicloudEssClient.DescribeScalingRulesResponse
+		describeScalingRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeScalingRulesRequest := &alicloudEssClient.DescribeScalingRulesRequest{
+				RegionId:       r.client.RegionId,
+				ScalingGroupId: tea.String(scalingGroupId),
+				PageNumber:     tea.Int32(pageNumber),
+				PageSize:       tea.Int32(pageSize),
+			}
+
+			var err error
+			describeScalingRulesResponse, err = r.client.DescribeScalingRulesWithOptions(describeScalingRulesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeScalingRules); err != nil {
+			return nil, err
+		}
+
+		scalingRules = append(scalingRules, describeScalingRulesResponse.Body.ScalingRules...)
+
+		if pageNumber*pageSize >= tea.Int32Value(describeScalingRulesResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return scalingRules, nil
+}
+
+// Function to create the scaling rule, the ID and ARI of the created scaling
+// rule are set into the model.
+func (r *essScalingRuleBatchResource) createScalingRule(scalingGroupId string, scalingRule *essScalingRule) error {
+	var createScalingRuleResponse *alicloudEssClient.CreateScalingRuleResponse
+	createScalingRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createScalingRuleRequest := &alicloudEssClient.CreateScalingRuleRequest{
+			RegionId:                r.client.RegionId,
+			ScalingGroupId:          tea.String(scalingGroupId),
+			ScalingRuleName:         tea.String(scalingRule.ScalingRuleName.ValueString()),
+			ScalingRuleType:         tea.String(scalingRule.ScalingRuleType.ValueString()),
+			AdjustmentType:          stringPointerOrNil(scalingRule.AdjustmentType),
+			AdjustmentValue:         int32PointerOrNil(scalingRule.AdjustmentValue),
+			MinAdjustmentMagnitude:  int32PointerOrNil(scalingRule.MinAdjustmentMagnitude),
+			Cooldown:                int32PointerOrNil(scalingRule.Cooldown),
+			MetricName:              stringPointerOrNil(scalingRule.MetricName),
+			TargetValue:             essFloat32Pointer(scalingRule.TargetValue),
+			DisableScaleIn:          essBoolPointer(scalingRule.DisableScaleIn),
+			EstimatedInstanceWarmup: int32PointerOrNil(scalingRule.EstimatedInstanceWarmup),
+		}
+		for _, stepAdjustment := range scalingRule.StepAdjustments {
+			createScalingRuleRequest.StepAdjustments = append(createScalingRuleRequest.StepAdjustments, &alicloudEssClient.CreateScalingRuleRequestStepAdjustments{
+				MetricIntervalLowerBound: essFloat32Pointer(stepAdjustment.MetricIntervalLowerBound),
+				MetricIntervalUpperBound: essFloat32Pointer(stepAdjustment.MetricIntervalUpperBound),
+				ScalingAdjustment:        int32PointerOrNil(stepAdjustment.ScalingAdjustment),
+			})
+		}
+
+		var err error
+		createScalingRuleResponse, err = r.client.CreateScalingRuleWithOptions(createScalingRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createScalingRule); err != nil {
+		return err
+	}
+
+	scalingRule.ScalingRuleId = types.StringValue(tea.StringValue(createScalingRuleResponse.Body.ScalingRuleId))
+	scalingRule.ScalingRuleAri = types.StringValue(tea.StringValue(createScalingRuleResponse.Body.ScalingRuleAri))
+	return nil
+}
+
+// Function to modify the scaling rule.
+func (r *essScalingRuleBatchResource) modifyScalingRule(scalingRule *essScalingRule) error {
+	modifyScalingRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScalingRuleRequest := &alicloudEssClient.ModifyScalingRuleRequest{
+			ScalingRuleId:           tea.String(scalingRule.ScalingRuleId.ValueString()),
+			ScalingRuleName:         tea.String(scalingRule.ScalingRuleName.ValueString()),
+			AdjustmentType:          stringPointerOrNil(scalingRule.AdjustmentType),
+			AdjustmentValue:         int32PointerOrNil(scalingRule.AdjustmentValue),
+			MinAdjustmentMagnitude:  int32PointerOrNil(scalingRule.MinAdjustmentMagnitude),
+			Cooldown:                int32PointerOrNil(scalingRule.Cooldown),
+			MetricName:              stringPointerOrNil(scalingRule.MetricName),
+			TargetValue:             essFloat32Pointer(scalingRule.TargetValue),
+			DisableScaleIn:          essBoolPointer(scalingRule.DisableScaleIn),
+			EstimatedInstanceWarmup: int32PointerOrNil(scalingRule.EstimatedInstanceWarmup),
+		}
+		for _, stepAdjustment := range scalingRule.StepAdjustments {
+			modifyScalingRuleRequest.StepAdjustments = append(modifyScalingRuleRequest.StepAdjustments, &alicloudEssClient.ModifyScalingRuleRequestStepAdjustments{
+				MetricIntervalLowerBound: essFloat32Pointer(stepAdjustment.MetricIntervalLowerBound),
+				MetricIntervalUpperBound: essFloat32Pointer(stepAdjustment.MetricIntervalUpperBound),
+				ScalingAdjustment:        int32PointerOrNil(stepAdjustment.ScalingAdjustment),
+			})
+		}
+
+		if _, err := r.client.ModifyScalingRuleWithOptions(modifyScalingRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(modifyScalingRule)
+}
+
+// Function to delete the scaling rule.
+func (r *essScalingRuleBatchResource) deleteScalingRule(scalingRuleId string) error {
+	deleteScalingRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteScalingRuleRequest := &alicloudEssClient.DeleteScalingRuleRequest{
+			RegionId:      r.client.RegionId,
+			ScalingRuleId: tea.String(scalingRuleId),
+		}
+
+		if _, err := r.client.DeleteScalingRuleWithOptions(deleteScalingRuleRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidScalingRuleId.NotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteScalingRule)
+}
+
+// Compare the configurable attributes of two scaling rules.
+func (rule *essScalingRule) equal(other *essScalingRule) bool {
+	if !rule.ScalingRuleName.Equal(other.ScalingRuleName) ||
+		!rule.ScalingRuleType.Equal(other.ScalingRuleType) ||
+		!rule.AdjustmentType.Equal(other.AdjustmentType) ||
+		!rule.AdjustmentValue.Equal(other.AdjustmentValue) ||
+		!rule.MinAdjustmentMagnitude.Equal(other.MinAdjustmentMagnitude) ||
+		!rule.Cooldown.Equal(other.Cooldown) ||
+		!rule.MetricName.Equal(other.MetricName) ||
+		!rule.TargetValue.Equal(other.TargetValue) ||
+		!rule.DisableScaleIn.Equal(other.DisableScaleIn) ||
+		!rule.EstimatedInstanceWarmup.Equal(other.EstimatedInstanceWarmup) ||
+		len(rule.StepAdjustments) != len(other.StepAdjustments) {
+		return false
+	}
+	for i, stepAdjustment := range rule.StepAdjustments {
+		otherStepAdjustment := other.StepAdjustments[i]
+		if !stepAdjustment.MetricIntervalLowerBound.Equal(otherStepAdjustment.MetricIntervalLowerBound) ||
+			!stepAdjustment.MetricIntervalUpperBound.Equal(otherStepAdjustment.MetricIntervalUpperBound) ||
+			!stepAdjustment.ScalingAdjustment.Equal(otherStepAdjustment.ScalingAdjustment) {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert the scaling rule from AliCloud API into the model. The optional
+// attributes not set in the previous model are kept null when AliCloud
+// returns the default values, to avoid unnecessary diff.
+func flattenEssScalingRule(scalingRule *alicloudEssClient.DescribeScalingRulesResponseBodyScalingRules, previous *essScalingRule) *essScalingRule {
+	flattened := &essScalingRule{
+		ScalingRuleName:         types.StringValue(tea.StringValue(scalingRule.ScalingRuleName)),
+		ScalingRuleType:         types.StringValue(tea.StringValue(scalingRule.ScalingRuleType)),
+		AdjustmentType:          stringValueOrNull(previous.AdjustmentType, scalingRule.AdjustmentType),
+		AdjustmentValue:         int64ValueOrNull(previous.AdjustmentValue, scalingRule.AdjustmentValue),
+		MinAdjustmentMagnitude:  int64ValueOrNull(previous.MinAdjustmentMagnitude, scalingRule.MinAdjustmentMagnitude),
+		Cooldown:                int64ValueOrNull(previous.Cooldown, scalingRule.Cooldown),
+		MetricName:              stringValueOrNull(previous.MetricName, scalingRule.MetricName),
+		TargetValue:             essFloat64Value(previous.TargetValue, scalingRule.TargetValue),
+		DisableScaleIn:          boolValueOrNull(previous.DisableScaleIn, scalingRule.DisableScaleIn),
+		EstimatedInstanceWarmup: int64ValueOrNull(previous.EstimatedInstanceWarmup, scalingRule.EstimatedInstanceWarmup),
+		ScalingRuleId:           types.StringValue(tea.StringValue(scalingRule.ScalingRuleId)),
+		ScalingRuleAri:          types.StringValue(tea.StringValue(scalingRule.ScalingRuleAri)),
+		StepAdjustments:         []*essStepAdjustment{},
+	}
+	for i, stepAdjustment := range scalingRule.StepAdjustments {
+		previousStepAdjustment := &essStepAdjustment{}
+		if i < len(previous.StepAdjustments) {
+			previousStepAdjustment = previous.StepAdjustments[i]
+		}
+		flattened.StepAdjustments = append(flattened.StepAdjustments, &essStepAdjustment{
+			MetricIntervalLowerBound: essFloat64Value(previousStepAdjustment.MetricIntervalLowerBound, stepAdjustment.MetricIntervalLowerBound),
+			MetricIntervalUpperBound: essFloat64Value(previousStepAdjustment.MetricIntervalUpperBound, stepAdjustment.MetricIntervalUpperBound),
+			ScalingAdjustment:        types.Int64Value(int64(tea.Int32Value(stepAdjustment.ScalingAdjustment))),
+		})
+	}
+	return flattened
+}
+
+func essFloat32Pointer(value types.Float64) *float32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Float32(float32(value.ValueFloat64()))
+}
+
+func essBoolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Bool(value.ValueBool())
+}
+
+// AliCloud returns the float values in float32, keep the previous value if
+// both represent the same float32 value.
+func essFloat64Value(previous types.Float64, value *float32) types.Float64 {
+	if value == nil {
+		return types.Float64Null()
+	}
+	if !previous.IsNull() && float32(previous.ValueFloat64()) == *value {
+		return previous
+	}
+	return types.Float64Value(float64(*value))
+}
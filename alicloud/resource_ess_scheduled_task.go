@@ -0,0 +1,565 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudEssClient "github.com/alibabacloud-go/ess-20220222/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                   = &essScheduledTaskResource{}
+	_ resource.ResourceWithConfigure      = &essScheduledTaskResource{}
+	_ resource.ResourceWithImportState    = &essScheduledTaskResource{}
+	_ resource.ResourceWithValidateConfig = &essScheduledTaskResource{}
+)
+
+func NewEssScheduledTaskResource() resource.Resource {
+	return &essScheduledTaskResource{}
+}
+
+type essScheduledTaskResource struct {
+	client *alicloudEssClient.Client
+}
+
+type essScheduledTaskModel struct {
+	ScheduledTaskId      types.String `tfsdk:"scheduled_task_id"`
+	ScalingGroupId       types.String `tfsdk:"scaling_group_id"`
+	ScheduledTaskName    types.String `tfsdk:"scheduled_task_name"`
+	Description          types.String `tfsdk:"description"`
+	LaunchTime           types.String `tfsdk:"launch_time"`
+	LaunchExpirationTime types.Int64  `tfsdk:"launch_expiration_time"`
+	RecurrenceType       types.String `tfsdk:"recurrence_type"`
+	RecurrenceValue      types.String `tfsdk:"recurrence_value"`
+	RecurrenceEndTime    types.String `tfsdk:"recurrence_end_time"`
+	ScheduledAction      types.String `tfsdk:"scheduled_action"`
+	MinValue             types.Int64  `tfsdk:"min_value"`
+	MaxValue             types.Int64  `tfsdk:"max_value"`
+	DesiredCapacity      types.Int64  `tfsdk:"desired_capacity"`
+	TaskEnabled          types.Bool   `tfsdk:"task_enabled"`
+}
+
+var essScheduledTaskTimeRegex = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}Z$`)
+
+// Metadata returns the ESS Scheduled Task resource name.
+func (r *essScheduledTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ess_scheduled_task"
+}
+
+// Schema defines the schema for the ESS Scheduled Task resource.
+func (r *essScheduledTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a scheduled task of auto scaling (ESS), which executes a scaling rule or overrides the " +
+			"capacity of a scaling group at the specified time.",
+		Attributes: map[string]schema.Attribute{
+			"scheduled_task_id": schema.StringAttribute{
+				Description: "The ID of the scheduled task.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scaling_group_id": schema.StringAttribute{
+				Description: "Scaling Group ID. Required when the capacity of the scaling group is overridden.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheduled_task_name": schema.StringAttribute{
+				Description: "The name of the scheduled task.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the scheduled task.",
+				Optional:    true,
+			},
+			"launch_time": schema.StringAttribute{
+				Description: "The time in UTC to execute the scheduled task, in the format of YYYY-MM-DDThh:mmZ. " +
+					"The first execution time of a recurring task if recurrence is configured.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(essScheduledTaskTimeRegex, "must be in the format of YYYY-MM-DDThh:mmZ"),
+				},
+			},
+			"launch_expiration_time": schema.Int64Attribute{
+				Description: "The period in seconds to retry the scheduled task if it fails. Default to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1800),
+				},
+			},
+			"recurrence_type": schema.StringAttribute{
+				Description: "The recurrence type of the scheduled task. Accepted values: \"Daily\", \"Weekly\", \"Monthly\", \"Cron\".",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Daily", "Weekly", "Monthly", "Cron"),
+				},
+			},
+			"recurrence_value": schema.StringAttribute{
+				Description: "The recurrence of the scheduled task, depends on the recurrence type. " +
+					"Daily: the interval in days between 1 and 31, e.g. 1. " +
+					"Weekly: the days of week from 0 (Sunday) to 6 separated by commas, e.g. 1,3,5. " +
+					"Monthly: the range of days of month in the format of A-B, e.g. 1-5. " +
+					"Cron: the cron expression in UTC with 5 fields (minute hour day month week), e.g. 0 8 * * 1-5.",
+				Optional: true,
+			},
+			"recurrence_end_time": schema.StringAttribute{
+				Description: "The time in UTC to end the recurrence, in the format of YYYY-MM-DDThh:mmZ.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(essScheduledTaskTimeRegex, "must be in the format of YYYY-MM-DDThh:mmZ"),
+				},
+			},
+			"scheduled_action": schema.StringAttribute{
+				Description: "The unique identifier (ARI) of the scaling rule to execute. Conflicts with the capacity overrides.",
+				Optional:    true,
+			},
+			"min_value": schema.Int64Attribute{
+				Description: "The minimum number of instances of the scaling group to set.",
+				Optional:    true,
+			},
+			"max_value": schema.Int64Attribute{
+				Description: "The maximum number of instances of the scaling group to set.",
+				Optional:    true,
+			},
+			"desired_capacity": schema.Int64Attribute{
+				Description: "The desired number of instances of the scaling group to set.",
+				Optional:    true,
+			},
+			"task_enabled": schema.BoolAttribute{
+				Description: "Whether to enable the scheduled task. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the recurrence and the action of the scheduled
+// task during plan.
+func (r *essScheduledTaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *essScheduledTaskModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RecurrenceType.IsNull() != config.RecurrenceValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recurrence_value"),
+			"Invalid Recurrence",
+			"recurrence_type and recurrence_value must be configured together.",
+		)
+	}
+	if !config.RecurrenceEndTime.IsNull() && config.RecurrenceType.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recurrence_end_time"),
+			"Invalid Recurrence",
+			"recurrence_end_time can only be configured with recurrence_type.",
+		)
+	}
+
+	if !config.RecurrenceType.IsNull() && !config.RecurrenceType.IsUnknown() &&
+		!config.RecurrenceValue.IsNull() && !config.RecurrenceValue.IsUnknown() {
+		if err := validateEssRecurrence(config.RecurrenceType.ValueString(), config.RecurrenceValue.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recurrence_value"),
+				"Invalid Recurrence Value",
+				err.Error(),
+			)
+		}
+	}
+
+	overridesCapacity := !config.MinValue.IsNull() || !config.MaxValue.IsNull() || !config.DesiredCapacity.IsNull()
+	if config.ScheduledAction.IsNull() == !overridesCapacity {
+		resp.Diagnostics.AddError(
+			"Invalid Scheduled Task Action",
+			"Exactly one of scheduled_action or the capacity overrides (min_value, max_value, desired_capacity) must be configured.",
+		)
+	}
+	if overridesCapacity && config.ScalingGroupId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scaling_group_id"),
+			"Missing Scaling Group ID",
+			"scaling_group_id must be configured to override the capacity of the scaling group.",
+		)
+	}
+
+	if !config.MinValue.IsNull() && !config.MinValue.IsUnknown() && !config.MaxValue.IsNull() && !config.MaxValue.IsUnknown() {
+		if config.MinValue.ValueInt64() > config.MaxValue.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("min_value"),
+				"Invalid Capacity",
+				"min_value must not be greater than max_value.",
+			)
+		}
+	}
+	if !config.DesiredCapacity.IsNull() && !config.DesiredCapacity.IsUnknown() {
+		if !config.MinValue.IsNull() && !config.MinValue.IsUnknown() && config.DesiredCapacity.ValueInt64() < config.MinValue.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("desired_capacity"),
+				"Invalid Capacity",
+				"desired_capacity must not be less than min_value.",
+			)
+		}
+		if !config.MaxValue.IsNull() && !config.MaxValue.IsUnknown() && config.DesiredCapacity.ValueInt64() > config.MaxValue.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("desired_capacity"),
+				"Invalid Capacity",
+				"desired_capacity must not be greater than max_value.",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essScheduledTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).essClient
+}
+
+// Create a new scheduled task.
+func (r *essScheduledTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *essScheduledTaskModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createScheduledTaskResponse *alicloudEssClient.CreateScheduledTaskResponse
+	createScheduledTask := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createScheduledTaskRequest := &alicloudEssClient.CreateScheduledTaskRequest{
+			RegionId:             r.client.RegionId,
+			ScalingGroupId:       stringPointerOrNil(plan.ScalingGroupId),
+			ScheduledTaskName:    tea.String(plan.ScheduledTaskName.ValueString()),
+			Description:          stringPointerOrNil(plan.Description),
+			LaunchTime:           tea.String(plan.LaunchTime.ValueString()),
+			LaunchExpirationTime: int32PointerOrNil(plan.LaunchExpirationTime),
+			RecurrenceType:       stringPointerOrNil(plan.RecurrenceType),
+			RecurrenceValue:      stringPointerOrNil(plan.RecurrenceValue),
+			RecurrenceEndTime:    stringPointerOrNil(plan.RecurrenceEndTime),
+			ScheduledAction:      stringPointerOrNil(plan.ScheduledAction),
+			MinValue:             int32PointerOrNil(plan.MinValue),
+			MaxValue:             int32PointerOrNil(plan.MaxValue),
+			DesiredCapacity:      int32PointerOrNil(plan.DesiredCapacity),
+			TaskEnabled:          essBoolPointer(plan.TaskEnabled),
+		}
+
+		var err error
+		createScheduledTaskResponse, err = r.client.CreateScheduledTaskWithOptions(createScheduledTaskRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createScheduledTask); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Scheduled Task.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ScheduledTaskId = types.StringValue(tea.StringValue(createScheduledTaskResponse.Body.ScheduledTaskId))
+	// The scaling group is read from the scaling rule when the scheduled task
+	// executes a scaling rule.
+	if plan.ScalingGroupId.IsUnknown() {
+		plan.ScalingGroupId = types.StringNull()
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the scheduled task.
+func (r *essScheduledTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *essScheduledTaskModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeScheduledTasksResponse *alicloudEssClient.DescribeScheduledTasksResponse
+	describeScheduledTasks := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeScheduledTasksRequest := &alicloudEssClient.DescribeScheduledTasksRequest{
+			RegionId:         r.client.RegionId,
+			ScheduledTaskIds: []*string{tea.String(state.ScheduledTaskId.ValueString())},
+		}
+
+		var err error
+		describeScheduledTasksResponse, err = r.client.DescribeScheduledTasksWithOptions(describeScheduledTasksRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeScheduledTasks); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Scheduled Tasks.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(describeScheduledTasksResponse.Body.ScheduledTasks) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	scheduledTask := describeScheduledTasksResponse.Body.ScheduledTasks[0]
+	state.ScalingGroupId = types.StringValue(tea.StringValue(scheduledTask.ScalingGroupId))
+	state.ScheduledTaskName = types.StringValue(tea.StringValue(scheduledTask.ScheduledTaskName))
+	state.Description = stringValueOrNull(state.Description, scheduledTask.Description)
+	state.LaunchTime = types.StringValue(tea.StringValue(scheduledTask.LaunchTime))
+	state.LaunchExpirationTime = types.Int64Value(int64(tea.Int32Value(scheduledTask.LaunchExpirationTime)))
+	state.RecurrenceType = stringValueOrNull(state.RecurrenceType, scheduledTask.RecurrenceType)
+	state.RecurrenceValue = stringValueOrNull(state.RecurrenceValue, scheduledTask.RecurrenceValue)
+	state.RecurrenceEndTime = stringValueOrNull(state.RecurrenceEndTime, scheduledTask.RecurrenceEndTime)
+	state.ScheduledAction = stringValueOrNull(state.ScheduledAction, scheduledTask.ScheduledAction)
+	state.MinValue = essOptionalInt64Value(scheduledTask.MinValue)
+	state.MaxValue = essOptionalInt64Value(scheduledTask.MaxValue)
+	state.DesiredCapacity = essOptionalInt64Value(scheduledTask.DesiredCapacity)
+	state.TaskEnabled = types.BoolValue(tea.BoolValue(scheduledTask.TaskEnabled))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the scheduled task.
+func (r *essScheduledTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *essScheduledTaskModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyScheduledTask := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyScheduledTaskRequest := &alicloudEssClient.ModifyScheduledTaskRequest{
+			ScheduledTaskId:      tea.String(state.ScheduledTaskId.ValueString()),
+			ScalingGroupId:       stringPointerOrNil(plan.ScalingGroupId),
+			ScheduledTaskName:    tea.String(plan.ScheduledTaskName.ValueString()),
+			Description:          tea.String(plan.Description.ValueString()),
+			LaunchTime:           tea.String(plan.LaunchTime.ValueString()),
+			LaunchExpirationTime: int32PointerOrNil(plan.LaunchExpirationTime),
+			// Empty values are sent to clear the recurrence of the scheduled task.
+			RecurrenceType:    tea.String(plan.RecurrenceType.ValueString()),
+			RecurrenceValue:   tea.String(plan.RecurrenceValue.ValueString()),
+			RecurrenceEndTime: tea.String(plan.RecurrenceEndTime.ValueString()),
+			ScheduledAction:   stringPointerOrNil(plan.ScheduledAction),
+			MinValue:          int32PointerOrNil(plan.MinValue),
+			MaxValue:          int32PointerOrNil(plan.MaxValue),
+			DesiredCapacity:   int32PointerOrNil(plan.DesiredCapacity),
+			TaskEnabled:       essBoolPointer(plan.TaskEnabled),
+		}
+
+		if _, err := r.client.ModifyScheduledTaskWithOptions(modifyScheduledTaskRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyScheduledTask); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Scheduled Task.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ScheduledTaskId = state.ScheduledTaskId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the scheduled task.
+func (r *essScheduledTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *essScheduledTaskModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteScheduledTask := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteScheduledTaskRequest := &alicloudEssClient.DeleteScheduledTaskRequest{
+			RegionId:        r.client.RegionId,
+			ScheduledTaskId: tea.String(state.ScheduledTaskId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteScheduledTaskWithOptions(deleteScheduledTaskRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteScheduledTask); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Scheduled Task.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *essScheduledTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("scheduled_task_id"), req, resp)
+}
+
+func essOptionalInt64Value(value *int32) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// Validate the recurrence value of the scheduled task according to the
+// recurrence type.
+func validateEssRecurrence(recurrenceType, recurrenceValue string) error {
+	switch recurrenceType {
+	case "Daily":
+		days, err := strconv.Atoi(recurrenceValue)
+		if err != nil || days < 1 || days > 31 {
+			return fmt.Errorf("the recurrence value of Daily must be an interval in days between 1 and 31, got: %s", recurrenceValue)
+		}
+	case "Weekly":
+		seen := make(map[string]bool)
+		for _, day := range strings.Split(recurrenceValue, ",") {
+			value, err := strconv.Atoi(day)
+			if err != nil || value < 0 || value > 6 || seen[day] {
+				return fmt.Errorf("the recurrence value of Weekly must be unique days of week from 0 to 6 separated by commas, got: %s", recurrenceValue)
+			}
+			seen[day] = true
+		}
+	case "Monthly":
+		days := strings.Split(recurrenceValue, "-")
+		if len(days) != 2 {
+			return fmt.Errorf("the recurrence value of Monthly must be a range of days of month in the format of A-B, got: %s", recurrenceValue)
+		}
+		start, startErr := strconv.Atoi(days[0])
+		end, endErr := strconv.Atoi(days[1])
+		if startErr != nil || endErr != nil || start < 1 || end > 31 || start > end {
+			return fmt.Errorf("the recurrence value of Monthly must be a range of days of month between 1 and 31 in the format of A-B, got: %s", recurrenceValue)
+		}
+	case "Cron":
+		return validateEssCronExpression(recurrenceValue)
+	}
+	return nil
+}
+
+// The fields of the cron expression with the allowed range of values, and
+// whether "?" is allowed in the field.
+var essCronFields = []struct {
+	name          string
+	min, max      int
+	allowQuestion bool
+}{
+	{"minute", 0, 59, false},
+	{"hour", 0, 23, false},
+	{"day of month", 1, 31, true},
+	{"month", 1, 12, false},
+	{"day of week", 0, 6, true},
+}
+
+// Validate the cron expression with 5 fields. Each field accepts "*",
+// a value, a range "A-B" and a step "/N" after "*", a value or a range,
+// separated by commas.
+func validateEssCronExpression(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(essCronFields) {
+		return fmt.Errorf("the cron expression must have 5 fields (minute hour day month week), got: %s", expression)
+	}
+
+	for i, field := range fields {
+		cronField := essCronFields[i]
+		if field == "?" && cronField.allowQuestion {
+			continue
+		}
+		for _, item := range strings.Split(field, ",") {
+			if err := validateEssCronItem(item, cronField.min, cronField.max); err != nil {
+				return fmt.Errorf("invalid %s field %q in the cron expression: %s", cronField.name, field, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func validateEssCronItem(item string, min, max int) error {
+	rangePart, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		value, err := strconv.Atoi(step)
+		if err != nil || value < 1 || value > max {
+			return fmt.Errorf("step must be between 1 and %d", max)
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	start, end, isRange := strings.Cut(rangePart, "-")
+	startValue, err := strconv.Atoi(start)
+	if err != nil || startValue < min || startValue > max {
+		return fmt.Errorf("value must be between %d and %d", min, max)
+	}
+	if isRange {
+		endValue, err := strconv.Atoi(end)
+		if err != nil || endValue < min || endValue > max || endValue < startValue {
+			return fmt.Errorf("range must be between %d and %d in ascending order", min, max)
+		}
+	}
+	return nil
+}
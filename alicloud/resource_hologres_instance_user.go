@@ -0,0 +1,291 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudHologramClient "github.com/alibabacloud-go/hologram-20220601/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &hologresInstanceUserResource{}
+	_ resource.ResourceWithConfigure   = &hologresInstanceUserResource{}
+	_ resource.ResourceWithImportState = &hologresInstanceUserResource{}
+)
+
+func NewHologresInstanceUserResource() resource.Resource {
+	return &hologresInstanceUserResource{}
+}
+
+type hologresInstanceUserResource struct {
+	client *alicloudHologramClient.Client
+}
+
+type hologresInstanceUserModel struct {
+	InstanceId     types.String `tfsdk:"instance_id"`
+	RamAccountType types.String `tfsdk:"ram_account_type"`
+	RamAccountId   types.String `tfsdk:"ram_account_id"`
+	Superuser      types.Bool   `tfsdk:"superuser"`
+	UserName       types.String `tfsdk:"user_name"`
+}
+
+// Metadata returns the Hologres instance user resource name.
+func (r *hologresInstanceUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hologres_instance_user"
+}
+
+// Schema defines the schema for the Hologres instance user resource.
+func (r *hologresInstanceUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a user of a Hologres instance which is mapped to a RAM user or a RAM role.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the Hologres instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ram_account_type": schema.StringAttribute{
+				Description: "The type of the RAM account mapped to the user. Valid values: ram_user, ram_role.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ram_user", "ram_role"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ram_account_id": schema.StringAttribute{
+				Description: "The ID of the RAM user or the RAM role mapped to the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"superuser": schema.BoolAttribute{
+				Description: "Whether the user is a superuser of the instance. Default to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"user_name": schema.StringAttribute{
+				Description: "The name of the user in the Hologres instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *hologresInstanceUserResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).hologramClient
+}
+
+// Create the instance user mapped to the RAM account.
+func (r *hologresInstanceUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *hologresInstanceUserModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userName string
+	createInstanceUser := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		createInstanceUserRequest := &alicloudHologramClient.CreateInstanceUserRequest{
+			UserType:     tea.String(plan.RamAccountType.ValueString()),
+			RamAccountId: tea.String(plan.RamAccountId.ValueString()),
+			Superuser:    tea.Bool(plan.Superuser.ValueBool()),
+		}
+
+		createInstanceUserResponse, err := r.client.CreateInstanceUserWithOptions(tea.String(plan.InstanceId.ValueString()), createInstanceUserRequest, headers, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		userName = tea.StringValue(createInstanceUserResponse.Body.UserName)
+		return nil
+	}
+
+	if err := retryAPICall(createInstanceUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Hologres Instance User.",
+			err.Error(),
+		)
+		return
+	}
+	plan.UserName = types.StringValue(userName)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the instance user.
+func (r *hologresInstanceUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *hologresInstanceUserModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *alicloudHologramClient.ListInstanceUsersResponseBodyUsers
+	listInstanceUsers := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		listInstanceUsersResponse, err := r.client.ListInstanceUsersWithOptions(tea.String(state.InstanceId.ValueString()), headers, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		// The user is matched by the user name after importing.
+		user = nil
+		for _, u := range listInstanceUsersResponse.Body.Users {
+			if tea.StringValue(u.UserName) == state.UserName.ValueString() ||
+				(!state.RamAccountId.IsNull() && tea.StringValue(u.RamAccountId) == state.RamAccountId.ValueString()) {
+				user = u
+				break
+			}
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listInstanceUsers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Hologres Instance Users.",
+			err.Error(),
+		)
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.RamAccountType = types.StringValue(tea.StringValue(user.UserType))
+	state.RamAccountId = types.StringValue(tea.StringValue(user.RamAccountId))
+	state.Superuser = types.BoolValue(tea.BoolValue(user.Superuser))
+	state.UserName = types.StringValue(tea.StringValue(user.UserName))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the superuser privilege of the instance user.
+func (r *hologresInstanceUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *hologresInstanceUserModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInstanceUser := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		updateInstanceUserRequest := &alicloudHologramClient.UpdateInstanceUserRequest{
+			UserName:  tea.String(state.UserName.ValueString()),
+			Superuser: tea.Bool(plan.Superuser.ValueBool()),
+		}
+
+		if _, err := r.client.UpdateInstanceUserWithOptions(tea.String(plan.InstanceId.ValueString()), updateInstanceUserRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateInstanceUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Hologres Instance User.",
+			err.Error(),
+		)
+		return
+	}
+	plan.UserName = state.UserName
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the instance user.
+func (r *hologresInstanceUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *hologresInstanceUserModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInstanceUser := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		deleteInstanceUserRequest := &alicloudHologramClient.DeleteInstanceUserRequest{
+			UserName: tea.String(state.UserName.ValueString()),
+		}
+
+		if _, err := r.client.DeleteInstanceUserWithOptions(tea.String(state.InstanceId.ValueString()), deleteInstanceUserRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteInstanceUser); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Hologres Instance User.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the instance user with the ID in the format of
+// <instance_id>:<user_name>.
+func (r *hologresInstanceUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <instance_id>:<user_name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), idParts[1])...)
+}
@@ -0,0 +1,377 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudHologramClient "github.com/alibabacloud-go/hologram-20220601/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &hologresWarehouseResource{}
+	_ resource.ResourceWithConfigure   = &hologresWarehouseResource{}
+	_ resource.ResourceWithImportState = &hologresWarehouseResource{}
+)
+
+func NewHologresWarehouseResource() resource.Resource {
+	return &hologresWarehouseResource{}
+}
+
+type hologresWarehouseResource struct {
+	client *alicloudHologramClient.Client
+}
+
+type hologresWarehouseModel struct {
+	InstanceId   types.String `tfsdk:"instance_id"`
+	Name         types.String `tfsdk:"name"`
+	Cpu          types.Int64  `tfsdk:"cpu"`
+	ClusterCount types.Int64  `tfsdk:"cluster_count"`
+	WarehouseId  types.String `tfsdk:"warehouse_id"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// Metadata returns the Hologres warehouse resource name.
+func (r *hologresWarehouseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hologres_warehouse"
+}
+
+// Schema defines the schema for the Hologres warehouse resource.
+func (r *hologresWarehouseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a virtual warehouse of a Hologres instance.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the Hologres instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the virtual warehouse.",
+				Required:    true,
+			},
+			"cpu": schema.Int64Attribute{
+				Description: "The number of the CPU cores of each cluster of the virtual warehouse, " +
+					"which must be a multiple of 16.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(16),
+				},
+			},
+			"cluster_count": schema.Int64Attribute{
+				Description: "The number of the clusters of the virtual warehouse. Default to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"warehouse_id": schema.StringAttribute{
+				Description: "The ID of the virtual warehouse.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the virtual warehouse.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *hologresWarehouseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).hologramClient
+}
+
+// Create the virtual warehouse and wait until it is running.
+func (r *hologresWarehouseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *hologresWarehouseModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createHoloWarehouse := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		createHoloWarehouseRequest := &alicloudHologramClient.CreateHoloWarehouseRequest{
+			Name:         tea.String(plan.Name.ValueString()),
+			Cpu:          tea.String(fmt.Sprintf("%d", plan.Cpu.ValueInt64())),
+			ClusterCount: tea.String(fmt.Sprintf("%d", plan.ClusterCount.ValueInt64())),
+		}
+
+		if _, err := r.client.CreateHoloWarehouseWithOptions(tea.String(plan.InstanceId.ValueString()), createHoloWarehouseRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createHoloWarehouse); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Hologres Warehouse.",
+			err.Error(),
+		)
+		return
+	}
+
+	warehouse, err := r.waitWarehouseRunning(plan.InstanceId.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Hologres Warehouse to be Running.",
+			err.Error(),
+		)
+		return
+	}
+	plan.WarehouseId = types.StringValue(fmt.Sprintf("%d", tea.Int64Value(warehouse.Id)))
+	plan.Status = types.StringValue(tea.StringValue(warehouse.Status))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the virtual warehouse.
+func (r *hologresWarehouseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *hologresWarehouseModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouse, err := r.describeWarehouse(state.InstanceId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Hologres Warehouses.",
+			err.Error(),
+		)
+		return
+	}
+	if warehouse == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Cpu = types.Int64Value(tea.Int64Value(warehouse.Cpu))
+	state.ClusterCount = types.Int64Value(tea.Int64Value(warehouse.ClusterCount))
+	state.WarehouseId = types.StringValue(fmt.Sprintf("%d", tea.Int64Value(warehouse.Id)))
+	state.Status = types.StringValue(tea.StringValue(warehouse.Status))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Rename and scale the virtual warehouse.
+func (r *hologresWarehouseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *hologresWarehouseModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceId := tea.String(plan.InstanceId.ValueString())
+
+	if !plan.Name.Equal(state.Name) {
+		renameHoloWarehouse := func() error {
+			runtime := &util.RuntimeOptions{}
+			headers := make(map[string]*string)
+
+			renameHoloWarehouseRequest := &alicloudHologramClient.RenameHoloWarehouseRequest{
+				Name:    tea.String(state.Name.ValueString()),
+				NewName: tea.String(plan.Name.ValueString()),
+			}
+
+			if _, err := r.client.RenameHoloWarehouseWithOptions(instanceId, renameHoloWarehouseRequest, headers, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(renameHoloWarehouse); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Rename Hologres Warehouse.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Cpu.Equal(state.Cpu) || !plan.ClusterCount.Equal(state.ClusterCount) {
+		scaleHoloWarehouse := func() error {
+			runtime := &util.RuntimeOptions{}
+			headers := make(map[string]*string)
+
+			scaleHoloWarehouseRequest := &alicloudHologramClient.ScaleHoloWarehouseRequest{
+				Name:         tea.String(plan.Name.ValueString()),
+				Cpu:          tea.String(fmt.Sprintf("%d", plan.Cpu.ValueInt64())),
+				ClusterCount: tea.String(fmt.Sprintf("%d", plan.ClusterCount.ValueInt64())),
+			}
+
+			if _, err := r.client.ScaleHoloWarehouseWithOptions(instanceId, scaleHoloWarehouseRequest, headers, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(scaleHoloWarehouse); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Scale Hologres Warehouse.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	warehouse, err := r.waitWarehouseRunning(plan.InstanceId.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Hologres Warehouse to be Running.",
+			err.Error(),
+		)
+		return
+	}
+	plan.WarehouseId = types.StringValue(fmt.Sprintf("%d", tea.Int64Value(warehouse.Id)))
+	plan.Status = types.StringValue(tea.StringValue(warehouse.Status))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the virtual warehouse.
+func (r *hologresWarehouseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *hologresWarehouseModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteHoloWarehouse := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		deleteHoloWarehouseRequest := &alicloudHologramClient.DeleteHoloWarehouseRequest{
+			Name: tea.String(state.Name.ValueString()),
+		}
+
+		if _, err := r.client.DeleteHoloWarehouseWithOptions(tea.String(state.InstanceId.ValueString()), deleteHoloWarehouseRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteHoloWarehouse); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Hologres Warehouse.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the virtual warehouse with the ID in the format of
+// <instance_id>:<name>.
+func (r *hologresWarehouseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <instance_id>:<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+// Function to describe the virtual warehouse by name, returns nil if the
+// warehouse is not found.
+func (r *hologresWarehouseResource) describeWarehouse(instanceId, name string) (*alicloudHologramClient.ListWarehousesResponseBodyWarehouseList, error) {
+	var warehouse *alicloudHologramClient.ListWarehousesResponseBodyWarehouseList
+	listWarehouses := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		listWarehousesResponse, err := r.client.ListWarehousesWithOptions(tea.String(instanceId), headers, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		warehouse = nil
+		for _, w := range listWarehousesResponse.Body.WarehouseList {
+			if tea.StringValue(w.Name) == name {
+				warehouse = w
+				break
+			}
+		}
+		return nil
+	}
+
+	err := retryAPICall(listWarehouses)
+	return warehouse, err
+}
+
+// Function to wait until the virtual warehouse is running.
+func (r *hologresWarehouseResource) waitWarehouseRunning(instanceId, name string) (*alicloudHologramClient.ListWarehousesResponseBodyWarehouseList, error) {
+	var warehouse *alicloudHologramClient.ListWarehousesResponseBodyWarehouseList
+	waitWarehouseRunning := func() error {
+		var err error
+		warehouse, err = r.describeWarehouse(instanceId, name)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if warehouse == nil {
+			return fmt.Errorf("Hologres warehouse %s is not found in instance %s", name, instanceId)
+		}
+		switch status := tea.StringValue(warehouse.Status); status {
+		case "kRunning":
+			return nil
+		case "kFailed":
+			return backoff.Permanent(fmt.Errorf("Hologres warehouse %s is %s", name, status))
+		default:
+			return fmt.Errorf("Hologres warehouse %s is %s", name, status)
+		}
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitWarehouseRunning, reconnectBackoff)
+	return warehouse, err
+}
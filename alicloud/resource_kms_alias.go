@@ -0,0 +1,313 @@
+package alicloud
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &kmsAliasResource{}
+	_ resource.ResourceWithConfigure   = &kmsAliasResource{}
+	_ resource.ResourceWithImportState = &kmsAliasResource{}
+)
+
+func NewKmsAliasResource() resource.Resource {
+	return &kmsAliasResource{}
+}
+
+type kmsAliasResource struct {
+	client        *alicloudKmsClient.Client
+	adoptExisting bool
+}
+
+type kmsAliasModel struct {
+	AliasName types.String `tfsdk:"alias_name"`
+	KeyId     types.String `tfsdk:"key_id"`
+	AliasArn  types.String `tfsdk:"alias_arn"`
+}
+
+// Metadata returns the KMS alias resource name.
+func (r *kmsAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_alias"
+}
+
+// Schema defines the schema for the KMS alias resource.
+func (r *kmsAliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an alias of a KMS key, the alias can be switched to another key without changing " +
+			"the applications referencing the alias.",
+		Attributes: map[string]schema.Attribute{
+			"alias_name": schema.StringAttribute{
+				Description: "The name of the alias, must start with `alias/`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^alias/[a-zA-Z0-9:/_-]+$`), "must start with alias/"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key to be associated with the alias.",
+				Required:    true,
+			},
+			"alias_arn": schema.StringAttribute{
+				Description: "The ARN of the alias.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *kmsAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).kmsClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the alias, the existing alias is pointed to the key if adoption is
+// enabled.
+func (r *kmsAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *kmsAliasModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adopted := false
+	createAlias := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createAliasRequest := &alicloudKmsClient.CreateAliasRequest{
+			AliasName: tea.String(plan.AliasName.ValueString()),
+			KeyId:     tea.String(plan.KeyId.ValueString()),
+		}
+
+		if _, err := r.client.CreateAliasWithOptions(createAliasRequest, runtime); err != nil {
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					adopted = true
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createAlias); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Alias.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Point the adopted alias to the configured key.
+	if adopted {
+		if err := r.updateAlias(plan); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Alias.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	alias, err := r.findAlias(plan.AliasName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Aliases.",
+			err.Error(),
+		)
+		return
+	}
+	if alias != nil {
+		plan.AliasArn = types.StringValue(tea.StringValue(alias.AliasArn))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the alias.
+func (r *kmsAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *kmsAliasModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.findAlias(state.AliasName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Aliases.",
+			err.Error(),
+		)
+		return
+	}
+	if alias == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.KeyId = types.StringValue(tea.StringValue(alias.KeyId))
+	state.AliasArn = types.StringValue(tea.StringValue(alias.AliasArn))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the key associated with the alias.
+func (r *kmsAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *kmsAliasModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateAlias(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Alias.",
+			err.Error(),
+		)
+		return
+	}
+	plan.AliasArn = state.AliasArn
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the alias, the key is not affected.
+func (r *kmsAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *kmsAliasModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteAlias := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAliasRequest := &alicloudKmsClient.DeleteAliasRequest{
+			AliasName: tea.String(state.AliasName.ValueString()),
+		}
+
+		if _, err := r.client.DeleteAliasWithOptions(deleteAliasRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.AliasNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAlias); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Alias.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *kmsAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("alias_name"), req, resp)
+}
+
+func (r *kmsAliasResource) updateAlias(model *kmsAliasModel) error {
+	updateAlias := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateAliasRequest := &alicloudKmsClient.UpdateAliasRequest{
+			AliasName: tea.String(model.AliasName.ValueString()),
+			KeyId:     tea.String(model.KeyId.ValueString()),
+		}
+
+		if _, err := r.client.UpdateAliasWithOptions(updateAliasRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateAlias)
+}
+
+// Function to find the alias by the name, returns nil if the alias is not
+// found. The aliases can not be filtered by the name, all the pages are
+// listed.
+func (r *kmsAliasResource) findAlias(aliasName string) (*alicloudKmsClient.ListAliasesResponseBodyAliasesAlias, error) {
+	pageNumber := int32(1)
+	pageSize := int32(100)
+	for {
+		var listAliasesResponse *alicloudKmsClient.ListAliasesResponse
+		listAliases := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listAliasesRequest := &alicloudKmsClient.ListAliasesRequest{
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+
+			var err error
+			listAliasesResponse, err = r.client.ListAliasesWithOptions(listAliasesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listAliases); err != nil {
+			return nil, err
+		}
+
+		if listAliasesResponse.Body.Aliases != nil {
+			for _, alias := range listAliasesResponse.Body.Aliases.Alias {
+				if tea.StringValue(alias.AliasName) == aliasName {
+					return alias, nil
+				}
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(listAliasesResponse.Body.TotalCount) {
+			return nil, nil
+		}
+		pageNumber++
+	}
+}
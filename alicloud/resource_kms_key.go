@@ -0,0 +1,519 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &kmsKeyResource{}
+	_ resource.ResourceWithConfigure   = &kmsKeyResource{}
+	_ resource.ResourceWithImportState = &kmsKeyResource{}
+)
+
+func NewKmsKeyResource() resource.Resource {
+	return &kmsKeyResource{}
+}
+
+type kmsKeyResource struct {
+	client *alicloudKmsClient.Client
+}
+
+type kmsKeyModel struct {
+	KeyId               types.String `tfsdk:"key_id"`
+	Arn                 types.String `tfsdk:"arn"`
+	CreationDate        types.String `tfsdk:"creation_date"`
+	Description         types.String `tfsdk:"description"`
+	KeySpec             types.String `tfsdk:"key_spec"`
+	KeyUsage            types.String `tfsdk:"key_usage"`
+	ProtectionLevel     types.String `tfsdk:"protection_level"`
+	AutomaticRotation   types.Bool   `tfsdk:"automatic_rotation"`
+	RotationInterval    types.String `tfsdk:"rotation_interval"`
+	PendingWindowInDays types.Int64  `tfsdk:"pending_window_in_days"`
+	Policy              types.String `tfsdk:"policy"`
+}
+
+// Metadata returns the KMS key resource name.
+func (r *kmsKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key"
+}
+
+// Schema defines the schema for the KMS key resource.
+func (r *kmsKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a KMS customer master key (CMK) with its automatic rotation and key policy. The key " +
+			"is scheduled for deletion when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "The time when the key was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the key. Default to empty.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"key_spec": schema.StringAttribute{
+				Description: "The specification of the key, e.g. `Aliyun_AES_256`, `Aliyun_SM4`, `RSA_2048`, " +
+					"`EC_P256`. Default to `Aliyun_AES_256`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Aliyun_AES_256"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_usage": schema.StringAttribute{
+				Description: "The usage of the key. Valid values: `ENCRYPT/DECRYPT`, `SIGN/VERIFY`. Default to " +
+					"`ENCRYPT/DECRYPT`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ENCRYPT/DECRYPT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ENCRYPT/DECRYPT", "SIGN/VERIFY"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protection_level": schema.StringAttribute{
+				Description: "The protection level of the key. Valid values: `SOFTWARE`, `HSM`. Default to `SOFTWARE`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SOFTWARE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("SOFTWARE", "HSM"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"automatic_rotation": schema.BoolAttribute{
+				Description: "Whether to enable the automatic rotation of the key, only symmetric keys can be " +
+					"rotated. Default to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"rotation_interval": schema.StringAttribute{
+				Description: "The interval of the automatic rotation in the format of `<number>d`, `<number>h` " +
+					"or `<number>s`, e.g. `365d`. Valid from 7 days to 730 days. Default to `365d`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("365d"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kmsSecretRotationIntervalRegex, "must be in the format of <number>d, <number>h or <number>s"),
+				},
+			},
+			"pending_window_in_days": schema.Int64Attribute{
+				Description: "The number of days before the key is deleted after the resource is destroyed. Valid " +
+					"from 7 to 366. Default to 30.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.Between(7, 366),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "The key policy in JSON format. The key policy is not managed if not configured, " +
+					"which should be left empty when the key policy is managed by `st-alicloud_kms_key_grant`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *kmsKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).kmsClient
+}
+
+// Create the key and set the key policy.
+func (r *kmsKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *kmsKeyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createKey := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createKeyRequest := &alicloudKmsClient.CreateKeyRequest{
+			Description:             tea.String(plan.Description.ValueString()),
+			KeySpec:                 tea.String(plan.KeySpec.ValueString()),
+			KeyUsage:                tea.String(plan.KeyUsage.ValueString()),
+			ProtectionLevel:         tea.String(plan.ProtectionLevel.ValueString()),
+			EnableAutomaticRotation: tea.Bool(plan.AutomaticRotation.ValueBool()),
+		}
+		if plan.AutomaticRotation.ValueBool() {
+			createKeyRequest.RotationInterval = tea.String(plan.RotationInterval.ValueString())
+		}
+
+		createKeyResponse, err := r.client.CreateKeyWithOptions(createKeyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.KeyId = types.StringValue(tea.StringValue(createKeyResponse.Body.KeyMetadata.KeyId))
+		return nil
+	}
+
+	if err := retryAPICall(createKey); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Key.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the key ID first, so that the key is scheduled for deletion if
+	// the following steps failed.
+	setStateDiags := resp.State.SetAttribute(ctx, path.Root("key_id"), plan.KeyId)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Policy.IsNull() {
+		if err := r.setKeyPolicy(plan.KeyId.ValueString(), plan.Policy.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Set Key Policy.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if _, err := r.readKey(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Key.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the key and the key policy.
+func (r *kmsKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *kmsKeyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exists, err := r.readKey(state)
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.KeyNotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Key.",
+			err.Error(),
+		)
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !state.Policy.IsNull() {
+		policy, err := r.getKeyPolicy(state.KeyId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Get Key Policy.",
+				err.Error(),
+			)
+			return
+		}
+		if !isJsonStringEqual(state.Policy.ValueString(), policy) {
+			state.Policy = types.StringValue(policy)
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the description, rotation policy and key policy of the key.
+func (r *kmsKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *kmsKeyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.KeyId = state.KeyId
+
+	if !plan.Description.Equal(state.Description) {
+		updateKeyDescription := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateKeyDescriptionRequest := &alicloudKmsClient.UpdateKeyDescriptionRequest{
+				KeyId:       tea.String(plan.KeyId.ValueString()),
+				Description: tea.String(plan.Description.ValueString()),
+			}
+
+			if _, err := r.client.UpdateKeyDescriptionWithOptions(updateKeyDescriptionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateKeyDescription); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Key Description.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.AutomaticRotation.Equal(state.AutomaticRotation) || !plan.RotationInterval.Equal(state.RotationInterval) {
+		updateRotationPolicy := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateRotationPolicyRequest := &alicloudKmsClient.UpdateRotationPolicyRequest{
+				KeyId:                   tea.String(plan.KeyId.ValueString()),
+				EnableAutomaticRotation: tea.Bool(plan.AutomaticRotation.ValueBool()),
+			}
+			if plan.AutomaticRotation.ValueBool() {
+				updateRotationPolicyRequest.RotationInterval = tea.String(plan.RotationInterval.ValueString())
+			}
+
+			if _, err := r.client.UpdateRotationPolicyWithOptions(updateRotationPolicyRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateRotationPolicy); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Rotation Policy.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// The key policy is left as is when it is removed from the configuration.
+	if !plan.Policy.IsNull() && !plan.Policy.Equal(state.Policy) {
+		if err := r.setKeyPolicy(plan.KeyId.ValueString(), plan.Policy.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Set Key Policy.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if _, err := r.readKey(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Key.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schedule the deletion of the key, the key is deleted after the pending
+// window.
+func (r *kmsKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *kmsKeyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pendingWindowInDays := state.PendingWindowInDays.ValueInt64()
+	if state.PendingWindowInDays.IsNull() {
+		pendingWindowInDays = 30
+	}
+
+	scheduleKeyDeletion := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		scheduleKeyDeletionRequest := &alicloudKmsClient.ScheduleKeyDeletionRequest{
+			KeyId:               tea.String(state.KeyId.ValueString()),
+			PendingWindowInDays: tea.Int32(int32(pendingWindowInDays)),
+		}
+
+		if _, err := r.client.ScheduleKeyDeletionWithOptions(scheduleKeyDeletionRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.KeyNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(scheduleKeyDeletion); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Schedule Key Deletion.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *kmsKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key_id"), req, resp)
+}
+
+// Function to read the key into the model, returns false if the key is
+// pending deletion.
+func (r *kmsKeyResource) readKey(model *kmsKeyModel) (bool, error) {
+	var describeKeyResponse *alicloudKmsClient.DescribeKeyResponse
+	describeKey := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeKeyRequest := &alicloudKmsClient.DescribeKeyRequest{
+			KeyId: tea.String(model.KeyId.ValueString()),
+		}
+
+		var err error
+		describeKeyResponse, err = r.client.DescribeKeyWithOptions(describeKeyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeKey); err != nil {
+		return false, err
+	}
+
+	key := describeKeyResponse.Body.KeyMetadata
+	if tea.StringValue(key.KeyState) == "PendingDeletion" {
+		return false, nil
+	}
+
+	model.Arn = types.StringValue(tea.StringValue(key.Arn))
+	model.CreationDate = types.StringValue(tea.StringValue(key.CreationDate))
+	model.Description = types.StringValue(tea.StringValue(key.Description))
+	model.KeySpec = types.StringValue(tea.StringValue(key.KeySpec))
+	model.KeyUsage = types.StringValue(tea.StringValue(key.KeyUsage))
+	model.ProtectionLevel = types.StringValue(tea.StringValue(key.ProtectionLevel))
+	model.AutomaticRotation = types.BoolValue(tea.StringValue(key.AutomaticRotation) == "Enabled")
+
+	// AliCloud returns the rotation interval in seconds, and the interval is
+	// only meaningful if the automatic rotation is enabled.
+	if model.AutomaticRotation.ValueBool() {
+		rotationInterval := tea.StringValue(key.RotationInterval)
+		configured, configuredErr := parseKmsSecretRotationInterval(model.RotationInterval.ValueString())
+		actual, actualErr := parseKmsSecretRotationInterval(rotationInterval)
+		if configuredErr != nil || actualErr != nil || configured != actual {
+			model.RotationInterval = types.StringValue(rotationInterval)
+		}
+	}
+	if model.RotationInterval.IsNull() || model.RotationInterval.IsUnknown() {
+		model.RotationInterval = types.StringValue("365d")
+	}
+	if model.PendingWindowInDays.IsNull() || model.PendingWindowInDays.IsUnknown() {
+		model.PendingWindowInDays = types.Int64Value(30)
+	}
+
+	return true, nil
+}
+
+func (r *kmsKeyResource) getKeyPolicy(keyId string) (string, error) {
+	var getKeyPolicyResponse *alicloudKmsClient.GetKeyPolicyResponse
+	getKeyPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getKeyPolicyRequest := &alicloudKmsClient.GetKeyPolicyRequest{
+			KeyId:      tea.String(keyId),
+			PolicyName: tea.String(kmsKeyPolicyName),
+		}
+
+		var err error
+		getKeyPolicyResponse, err = r.client.GetKeyPolicyWithOptions(getKeyPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getKeyPolicy); err != nil {
+		return "", err
+	}
+	return tea.StringValue(getKeyPolicyResponse.Body.Policy), nil
+}
+
+func (r *kmsKeyResource) setKeyPolicy(keyId, policy string) error {
+	setKeyPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setKeyPolicyRequest := &alicloudKmsClient.SetKeyPolicyRequest{
+			KeyId:      tea.String(keyId),
+			PolicyName: tea.String(kmsKeyPolicyName),
+			Policy:     tea.String(policy),
+		}
+
+		if _, err := r.client.SetKeyPolicyWithOptions(setKeyPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setKeyPolicy)
+}
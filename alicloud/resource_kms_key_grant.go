@@ -0,0 +1,379 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The only key policy name supported by AliCloud KMS.
+	kmsKeyPolicyName = "default"
+)
+
+var (
+	_ resource.Resource                = &kmsKeyGrantResource{}
+	_ resource.ResourceWithConfigure   = &kmsKeyGrantResource{}
+	_ resource.ResourceWithImportState = &kmsKeyGrantResource{}
+)
+
+func NewKmsKeyGrantResource() resource.Resource {
+	return &kmsKeyGrantResource{}
+}
+
+type kmsKeyGrantResource struct {
+	client *alicloudKmsClient.Client
+}
+
+type kmsKeyGrantModel struct {
+	KeyId      types.String `tfsdk:"key_id"`
+	Name       types.String `tfsdk:"name"`
+	Principals types.List   `tfsdk:"principals"`
+	Operations types.List   `tfsdk:"operations"`
+}
+
+// Metadata returns the KMS Key Grant resource name.
+func (r *kmsKeyGrantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_grant"
+}
+
+// Schema defines the schema for the KMS Key Grant resource.
+func (r *kmsKeyGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grant KMS key operations to RAM principals by managing a statement in the key policy. " +
+			"Other statements of the key policy are not affected.",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the KMS key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the grant, which is used as the Sid of the statement in the key policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principals": schema.ListAttribute{
+				Description: "The ARNs of the RAM users or roles to be granted, e.g. acs:ram::123456789012****:role/app.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"operations": schema.ListAttribute{
+				Description: "The KMS operations to be granted, e.g. kms:Encrypt, kms:Decrypt, kms:GenerateDataKey.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *kmsKeyGrantResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).kmsClient
+}
+
+// Add the grant statement into the key policy.
+func (r *kmsKeyGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *kmsKeyGrantModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var principals, operations []string
+	resp.Diagnostics.Append(plan.Principals.ElementsAs(ctx, &principals, false)...)
+	resp.Diagnostics.Append(plan.Operations.ElementsAs(ctx, &operations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putGrantStatement(plan.KeyId.ValueString(), plan.Name.ValueString(), principals, operations); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Key Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the grant statement from the key policy.
+func (r *kmsKeyGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *kmsKeyGrantModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.getKeyPolicy(state.KeyId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Key Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	var statement *kmsKeyPolicyStatement
+	for _, s := range policy.Statement {
+		if s.Sid == state.Name.ValueString() {
+			statement = s
+			break
+		}
+	}
+	if statement == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	principals, diags := types.ListValueFrom(ctx, types.StringType, statement.Principal.RAM)
+	resp.Diagnostics.Append(diags...)
+	operations, diags := types.ListValueFrom(ctx, types.StringType, statement.Action)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Principals = principals
+	state.Operations = operations
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the grant statement in the key policy.
+func (r *kmsKeyGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *kmsKeyGrantModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var principals, operations []string
+	resp.Diagnostics.Append(plan.Principals.ElementsAs(ctx, &principals, false)...)
+	resp.Diagnostics.Append(plan.Operations.ElementsAs(ctx, &operations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putGrantStatement(plan.KeyId.ValueString(), plan.Name.ValueString(), principals, operations); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Key Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the grant statement from the key policy.
+func (r *kmsKeyGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *kmsKeyGrantModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putGrantStatement(state.KeyId.ValueString(), state.Name.ValueString(), nil, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Key Policy.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the grant by the ID in the format of <key_id>:<name>.
+func (r *kmsKeyGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <key_id>:<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), ids[1])...)
+}
+
+type kmsKeyPolicy struct {
+	Version   string                   `json:"Version"`
+	Statement []*kmsKeyPolicyStatement `json:"Statement"`
+}
+
+type kmsKeyPolicyStatement struct {
+	Sid       string                         `json:"Sid,omitempty"`
+	Effect    string                         `json:"Effect"`
+	Principal kmsKeyPolicyStatementPrincipal `json:"Principal"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition json.RawMessage                `json:"Condition,omitempty"`
+}
+
+type kmsKeyPolicyStatementPrincipal struct {
+	RAM []string `json:"RAM"`
+}
+
+// UnmarshalJSON accepts both a single string and a list of strings for the
+// fields of the statement, as AliCloud allows both formats in key policy.
+func (s *kmsKeyPolicyStatement) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Sid       string `json:"Sid"`
+		Effect    string `json:"Effect"`
+		Principal struct {
+			RAM json.RawMessage `json:"RAM"`
+		} `json:"Principal"`
+		Action    json.RawMessage `json:"Action"`
+		Resource  json.RawMessage `json:"Resource"`
+		Condition json.RawMessage `json:"Condition"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	toList := func(data json.RawMessage) ([]string, error) {
+		if len(data) == 0 {
+			return nil, nil
+		}
+		var list []string
+		if err := json.Unmarshal(data, &list); err == nil {
+			return list, nil
+		}
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return nil, err
+		}
+		return []string{str}, nil
+	}
+
+	var err error
+	s.Sid = raw.Sid
+	s.Effect = raw.Effect
+	s.Condition = raw.Condition
+	if s.Principal.RAM, err = toList(raw.Principal.RAM); err != nil {
+		return err
+	}
+	if s.Action, err = toList(raw.Action); err != nil {
+		return err
+	}
+	if s.Resource, err = toList(raw.Resource); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *kmsKeyGrantResource) getKeyPolicy(keyId string) (*kmsKeyPolicy, error) {
+	var getKeyPolicyResponse *alicloudKmsClient.GetKeyPolicyResponse
+	getKeyPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getKeyPolicyRequest := &alicloudKmsClient.GetKeyPolicyRequest{
+			KeyId:      tea.String(keyId),
+			PolicyName: tea.String(kmsKeyPolicyName),
+		}
+
+		var err error
+		getKeyPolicyResponse, err = r.client.GetKeyPolicyWithOptions(getKeyPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getKeyPolicy); err != nil {
+		return nil, err
+	}
+
+	policy := &kmsKeyPolicy{}
+	if err := json.Unmarshal([]byte(tea.StringValue(getKeyPolicyResponse.Body.Policy)), policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Replace the statement with the given Sid in the key policy, the statement
+// is removed if no principal or operation is given.
+func (r *kmsKeyGrantResource) putGrantStatement(keyId, sid string, principals, operations []string) error {
+	policy, err := r.getKeyPolicy(keyId)
+	if err != nil {
+		return err
+	}
+
+	statements := []*kmsKeyPolicyStatement{}
+	for _, statement := range policy.Statement {
+		if statement.Sid != sid {
+			statements = append(statements, statement)
+		}
+	}
+	if len(principals) > 0 && len(operations) > 0 {
+		statements = append(statements, &kmsKeyPolicyStatement{
+			Sid:    sid,
+			Effect: "Allow",
+			Principal: kmsKeyPolicyStatementPrincipal{
+				RAM: principals,
+			},
+			Action:   operations,
+			Resource: []string{"*"},
+		})
+	}
+	policy.Statement = statements
+
+	policyJson, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	setKeyPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setKeyPolicyRequest := &alicloudKmsClient.SetKeyPolicyRequest{
+			KeyId:      tea.String(keyId),
+			PolicyName: tea.String(kmsKeyPolicyName),
+			Policy:     tea.String(string(policyJson)),
+		}
+
+		if _, err := r.client.SetKeyPolicyWithOptions(setKeyPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setKeyPolicy)
+}
@@ -0,0 +1,708 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &kmsSecretResource{}
+	_ resource.ResourceWithConfigure   = &kmsSecretResource{}
+	_ resource.ResourceWithImportState = &kmsSecretResource{}
+	_ resource.ResourceWithModifyPlan  = &kmsSecretResource{}
+)
+
+// The version stage of the current secret value, which is always attached to
+// the latest version put by the resource.
+const kmsSecretCurrentVersionStage = "ACSCurrent"
+
+func NewKmsSecretResource() resource.Resource {
+	return &kmsSecretResource{}
+}
+
+type kmsSecretResource struct {
+	client *alicloudKmsClient.Client
+}
+
+type kmsSecretModel struct {
+	SecretName           types.String `tfsdk:"secret_name"`
+	Arn                  types.String `tfsdk:"arn"`
+	Description          types.String `tfsdk:"description"`
+	EncryptionKeyId      types.String `tfsdk:"encryption_key_id"`
+	SecretData           types.String `tfsdk:"secret_data"`
+	SecretDataType       types.String `tfsdk:"secret_data_type"`
+	VersionId            types.String `tfsdk:"version_id"`
+	VersionStages        types.List   `tfsdk:"version_stages"`
+	AutomaticRotation    types.Bool   `tfsdk:"automatic_rotation"`
+	RotationInterval     types.String `tfsdk:"rotation_interval"`
+	RecoveryWindowInDays types.Int64  `tfsdk:"recovery_window_in_days"`
+	Tags                 types.Map    `tfsdk:"tags"`
+}
+
+// Metadata returns the KMS secret resource name.
+func (r *kmsSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_secret"
+}
+
+// Schema defines the schema for the KMS secret resource.
+func (r *kmsSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a generic secret of KMS Secrets Manager, e.g. the credentials of the applications. A " +
+			"new version of the secret is put when the secret data is changed.",
+		Attributes: map[string]schema.Attribute{
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the secret.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the secret.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the secret. Default to empty.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"encryption_key_id": schema.StringAttribute{
+				Description: "The ID of the KMS key to encrypt the secret. The default key of Secrets Manager is " +
+					"used if not specified.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_data": schema.StringAttribute{
+				Description: "The value of the secret.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"secret_data_type": schema.StringAttribute{
+				Description: "The type of the secret value. Valid values: `text`, `binary`. Default to `text`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("text"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("text", "binary"),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Description: "The ID of the current version of the secret, which is changed when the secret data " +
+					"is changed.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_stages": schema.ListAttribute{
+				Description: "The custom version stages attached to the current version in addition to " +
+					"`ACSCurrent`, e.g. `blue` for the applications to read the secret by the stage.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.NoneOf(kmsSecretCurrentVersionStage, "ACSPrevious"),
+					),
+				},
+			},
+			"automatic_rotation": schema.BoolAttribute{
+				Description: "Whether to enable the automatic rotation of the secret. The rotation events are sent " +
+					"to EventBridge, which should trigger the Function Compute (FC) function to put the new secret " +
+					"value. The secret data is not refreshed from AliCloud when the automatic rotation is enabled. " +
+					"Default to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"rotation_interval": schema.StringAttribute{
+				Description: "The interval of the automatic rotation, in the format of `<number>d`, `<number>h` or " +
+					"`<number>s`, e.g. `30d`. The interval must be between 6 hours and 365 days. Default to `30d`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("30d"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kmsSecretRotationIntervalRegex, "must be in the format of <number>d, <number>h or <number>s"),
+				},
+			},
+			"recovery_window_in_days": schema.Int64Attribute{
+				Description: "The number of days the secret can be restored after the resource is destroyed. Valid " +
+					"from 7 to 30. Default to 30.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.Between(7, 30),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "A map of tags to be set on the secret.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *kmsSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).kmsClient
+}
+
+// Create the secret with the first version.
+func (r *kmsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *kmsSecretModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make(map[string]string)
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.VersionId = types.StringValue(kmsSecretVersionId())
+	createSecret := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createSecretRequest := &alicloudKmsClient.CreateSecretRequest{
+			SecretName:              tea.String(plan.SecretName.ValueString()),
+			Description:             tea.String(plan.Description.ValueString()),
+			SecretData:              tea.String(plan.SecretData.ValueString()),
+			SecretDataType:          tea.String(plan.SecretDataType.ValueString()),
+			VersionId:               tea.String(plan.VersionId.ValueString()),
+			EnableAutomaticRotation: tea.Bool(plan.AutomaticRotation.ValueBool()),
+		}
+		if !plan.EncryptionKeyId.IsNull() {
+			createSecretRequest.EncryptionKeyId = tea.String(plan.EncryptionKeyId.ValueString())
+		}
+		if plan.AutomaticRotation.ValueBool() {
+			createSecretRequest.RotationInterval = tea.String(plan.RotationInterval.ValueString())
+		}
+		if len(tags) > 0 {
+			createSecretRequest.Tags = tea.String(kmsSecretTagsJson(tags))
+		}
+
+		createSecretResponse, err := r.client.CreateSecretWithOptions(createSecretRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.Arn = types.StringValue(tea.StringValue(createSecretResponse.Body.Arn))
+		return nil
+	}
+
+	if err := retryAPICall(createSecret); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Secret.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The custom version stages can only be attached after the version is
+	// created.
+	for _, versionStage := range convertListValueToStrings(plan.VersionStages) {
+		if err := r.updateSecretVersionStage(plan.SecretName.ValueString(), versionStage, plan.VersionId.ValueString(), ""); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Secret Version Stage.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the secret and the current version of the secret value.
+func (r *kmsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *kmsSecretModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeSecretResponse *alicloudKmsClient.DescribeSecretResponse
+	describeSecret := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeSecretRequest := &alicloudKmsClient.DescribeSecretRequest{
+			SecretName: tea.String(state.SecretName.ValueString()),
+			FetchTags:  tea.String("true"),
+		}
+
+		var err error
+		describeSecretResponse, err = r.client.DescribeSecretWithOptions(describeSecretRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeSecret); err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.ResourceNotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Secret.",
+			err.Error(),
+		)
+		return
+	}
+
+	secret := describeSecretResponse.Body
+	// The secret is pending deletion, it can only be restored manually.
+	if tea.StringValue(secret.PlannedDeleteTime) != "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Arn = types.StringValue(tea.StringValue(secret.Arn))
+	state.Description = types.StringValue(tea.StringValue(secret.Description))
+	if !state.EncryptionKeyId.IsNull() {
+		state.EncryptionKeyId = types.StringValue(tea.StringValue(secret.EncryptionKeyId))
+	}
+	state.AutomaticRotation = types.BoolValue(tea.StringValue(secret.AutomaticRotation) == "Enabled")
+	if state.AutomaticRotation.ValueBool() {
+		rotationInterval := tea.StringValue(secret.RotationInterval)
+		configured, configuredErr := parseKmsSecretRotationInterval(state.RotationInterval.ValueString())
+		actual, actualErr := parseKmsSecretRotationInterval(rotationInterval)
+		if configuredErr != nil || actualErr != nil || configured != actual {
+			state.RotationInterval = types.StringValue(rotationInterval)
+		}
+	}
+
+	tags := make(map[string]string)
+	if secret.Tags != nil {
+		for _, tag := range secret.Tags.Tag {
+			tags[tea.StringValue(tag.TagKey)] = tea.StringValue(tag.TagValue)
+		}
+	}
+	if !state.Tags.IsNull() || len(tags) > 0 {
+		state.Tags = convertStringMapToMapValue(tags)
+	}
+
+	var getSecretValueResponse *alicloudKmsClient.GetSecretValueResponse
+	getSecretValue := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getSecretValueRequest := &alicloudKmsClient.GetSecretValueRequest{
+			SecretName:   tea.String(state.SecretName.ValueString()),
+			VersionStage: tea.String(kmsSecretCurrentVersionStage),
+		}
+
+		var err error
+		getSecretValueResponse, err = r.client.GetSecretValueWithOptions(getSecretValueRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getSecretValue); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Secret Value.",
+			err.Error(),
+		)
+		return
+	}
+
+	value := getSecretValueResponse.Body
+	// The secret value is put by the rotation function when the automatic
+	// rotation is enabled, which is not a drift of the configuration.
+	if !state.AutomaticRotation.ValueBool() || state.SecretData.IsNull() {
+		state.SecretData = types.StringValue(tea.StringValue(value.SecretData))
+		state.SecretDataType = types.StringValue(tea.StringValue(value.SecretDataType))
+		state.VersionId = types.StringValue(tea.StringValue(value.VersionId))
+	}
+
+	versionStages := []string{}
+	if value.VersionStages != nil {
+		for _, versionStage := range tea.StringSliceValue(value.VersionStages.VersionStage) {
+			if versionStage != kmsSecretCurrentVersionStage {
+				versionStages = append(versionStages, versionStage)
+			}
+		}
+	}
+	if !state.VersionStages.IsNull() || len(versionStages) > 0 {
+		// Keep the version stages in the same order as the state.
+		stateVersionStages := convertListValueToStrings(state.VersionStages)
+		orderedVersionStages := convertStringsDifference(stateVersionStages, convertStringsDifference(stateVersionStages, versionStages))
+		orderedVersionStages = append(orderedVersionStages, convertStringsDifference(versionStages, stateVersionStages)...)
+		state.VersionStages = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(orderedVersionStages)))
+	}
+
+	if state.RotationInterval.IsNull() {
+		state.RotationInterval = types.StringValue("30d")
+	}
+	if state.RecoveryWindowInDays.IsNull() {
+		state.RecoveryWindowInDays = types.Int64Value(30)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the secret, a new version is put if the secret data is changed and
+// the version stages are moved to the new version.
+func (r *kmsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *kmsSecretModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secretName := plan.SecretName.ValueString()
+	plan.Arn = state.Arn
+
+	if !plan.Description.Equal(state.Description) {
+		updateSecret := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateSecretRequest := &alicloudKmsClient.UpdateSecretRequest{
+				SecretName:  tea.String(secretName),
+				Description: tea.String(plan.Description.ValueString()),
+			}
+
+			if _, err := r.client.UpdateSecretWithOptions(updateSecretRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateSecret); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Secret.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	stateVersionStages := convertListValueToStrings(state.VersionStages)
+	planVersionStages := convertListValueToStrings(plan.VersionStages)
+	if !plan.SecretData.Equal(state.SecretData) || !plan.SecretDataType.Equal(state.SecretDataType) {
+		plan.VersionId = types.StringValue(kmsSecretVersionId())
+		versionStages := append([]string{kmsSecretCurrentVersionStage}, planVersionStages...)
+		putSecretValue := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			versionStagesJson, _ := json.Marshal(versionStages)
+			putSecretValueRequest := &alicloudKmsClient.PutSecretValueRequest{
+				SecretName:     tea.String(secretName),
+				SecretData:     tea.String(plan.SecretData.ValueString()),
+				SecretDataType: tea.String(plan.SecretDataType.ValueString()),
+				VersionId:      tea.String(plan.VersionId.ValueString()),
+				VersionStages:  tea.String(string(versionStagesJson)),
+			}
+
+			if _, err := r.client.PutSecretValueWithOptions(putSecretValueRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(putSecretValue); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Put Secret Value.",
+				err.Error(),
+			)
+			return
+		}
+
+		// The removed version stages are left on the previous version.
+		for _, versionStage := range convertStringsDifference(stateVersionStages, planVersionStages) {
+			if err := r.updateSecretVersionStage(secretName, versionStage, "", state.VersionId.ValueString()); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Update Secret Version Stage.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	} else {
+		plan.VersionId = state.VersionId
+		for _, versionStage := range convertStringsDifference(planVersionStages, stateVersionStages) {
+			if err := r.updateSecretVersionStage(secretName, versionStage, plan.VersionId.ValueString(), ""); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Update Secret Version Stage.",
+					err.Error(),
+				)
+				return
+			}
+		}
+		for _, versionStage := range convertStringsDifference(stateVersionStages, planVersionStages) {
+			if err := r.updateSecretVersionStage(secretName, versionStage, "", plan.VersionId.ValueString()); err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Update Secret Version Stage.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	if !plan.AutomaticRotation.Equal(state.AutomaticRotation) || !plan.RotationInterval.Equal(state.RotationInterval) {
+		updateSecretRotationPolicy := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateSecretRotationPolicyRequest := &alicloudKmsClient.UpdateSecretRotationPolicyRequest{
+				SecretName:              tea.String(secretName),
+				EnableAutomaticRotation: tea.Bool(plan.AutomaticRotation.ValueBool()),
+			}
+			if plan.AutomaticRotation.ValueBool() {
+				updateSecretRotationPolicyRequest.RotationInterval = tea.String(plan.RotationInterval.ValueString())
+			}
+
+			if _, err := r.client.UpdateSecretRotationPolicyWithOptions(updateSecretRotationPolicyRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateSecretRotationPolicy); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Secret Rotation Policy.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Tags.Equal(state.Tags) {
+		if err := r.updateTags(ctx, secretName, state.Tags, plan.Tags); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Secret Tags.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the secret, the secret can be restored within the recovery window.
+func (r *kmsSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *kmsSecretModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recoveryWindowInDays := state.RecoveryWindowInDays.ValueInt64()
+	if state.RecoveryWindowInDays.IsNull() {
+		recoveryWindowInDays = 30
+	}
+
+	deleteSecret := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteSecretRequest := &alicloudKmsClient.DeleteSecretRequest{
+			SecretName:           tea.String(state.SecretName.ValueString()),
+			RecoveryWindowInDays: tea.String(fmt.Sprint(recoveryWindowInDays)),
+		}
+
+		if _, err := r.client.DeleteSecretWithOptions(deleteSecretRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.ResourceNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteSecret); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Secret.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *kmsSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("secret_name"), req, resp)
+}
+
+// ModifyPlan marks the version ID as unknown when the secret data is changed,
+// as a new version is put.
+func (r *kmsSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *kmsSecretModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SecretData.Equal(state.SecretData) || !plan.SecretDataType.Equal(state.SecretDataType) {
+		plan.VersionId = types.StringUnknown()
+
+		setPlanDiags := resp.Plan.Set(ctx, &plan)
+		resp.Diagnostics.Append(setPlanDiags...)
+	}
+}
+
+// Function to attach the version stage to the version, or remove it from the
+// version.
+func (r *kmsSecretResource) updateSecretVersionStage(secretName, versionStage, moveToVersion, removeFromVersion string) error {
+	updateSecretVersionStage := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateSecretVersionStageRequest := &alicloudKmsClient.UpdateSecretVersionStageRequest{
+			SecretName:   tea.String(secretName),
+			VersionStage: tea.String(versionStage),
+		}
+		if moveToVersion != "" {
+			updateSecretVersionStageRequest.MoveToVersion = tea.String(moveToVersion)
+		}
+		if removeFromVersion != "" {
+			updateSecretVersionStageRequest.RemoveFromVersion = tea.String(removeFromVersion)
+		}
+
+		if _, err := r.client.UpdateSecretVersionStageWithOptions(updateSecretVersionStageRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateSecretVersionStage)
+}
+
+// Function to set the new tags and remove the deleted tags of the secret.
+func (r *kmsSecretResource) updateTags(ctx context.Context, secretName string, stateTags, planTags types.Map) error {
+	oldTags := make(map[string]string)
+	if diags := stateTags.ElementsAs(ctx, &oldTags, false); diags.HasError() {
+		return fmt.Errorf("failed to read the tags in state")
+	}
+	newTags := make(map[string]string)
+	if diags := planTags.ElementsAs(ctx, &newTags, false); diags.HasError() {
+		return fmt.Errorf("failed to read the tags in plan")
+	}
+
+	var removedTagKeys []string
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removedTagKeys = append(removedTagKeys, key)
+		}
+	}
+
+	if len(removedTagKeys) > 0 {
+		untagResource := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			tagKeysJson, _ := json.Marshal(removedTagKeys)
+			untagResourceRequest := &alicloudKmsClient.UntagResourceRequest{
+				SecretName: tea.String(secretName),
+				TagKeys:    tea.String(string(tagKeysJson)),
+			}
+
+			if _, err := r.client.UntagResourceWithOptions(untagResourceRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(untagResource); err != nil {
+			return err
+		}
+	}
+
+	if len(newTags) > 0 {
+		tagResource := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			tagResourceRequest := &alicloudKmsClient.TagResourceRequest{
+				SecretName: tea.String(secretName),
+				Tags:       tea.String(kmsSecretTagsJson(newTags)),
+			}
+
+			if _, err := r.client.TagResourceWithOptions(tagResourceRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(tagResource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to convert the tags to the JSON format of KMS API, e.g.
+// [{"TagKey":"env","TagValue":"prod"}].
+func kmsSecretTagsJson(tags map[string]string) string {
+	type kmsTag struct {
+		TagKey   string `json:"TagKey"`
+		TagValue string `json:"TagValue"`
+	}
+
+	kmsTags := []kmsTag{}
+	for key, value := range tags {
+		kmsTags = append(kmsTags, kmsTag{TagKey: key, TagValue: value})
+	}
+	tagsJson, _ := json.Marshal(kmsTags)
+	return string(tagsJson)
+}
+
+// Function to generate the ID of a new secret version, the version ID must
+// be unique within the secret.
+func kmsSecretVersionId() string {
+	return "v" + time.Now().UTC().Format("20060102150405")
+}
@@ -0,0 +1,311 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudKmsClient "github.com/alibabacloud-go/kms-20160120/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &kmsSecretRotationResource{}
+	_ resource.ResourceWithConfigure   = &kmsSecretRotationResource{}
+	_ resource.ResourceWithImportState = &kmsSecretRotationResource{}
+)
+
+func NewKmsSecretRotationResource() resource.Resource {
+	return &kmsSecretRotationResource{}
+}
+
+type kmsSecretRotationResource struct {
+	client *alicloudKmsClient.Client
+}
+
+type kmsSecretRotationModel struct {
+	SecretName       types.String `tfsdk:"secret_name"`
+	RotationInterval types.String `tfsdk:"rotation_interval"`
+	SecretType       types.String `tfsdk:"secret_type"`
+	ExtendedConfig   types.String `tfsdk:"extended_config"`
+	LastRotationDate types.String `tfsdk:"last_rotation_date"`
+	NextRotationDate types.String `tfsdk:"next_rotation_date"`
+}
+
+// Metadata returns the KMS Secret Rotation resource name.
+func (r *kmsSecretRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_secret_rotation"
+}
+
+// Schema defines the schema for the KMS Secret Rotation resource.
+func (r *kmsSecretRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable the automatic rotation of a RDS, RAM or ECS secret managed by KMS Secrets Manager.",
+		Attributes: map[string]schema.Attribute{
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the secret.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_interval": schema.StringAttribute{
+				Description: "The interval of the automatic rotation, in the format of `<number>d`, `<number>h` or `<number>s`, e.g. 7d. " +
+					"The interval must be between 6 hours and 365 days.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kmsSecretRotationIntervalRegex, "must be in the format of <number>d, <number>h or <number>s"),
+				},
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret, e.g. Rds, RAMCredentials or ECS.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extended_config": schema.StringAttribute{
+				Description: "The extended config of the secret in JSON, which contains the resource linked with the secret, e.g. the RDS instance or the RAM user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_rotation_date": schema.StringAttribute{
+				Description: "The time when the last rotation is performed.",
+				Computed:    true,
+			},
+			"next_rotation_date": schema.StringAttribute{
+				Description: "The time when the next rotation will be performed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *kmsSecretRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).kmsClient
+}
+
+// Enable the automatic rotation of the secret.
+func (r *kmsSecretRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *kmsSecretRotationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateSecretRotationPolicy(plan.SecretName.ValueString(), true, plan.RotationInterval.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Secret Rotation Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.readSecret(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Secret.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the rotation policy of the secret.
+func (r *kmsSecretRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *kmsSecretRotationModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, err := r.readSecret(state)
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "Forbidden.ResourceNotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Secret.",
+			err.Error(),
+		)
+		return
+	}
+
+	if !enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the rotation interval of the secret.
+func (r *kmsSecretRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *kmsSecretRotationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateSecretRotationPolicy(plan.SecretName.ValueString(), true, plan.RotationInterval.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Secret Rotation Policy.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.readSecret(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Secret.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the automatic rotation of the secret.
+func (r *kmsSecretRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *kmsSecretRotationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateSecretRotationPolicy(state.SecretName.ValueString(), false, ""); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Secret Rotation Policy.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *kmsSecretRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("secret_name"), req, resp)
+}
+
+func (r *kmsSecretRotationResource) updateSecretRotationPolicy(secretName string, enabled bool, rotationInterval string) error {
+	updateSecretRotationPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateSecretRotationPolicyRequest := &alicloudKmsClient.UpdateSecretRotationPolicyRequest{
+			SecretName:              tea.String(secretName),
+			EnableAutomaticRotation: tea.Bool(enabled),
+		}
+		if rotationInterval != "" {
+			updateSecretRotationPolicyRequest.RotationInterval = tea.String(rotationInterval)
+		}
+
+		if _, err := r.client.UpdateSecretRotationPolicyWithOptions(updateSecretRotationPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateSecretRotationPolicy)
+}
+
+// Function to read the secret into the model, returns whether the automatic
+// rotation is enabled.
+func (r *kmsSecretRotationResource) readSecret(model *kmsSecretRotationModel) (bool, error) {
+	var describeSecretResponse *alicloudKmsClient.DescribeSecretResponse
+	describeSecret := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeSecretRequest := &alicloudKmsClient.DescribeSecretRequest{
+			SecretName: tea.String(model.SecretName.ValueString()),
+		}
+
+		var err error
+		describeSecretResponse, err = r.client.DescribeSecretWithOptions(describeSecretRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeSecret); err != nil {
+		return false, err
+	}
+
+	body := describeSecretResponse.Body
+	model.SecretType = types.StringValue(tea.StringValue(body.SecretType))
+	model.ExtendedConfig = types.StringValue(tea.StringValue(body.ExtendedConfig))
+	model.LastRotationDate = types.StringValue(tea.StringValue(body.LastRotationDate))
+	model.NextRotationDate = types.StringValue(tea.StringValue(body.NextRotationDate))
+
+	// AliCloud returns the rotation interval in seconds, keep the configured
+	// format if both represent the same interval.
+	rotationInterval := tea.StringValue(body.RotationInterval)
+	configured, configuredErr := parseKmsSecretRotationInterval(model.RotationInterval.ValueString())
+	actual, actualErr := parseKmsSecretRotationInterval(rotationInterval)
+	if configuredErr != nil || actualErr != nil || configured != actual {
+		model.RotationInterval = types.StringValue(rotationInterval)
+	}
+
+	return tea.StringValue(body.AutomaticRotation) == "Enabled", nil
+}
+
+var kmsSecretRotationIntervalRegex = regexp.MustCompile(`^[0-9]+[dhs]$`)
+
+// Convert the rotation interval in the format of <number>d, <number>h or
+// <number>s to duration.
+func parseKmsSecretRotationInterval(interval string) (time.Duration, error) {
+	if !kmsSecretRotationIntervalRegex.MatchString(interval) {
+		return 0, fmt.Errorf("invalid rotation interval: %s", interval)
+	}
+
+	value, err := strconv.ParseInt(strings.TrimRight(interval, "dhs"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	switch interval[len(interval)-1] {
+	case 'd':
+		return time.Duration(value) * 24 * time.Hour, nil
+	case 'h':
+		return time.Duration(value) * time.Hour, nil
+	default:
+		return time.Duration(value) * time.Second, nil
+	}
+}
@@ -0,0 +1,403 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The modification protection status of SLB and ALB.
+	lbModificationProtectionEnabled  = "ConsoleProtection"
+	lbModificationProtectionDisabled = "NonProtection"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerProtectionResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerProtectionResource{}
+	_ resource.ResourceWithImportState = &loadBalancerProtectionResource{}
+)
+
+func NewLoadBalancerProtectionResource() resource.Resource {
+	return &loadBalancerProtectionResource{}
+}
+
+type loadBalancerProtectionResource struct {
+	slbClient *alicloudSlbClient.Client
+	albClient *alicloudAlbClient.Client
+}
+
+type loadBalancerProtectionModel struct {
+	LoadBalancerId               types.String `tfsdk:"load_balancer_id"`
+	LoadBalancerType             types.String `tfsdk:"load_balancer_type"`
+	DeletionProtection           types.Bool   `tfsdk:"deletion_protection"`
+	ModificationProtection       types.Bool   `tfsdk:"modification_protection"`
+	ModificationProtectionReason types.String `tfsdk:"modification_protection_reason"`
+}
+
+// Metadata returns the Load Balancer Protection resource name.
+func (r *loadBalancerProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_protection"
+}
+
+// Schema defines the schema for the Load Balancer Protection resource.
+func (r *loadBalancerProtectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Toggle the deletion protection and the configuration modification protection of a classic " +
+			"Server Load Balancer (SLB) or an application load balancer (ALB) created elsewhere. Both protections " +
+			"are disabled when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Description: "The ID of the load balancer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"load_balancer_type": schema.StringAttribute{
+				Description: "The type of the load balancer. Valid values: `slb`, `alb`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("slb", "alb"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether to enable the deletion protection. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"modification_protection": schema.BoolAttribute{
+				Description: "Whether to enable the configuration modification protection, which prevents the " +
+					"configuration from being modified in the console. Default to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"modification_protection_reason": schema.StringAttribute{
+				Description: "The reason of the configuration modification protection, which is only applied when " +
+					"modification_protection is true.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *loadBalancerProtectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.slbClient = req.ProviderData.(alicloudClients).slbClient
+	r.albClient = req.ProviderData.(alicloudClients).albClient
+}
+
+// Set the protections of the load balancer.
+func (r *loadBalancerProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *loadBalancerProtectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setProtection(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Load Balancer Protection.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the protections of the load balancer. The resource is removed from
+// state when the load balancer is deleted.
+func (r *loadBalancerProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *loadBalancerProtectionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.readProtection(state)
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Load Balancer Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Set the changed protections of the load balancer.
+func (r *loadBalancerProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *loadBalancerProtectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setProtection(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Load Balancer Protection.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable both protections of the load balancer.
+func (r *loadBalancerProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *loadBalancerProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeletionProtection = types.BoolValue(false)
+	state.ModificationProtection = types.BoolValue(false)
+	state.ModificationProtectionReason = types.StringNull()
+
+	err := r.setProtection(state)
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Load Balancer Protection.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the protections by the ID in the format of
+// <load_balancer_type>:<load_balancer_id>.
+func (r *loadBalancerProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || (ids[0] != "slb" && ids[0] != "alb") || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <load_balancer_type>:<load_balancer_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_type"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), ids[1])...)
+}
+
+// Function to set the protections of the load balancer with the API of the
+// load balancer type.
+func (r *loadBalancerProtectionResource) setProtection(model *loadBalancerProtectionModel) error {
+	modificationProtectionStatus := lbModificationProtectionDisabled
+	var modificationProtectionReason *string
+	if model.ModificationProtection.ValueBool() {
+		modificationProtectionStatus = lbModificationProtectionEnabled
+		if !model.ModificationProtectionReason.IsNull() {
+			modificationProtectionReason = tea.String(model.ModificationProtectionReason.ValueString())
+		}
+	}
+
+	var setDeletionProtection, setModificationProtection func() error
+	switch model.LoadBalancerType.ValueString() {
+	case "slb":
+		setDeletionProtection = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			deleteProtection := "off"
+			if model.DeletionProtection.ValueBool() {
+				deleteProtection = "on"
+			}
+			setLoadBalancerDeleteProtectionRequest := &alicloudSlbClient.SetLoadBalancerDeleteProtectionRequest{
+				RegionId:         r.slbClient.RegionId,
+				LoadBalancerId:   tea.String(model.LoadBalancerId.ValueString()),
+				DeleteProtection: tea.String(deleteProtection),
+			}
+
+			if _, err := r.slbClient.SetLoadBalancerDeleteProtectionWithOptions(setLoadBalancerDeleteProtectionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+		setModificationProtection = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			setLoadBalancerModificationProtectionRequest := &alicloudSlbClient.SetLoadBalancerModificationProtectionRequest{
+				RegionId:                     r.slbClient.RegionId,
+				LoadBalancerId:               tea.String(model.LoadBalancerId.ValueString()),
+				ModificationProtectionStatus: tea.String(modificationProtectionStatus),
+				ModificationProtectionReason: modificationProtectionReason,
+			}
+
+			if _, err := r.slbClient.SetLoadBalancerModificationProtectionWithOptions(setLoadBalancerModificationProtectionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+	case "alb":
+		setDeletionProtection = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			var err error
+			if model.DeletionProtection.ValueBool() {
+				_, err = r.albClient.EnableDeletionProtectionWithOptions(&alicloudAlbClient.EnableDeletionProtectionRequest{
+					ResourceId: tea.String(model.LoadBalancerId.ValueString()),
+				}, runtime)
+			} else {
+				_, err = r.albClient.DisableDeletionProtectionWithOptions(&alicloudAlbClient.DisableDeletionProtectionRequest{
+					ResourceId: tea.String(model.LoadBalancerId.ValueString()),
+				}, runtime)
+			}
+			if err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+		setModificationProtection = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateLoadBalancerAttributeRequest := &alicloudAlbClient.UpdateLoadBalancerAttributeRequest{
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+				ModificationProtectionConfig: &alicloudAlbClient.UpdateLoadBalancerAttributeRequestModificationProtectionConfig{
+					Status: tea.String(modificationProtectionStatus),
+					Reason: modificationProtectionReason,
+				},
+			}
+
+			if _, err := r.albClient.UpdateLoadBalancerAttributeWithOptions(updateLoadBalancerAttributeRequest, runtime); err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+	}
+
+	if err := retryAPICall(setDeletionProtection); err != nil {
+		return err
+	}
+
+	return retryAPICall(setModificationProtection)
+}
+
+// Function to read the protections of the load balancer into the model.
+func (r *loadBalancerProtectionResource) readProtection(model *loadBalancerProtectionModel) error {
+	var deletionProtection bool
+	var modificationProtectionStatus, modificationProtectionReason string
+
+	var describeLoadBalancerAttribute func() error
+	switch model.LoadBalancerType.ValueString() {
+	case "slb":
+		describeLoadBalancerAttribute = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeLoadBalancerAttributeRequest := &alicloudSlbClient.DescribeLoadBalancerAttributeRequest{
+				RegionId:       r.slbClient.RegionId,
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+
+			describeLoadBalancerAttributeResponse, err := r.slbClient.DescribeLoadBalancerAttributeWithOptions(describeLoadBalancerAttributeRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			body := describeLoadBalancerAttributeResponse.Body
+			deletionProtection = tea.StringValue(body.DeleteProtection) == "on"
+			modificationProtectionStatus = tea.StringValue(body.ModificationProtectionStatus)
+			modificationProtectionReason = tea.StringValue(body.ModificationProtectionReason)
+			return nil
+		}
+	case "alb":
+		describeLoadBalancerAttribute = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			getLoadBalancerAttributeRequest := &alicloudAlbClient.GetLoadBalancerAttributeRequest{
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+
+			getLoadBalancerAttributeResponse, err := r.albClient.GetLoadBalancerAttributeWithOptions(getLoadBalancerAttributeRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			body := getLoadBalancerAttributeResponse.Body
+			if body.DeletionProtectionConfig != nil {
+				deletionProtection = tea.BoolValue(body.DeletionProtectionConfig.Enabled)
+			}
+			if body.ModificationProtectionConfig != nil {
+				modificationProtectionStatus = tea.StringValue(body.ModificationProtectionConfig.Status)
+				modificationProtectionReason = tea.StringValue(body.ModificationProtectionConfig.Reason)
+			}
+			return nil
+		}
+	}
+
+	if err := retryAPICall(describeLoadBalancerAttribute); err != nil {
+		return err
+	}
+
+	model.DeletionProtection = types.BoolValue(deletionProtection)
+	model.ModificationProtection = types.BoolValue(modificationProtectionStatus == lbModificationProtectionEnabled)
+	// The reason is only kept by AliCloud when the modification protection
+	// is enabled, and an empty reason is returned when the reason is not
+	// given, keep it as null to match the configuration.
+	if model.ModificationProtection.ValueBool() {
+		model.ModificationProtectionReason = types.StringNull()
+		if modificationProtectionReason != "" {
+			model.ModificationProtectionReason = types.StringValue(modificationProtectionReason)
+		}
+	}
+	return nil
+}
+
+func isLoadBalancerNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		code := tea.StringValue(_t.Code)
+		return code == "InvalidLoadBalancerId.NotFound" || code == "ResourceNotFound.LoadBalancer"
+	}
+	return false
+}
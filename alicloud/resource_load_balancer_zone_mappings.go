@@ -0,0 +1,434 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAlbClient "github.com/alibabacloud-go/alb-20200616/v2/client"
+	alicloudNlbClient "github.com/alibabacloud-go/nlb-20220430/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerZoneMappingsResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerZoneMappingsResource{}
+	_ resource.ResourceWithImportState = &loadBalancerZoneMappingsResource{}
+)
+
+func NewLoadBalancerZoneMappingsResource() resource.Resource {
+	return &loadBalancerZoneMappingsResource{}
+}
+
+type loadBalancerZoneMappingsResource struct {
+	albClient *alicloudAlbClient.Client
+	nlbClient *alicloudNlbClient.Client
+}
+
+type loadBalancerZoneMappingsModel struct {
+	LoadBalancerId   types.String               `tfsdk:"load_balancer_id"`
+	LoadBalancerType types.String               `tfsdk:"load_balancer_type"`
+	ZoneMappings     []*loadBalancerZoneMapping `tfsdk:"zone_mappings"`
+}
+
+type loadBalancerZoneMapping struct {
+	ZoneId    types.String `tfsdk:"zone_id"`
+	VSwitchId types.String `tfsdk:"vswitch_id"`
+}
+
+// Metadata returns the Load Balancer Zone Mappings resource name.
+func (r *loadBalancerZoneMappingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_zone_mappings"
+}
+
+// Schema defines the schema for the Load Balancer Zone Mappings resource.
+func (r *loadBalancerZoneMappingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the zone mappings of an application load balancer (ALB) or a network load balancer " +
+			"(NLB) created elsewhere. The changes are rolled out in stages, one zone at a time, by adding the new " +
+			"zones first, then swapping the vSwitches and removing the zones at last, and each stage waits until " +
+			"the load balancer is active again. The zone mappings are left as is when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Description: "The ID of the load balancer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"load_balancer_type": schema.StringAttribute{
+				Description: "The type of the load balancer. Valid values: `alb`, `nlb`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("alb", "nlb"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"zone_mappings": schema.ListNestedBlock{
+				Description: "The zones of the load balancer and the vSwitch in each zone. At least 2 zones are " +
+					"required.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"zone_id": schema.StringAttribute{
+							Description: "The ID of the zone, e.g. cn-hongkong-b.",
+							Required:    true,
+						},
+						"vswitch_id": schema.StringAttribute{
+							Description: "The ID of the vSwitch in the zone.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *loadBalancerZoneMappingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.albClient = req.ProviderData.(alicloudClients).albClient
+	r.nlbClient = req.ProviderData.(alicloudClients).nlbClient
+}
+
+// Roll out the zone mappings to the load balancer.
+func (r *loadBalancerZoneMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *loadBalancerZoneMappingsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rolloutZoneMappings(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Load Balancer Zones.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the zone mappings of the load balancer.
+func (r *loadBalancerZoneMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *loadBalancerZoneMappingsModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneMappings, _, err := r.getZoneMappings(state)
+	if err != nil {
+		if isLoadBalancerNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Load Balancer Attribute.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Keep the zone mappings in the same order as the state.
+	stateZoneMappings := []*loadBalancerZoneMapping{}
+	for _, stateZoneMapping := range state.ZoneMappings {
+		if vSwitchId, ok := zoneMappings[stateZoneMapping.ZoneId.ValueString()]; ok {
+			stateZoneMapping.VSwitchId = types.StringValue(vSwitchId)
+			stateZoneMappings = append(stateZoneMappings, stateZoneMapping)
+			delete(zoneMappings, stateZoneMapping.ZoneId.ValueString())
+		}
+	}
+	for zoneId, vSwitchId := range zoneMappings {
+		stateZoneMappings = append(stateZoneMappings, &loadBalancerZoneMapping{
+			ZoneId:    types.StringValue(zoneId),
+			VSwitchId: types.StringValue(vSwitchId),
+		})
+	}
+	state.ZoneMappings = stateZoneMappings
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Roll out the changed zone mappings to the load balancer.
+func (r *loadBalancerZoneMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *loadBalancerZoneMappingsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rolloutZoneMappings(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Load Balancer Zones.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the resource from state, the zones of a load balancer
+// can not be removed entirely.
+func (r *loadBalancerZoneMappingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the zone mappings by the ID in the format of
+// <load_balancer_type>:<load_balancer_id>.
+func (r *loadBalancerZoneMappingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || (ids[0] != "alb" && ids[0] != "nlb") || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <load_balancer_type>:<load_balancer_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_type"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), ids[1])...)
+}
+
+// Function to roll out the zone mappings in stages, each stage changes one
+// zone only so that the traffic of the other zones is not disrupted. The new
+// zones are added first, then the vSwitches are swapped, and the removed
+// zones are removed at last, so that the load balancer always has enough
+// zones during the rollout.
+func (r *loadBalancerZoneMappingsResource) rolloutZoneMappings(model *loadBalancerZoneMappingsModel) error {
+	current, zoneIds, err := r.getZoneMappings(model)
+	if err != nil {
+		return err
+	}
+
+	planned := make(map[string]string)
+	for _, zoneMapping := range model.ZoneMappings {
+		planned[zoneMapping.ZoneId.ValueString()] = zoneMapping.VSwitchId.ValueString()
+	}
+
+	var stages []map[string]string
+	nextStage := func(change func(stage map[string]string)) {
+		stage := make(map[string]string)
+		for zoneId, vSwitchId := range current {
+			stage[zoneId] = vSwitchId
+		}
+		change(stage)
+		stages = append(stages, stage)
+		current = stage
+	}
+
+	for _, zoneMapping := range model.ZoneMappings {
+		zoneId, vSwitchId := zoneMapping.ZoneId.ValueString(), zoneMapping.VSwitchId.ValueString()
+		if _, ok := current[zoneId]; !ok {
+			nextStage(func(stage map[string]string) { stage[zoneId] = vSwitchId })
+			zoneIds = append(zoneIds, zoneId)
+		}
+	}
+	for _, zoneMapping := range model.ZoneMappings {
+		zoneId, vSwitchId := zoneMapping.ZoneId.ValueString(), zoneMapping.VSwitchId.ValueString()
+		if current[zoneId] != vSwitchId {
+			nextStage(func(stage map[string]string) { stage[zoneId] = vSwitchId })
+		}
+	}
+	for _, zoneId := range zoneIds {
+		if _, ok := planned[zoneId]; !ok {
+			nextStage(func(stage map[string]string) { delete(stage, zoneId) })
+		}
+	}
+
+	for _, stage := range stages {
+		if err := r.waitLoadBalancerActive(model); err != nil {
+			return err
+		}
+		if err := r.updateZoneMappings(model, zoneIds, stage); err != nil {
+			return err
+		}
+	}
+	return r.waitLoadBalancerActive(model)
+}
+
+// Function to update the zone mappings of the load balancer, the zones are
+// ordered by the given zone IDs.
+func (r *loadBalancerZoneMappingsResource) updateZoneMappings(model *loadBalancerZoneMappingsModel, zoneIds []string, zoneMappings map[string]string) error {
+	var updateLoadBalancerZones func() error
+	switch model.LoadBalancerType.ValueString() {
+	case "alb":
+		updateLoadBalancerZones = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateLoadBalancerZonesRequest := &alicloudAlbClient.UpdateLoadBalancerZonesRequest{
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+			for _, zoneId := range zoneIds {
+				if vSwitchId, ok := zoneMappings[zoneId]; ok {
+					updateLoadBalancerZonesRequest.ZoneMappings = append(updateLoadBalancerZonesRequest.ZoneMappings,
+						&alicloudAlbClient.UpdateLoadBalancerZonesRequestZoneMappings{
+							ZoneId:    tea.String(zoneId),
+							VSwitchId: tea.String(vSwitchId),
+						})
+				}
+			}
+
+			if _, err := r.albClient.UpdateLoadBalancerZonesWithOptions(updateLoadBalancerZonesRequest, runtime); err != nil {
+				return handleAlbListenerAPIError(err)
+			}
+			return nil
+		}
+	case "nlb":
+		updateLoadBalancerZones = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateLoadBalancerZonesRequest := &alicloudNlbClient.UpdateLoadBalancerZonesRequest{
+				RegionId:       r.nlbClient.RegionId,
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+			for _, zoneId := range zoneIds {
+				if vSwitchId, ok := zoneMappings[zoneId]; ok {
+					updateLoadBalancerZonesRequest.ZoneMappings = append(updateLoadBalancerZonesRequest.ZoneMappings,
+						&alicloudNlbClient.UpdateLoadBalancerZonesRequestZoneMappings{
+							ZoneId:    tea.String(zoneId),
+							VSwitchId: tea.String(vSwitchId),
+						})
+				}
+			}
+
+			if _, err := r.nlbClient.UpdateLoadBalancerZonesWithOptions(updateLoadBalancerZonesRequest, runtime); err != nil {
+				return handleNlbServerGroupAPIError(err)
+			}
+			return nil
+		}
+	}
+
+	return retryAPICall(updateLoadBalancerZones)
+}
+
+// Function to get the zone mappings of the load balancer, returns the map of
+// the zone ID to the vSwitch ID, and the zone IDs in the returned order.
+func (r *loadBalancerZoneMappingsResource) getZoneMappings(model *loadBalancerZoneMappingsModel) (map[string]string, []string, error) {
+	zoneMappings := make(map[string]string)
+	var zoneIds []string
+
+	var getLoadBalancerAttribute func() error
+	switch model.LoadBalancerType.ValueString() {
+	case "alb":
+		getLoadBalancerAttribute = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			getLoadBalancerAttributeRequest := &alicloudAlbClient.GetLoadBalancerAttributeRequest{
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+
+			getLoadBalancerAttributeResponse, err := r.albClient.GetLoadBalancerAttributeWithOptions(getLoadBalancerAttributeRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			zoneIds = nil
+			for _, zoneMapping := range getLoadBalancerAttributeResponse.Body.ZoneMappings {
+				zoneMappings[tea.StringValue(zoneMapping.ZoneId)] = tea.StringValue(zoneMapping.VSwitchId)
+				zoneIds = append(zoneIds, tea.StringValue(zoneMapping.ZoneId))
+			}
+			return nil
+		}
+	case "nlb":
+		getLoadBalancerAttribute = func() error {
+			runtime := &util.RuntimeOptions{}
+
+			getLoadBalancerAttributeRequest := &alicloudNlbClient.GetLoadBalancerAttributeRequest{
+				RegionId:       r.nlbClient.RegionId,
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}
+
+			getLoadBalancerAttributeResponse, err := r.nlbClient.GetLoadBalancerAttributeWithOptions(getLoadBalancerAttributeRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			zoneIds = nil
+			for _, zoneMapping := range getLoadBalancerAttributeResponse.Body.ZoneMappings {
+				zoneMappings[tea.StringValue(zoneMapping.ZoneId)] = tea.StringValue(zoneMapping.VSwitchId)
+				zoneIds = append(zoneIds, tea.StringValue(zoneMapping.ZoneId))
+			}
+			return nil
+		}
+	}
+
+	if err := retryAPICall(getLoadBalancerAttribute); err != nil {
+		return nil, nil, err
+	}
+	return zoneMappings, zoneIds, nil
+}
+
+// Function to wait until the load balancer is active, as the zones can not
+// be updated while the load balancer is being configured.
+func (r *loadBalancerZoneMappingsResource) waitLoadBalancerActive(model *loadBalancerZoneMappingsModel) error {
+	waitLoadBalancerActive := func() error {
+		var status string
+		switch model.LoadBalancerType.ValueString() {
+		case "alb":
+			getLoadBalancerAttributeResponse, err := r.albClient.GetLoadBalancerAttributeWithOptions(&alicloudAlbClient.GetLoadBalancerAttributeRequest{
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}, &util.RuntimeOptions{})
+			if err != nil {
+				return handleAPIError(err)
+			}
+			status = tea.StringValue(getLoadBalancerAttributeResponse.Body.LoadBalancerStatus)
+		case "nlb":
+			getLoadBalancerAttributeResponse, err := r.nlbClient.GetLoadBalancerAttributeWithOptions(&alicloudNlbClient.GetLoadBalancerAttributeRequest{
+				RegionId:       r.nlbClient.RegionId,
+				LoadBalancerId: tea.String(model.LoadBalancerId.ValueString()),
+			}, &util.RuntimeOptions{})
+			if err != nil {
+				return handleAPIError(err)
+			}
+			status = tea.StringValue(getLoadBalancerAttributeResponse.Body.LoadBalancerStatus)
+		}
+
+		if status != "Active" {
+			return fmt.Errorf("load balancer %s is still %s", model.LoadBalancerId.ValueString(), status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitLoadBalancerActive, reconnectBackoff)
+}
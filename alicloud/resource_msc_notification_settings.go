@@ -0,0 +1,590 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudMscClient "github.com/alibabacloud-go/mscopensubscription-20210713/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource              = &mscNotificationSettingsResource{}
+	_ resource.ResourceWithConfigure = &mscNotificationSettingsResource{}
+)
+
+func NewMscNotificationSettingsResource() resource.Resource {
+	return &mscNotificationSettingsResource{}
+}
+
+type mscNotificationSettingsResource struct {
+	client        *alicloudMscClient.Client
+	adoptExisting bool
+}
+
+type mscNotificationSettingsModel struct {
+	Contacts      []*mscNotificationContact      `tfsdk:"contacts"`
+	Subscriptions []*mscNotificationSubscription `tfsdk:"subscriptions"`
+}
+
+type mscNotificationContact struct {
+	ContactId types.Int64  `tfsdk:"contact_id"`
+	Name      types.String `tfsdk:"name"`
+	Email     types.String `tfsdk:"email"`
+	Mobile    types.String `tfsdk:"mobile"`
+	Position  types.String `tfsdk:"position"`
+}
+
+type mscNotificationSubscription struct {
+	ItemId       types.Int64  `tfsdk:"item_id"`
+	ItemName     types.String `tfsdk:"item_name"`
+	Channels     types.List   `tfsdk:"channels"`
+	ContactNames types.List   `tfsdk:"contact_names"`
+}
+
+// Metadata returns the Message Center Notification Settings resource name.
+func (r *mscNotificationSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_msc_notification_settings"
+}
+
+// Schema defines the schema for the Message Center Notification Settings resource.
+func (r *mscNotificationSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the notification settings of the account in the Message Center, including the " +
+			"message receivers and the receivers and channels of the message categories, e.g. the security, " +
+			"the operations and the billing notifications. There should be only one of this resource in an " +
+			"account. The subscription items which are not configured are left unchanged.",
+		Blocks: map[string]schema.Block{
+			"contacts": schema.ListNestedBlock{
+				Description: "The message receivers managed by this resource, identified by the name.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_id": schema.Int64Attribute{
+							Description: "The ID of the message receiver.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the message receiver.",
+							Required:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the message receiver, which must be verified by " +
+								"the receiver before the messages are sent.",
+							Required: true,
+						},
+						"mobile": schema.StringAttribute{
+							Description: "The mobile phone number of the message receiver.",
+							Required:    true,
+						},
+						"position": schema.StringAttribute{
+							Description: "The position of the message receiver. Valid values: `CEO`, " +
+								"`Technical Director`, `Maintenance Director`, `Project Director`, " +
+								"`Finance Director`, `Others`.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("CEO", "Technical Director", "Maintenance Director",
+									"Project Director", "Finance Director", "Others"),
+							},
+						},
+					},
+				},
+			},
+			"subscriptions": schema.ListNestedBlock{
+				Description: "The message categories to configure, identified by the name of the subscription item.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							Description: "The ID of the subscription item.",
+							Computed:    true,
+						},
+						"item_name": schema.StringAttribute{
+							Description: "The name of the subscription item, e.g. `Security Notifications`, " +
+								"`Product Maintenance Notifications`, `Account Balance Alerts`.",
+							Required: true,
+						},
+						"channels": schema.ListAttribute{
+							Description: "The channels to send the messages. Valid values: `sms`, `mail`, " +
+								"`tts`, `im`.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("sms", "mail", "tts", "im")),
+							},
+						},
+						"contact_names": schema.ListAttribute{
+							Description: "The names of the message receivers, which can be the receivers of " +
+								"the contacts blocks or the existing receivers, e.g. the account contact.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mscNotificationSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).mscClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the message receivers and configure the subscription items.
+func (r *mscNotificationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *mscNotificationSettingsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(plan, &mscNotificationSettingsModel{}); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Apply Notification Settings.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the managed message receivers and subscription items.
+func (r *mscNotificationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *mscNotificationSettingsModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contacts, err := r.listContacts()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Contacts.",
+			err.Error(),
+		)
+		return
+	}
+
+	contactNames := map[int64]string{}
+	contactsByName := map[string]*alicloudMscClient.ListContactsResponseBodyContacts{}
+	for _, contact := range contacts {
+		contactNames[tea.Int64Value(contact.ContactId)] = tea.StringValue(contact.ContactName)
+		contactsByName[tea.StringValue(contact.ContactName)] = contact
+	}
+
+	readContacts := []*mscNotificationContact{}
+	for _, stateContact := range state.Contacts {
+		contact, ok := contactsByName[stateContact.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		readContacts = append(readContacts, &mscNotificationContact{
+			ContactId: types.Int64Value(tea.Int64Value(contact.ContactId)),
+			Name:      types.StringValue(tea.StringValue(contact.ContactName)),
+			Email:     types.StringValue(tea.StringValue(contact.Email)),
+			Mobile:    types.StringValue(tea.StringValue(contact.Mobile)),
+			Position:  types.StringValue(tea.StringValue(contact.Position)),
+		})
+	}
+	state.Contacts = readContacts
+
+	readSubscriptions := []*mscNotificationSubscription{}
+	for _, stateSubscription := range state.Subscriptions {
+		item, err := r.getSubscriptionItem(stateSubscription.ItemName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to List Subscription Items.",
+				err.Error(),
+			)
+			return
+		}
+		if item == nil {
+			continue
+		}
+
+		names := []string{}
+		for _, contactId := range item.ContactIds {
+			if name, ok := contactNames[tea.Int64Value(contactId)]; ok {
+				names = append(names, name)
+			}
+		}
+		channels := strings.Split(tea.StringValue(item.Channel), ",")
+
+		readSubscriptions = append(readSubscriptions, &mscNotificationSubscription{
+			ItemId:       types.Int64Value(tea.Int64Value(item.ItemId)),
+			ItemName:     types.StringValue(tea.StringValue(item.ItemName)),
+			Channels:     mscOrderedListValue(stateSubscription.Channels, channels),
+			ContactNames: mscOrderedListValue(stateSubscription.ContactNames, names),
+		})
+	}
+	state.Subscriptions = readSubscriptions
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the message receivers and the subscription items.
+func (r *mscNotificationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *mscNotificationSettingsModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(plan, state); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Apply Notification Settings.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the managed message receivers from the subscription items, and
+// delete the managed message receivers. The channels of the subscription
+// items are left unchanged.
+func (r *mscNotificationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *mscNotificationSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applySettings(&mscNotificationSettingsModel{}, state); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Notification Settings.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to apply the message receivers and the subscription items of the
+// plan, and remove the message receivers of the prior state which are no
+// longer in the plan. The computed IDs of the plan are filled.
+func (r *mscNotificationSettingsResource) applySettings(plan, prior *mscNotificationSettingsModel) error {
+	contacts, err := r.listContacts()
+	if err != nil {
+		return err
+	}
+	contactIds := map[string]int64{}
+	contactsByName := map[string]*alicloudMscClient.ListContactsResponseBodyContacts{}
+	for _, contact := range contacts {
+		contactIds[tea.StringValue(contact.ContactName)] = tea.Int64Value(contact.ContactId)
+		contactsByName[tea.StringValue(contact.ContactName)] = contact
+	}
+
+	priorContactNames := []string{}
+	for _, contact := range prior.Contacts {
+		priorContactNames = append(priorContactNames, contact.Name.ValueString())
+	}
+
+	// Create or update the planned message receivers.
+	planContactNames := []string{}
+	for _, planContact := range plan.Contacts {
+		name := planContact.Name.ValueString()
+		planContactNames = append(planContactNames, name)
+
+		contact, exists := contactsByName[name]
+		if !exists {
+			contactId, err := r.createContact(planContact)
+			if err != nil {
+				return err
+			}
+			contactIds[name] = contactId
+			planContact.ContactId = types.Int64Value(contactId)
+			continue
+		}
+
+		// The receiver which is not managed by the prior state is only
+		// adopted if it is allowed by the provider.
+		if !r.adoptExisting && len(convertStringsDifference([]string{name}, priorContactNames)) > 0 {
+			return fmt.Errorf("the message receiver %s already exists, set adopt_existing_resources = true "+
+				"in the provider to adopt and manage it", name)
+		}
+		planContact.ContactId = types.Int64Value(tea.Int64Value(contact.ContactId))
+		if tea.StringValue(contact.Email) != planContact.Email.ValueString() ||
+			tea.StringValue(contact.Mobile) != planContact.Mobile.ValueString() ||
+			tea.StringValue(contact.Position) != planContact.Position.ValueString() {
+			if err := r.updateContact(planContact); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The message receivers to be deleted are removed from the subscription
+	// items first.
+	removedContactIds := map[int64]bool{}
+	for _, name := range convertStringsDifference(priorContactNames, planContactNames) {
+		if contactId, ok := contactIds[name]; ok {
+			removedContactIds[contactId] = true
+		}
+	}
+
+	// Configure the planned subscription items.
+	planItemNames := []string{}
+	for _, subscription := range plan.Subscriptions {
+		planItemNames = append(planItemNames, subscription.ItemName.ValueString())
+
+		item, err := r.getSubscriptionItem(subscription.ItemName.ValueString())
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("subscription item %s is not found", subscription.ItemName.ValueString())
+		}
+
+		itemContactIds := []int64{}
+		for _, name := range convertListValueToStrings(subscription.ContactNames) {
+			contactId, ok := contactIds[name]
+			if !ok {
+				return fmt.Errorf("message receiver %s of subscription item %s is not found",
+					name, subscription.ItemName.ValueString())
+			}
+			itemContactIds = append(itemContactIds, contactId)
+		}
+
+		channel := strings.Join(convertListValueToStrings(subscription.Channels), ",")
+		if err := r.updateSubscriptionItem(tea.Int64Value(item.ItemId), channel, itemContactIds); err != nil {
+			return err
+		}
+		subscription.ItemId = types.Int64Value(tea.Int64Value(item.ItemId))
+	}
+
+	// The subscription items which are no longer configured are left
+	// unchanged, except the message receivers to be deleted.
+	if len(removedContactIds) > 0 {
+		for _, subscription := range prior.Subscriptions {
+			if len(convertStringsDifference([]string{subscription.ItemName.ValueString()}, planItemNames)) == 0 {
+				continue
+			}
+
+			item, err := r.getSubscriptionItem(subscription.ItemName.ValueString())
+			if err != nil {
+				return err
+			}
+			if item == nil {
+				continue
+			}
+
+			itemContactIds := []int64{}
+			for _, contactId := range item.ContactIds {
+				if !removedContactIds[tea.Int64Value(contactId)] {
+					itemContactIds = append(itemContactIds, tea.Int64Value(contactId))
+				}
+			}
+			if len(itemContactIds) == len(item.ContactIds) {
+				continue
+			}
+			if err := r.updateSubscriptionItem(tea.Int64Value(item.ItemId), tea.StringValue(item.Channel), itemContactIds); err != nil {
+				return err
+			}
+		}
+	}
+
+	for contactId := range removedContactIds {
+		if err := r.deleteContact(contactId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *mscNotificationSettingsResource) listContacts() ([]*alicloudMscClient.ListContactsResponseBodyContacts, error) {
+	contacts := []*alicloudMscClient.ListContactsResponseBodyContacts{}
+	nextToken := ""
+	for {
+		var listContactsResponse *alicloudMscClient.ListContactsResponse
+		listContacts := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listContactsRequest := &alicloudMscClient.ListContactsRequest{
+				MaxResults: tea.Int32(100),
+			}
+			if nextToken != "" {
+				listContactsRequest.NextToken = tea.String(nextToken)
+			}
+
+			var err error
+			listContactsResponse, err = r.client.ListContactsWithOptions(listContactsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listContacts); err != nil {
+			return nil, err
+		}
+
+		contacts = append(contacts, listContactsResponse.Body.Contacts...)
+		nextToken = tea.StringValue(listContactsResponse.Body.NextToken)
+		if nextToken == "" {
+			return contacts, nil
+		}
+	}
+}
+
+func (r *mscNotificationSettingsResource) createContact(contact *mscNotificationContact) (int64, error) {
+	var contactId int64
+	createContact := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createContactRequest := &alicloudMscClient.CreateContactRequest{
+			ContactName: tea.String(contact.Name.ValueString()),
+			Email:       tea.String(contact.Email.ValueString()),
+			Mobile:      tea.String(contact.Mobile.ValueString()),
+			Position:    tea.String(contact.Position.ValueString()),
+		}
+
+		createContactResponse, err := r.client.CreateContactWithOptions(createContactRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		contactId = tea.Int64Value(createContactResponse.Body.ContactId)
+		return nil
+	}
+
+	err := retryAPICall(createContact)
+	return contactId, err
+}
+
+func (r *mscNotificationSettingsResource) updateContact(contact *mscNotificationContact) error {
+	updateContact := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateContactRequest := &alicloudMscClient.UpdateContactRequest{
+			ContactId:   tea.Int64(contact.ContactId.ValueInt64()),
+			ContactName: tea.String(contact.Name.ValueString()),
+			Email:       tea.String(contact.Email.ValueString()),
+			Mobile:      tea.String(contact.Mobile.ValueString()),
+			Position:    tea.String(contact.Position.ValueString()),
+		}
+
+		if _, err := r.client.UpdateContactWithOptions(updateContactRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateContact)
+}
+
+func (r *mscNotificationSettingsResource) deleteContact(contactId int64) error {
+	deleteContact := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteContactRequest := &alicloudMscClient.DeleteContactRequest{
+			ContactId: tea.Int64(contactId),
+		}
+
+		if _, err := r.client.DeleteContactWithOptions(deleteContactRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteContact)
+}
+
+// Function to find the subscription item by the name, returns nil if the
+// subscription item is not found.
+func (r *mscNotificationSettingsResource) getSubscriptionItem(itemName string) (*alicloudMscClient.ListSubscriptionItemsResponseBodySubscriptionItems, error) {
+	var item *alicloudMscClient.ListSubscriptionItemsResponseBodySubscriptionItems
+	listSubscriptionItems := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listSubscriptionItemsRequest := &alicloudMscClient.ListSubscriptionItemsRequest{
+			ItemName:   tea.String(itemName),
+			MaxResults: tea.Int32(100),
+		}
+
+		listSubscriptionItemsResponse, err := r.client.ListSubscriptionItemsWithOptions(listSubscriptionItemsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		// The items are matched by the keyword of the name.
+		item = nil
+		for _, subscriptionItem := range listSubscriptionItemsResponse.Body.SubscriptionItems {
+			if tea.StringValue(subscriptionItem.ItemName) == itemName {
+				item = subscriptionItem
+				break
+			}
+		}
+		return nil
+	}
+
+	err := retryAPICall(listSubscriptionItems)
+	return item, err
+}
+
+func (r *mscNotificationSettingsResource) updateSubscriptionItem(itemId int64, channel string, contactIds []int64) error {
+	contactIdsJson, err := json.Marshal(contactIds)
+	if err != nil {
+		return err
+	}
+
+	updateSubscriptionItem := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateSubscriptionItemRequest := &alicloudMscClient.UpdateSubscriptionItemRequest{
+			ItemId:     tea.Int64(itemId),
+			Channel:    tea.String(channel),
+			ContactIds: tea.String(string(contactIdsJson)),
+		}
+
+		if _, err := r.client.UpdateSubscriptionItemWithOptions(updateSubscriptionItemRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(updateSubscriptionItem)
+}
+
+// Function to build the list value in the same order as the previous list
+// value, the values which are not in the previous list are appended.
+func mscOrderedListValue(prev types.List, values []string) types.List {
+	prevValues := convertListValueToStrings(prev)
+	orderedValues := convertStringsDifference(prevValues, convertStringsDifference(prevValues, values))
+	orderedValues = append(orderedValues, convertStringsDifference(values, prevValues)...)
+	return types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(orderedValues)))
+}
@@ -0,0 +1,861 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+var (
+	_ resource.Resource                = &ossBucketResource{}
+	_ resource.ResourceWithConfigure   = &ossBucketResource{}
+	_ resource.ResourceWithImportState = &ossBucketResource{}
+)
+
+func NewOssBucketResource() resource.Resource {
+	return &ossBucketResource{}
+}
+
+type ossBucketResource struct {
+	client        *alicloudOssClient.Client
+	adoptExisting bool
+}
+
+type ossBucketModel struct {
+	Bucket               types.String                   `tfsdk:"bucket"`
+	StorageClass         types.String                   `tfsdk:"storage_class"`
+	RedundancyType       types.String                   `tfsdk:"redundancy_type"`
+	Acl                  types.String                   `tfsdk:"acl"`
+	Versioning           types.String                   `tfsdk:"versioning"`
+	TransferAcceleration types.Bool                     `tfsdk:"transfer_acceleration"`
+	Policy               types.String                   `tfsdk:"policy"`
+	ServerSideEncryption *ossBucketServerSideEncryption `tfsdk:"server_side_encryption"`
+	LifecycleRules       []*ossBucketLifecycleRule      `tfsdk:"lifecycle_rules"`
+	CreationDate         types.String                   `tfsdk:"creation_date"`
+	ExtranetEndpoint     types.String                   `tfsdk:"extranet_endpoint"`
+	IntranetEndpoint     types.String                   `tfsdk:"intranet_endpoint"`
+}
+
+type ossBucketServerSideEncryption struct {
+	SseAlgorithm      types.String `tfsdk:"sse_algorithm"`
+	KmsMasterKeyId    types.String `tfsdk:"kms_master_key_id"`
+	KmsDataEncryption types.String `tfsdk:"kms_data_encryption"`
+}
+
+type ossBucketLifecycleRule struct {
+	Id                              types.String                    `tfsdk:"id"`
+	Prefix                          types.String                    `tfsdk:"prefix"`
+	Enabled                         types.Bool                      `tfsdk:"enabled"`
+	ExpirationDays                  types.Int64                     `tfsdk:"expiration_days"`
+	ExpiredObjectDeleteMarker       types.Bool                      `tfsdk:"expired_object_delete_marker"`
+	AbortMultipartUploadDays        types.Int64                     `tfsdk:"abort_multipart_upload_days"`
+	NoncurrentVersionExpirationDays types.Int64                     `tfsdk:"noncurrent_version_expiration_days"`
+	Transitions                     []*ossBucketLifecycleTransition `tfsdk:"transitions"`
+	NoncurrentVersionTransitions    []*ossBucketLifecycleTransition `tfsdk:"noncurrent_version_transitions"`
+}
+
+type ossBucketLifecycleTransition struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+// Metadata returns the OSS Bucket resource name.
+func (r *ossBucketResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oss_bucket"
+}
+
+// Schema defines the schema for the OSS Bucket resource.
+func (r *ossBucketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	transitionBlock := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"days": schema.Int64Attribute{
+					Description: "The number of days after which the objects are transitioned.",
+					Required:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"storage_class": schema.StringAttribute{
+					Description: "The storage class to transition to. Valid values: `IA`, `Archive`, `ColdArchive`.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("IA", "Archive", "ColdArchive"),
+					},
+				},
+			},
+		},
+	}
+	transitions := transitionBlock
+	transitions.Description = "The transitions of the current versions of the objects to the other storage classes."
+	noncurrentVersionTransitions := transitionBlock
+	noncurrentVersionTransitions.Description = "The transitions of the previous versions of the objects to the other " +
+		"storage classes, the days are counted from the time the versions become previous."
+
+	resp.Schema = schema.Schema{
+		Description: "Manage an OSS bucket with its ACL, versioning, lifecycle rules, server-side encryption, " +
+			"transfer acceleration and bucket policy. Only the configured sections are read for drift detection, " +
+			"the sections which are not configured are left unmanaged.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the OSS bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_class": schema.StringAttribute{
+				Description: "The storage class of the bucket. Valid values: `Standard`, `IA`, `Archive`, " +
+					"`ColdArchive`. Default to `Standard`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Standard"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Standard", "IA", "Archive", "ColdArchive"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"redundancy_type": schema.StringAttribute{
+				Description: "The data redundancy type of the bucket. Valid values: `LRS`, `ZRS`. Default to `LRS`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("LRS"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("LRS", "ZRS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"acl": schema.StringAttribute{
+				Description: "The ACL of the bucket. Valid values: `private`, `public-read`, `public-read-write`. " +
+					"Default to `private`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("private"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("private", "public-read", "public-read-write"),
+				},
+			},
+			"versioning": schema.StringAttribute{
+				Description: "The versioning status of the bucket. Valid values: `Enabled`, `Suspended`. Versioning " +
+					"can not be disabled once it is enabled, removing this attribute leaves the status unchanged.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Suspended"),
+				},
+			},
+			"transfer_acceleration": schema.BoolAttribute{
+				Description: "Whether to enable the transfer acceleration of the bucket.",
+				Optional:    true,
+			},
+			"policy": schema.StringAttribute{
+				Description: "The bucket policy in JSON format. Removing this attribute deletes the bucket policy.",
+				Optional:    true,
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "The creation date of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extranet_endpoint": schema.StringAttribute{
+				Description: "The public endpoint of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"intranet_endpoint": schema.StringAttribute{
+				Description: "The internal endpoint of the bucket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"server_side_encryption": schema.SingleNestedBlock{
+				Description: "The default server-side encryption of the bucket. Removing this block deletes the " +
+					"encryption rule.",
+				Attributes: map[string]schema.Attribute{
+					"sse_algorithm": schema.StringAttribute{
+						Description: "The encryption algorithm. Valid values: `AES256`, `KMS`, `SM4`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("AES256", "KMS", "SM4"),
+						},
+					},
+					"kms_master_key_id": schema.StringAttribute{
+						Description: "The ID of the KMS key when the algorithm is `KMS`. Default to the OSS managed key.",
+						Optional:    true,
+					},
+					"kms_data_encryption": schema.StringAttribute{
+						Description: "The algorithm to encrypt the objects when the algorithm is `KMS`. Valid values: `SM4`. " +
+							"Default to AES256.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("SM4"),
+						},
+					},
+				},
+			},
+			"lifecycle_rules": schema.ListNestedBlock{
+				Description: "The lifecycle rules of the bucket. Removing all the rules deletes the lifecycle " +
+					"configuration.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the rule.",
+							Required:    true,
+						},
+						"prefix": schema.StringAttribute{
+							Description: "The prefix of the objects that the rule applies to. Default to all the objects.",
+							Optional:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the rule is enabled.",
+							Required:    true,
+						},
+						"expiration_days": schema.Int64Attribute{
+							Description: "The number of days after which the current versions of the objects expire.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"expired_object_delete_marker": schema.BoolAttribute{
+							Description: "Whether to remove the delete markers without any previous versions.",
+							Optional:    true,
+						},
+						"abort_multipart_upload_days": schema.Int64Attribute{
+							Description: "The number of days after which the incomplete multipart uploads are aborted.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							Description: "The number of days after which the previous versions of the objects expire.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"transitions":                    transitions,
+						"noncurrent_version_transitions": noncurrentVersionTransitions,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ossBucketResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ossClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the bucket and apply the configured sections.
+func (r *ossBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ossBucketModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := plan.Bucket.ValueString()
+	createBucket := func() error {
+		err := r.client.CreateBucket(bucket,
+			alicloudOssClient.ACL(alicloudOssClient.ACLType(plan.Acl.ValueString())),
+			alicloudOssClient.StorageClass(alicloudOssClient.StorageClassType(plan.StorageClass.ValueString())),
+			alicloudOssClient.RedundancyType(alicloudOssClient.DataRedundancyType(plan.RedundancyType.ValueString())),
+		)
+		if err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); ok && _t.Code == "BucketAlreadyExists" {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createBucket); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Bucket.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Compare with the empty sections, so that all the configured sections
+	// are applied, also to the adopted bucket.
+	prior := &ossBucketModel{
+		Acl:                  types.StringNull(),
+		Versioning:           types.StringNull(),
+		TransferAcceleration: types.BoolNull(),
+		Policy:               types.StringNull(),
+		LifecycleRules:       []*ossBucketLifecycleRule{},
+	}
+	if err := r.updateBucket(plan, prior); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Bucket.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.readBucketInfo(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Bucket Info.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the bucket and the configured sections. All the sections are read
+// when the bucket is imported.
+func (r *ossBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ossBucketModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := state.Bucket.ValueString()
+	importing := state.CreationDate.IsNull()
+
+	if err := r.readBucketInfo(state); err != nil {
+		if _t, ok := err.(alicloudOssClient.ServiceError); ok && _t.Code == "NoSuchBucket" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Bucket Info.",
+			err.Error(),
+		)
+		return
+	}
+
+	if importing || !state.TransferAcceleration.IsNull() {
+		var transferAccConfiguration alicloudOssClient.TransferAccConfiguration
+		getBucketTransferAcc := func() error {
+			var err error
+			transferAccConfiguration, err = r.client.GetBucketTransferAcc(bucket)
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getBucketTransferAcc); err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); !ok || _t.Code != "NoSuchTransferAccelerationConfiguration" {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Get Bucket Transfer Acceleration.",
+					err.Error(),
+				)
+				return
+			}
+		}
+		state.TransferAcceleration = types.BoolValue(transferAccConfiguration.Enabled)
+	}
+
+	if importing || !state.Policy.IsNull() {
+		var policy string
+		getBucketPolicy := func() error {
+			var err error
+			policy, err = r.client.GetBucketPolicy(bucket)
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getBucketPolicy); err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); !ok || _t.Code != "NoSuchBucketPolicy" {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Get Bucket Policy.",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		// Keep the policy in state if only the formatting is different.
+		if policy == "" {
+			state.Policy = types.StringNull()
+		} else if state.Policy.IsNull() || !isJsonStringEqual(state.Policy.ValueString(), policy) {
+			state.Policy = types.StringValue(policy)
+		}
+	}
+
+	if importing || state.ServerSideEncryption != nil {
+		var getBucketEncryptionResult alicloudOssClient.GetBucketEncryptionResult
+		getBucketEncryption := func() error {
+			var err error
+			getBucketEncryptionResult, err = r.client.GetBucketEncryption(bucket)
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getBucketEncryption); err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); !ok || _t.Code != "NoSuchServerSideEncryptionRule" {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Get Bucket Encryption.",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		rule := getBucketEncryptionResult.SSEDefault
+		if rule.SSEAlgorithm == "" {
+			state.ServerSideEncryption = nil
+		} else {
+			prev := state.ServerSideEncryption
+			if prev == nil {
+				prev = &ossBucketServerSideEncryption{
+					KmsMasterKeyId:    types.StringNull(),
+					KmsDataEncryption: types.StringNull(),
+				}
+			}
+			state.ServerSideEncryption = &ossBucketServerSideEncryption{
+				SseAlgorithm:      types.StringValue(rule.SSEAlgorithm),
+				KmsMasterKeyId:    ossStringValue(prev.KmsMasterKeyId, rule.KMSMasterKeyID),
+				KmsDataEncryption: ossStringValue(prev.KmsDataEncryption, rule.KMSDataEncryption),
+			}
+		}
+	}
+
+	if importing || len(state.LifecycleRules) > 0 {
+		var getBucketLifecycleResult alicloudOssClient.GetBucketLifecycleResult
+		getBucketLifecycle := func() error {
+			var err error
+			getBucketLifecycleResult, err = r.client.GetBucketLifecycle(bucket)
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getBucketLifecycle); err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); !ok || _t.Code != "NoSuchLifecycle" {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Get Bucket Lifecycle.",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		stateRules := make(map[string]*ossBucketLifecycleRule)
+		for _, rule := range state.LifecycleRules {
+			stateRules[rule.Id.ValueString()] = rule
+		}
+		lifecycleRules := []*ossBucketLifecycleRule{}
+		for _, rule := range getBucketLifecycleResult.Rules {
+			lifecycleRules = append(lifecycleRules, convertOssBucketLifecycleRule(rule, stateRules[rule.ID]))
+		}
+		state.LifecycleRules = lifecycleRules
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the changed sections of the bucket.
+func (r *ossBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ossBucketModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateBucket(plan, state); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Bucket.",
+			err.Error(),
+		)
+		return
+	}
+	plan.CreationDate = state.CreationDate
+	plan.ExtranetEndpoint = state.ExtranetEndpoint
+	plan.IntranetEndpoint = state.IntranetEndpoint
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the bucket, the bucket must be empty.
+func (r *ossBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ossBucketModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteBucket := func() error {
+		if err := r.client.DeleteBucket(state.Bucket.ValueString()); err != nil {
+			if _t, ok := err.(alicloudOssClient.ServiceError); ok && _t.Code == "NoSuchBucket" {
+				return nil
+			}
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(deleteBucket)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Bucket.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ossBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+// Function to read the bucket info into the model, including the ACL and the
+// versioning status.
+func (r *ossBucketResource) readBucketInfo(model *ossBucketModel) error {
+	var getBucketInfoResult alicloudOssClient.GetBucketInfoResult
+	getBucketInfo := func() error {
+		var err error
+		getBucketInfoResult, err = r.client.GetBucketInfo(model.Bucket.ValueString())
+		if err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getBucketInfo); err != nil {
+		return err
+	}
+
+	// The versioning status is only read when it is configured or the bucket
+	// is imported, it is empty if versioning has never been enabled.
+	bucketInfo := getBucketInfoResult.BucketInfo
+	if model.CreationDate.IsNull() || !model.Versioning.IsNull() {
+		model.Versioning = ossStringValue(model.Versioning, bucketInfo.Versioning)
+	}
+	model.StorageClass = types.StringValue(bucketInfo.StorageClass)
+	model.RedundancyType = types.StringValue(bucketInfo.RedundancyType)
+	model.Acl = types.StringValue(bucketInfo.ACL)
+	model.CreationDate = types.StringValue(bucketInfo.CreationDate.Format(time.RFC3339))
+	model.ExtranetEndpoint = types.StringValue(bucketInfo.ExtranetEndpoint)
+	model.IntranetEndpoint = types.StringValue(bucketInfo.IntranetEndpoint)
+	return nil
+}
+
+// Function to apply the sections which are changed from the prior model to
+// the planned model.
+func (r *ossBucketResource) updateBucket(plan, prior *ossBucketModel) error {
+	bucket := plan.Bucket.ValueString()
+	retry := func(operation backoff.Operation) error {
+		return retryAPICall(operation)
+	}
+
+	if !plan.Acl.Equal(prior.Acl) {
+		setBucketACL := func() error {
+			if err := r.client.SetBucketACL(bucket, alicloudOssClient.ACLType(plan.Acl.ValueString())); err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(setBucketACL); err != nil {
+			return fmt.Errorf("failed to set bucket ACL: %w", err)
+		}
+	}
+
+	// Versioning can not be disabled, it is left unchanged when the attribute
+	// is removed.
+	if !plan.Versioning.IsNull() && !plan.Versioning.Equal(prior.Versioning) {
+		setBucketVersioning := func() error {
+			versioningConfig := alicloudOssClient.VersioningConfig{
+				Status: plan.Versioning.ValueString(),
+			}
+			if err := r.client.SetBucketVersioning(bucket, versioningConfig); err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(setBucketVersioning); err != nil {
+			return fmt.Errorf("failed to set bucket versioning: %w", err)
+		}
+	}
+
+	if !plan.TransferAcceleration.Equal(prior.TransferAcceleration) &&
+		(!plan.TransferAcceleration.IsNull() || prior.TransferAcceleration.ValueBool()) {
+		setBucketTransferAcc := func() error {
+			transferAccConfiguration := alicloudOssClient.TransferAccConfiguration{
+				Enabled: plan.TransferAcceleration.ValueBool(),
+			}
+			if err := r.client.SetBucketTransferAcc(bucket, transferAccConfiguration); err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(setBucketTransferAcc); err != nil {
+			return fmt.Errorf("failed to set bucket transfer acceleration: %w", err)
+		}
+	}
+
+	if !plan.Policy.Equal(prior.Policy) {
+		updateBucketPolicy := func() error {
+			var err error
+			if plan.Policy.IsNull() {
+				err = r.client.DeleteBucketPolicy(bucket)
+			} else {
+				err = r.client.SetBucketPolicy(bucket, plan.Policy.ValueString())
+			}
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(updateBucketPolicy); err != nil {
+			return fmt.Errorf("failed to update bucket policy: %w", err)
+		}
+	}
+
+	if !ossBucketServerSideEncryptionEqual(plan.ServerSideEncryption, prior.ServerSideEncryption) {
+		updateBucketEncryption := func() error {
+			var err error
+			if plan.ServerSideEncryption == nil {
+				err = r.client.DeleteBucketEncryption(bucket)
+			} else {
+				serverEncryptionRule := alicloudOssClient.ServerEncryptionRule{
+					SSEDefault: alicloudOssClient.SSEDefaultRule{
+						SSEAlgorithm:      plan.ServerSideEncryption.SseAlgorithm.ValueString(),
+						KMSMasterKeyID:    plan.ServerSideEncryption.KmsMasterKeyId.ValueString(),
+						KMSDataEncryption: plan.ServerSideEncryption.KmsDataEncryption.ValueString(),
+					},
+				}
+				err = r.client.SetBucketEncryption(bucket, serverEncryptionRule)
+			}
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(updateBucketEncryption); err != nil {
+			return fmt.Errorf("failed to update bucket encryption: %w", err)
+		}
+	}
+
+	if !ossBucketLifecycleRulesEqual(plan.LifecycleRules, prior.LifecycleRules) {
+		updateBucketLifecycle := func() error {
+			var err error
+			if len(plan.LifecycleRules) == 0 {
+				err = r.client.DeleteBucketLifecycle(bucket)
+			} else {
+				var rules []alicloudOssClient.LifecycleRule
+				for _, rule := range plan.LifecycleRules {
+					rules = append(rules, buildOssBucketLifecycleRule(rule))
+				}
+				err = r.client.SetBucketLifecycle(bucket, rules)
+			}
+			if err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+		if err := retry(updateBucketLifecycle); err != nil {
+			return fmt.Errorf("failed to update bucket lifecycle: %w", err)
+		}
+	}
+	return nil
+}
+
+// Function to build the lifecycle rule of OSS SDK from the model.
+func buildOssBucketLifecycleRule(rule *ossBucketLifecycleRule) alicloudOssClient.LifecycleRule {
+	lifecycleRule := alicloudOssClient.LifecycleRule{
+		ID:     rule.Id.ValueString(),
+		Prefix: rule.Prefix.ValueString(),
+		Status: "Disabled",
+	}
+	if rule.Enabled.ValueBool() {
+		lifecycleRule.Status = "Enabled"
+	}
+	if !rule.ExpirationDays.IsNull() || !rule.ExpiredObjectDeleteMarker.IsNull() {
+		lifecycleRule.Expiration = &alicloudOssClient.LifecycleExpiration{
+			Days: int(rule.ExpirationDays.ValueInt64()),
+		}
+		if !rule.ExpiredObjectDeleteMarker.IsNull() {
+			lifecycleRule.Expiration.ExpiredObjectDeleteMarker = rule.ExpiredObjectDeleteMarker.ValueBoolPointer()
+		}
+	}
+	if !rule.AbortMultipartUploadDays.IsNull() {
+		lifecycleRule.AbortMultipartUpload = &alicloudOssClient.LifecycleAbortMultipartUpload{
+			Days: int(rule.AbortMultipartUploadDays.ValueInt64()),
+		}
+	}
+	if !rule.NoncurrentVersionExpirationDays.IsNull() {
+		lifecycleRule.NonVersionExpiration = &alicloudOssClient.LifecycleVersionExpiration{
+			NoncurrentDays: int(rule.NoncurrentVersionExpirationDays.ValueInt64()),
+		}
+	}
+	for _, transition := range rule.Transitions {
+		lifecycleRule.Transitions = append(lifecycleRule.Transitions, alicloudOssClient.LifecycleTransition{
+			Days:         int(transition.Days.ValueInt64()),
+			StorageClass: alicloudOssClient.StorageClassType(transition.StorageClass.ValueString()),
+		})
+	}
+	for _, transition := range rule.NoncurrentVersionTransitions {
+		lifecycleRule.NonVersionTransitions = append(lifecycleRule.NonVersionTransitions, alicloudOssClient.LifecycleVersionTransition{
+			NoncurrentDays: int(transition.Days.ValueInt64()),
+			StorageClass:   alicloudOssClient.StorageClassType(transition.StorageClass.ValueString()),
+		})
+	}
+	return lifecycleRule
+}
+
+// Function to convert the lifecycle rule returned by OSS API, the optional
+// attributes which are not configured in the previous rule are kept null.
+func convertOssBucketLifecycleRule(rule alicloudOssClient.LifecycleRule, prev *ossBucketLifecycleRule) *ossBucketLifecycleRule {
+	if prev == nil {
+		prev = &ossBucketLifecycleRule{
+			Prefix:                    types.StringNull(),
+			ExpiredObjectDeleteMarker: types.BoolNull(),
+		}
+	}
+
+	lifecycleRule := &ossBucketLifecycleRule{
+		Id:                              types.StringValue(rule.ID),
+		Prefix:                          ossStringValue(prev.Prefix, rule.Prefix),
+		Enabled:                         types.BoolValue(rule.Status == "Enabled"),
+		ExpirationDays:                  types.Int64Null(),
+		ExpiredObjectDeleteMarker:       types.BoolNull(),
+		AbortMultipartUploadDays:        types.Int64Null(),
+		NoncurrentVersionExpirationDays: types.Int64Null(),
+		Transitions:                     []*ossBucketLifecycleTransition{},
+		NoncurrentVersionTransitions:    []*ossBucketLifecycleTransition{},
+	}
+	if rule.Expiration != nil {
+		if rule.Expiration.Days != 0 {
+			lifecycleRule.ExpirationDays = types.Int64Value(int64(rule.Expiration.Days))
+		}
+		if rule.Expiration.ExpiredObjectDeleteMarker != nil && (!prev.ExpiredObjectDeleteMarker.IsNull() || *rule.Expiration.ExpiredObjectDeleteMarker) {
+			lifecycleRule.ExpiredObjectDeleteMarker = types.BoolValue(*rule.Expiration.ExpiredObjectDeleteMarker)
+		}
+	}
+	if rule.AbortMultipartUpload != nil && rule.AbortMultipartUpload.Days != 0 {
+		lifecycleRule.AbortMultipartUploadDays = types.Int64Value(int64(rule.AbortMultipartUpload.Days))
+	}
+	if rule.NonVersionExpiration != nil && rule.NonVersionExpiration.NoncurrentDays != 0 {
+		lifecycleRule.NoncurrentVersionExpirationDays = types.Int64Value(int64(rule.NonVersionExpiration.NoncurrentDays))
+	}
+	for _, transition := range rule.Transitions {
+		lifecycleRule.Transitions = append(lifecycleRule.Transitions, &ossBucketLifecycleTransition{
+			Days:         types.Int64Value(int64(transition.Days)),
+			StorageClass: types.StringValue(string(transition.StorageClass)),
+		})
+	}
+	for _, transition := range rule.NonVersionTransitions {
+		lifecycleRule.NoncurrentVersionTransitions = append(lifecycleRule.NoncurrentVersionTransitions, &ossBucketLifecycleTransition{
+			Days:         types.Int64Value(int64(transition.NoncurrentDays)),
+			StorageClass: types.StringValue(string(transition.StorageClass)),
+		})
+	}
+	return lifecycleRule
+}
+
+func ossBucketServerSideEncryptionEqual(a, b *ossBucketServerSideEncryption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.SseAlgorithm.Equal(b.SseAlgorithm) &&
+		a.KmsMasterKeyId.Equal(b.KmsMasterKeyId) &&
+		a.KmsDataEncryption.Equal(b.KmsDataEncryption)
+}
+
+func ossBucketLifecycleRulesEqual(a, b []*ossBucketLifecycleRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Id.Equal(b[i].Id) ||
+			!a[i].Prefix.Equal(b[i].Prefix) ||
+			!a[i].Enabled.Equal(b[i].Enabled) ||
+			!a[i].ExpirationDays.Equal(b[i].ExpirationDays) ||
+			!a[i].ExpiredObjectDeleteMarker.Equal(b[i].ExpiredObjectDeleteMarker) ||
+			!a[i].AbortMultipartUploadDays.Equal(b[i].AbortMultipartUploadDays) ||
+			!a[i].NoncurrentVersionExpirationDays.Equal(b[i].NoncurrentVersionExpirationDays) ||
+			!ossBucketLifecycleTransitionsEqual(a[i].Transitions, b[i].Transitions) ||
+			!ossBucketLifecycleTransitionsEqual(a[i].NoncurrentVersionTransitions, b[i].NoncurrentVersionTransitions) {
+			return false
+		}
+	}
+	return true
+}
+
+func ossBucketLifecycleTransitionsEqual(a, b []*ossBucketLifecycleTransition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Days.Equal(b[i].Days) || !a[i].StorageClass.Equal(b[i].StorageClass) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keep the optional value null if it is not configured and OSS API returns
+// an empty value.
+func ossStringValue(prev types.String, value string) types.String {
+	if prev.IsNull() && value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,219 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+var (
+	_ resource.Resource                = &ossBucketRefererResource{}
+	_ resource.ResourceWithConfigure   = &ossBucketRefererResource{}
+	_ resource.ResourceWithImportState = &ossBucketRefererResource{}
+)
+
+func NewOssBucketRefererResource() resource.Resource {
+	return &ossBucketRefererResource{}
+}
+
+type ossBucketRefererResource struct {
+	client *alicloudOssClient.Client
+}
+
+type ossBucketRefererModel struct {
+	Bucket            types.String `tfsdk:"bucket"`
+	AllowEmptyReferer types.Bool   `tfsdk:"allow_empty_referer"`
+	RefererList       types.List   `tfsdk:"referer_list"`
+}
+
+// Metadata returns the OSS Bucket Referer resource name.
+func (r *ossBucketRefererResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oss_bucket_referer"
+}
+
+// Schema defines the schema for the OSS Bucket Referer resource.
+func (r *ossBucketRefererResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure the referer whitelist (hotlink protection) of an OSS bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the OSS bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_empty_referer": schema.BoolAttribute{
+				Description: "Whether to allow the requests with empty referer. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"referer_list": schema.ListAttribute{
+				Description: "The referer whitelist of the bucket. Wildcards `*` and `?` are supported, e.g. `https://*.example.com`.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ossBucketRefererResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ossClient
+}
+
+// Set the referer whitelist of the bucket.
+func (r *ossBucketRefererResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ossBucketRefererModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var refererList []string
+	resp.Diagnostics.Append(plan.RefererList.ElementsAs(ctx, &refererList, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setBucketReferer(plan.Bucket.ValueString(), refererList, plan.AllowEmptyReferer.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Bucket Referer.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the referer whitelist of the bucket.
+func (r *ossBucketRefererResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ossBucketRefererModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var getBucketRefererResult alicloudOssClient.GetBucketRefererResult
+	getBucketReferer := func() error {
+		var err error
+		getBucketRefererResult, err = r.client.GetBucketReferer(state.Bucket.ValueString())
+		if err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(getBucketReferer)
+	if err != nil {
+		if _t, ok := err.(alicloudOssClient.ServiceError); ok && _t.Code == "NoSuchBucket" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Bucket Referer.",
+			err.Error(),
+		)
+		return
+	}
+
+	if getBucketRefererResult.RefererList == nil {
+		getBucketRefererResult.RefererList = []string{}
+	}
+	refererList, diags := types.ListValueFrom(ctx, types.StringType, getBucketRefererResult.RefererList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.RefererList = refererList
+	state.AllowEmptyReferer = types.BoolValue(getBucketRefererResult.AllowEmptyReferer)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the referer whitelist of the bucket.
+func (r *ossBucketRefererResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ossBucketRefererModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var refererList []string
+	resp.Diagnostics.Append(plan.RefererList.ElementsAs(ctx, &refererList, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setBucketReferer(plan.Bucket.ValueString(), refererList, plan.AllowEmptyReferer.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Bucket Referer.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete clears the referer whitelist and allows empty referer, which is the
+// default referer configuration of a bucket.
+func (r *ossBucketRefererResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ossBucketRefererModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setBucketReferer(state.Bucket.ValueString(), []string{}, true); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Reset Bucket Referer.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ossBucketRefererResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *ossBucketRefererResource) setBucketReferer(bucket string, refererList []string, allowEmptyReferer bool) error {
+	setBucketReferer := func() error {
+		if err := r.client.SetBucketReferer(bucket, refererList, allowEmptyReferer); err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setBucketReferer)
+}
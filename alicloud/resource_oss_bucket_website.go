@@ -0,0 +1,350 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+var (
+	_ resource.Resource                = &ossBucketWebsiteResource{}
+	_ resource.ResourceWithConfigure   = &ossBucketWebsiteResource{}
+	_ resource.ResourceWithImportState = &ossBucketWebsiteResource{}
+)
+
+func NewOssBucketWebsiteResource() resource.Resource {
+	return &ossBucketWebsiteResource{}
+}
+
+type ossBucketWebsiteResource struct {
+	client *alicloudOssClient.Client
+}
+
+type ossBucketWebsiteModel struct {
+	Bucket        types.String                   `tfsdk:"bucket"`
+	IndexDocument types.String                   `tfsdk:"index_document"`
+	ErrorDocument types.String                   `tfsdk:"error_document"`
+	RoutingRules  []*ossBucketWebsiteRoutingRule `tfsdk:"routing_rules"`
+}
+
+type ossBucketWebsiteRoutingRule struct {
+	RuleNumber                  types.Int64  `tfsdk:"rule_number"`
+	KeyPrefixEquals             types.String `tfsdk:"key_prefix_equals"`
+	HttpErrorCodeReturnedEquals types.Int64  `tfsdk:"http_error_code_returned_equals"`
+	RedirectType                types.String `tfsdk:"redirect_type"`
+	Protocol                    types.String `tfsdk:"protocol"`
+	HostName                    types.String `tfsdk:"host_name"`
+	HttpRedirectCode            types.Int64  `tfsdk:"http_redirect_code"`
+	ReplaceKeyPrefixWith        types.String `tfsdk:"replace_key_prefix_with"`
+	ReplaceKeyWith              types.String `tfsdk:"replace_key_with"`
+	PassQueryString             types.Bool   `tfsdk:"pass_query_string"`
+}
+
+// Metadata returns the OSS Bucket Website resource name.
+func (r *ossBucketWebsiteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oss_bucket_website"
+}
+
+// Schema defines the schema for the OSS Bucket Website resource.
+func (r *ossBucketWebsiteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure the static website hosting of an OSS bucket, including the index document, error document and routing rules.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the OSS bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_document": schema.StringAttribute{
+				Description: "The default index document of the static website, e.g. index.html.",
+				Required:    true,
+			},
+			"error_document": schema.StringAttribute{
+				Description: "The error document returned when the requested object does not exist, e.g. error.html.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"routing_rules": schema.ListNestedBlock{
+				Description: "The routing rules of the static website. Rules are matched by rule number in ascending order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_number": schema.Int64Attribute{
+							Description: "The sequence number of the routing rule.",
+							Required:    true,
+						},
+						"key_prefix_equals": schema.StringAttribute{
+							Description: "Matches the objects with the specified prefix.",
+							Optional:    true,
+						},
+						"http_error_code_returned_equals": schema.Int64Attribute{
+							Description: "Matches the requests that return the specified HTTP status code, e.g. 404.",
+							Optional:    true,
+						},
+						"redirect_type": schema.StringAttribute{
+							Description: "The redirect type. Valid values: `External`, `Internal`, `AliCDN`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("External", "Internal", "AliCDN"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The protocol used for redirection. Valid values: `http`, `https`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("http", "https"),
+							},
+						},
+						"host_name": schema.StringAttribute{
+							Description: "The domain name used for redirection.",
+							Optional:    true,
+						},
+						"http_redirect_code": schema.Int64Attribute{
+							Description: "The HTTP status code returned for redirection, e.g. 301 or 302.",
+							Optional:    true,
+						},
+						"replace_key_prefix_with": schema.StringAttribute{
+							Description: "The prefix that replaces `key_prefix_equals` in the object name.",
+							Optional:    true,
+						},
+						"replace_key_with": schema.StringAttribute{
+							Description: "The object name that replaces the requested object name.",
+							Optional:    true,
+						},
+						"pass_query_string": schema.BoolAttribute{
+							Description: "Whether to pass the query string of the request to the redirect target.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ossBucketWebsiteResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ossClient
+}
+
+// Enable the static website hosting of the bucket.
+func (r *ossBucketWebsiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ossBucketWebsiteModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setBucketWebsite(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Bucket Website.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the static website configuration of the bucket.
+func (r *ossBucketWebsiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ossBucketWebsiteModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var getBucketWebsiteResult alicloudOssClient.GetBucketWebsiteResult
+	getBucketWebsite := func() error {
+		var err error
+		getBucketWebsiteResult, err = r.client.GetBucketWebsite(state.Bucket.ValueString())
+		if err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(getBucketWebsite)
+	if err != nil {
+		if _t, ok := err.(alicloudOssClient.ServiceError); ok && (_t.Code == "NoSuchWebsiteConfiguration" || _t.Code == "NoSuchBucket") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Bucket Website.",
+			err.Error(),
+		)
+		return
+	}
+
+	state.IndexDocument = types.StringValue(getBucketWebsiteResult.IndexDocument.Suffix)
+	if getBucketWebsiteResult.ErrorDocument.Key != "" {
+		state.ErrorDocument = types.StringValue(getBucketWebsiteResult.ErrorDocument.Key)
+	} else {
+		state.ErrorDocument = types.StringNull()
+	}
+
+	routingRules := []*ossBucketWebsiteRoutingRule{}
+	for _, rule := range getBucketWebsiteResult.RoutingRules {
+		routingRule := &ossBucketWebsiteRoutingRule{
+			RuleNumber:                  types.Int64Value(int64(rule.RuleNumber)),
+			KeyPrefixEquals:             types.StringNull(),
+			HttpErrorCodeReturnedEquals: types.Int64Null(),
+			RedirectType:                types.StringValue(rule.Redirect.RedirectType),
+			Protocol:                    types.StringNull(),
+			HostName:                    types.StringNull(),
+			HttpRedirectCode:            types.Int64Null(),
+			ReplaceKeyPrefixWith:        types.StringNull(),
+			ReplaceKeyWith:              types.StringNull(),
+			PassQueryString:             types.BoolNull(),
+		}
+		if rule.Condition.KeyPrefixEquals != "" {
+			routingRule.KeyPrefixEquals = types.StringValue(rule.Condition.KeyPrefixEquals)
+		}
+		if rule.Condition.HTTPErrorCodeReturnedEquals != 0 {
+			routingRule.HttpErrorCodeReturnedEquals = types.Int64Value(int64(rule.Condition.HTTPErrorCodeReturnedEquals))
+		}
+		if rule.Redirect.Protocol != "" {
+			routingRule.Protocol = types.StringValue(rule.Redirect.Protocol)
+		}
+		if rule.Redirect.HostName != "" {
+			routingRule.HostName = types.StringValue(rule.Redirect.HostName)
+		}
+		if rule.Redirect.HttpRedirectCode != 0 {
+			routingRule.HttpRedirectCode = types.Int64Value(int64(rule.Redirect.HttpRedirectCode))
+		}
+		if rule.Redirect.ReplaceKeyPrefixWith != "" {
+			routingRule.ReplaceKeyPrefixWith = types.StringValue(rule.Redirect.ReplaceKeyPrefixWith)
+		}
+		if rule.Redirect.ReplaceKeyWith != "" {
+			routingRule.ReplaceKeyWith = types.StringValue(rule.Redirect.ReplaceKeyWith)
+		}
+		if rule.Redirect.PassQueryString != nil {
+			routingRule.PassQueryString = types.BoolValue(*rule.Redirect.PassQueryString)
+		}
+		routingRules = append(routingRules, routingRule)
+	}
+	state.RoutingRules = routingRules
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the static website configuration of the bucket.
+func (r *ossBucketWebsiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ossBucketWebsiteModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setBucketWebsite(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Set Bucket Website.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the static website hosting of the bucket.
+func (r *ossBucketWebsiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ossBucketWebsiteModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteBucketWebsite := func() error {
+		if err := r.client.DeleteBucketWebsite(state.Bucket.ValueString()); err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(deleteBucketWebsite)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Bucket Website.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ossBucketWebsiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *ossBucketWebsiteResource) setBucketWebsite(model *ossBucketWebsiteModel) error {
+	websiteXML := alicloudOssClient.WebsiteXML{
+		IndexDocument: alicloudOssClient.IndexDocument{
+			Suffix: model.IndexDocument.ValueString(),
+		},
+		ErrorDocument: alicloudOssClient.ErrorDocument{
+			Key: model.ErrorDocument.ValueString(),
+		},
+	}
+
+	for _, rule := range model.RoutingRules {
+		routingRule := alicloudOssClient.RoutingRule{
+			RuleNumber: int(rule.RuleNumber.ValueInt64()),
+			Condition: alicloudOssClient.Condition{
+				KeyPrefixEquals:             rule.KeyPrefixEquals.ValueString(),
+				HTTPErrorCodeReturnedEquals: int(rule.HttpErrorCodeReturnedEquals.ValueInt64()),
+			},
+			Redirect: alicloudOssClient.Redirect{
+				RedirectType:         rule.RedirectType.ValueString(),
+				Protocol:             rule.Protocol.ValueString(),
+				HostName:             rule.HostName.ValueString(),
+				HttpRedirectCode:     int(rule.HttpRedirectCode.ValueInt64()),
+				ReplaceKeyPrefixWith: rule.ReplaceKeyPrefixWith.ValueString(),
+				ReplaceKeyWith:       rule.ReplaceKeyWith.ValueString(),
+			},
+		}
+		if !rule.PassQueryString.IsNull() {
+			routingRule.Redirect.PassQueryString = rule.PassQueryString.ValueBoolPointer()
+		}
+		websiteXML.RoutingRules = append(websiteXML.RoutingRules, routingRule)
+	}
+
+	setBucketWebsite := func() error {
+		if err := r.client.SetBucketWebsiteDetail(model.Bucket.ValueString(), websiteXML); err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setBucketWebsite)
+}
@@ -0,0 +1,479 @@
+package alicloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOssClient "github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+var (
+	_ resource.Resource                     = &ossObjectResource{}
+	_ resource.ResourceWithConfigure        = &ossObjectResource{}
+	_ resource.ResourceWithConfigValidators = &ossObjectResource{}
+	_ resource.ResourceWithImportState      = &ossObjectResource{}
+	_ resource.ResourceWithModifyPlan       = &ossObjectResource{}
+)
+
+func NewOssObjectResource() resource.Resource {
+	return &ossObjectResource{}
+}
+
+type ossObjectResource struct {
+	client *alicloudOssClient.Client
+}
+
+type ossObjectModel struct {
+	Bucket               types.String `tfsdk:"bucket"`
+	Key                  types.String `tfsdk:"key"`
+	Content              types.String `tfsdk:"content"`
+	Source               types.String `tfsdk:"source"`
+	ContentType          types.String `tfsdk:"content_type"`
+	Acl                  types.String `tfsdk:"acl"`
+	ServerSideEncryption types.String `tfsdk:"server_side_encryption"`
+	KmsKeyId             types.String `tfsdk:"kms_key_id"`
+	Etag                 types.String `tfsdk:"etag"`
+}
+
+// Metadata returns the OSS Object resource name.
+func (r *ossObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oss_object"
+}
+
+// Schema defines the schema for the OSS Object resource.
+func (r *ossObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upload a small object to an OSS bucket from the inline content or a local file, e.g. the " +
+			"bootstrap scripts and the static config files. The object is uploaded again when its ETag is " +
+			"changed outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "The name of the OSS bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The name of the object, e.g. scripts/bootstrap.sh.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the object. Conflicts with `source`.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the local file to upload. Conflicts with `content`.",
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The MIME type of the object, e.g. text/plain. Default to the type detected by the " +
+					"extension of the key.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acl": schema.StringAttribute{
+				Description: "The ACL of the object. Valid values: `default`, `private`, `public-read`, " +
+					"`public-read-write`. Default to `default`, which inherits the ACL of the bucket.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("default"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "private", "public-read", "public-read-write"),
+				},
+			},
+			"server_side_encryption": schema.StringAttribute{
+				Description: "The server-side encryption algorithm of the object. Valid values: `AES256`, `KMS`, " +
+					"`SM4`. Default to the encryption rule of the bucket.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AES256", "KMS", "SM4"),
+				},
+			},
+			"kms_key_id": schema.StringAttribute{
+				Description: "The ID of the KMS key when `server_side_encryption` is `KMS`. Default to the OSS " +
+					"managed key.",
+				Optional: true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "The ETag of the object, which is the uppercase MD5 of the content.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ossObjectResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("content"),
+			path.MatchRoot("source"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ossObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).ossClient
+}
+
+// Upload the object.
+func (r *ossObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ossObjectModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putObject(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Object.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the metadata and the ACL of the object.
+func (r *ossObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ossObjectModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.Bucket(state.Bucket.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to Get Bucket.",
+			err.Error(),
+		)
+		return
+	}
+
+	var getObjectDetailedMetaResult map[string][]string
+	getObjectDetailedMeta := func() error {
+		header, err := bucket.GetObjectDetailedMeta(state.Key.ValueString())
+		if err != nil {
+			return handleOssAPIError(err)
+		}
+		getObjectDetailedMetaResult = header
+		return nil
+	}
+
+	if err := retryAPICall(getObjectDetailedMeta); err != nil {
+		if _t, ok := err.(alicloudOssClient.ServiceError); ok && (_t.StatusCode == 404 || _t.Code == "NoSuchBucket") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Object Detailed Meta.",
+			err.Error(),
+		)
+		return
+	}
+
+	var getObjectACLResult alicloudOssClient.GetObjectACLResult
+	getObjectACL := func() error {
+		var err error
+		getObjectACLResult, err = bucket.GetObjectACL(state.Key.ValueString())
+		if err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(getObjectACL); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Object ACL.",
+			err.Error(),
+		)
+		return
+	}
+
+	header := func(key string) string {
+		if values := getObjectDetailedMetaResult[key]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	state.Etag = types.StringValue(strings.Trim(header(alicloudOssClient.HTTPHeaderEtag), "\""))
+	state.ContentType = types.StringValue(header(alicloudOssClient.HTTPHeaderContentType))
+	state.ServerSideEncryption = ossStringValue(state.ServerSideEncryption, header(alicloudOssClient.HTTPHeaderOssServerSideEncryption))
+	state.KmsKeyId = ossStringValue(state.KmsKeyId, header(alicloudOssClient.HTTPHeaderOssServerSideEncryptionKeyID))
+	state.Acl = types.StringValue(getObjectACLResult.ACL)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Upload the object again, or only update the ACL if nothing else is changed.
+func (r *ossObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ossObjectModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Etag.Equal(state.Etag) &&
+		plan.Content.Equal(state.Content) &&
+		plan.Source.Equal(state.Source) &&
+		plan.ContentType.Equal(state.ContentType) &&
+		plan.ServerSideEncryption.Equal(state.ServerSideEncryption) &&
+		plan.KmsKeyId.Equal(state.KmsKeyId) {
+		bucket, err := r.client.Bucket(plan.Bucket.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[ERROR] Failed to Get Bucket.",
+				err.Error(),
+			)
+			return
+		}
+
+		setObjectACL := func() error {
+			if err := bucket.SetObjectACL(plan.Key.ValueString(), alicloudOssClient.ACLType(plan.Acl.ValueString())); err != nil {
+				return handleOssAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(setObjectACL); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Set Object ACL.",
+				err.Error(),
+			)
+			return
+		}
+	} else if err := r.putObject(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Put Object.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the object.
+func (r *ossObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ossObjectModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.Bucket(state.Bucket.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to Get Bucket.",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteObject := func() error {
+		if err := bucket.DeleteObject(state.Key.ValueString()); err != nil {
+			return handleOssAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteObject); err != nil {
+		if _t, ok := err.(alicloudOssClient.ServiceError); ok && _t.Code == "NoSuchBucket" {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Object.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ossObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The bucket name does not contain colons, the rest is the object key.
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <bucket>:<key>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+}
+
+// ModifyPlan computes the ETag of the content or the source file, so that the
+// object is uploaded again when the source file or the uploaded object is
+// changed.
+func (r *ossObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *ossObjectModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Content.IsUnknown() || plan.Source.IsUnknown() {
+		return
+	}
+
+	reader, err := ossObjectReader(plan)
+	if err != nil {
+		// The source file may be generated by the other resources during
+		// the apply, the ETag is unknown until it is uploaded.
+		if os.IsNotExist(err) {
+			return
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Failed to Read Source File",
+			err.Error(),
+		)
+		return
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Failed to Read Source File",
+			err.Error(),
+		)
+		return
+	}
+	plan.Etag = types.StringValue(strings.ToUpper(hex.EncodeToString(hash.Sum(nil))))
+
+	setPlanDiags := resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(setPlanDiags...)
+}
+
+func (r *ossObjectResource) putObject(model *ossObjectModel) error {
+	bucket, err := r.client.Bucket(model.Bucket.ValueString())
+	if err != nil {
+		return err
+	}
+
+	options := []alicloudOssClient.Option{
+		alicloudOssClient.ObjectACL(alicloudOssClient.ACLType(model.Acl.ValueString())),
+	}
+	if !model.ContentType.IsUnknown() && !model.ContentType.IsNull() {
+		options = append(options, alicloudOssClient.ContentType(model.ContentType.ValueString()))
+	}
+	if !model.ServerSideEncryption.IsNull() {
+		options = append(options, alicloudOssClient.ServerSideEncryption(model.ServerSideEncryption.ValueString()))
+	}
+	if !model.KmsKeyId.IsNull() {
+		options = append(options, alicloudOssClient.ServerSideEncryptionKeyID(model.KmsKeyId.ValueString()))
+	}
+
+	var etag, contentType string
+	putObject := func() error {
+		reader, err := ossObjectReader(model)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		var responseHeader http.Header
+		putOptions := append(options, alicloudOssClient.GetResponseHeader(&responseHeader))
+		if err := bucket.PutObject(model.Key.ValueString(), reader, putOptions...); err != nil {
+			return handleOssAPIError(err)
+		}
+		etag = strings.Trim(responseHeader.Get(alicloudOssClient.HTTPHeaderEtag), "\"")
+		return nil
+	}
+
+	if err := retryAPICall(putObject); err != nil {
+		return err
+	}
+
+	// The content type is detected by OSS if it is not configured.
+	if model.ContentType.IsUnknown() || model.ContentType.IsNull() {
+		header, err := bucket.GetObjectDetailedMeta(model.Key.ValueString())
+		if err != nil {
+			return err
+		}
+		if values := header[alicloudOssClient.HTTPHeaderContentType]; len(values) > 0 {
+			contentType = values[0]
+		}
+		model.ContentType = types.StringValue(contentType)
+	}
+
+	// The ETag is unknown in the plan if the source file is generated during
+	// the apply.
+	if model.Etag.IsUnknown() {
+		model.Etag = types.StringValue(etag)
+	}
+	return nil
+}
+
+// Function to open the content or the source file of the object.
+func ossObjectReader(model *ossObjectModel) (io.Reader, error) {
+	if !model.Source.IsNull() {
+		return os.Open(model.Source.ValueString())
+	}
+	return bytes.NewReader([]byte(model.Content.ValueString())), nil
+}
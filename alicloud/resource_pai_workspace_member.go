@@ -0,0 +1,375 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudAiworkspaceClient "github.com/alibabacloud-go/aiworkspace-20210204/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &paiWorkspaceMemberResource{}
+	_ resource.ResourceWithConfigure   = &paiWorkspaceMemberResource{}
+	_ resource.ResourceWithImportState = &paiWorkspaceMemberResource{}
+)
+
+func NewPaiWorkspaceMemberResource() resource.Resource {
+	return &paiWorkspaceMemberResource{}
+}
+
+type paiWorkspaceMemberResource struct {
+	client        *alicloudAiworkspaceClient.Client
+	adoptExisting bool
+}
+
+type paiWorkspaceMemberModel struct {
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	UserId      types.String `tfsdk:"user_id"`
+	Roles       types.List   `tfsdk:"roles"`
+	MemberId    types.String `tfsdk:"member_id"`
+	MemberName  types.String `tfsdk:"member_name"`
+}
+
+// Metadata returns the PAI workspace member resource name.
+func (r *paiWorkspaceMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pai_workspace_member"
+}
+
+// Schema defines the schema for the PAI workspace member resource.
+func (r *paiWorkspaceMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a RAM user or a RAM role as the member of a PAI workspace and the roles of the member.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Description: "The ID of the PAI workspace.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the RAM user or the RAM role to be added to the workspace.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.ListAttribute{
+				Description: "The roles of the member. Valid values: PAI.WorkspaceAdmin, PAI.AlgoDeveloper, " +
+					"PAI.AlgoOperator, PAI.LabelManager, PAI.MaxComputeDeveloper, PAI.WorkspaceGuest.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(
+						"PAI.WorkspaceAdmin",
+						"PAI.AlgoDeveloper",
+						"PAI.AlgoOperator",
+						"PAI.LabelManager",
+						"PAI.MaxComputeDeveloper",
+						"PAI.WorkspaceGuest",
+					)),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Description: "The ID of the member in the workspace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_name": schema.StringAttribute{
+				Description: "The name of the member in the workspace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *paiWorkspaceMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).aiworkspaceClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the workspace member with the roles.
+func (r *paiWorkspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *paiWorkspaceMemberModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles := convertListValueToStrings(plan.Roles)
+	createMember := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		createMemberRequest := &alicloudAiworkspaceClient.CreateMemberRequest{
+			Members: []*alicloudAiworkspaceClient.CreateMemberRequestMembers{
+				{
+					UserId: tea.String(plan.UserId.ValueString()),
+					Roles:  tea.StringSlice(roles),
+				},
+			},
+		}
+
+		if _, err := r.client.CreateMemberWithOptions(tea.String(plan.WorkspaceId.ValueString()), createMemberRequest, headers, runtime); err != nil {
+			// The user is already a member of the workspace.
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createMember); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create PAI Workspace Member.",
+			err.Error(),
+		)
+		return
+	}
+
+	member, err := r.describeMember(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List PAI Workspace Members.",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Find PAI Workspace Member.",
+			fmt.Sprintf("The member of the user %s is not found in the workspace after creation.", plan.UserId.ValueString()),
+		)
+		return
+	}
+	plan.MemberId = types.StringValue(tea.StringValue(member.MemberId))
+	plan.MemberName = types.StringValue(tea.StringValue(member.MemberName))
+
+	// The adopted member may have the other roles already.
+	if err := r.updateRoles(plan, tea.StringSliceValue(member.Roles), roles); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update PAI Workspace Member Roles.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the workspace member and the roles.
+func (r *paiWorkspaceMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *paiWorkspaceMemberModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.describeMember(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List PAI Workspace Members.",
+			err.Error(),
+		)
+		return
+	}
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the order of the roles in the state, the roles granted outside of
+	// Terraform are appended at the end.
+	memberRoles := tea.StringSliceValue(member.Roles)
+	stateRoles := convertListValueToStrings(state.Roles)
+	roles := convertStringsDifference(stateRoles, convertStringsDifference(stateRoles, memberRoles))
+	roles = append(roles, convertStringsDifference(memberRoles, stateRoles)...)
+
+	state.Roles = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(roles)))
+	state.MemberId = types.StringValue(tea.StringValue(member.MemberId))
+	state.MemberName = types.StringValue(tea.StringValue(member.MemberName))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the roles of the workspace member.
+func (r *paiWorkspaceMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *paiWorkspaceMemberModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.MemberId = state.MemberId
+	plan.MemberName = state.MemberName
+	if err := r.updateRoles(plan, convertListValueToStrings(state.Roles), convertListValueToStrings(plan.Roles)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update PAI Workspace Member Roles.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the workspace member.
+func (r *paiWorkspaceMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *paiWorkspaceMemberModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteMembers := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		deleteMembersRequest := &alicloudAiworkspaceClient.DeleteMembersRequest{
+			MemberIds: tea.String(state.MemberId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteMembersWithOptions(tea.String(state.WorkspaceId.ValueString()), deleteMembersRequest, headers, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteMembers); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete PAI Workspace Member.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the workspace member with the ID in the format of
+// <workspace_id>:<user_id>.
+func (r *paiWorkspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <workspace_id>:<user_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("roles"), types.ListValueMust(types.StringType, nil))...)
+}
+
+// Function to grant the roles which are not granted yet and revoke the roles
+// which are no longer desired.
+func (r *paiWorkspaceMemberResource) updateRoles(plan *paiWorkspaceMemberModel, memberRoles, roles []string) error {
+	updateRoles := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		workspaceId := tea.String(plan.WorkspaceId.ValueString())
+		memberId := tea.String(plan.MemberId.ValueString())
+		for _, role := range convertStringsDifference(roles, memberRoles) {
+			if _, err := r.client.CreateMemberRoleWithOptions(workspaceId, memberId, tea.String(role), headers, runtime); err != nil {
+				if isAlreadyExistsError(err) {
+					continue
+				}
+				return handleAPIError(err)
+			}
+		}
+
+		for _, role := range convertStringsDifference(memberRoles, roles) {
+			if _, err := r.client.DeleteMemberRoleWithOptions(workspaceId, memberId, tea.String(role), headers, runtime); err != nil {
+				return handleAPIError(err)
+			}
+		}
+		return nil
+	}
+
+	return retryAPICall(updateRoles)
+}
+
+// Function to find the workspace member of the user, returns nil if the user
+// is not a member of the workspace.
+func (r *paiWorkspaceMemberResource) describeMember(model *paiWorkspaceMemberModel) (*alicloudAiworkspaceClient.ListMembersResponseBodyMembers, error) {
+	var member *alicloudAiworkspaceClient.ListMembersResponseBodyMembers
+	listMembers := func() error {
+		runtime := &util.RuntimeOptions{}
+		headers := make(map[string]*string)
+
+		member = nil
+		pageNumber := int64(1)
+		for {
+			listMembersRequest := &alicloudAiworkspaceClient.ListMembersRequest{
+				PageNumber: tea.Int64(pageNumber),
+				PageSize:   tea.Int64(100),
+			}
+
+			listMembersResponse, err := r.client.ListMembersWithOptions(tea.String(model.WorkspaceId.ValueString()), listMembersRequest, headers, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+
+			for _, m := range listMembersResponse.Body.Members {
+				if tea.StringValue(m.UserId) == model.UserId.ValueString() {
+					member = m
+					return nil
+				}
+			}
+			if len(listMembersResponse.Body.Members) == 0 || pageNumber*100 >= tea.Int64Value(listMembersResponse.Body.TotalCount) {
+				return nil
+			}
+			pageNumber++
+		}
+	}
+
+	err := retryAPICall(listMembers)
+	return member, err
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,6 +26,7 @@ var (
 	_ resource.Resource                = &ramPolicyResource{}
 	_ resource.ResourceWithConfigure   = &ramPolicyResource{}
 	_ resource.ResourceWithImportState = &ramPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &ramPolicyResource{}
 )
 
 func NewRamPolicyResource() resource.Resource {
@@ -34,13 +34,18 @@ func NewRamPolicyResource() resource.Resource {
 }
 
 type ramPolicyResource struct {
-	client *alicloudRamClient.Client
+	client        *alicloudRamClient.Client
+	adoptExisting bool
+	namePrefix    string
 }
 
 type ramPolicyResourceModel struct {
-	AttachedPolicies types.List   `tfsdk:"attached_policies"`
-	Policies         types.List   `tfsdk:"policies"`
-	UserName         types.String `tfsdk:"user_name"`
+	AttachedPolicies        types.List   `tfsdk:"attached_policies"`
+	Policies                types.List   `tfsdk:"policies"`
+	UserName                types.String `tfsdk:"user_name"`
+	TotalStatementLength    types.Int64  `tfsdk:"total_statement_length"`
+	SegmentCount            types.Int64  `tfsdk:"segment_count"`
+	SegmentRemainingLengths types.List   `tfsdk:"segment_remaining_lengths"`
 }
 
 type policyDetail struct {
@@ -85,6 +90,21 @@ func (r *ramPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The name of the RAM user that attached to the policy.",
 				Required:    true,
 			},
+			"total_statement_length": schema.Int64Attribute{
+				Description: "The total character length of the policy documents attached to the user.",
+				Computed:    true,
+			},
+			"segment_count": schema.Int64Attribute{
+				Description: "The number of the policies attached to the user, which counts " +
+					"towards the maximum number of attached policies of the user.",
+				Computed: true,
+			},
+			"segment_remaining_lengths": schema.ListAttribute{
+				Description: "The remaining characters of each policy in policies before " +
+					"reaching the maximum length of a policy (6144).",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -94,6 +114,8 @@ func (r *ramPolicyResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 	r.client = req.ProviderData.(alicloudClients).ramClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+	r.namePrefix = req.ProviderData.(alicloudClients).namePrefix
 }
 
 func (r *ramPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -175,9 +197,7 @@ func (r *ramPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(listPoliciesForUser, reconnectBackoff)
+	err := retryAPICall(listPoliciesForUser)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Read Users for Group",
@@ -283,6 +303,39 @@ func (r *ramPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ModifyPlan keeps the length metrics in state when the attached policies and
+// the user are not changed, as they are only recalculated when the policies
+// are combined again.
+func (r *ramPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep if the resource is planned for creation or destruction.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *ramPolicyResourceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AttachedPolicies.Equal(state.AttachedPolicies) || !plan.UserName.Equal(state.UserName) {
+		return
+	}
+
+	plan.TotalStatementLength = state.TotalStatementLength
+	plan.SegmentCount = state.SegmentCount
+	plan.SegmentRemainingLengths = state.SegmentRemainingLengths
+
+	setPlanDiags := resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(setPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
 func (r *ramPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	policyDetailsState := []*policyDetail{}
 	getPolicyResponse := &alicloudRamClient.GetPolicyResponse{}
@@ -335,9 +388,7 @@ func (r *ramPolicyResource) ImportState(ctx context.Context, req resource.Import
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(getPolicy, reconnectBackoff)
+	err = retryAPICall(getPolicy)
 	if err != nil {
 		return
 	}
@@ -375,7 +426,7 @@ func (r *ramPolicyResource) createPolicy(plan *ramPolicyResourceModel) (policies
 		runtime := &util.RuntimeOptions{}
 
 		for i, policy := range combinedPolicyStatements {
-			policyName := plan.UserName.ValueString() + "-" + strconv.Itoa(i+1)
+			policyName := r.combinedPolicyName(plan.UserName.ValueString(), i)
 
 			createPolicyRequest := &alicloudRamClient.CreatePolicyRequest{
 				PolicyName:     tea.String(policyName),
@@ -383,7 +434,16 @@ func (r *ramPolicyResource) createPolicy(plan *ramPolicyResourceModel) (policies
 			}
 
 			if _, err := r.client.CreatePolicyWithOptions(createPolicyRequest, runtime); err != nil {
-				handleAPIError(err)
+				if isAlreadyExistsError(err) {
+					if !r.adoptExisting {
+						return newAlreadyExistsError(err)
+					}
+					if err := r.adoptPolicy(policyName, policy); err != nil {
+						return handleAPIError(err)
+					}
+					continue
+				}
+				return handleAPIError(err)
 			}
 		}
 
@@ -391,7 +451,7 @@ func (r *ramPolicyResource) createPolicy(plan *ramPolicyResourceModel) (policies
 	}
 
 	for i, policies := range combinedPolicyStatements {
-		policyName := plan.UserName.ValueString() + "-" + strconv.Itoa(i+1)
+		policyName := r.combinedPolicyName(plan.UserName.ValueString(), i)
 
 		policyObj := types.ObjectValueMust(
 			map[string]attr.Type{
@@ -423,9 +483,7 @@ func (r *ramPolicyResource) createPolicy(plan *ramPolicyResourceModel) (policies
 		policiesList = append(policiesList, policyObj)
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return policiesList, backoff.Retry(createPolicy, reconnectBackoff)
+	return policiesList, retryAPICall(createPolicy)
 }
 
 func (r *ramPolicyResource) readPolicy(state *ramPolicyResourceModel) diag.Diagnostics {
@@ -465,9 +523,7 @@ func (r *ramPolicyResource) readPolicy(state *ramPolicyResourceModel) diag.Diagn
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(getPolicy, reconnectBackoff)
+	err = retryAPICall(getPolicy)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -499,9 +555,33 @@ func (r *ramPolicyResource) readPolicy(state *ramPolicyResourceModel) diag.Diagn
 		},
 		policyDetails,
 	)
+
+	setPolicyLengthMetrics(state, policyDetailsState)
 	return nil
 }
 
+// Function to set the length metrics of the policies, so that the modules are
+// able to alert before reaching the limits.
+func setPolicyLengthMetrics(state *ramPolicyResourceModel, policies []*policyDetail) {
+	totalLength := 0
+	remainingLengths := []attr.Value{}
+	for _, policy := range policies {
+		length := len(policy.PolicyDocument.ValueString())
+		totalLength += length
+
+		// The policies that exceed the maximum length are attached directly.
+		remaining := maxLength - length
+		if remaining < 0 {
+			remaining = 0
+		}
+		remainingLengths = append(remainingLengths, types.Int64Value(int64(remaining)))
+	}
+
+	state.TotalStatementLength = types.Int64Value(int64(totalLength))
+	state.SegmentCount = types.Int64Value(int64(len(policies)))
+	state.SegmentRemainingLengths = types.ListValueMust(types.Int64Type, remainingLengths)
+}
+
 func (r *ramPolicyResource) removePolicy(state *ramPolicyResourceModel) diag.Diagnostics {
 	data := make(map[string]string)
 
@@ -533,9 +613,7 @@ func (r *ramPolicyResource) removePolicy(state *ramPolicyResourceModel) diag.Dia
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(removePolicy, reconnectBackoff)
+	err := retryAPICall(removePolicy)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -555,13 +633,11 @@ type simplePolicy struct {
 
 func (r *ramPolicyResource) getPolicyDocument(plan *ramPolicyResourceModel) (finalPolicyDocument []string, excludedPolicy []simplePolicy, err error) {
 	policyName := ""
-	currentLength := 0
-	currentPolicyDocument := ""
-	appendedPolicyDocument := make([]string, 0)
+	policies := []simplePolicy{}
 
 	var getPolicyResponse *alicloudRamClient.GetPolicyResponse
 
-	for i, policy := range plan.AttachedPolicies.Elements() {
+	for _, policy := range plan.AttachedPolicies.Elements() {
 		policyName = policy.String()
 		getPolicyRequest := &alicloudRamClient.GetPolicyRequest{
 			PolicyType: tea.String("Custom"),
@@ -593,72 +669,87 @@ func (r *ramPolicyResource) getPolicyDocument(plan *ramPolicyResourceModel) (fin
 			return nil
 		}
 
-		reconnectBackoff := backoff.NewExponentialBackOff()
-		reconnectBackoff.MaxElapsedTime = 30 * time.Second
-		backoff.Retry(getPolicy, reconnectBackoff)
+		retryAPICall(getPolicy)
 
 		if getPolicyResponse.Body != nil && getPolicyResponse.Body.DefaultPolicyVersion != nil {
 			if getPolicyResponse.Body.DefaultPolicyVersion.PolicyDocument != nil {
-				tempPolicyDocument := *getPolicyResponse.Body.DefaultPolicyVersion.PolicyDocument
-
-				skipCombinePolicy := false
-				// If the policy itself have more than 6144 characters, then skip the combine
-				// policy part since splitting the policy "statement" will be hitting the
-				// limitation of "maximum number of attached policies" easily.
-				if len(tempPolicyDocument) > maxLength {
-					excludedPolicy = append(excludedPolicy, simplePolicy{
-						policyName:     policyName,
-						policyDocument: tempPolicyDocument,
-					})
-					skipCombinePolicy = true
-				}
+				policies = append(policies, simplePolicy{
+					policyName:     policyName,
+					policyDocument: *getPolicyResponse.Body.DefaultPolicyVersion.PolicyDocument,
+				})
+			}
+		} else {
+			return nil, nil, fmt.Errorf("could not find the policy: %v", policyName)
+		}
+	}
 
-				if !skipCombinePolicy {
-					var data map[string]interface{}
-					if err := json.Unmarshal([]byte(tempPolicyDocument), &data); err != nil {
-						return nil, nil, err
-					}
+	return combinePolicyDocuments(policies, maxLength)
+}
 
-					statementArr := data["Statement"].([]interface{})
-					statementBytes, err := json.MarshalIndent(statementArr, "", "  ")
-					if err != nil {
-						return nil, nil, err
-					}
+// Function to combine the statements of the policies into as few policy
+// documents as possible, each of them does not exceed the max length. The
+// policies which exceed the max length themselves are excluded and returned
+// as is. It is shared by the RAM policies and the control policies of the
+// resource directory.
+func combinePolicyDocuments(policies []simplePolicy, maxLength int) (finalPolicyDocument []string, excludedPolicy []simplePolicy, err error) {
+	currentLength := 0
+	currentPolicyDocument := ""
+	appendedPolicyDocument := make([]string, 0)
 
-					removeSpaces := strings.ReplaceAll(string(statementBytes), " ", "")
-					replacer := strings.NewReplacer("\n", "")
-					removeParagraphs := replacer.Replace(removeSpaces)
+	for i, policy := range policies {
+		tempPolicyDocument := policy.policyDocument
 
-					finalStatement := strings.Trim(removeParagraphs, "[]")
+		skipCombinePolicy := false
+		// If the policy itself have more than the max length, then skip the combine
+		// policy part since splitting the policy "statement" will be hitting the
+		// limitation of "maximum number of attached policies" easily.
+		if len(tempPolicyDocument) > maxLength {
+			excludedPolicy = append(excludedPolicy, policy)
+			skipCombinePolicy = true
+		}
 
-					currentLength += len(finalStatement)
+		if !skipCombinePolicy {
+			var data map[string]interface{}
+			if err := json.Unmarshal([]byte(tempPolicyDocument), &data); err != nil {
+				return nil, nil, err
+			}
 
-					// Before further proceeding the current policy, we need to add a number of 30 to simulate the total length of completed policy to check whether it is already execeeded the max character length of 6144.
-					// Number of 30 indicates the character length of neccessary policy keyword such as "Version" and "Statement" and some JSON symbols ({}, [])
-					if (currentLength + 30) > maxLength {
-						lastCommaIndex := strings.LastIndex(currentPolicyDocument, ",")
-						if lastCommaIndex >= 0 {
-							currentPolicyDocument = currentPolicyDocument[:lastCommaIndex] + currentPolicyDocument[lastCommaIndex+1:]
-						}
+			statementArr := data["Statement"].([]interface{})
+			statementBytes, err := json.MarshalIndent(statementArr, "", "  ")
+			if err != nil {
+				return nil, nil, err
+			}
 
-						appendedPolicyDocument = append(appendedPolicyDocument, currentPolicyDocument)
-						currentPolicyDocument = finalStatement + ","
-						currentLength = len(finalStatement)
-					} else {
-						currentPolicyDocument += finalStatement + ","
-					}
-				}
+			removeSpaces := strings.ReplaceAll(string(statementBytes), " ", "")
+			replacer := strings.NewReplacer("\n", "")
+			removeParagraphs := replacer.Replace(removeSpaces)
 
-				if i == len(plan.AttachedPolicies.Elements())-1 && (currentLength+30) <= maxLength {
-					lastCommaIndex := strings.LastIndex(currentPolicyDocument, ",")
-					if lastCommaIndex >= 0 {
-						currentPolicyDocument = currentPolicyDocument[:lastCommaIndex] + currentPolicyDocument[lastCommaIndex+1:]
-					}
-					appendedPolicyDocument = append(appendedPolicyDocument, currentPolicyDocument)
+			finalStatement := strings.Trim(removeParagraphs, "[]")
+
+			currentLength += len(finalStatement)
+
+			// Before further proceeding the current policy, we need to add a number of 30 to simulate the total length of completed policy to check whether it is already execeeded the max character length.
+			// Number of 30 indicates the character length of neccessary policy keyword such as "Version" and "Statement" and some JSON symbols ({}, [])
+			if (currentLength + 30) > maxLength {
+				lastCommaIndex := strings.LastIndex(currentPolicyDocument, ",")
+				if lastCommaIndex >= 0 {
+					currentPolicyDocument = currentPolicyDocument[:lastCommaIndex] + currentPolicyDocument[lastCommaIndex+1:]
 				}
+
+				appendedPolicyDocument = append(appendedPolicyDocument, currentPolicyDocument)
+				currentPolicyDocument = finalStatement + ","
+				currentLength = len(finalStatement)
+			} else {
+				currentPolicyDocument += finalStatement + ","
 			}
-		} else {
-			return nil, nil, fmt.Errorf("could not find the policy: %v", policyName)
+		}
+
+		if i == len(policies)-1 && (currentLength+30) <= maxLength {
+			lastCommaIndex := strings.LastIndex(currentPolicyDocument, ",")
+			if lastCommaIndex >= 0 {
+				currentPolicyDocument = currentPolicyDocument[:lastCommaIndex] + currentPolicyDocument[lastCommaIndex+1:]
+			}
+			appendedPolicyDocument = append(appendedPolicyDocument, currentPolicyDocument)
 		}
 	}
 
@@ -671,6 +762,28 @@ func (r *ramPolicyResource) getPolicyDocument(plan *ramPolicyResourceModel) (fin
 	return finalPolicyDocument, excludedPolicy, nil
 }
 
+// Function to generate the name of the combined policy of the user, with the
+// name prefix configured in the provider.
+func (r *ramPolicyResource) combinedPolicyName(userName string, index int) string {
+	return r.namePrefix + userName + "-" + strconv.Itoa(index+1)
+}
+
+// Function to adopt an existing policy by setting the planned document as
+// its default version.
+func (r *ramPolicyResource) adoptPolicy(policyName, policyDocument string) error {
+	runtime := &util.RuntimeOptions{}
+
+	createPolicyVersionRequest := &alicloudRamClient.CreatePolicyVersionRequest{
+		PolicyName:     tea.String(policyName),
+		PolicyDocument: tea.String(policyDocument),
+		SetAsDefault:   tea.Bool(true),
+		RotateStrategy: tea.String("DeleteOldestNonDefaultVersionWhenLimitExceeded"),
+	}
+
+	_, err := r.client.CreatePolicyVersionWithOptions(createPolicyVersionRequest, runtime)
+	return err
+}
+
 func (r *ramPolicyResource) attachPolicyToUser(state *ramPolicyResourceModel) (err error) {
 	data := make(map[string]string)
 
@@ -692,9 +805,7 @@ func (r *ramPolicyResource) attachPolicyToUser(state *ramPolicyResourceModel) (e
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return backoff.Retry(attachPolicyToUser, reconnectBackoff)
+	return retryAPICall(attachPolicyToUser)
 }
 
 func handleAPIError(err error) error {
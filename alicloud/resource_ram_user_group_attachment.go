@@ -2,9 +2,11 @@ package alicloud
 
 import (
 	"context"
-	"time"
+	"fmt"
+	"strings"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,8 +17,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &ramUserGroupAttachmentResource{}
-	_ resource.ResourceWithConfigure = &ramUserGroupAttachmentResource{}
+	_ resource.Resource                = &ramUserGroupAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &ramUserGroupAttachmentResource{}
+	_ resource.ResourceWithImportState = &ramUserGroupAttachmentResource{}
 )
 
 func NewRamUserGroupAttachmentResource() resource.Resource {
@@ -24,7 +27,8 @@ func NewRamUserGroupAttachmentResource() resource.Resource {
 }
 
 type ramUserGroupAttachmentResource struct {
-	client *alicloudRamClient.Client
+	client        *alicloudRamClient.Client
+	adoptExisting bool
 }
 
 type ramUserGroupAttachmentResourceModel struct {
@@ -57,6 +61,7 @@ func (r *ramUserGroupAttachmentResource) Configure(_ context.Context, req resour
 		return
 	}
 	r.client = req.ProviderData.(alicloudClients).ramClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
 }
 
 func (r *ramUserGroupAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -123,9 +128,7 @@ func (r *ramUserGroupAttachmentResource) Read(ctx context.Context, req resource.
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err := backoff.Retry(readUserForGroup, reconnectBackoff)
+	err := retryAPICall(readUserForGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"[API ERROR] Failed to Read Users for Group",
@@ -192,6 +195,22 @@ func (r *ramUserGroupAttachmentResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+// ImportState imports the group membership by the ID in the format of
+// <group_name>:<user_name>.
+func (r *ramUserGroupAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <group_name>:<user_name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), ids[1])...)
+}
+
 func (r *ramUserGroupAttachmentResource) addUserToGroup(plan *ramUserGroupAttachmentResourceModel) (err error) {
 	addUserToGroupRequest := &alicloudRamClient.AddUserToGroupRequest{
 		UserName:  tea.String(plan.UserName.ValueString()),
@@ -202,6 +221,13 @@ func (r *ramUserGroupAttachmentResource) addUserToGroup(plan *ramUserGroupAttach
 		runtime := &util.RuntimeOptions{}
 
 		if _, err := r.client.AddUserToGroupWithOptions(addUserToGroupRequest, runtime); err != nil {
+			// The user is already a member of the group.
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
 			if _t, ok := err.(*tea.SDKError); ok {
 				if isAbleToRetry(*_t.Code) {
 					return err
@@ -215,7 +241,5 @@ func (r *ramUserGroupAttachmentResource) addUserToGroup(plan *ramUserGroupAttach
 		return nil
 	}
 
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	return backoff.Retry(addUserToGroup, reconnectBackoff)
+	return retryAPICall(addUserToGroup)
 }
@@ -0,0 +1,441 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &resourceManagerAccountResource{}
+	_ resource.ResourceWithConfigure   = &resourceManagerAccountResource{}
+	_ resource.ResourceWithImportState = &resourceManagerAccountResource{}
+)
+
+func NewResourceManagerAccountResource() resource.Resource {
+	return &resourceManagerAccountResource{}
+}
+
+type resourceManagerAccountResource struct {
+	client *alicloudResourceManagerClient.Client
+}
+
+type resourceManagerAccountModel struct {
+	Id                types.String `tfsdk:"id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	AccountNamePrefix types.String `tfsdk:"account_name_prefix"`
+	FolderId          types.String `tfsdk:"folder_id"`
+	PayerAccountId    types.String `tfsdk:"payer_account_id"`
+	AccountName       types.String `tfsdk:"account_name"`
+	Status            types.String `tfsdk:"status"`
+}
+
+// Metadata returns the Resource Manager Account resource name.
+func (r *resourceManagerAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_account"
+}
+
+// Schema defines the schema for the Resource Manager Account resource.
+func (r *resourceManagerAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a member account in the resource directory and wait until it is provisioned, so that " +
+			"the accounts of the landing zone can be vended by Terraform. The member account is deleted when the " +
+			"resource is destroyed, which fails if the account is still in use.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the member account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the member account.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 50),
+				},
+			},
+			"account_name_prefix": schema.StringAttribute{
+				Description: "The prefix of the account name, the account name is " +
+					"`<account_name_prefix>@<resource_directory_id>.aliyunid.com`. Default to a random prefix.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "The ID of the folder that the member account belongs to. The member account is " +
+					"moved to the folder when changed. Default to the root folder.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payer_account_id": schema.StringAttribute{
+				Description: "The ID of the account that pays for the member account. Default to the member " +
+					"account itself.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				Description: "The name of the member account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the member account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceManagerAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+}
+
+// Create the member account and wait until it is provisioned.
+func (r *resourceManagerAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *resourceManagerAccountModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResourceAccount := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createResourceAccountRequest := &alicloudResourceManagerClient.CreateResourceAccountRequest{
+			DisplayName:       tea.String(plan.DisplayName.ValueString()),
+			AccountNamePrefix: stringPointerOrNil(plan.AccountNamePrefix),
+			ParentFolderId:    stringPointerOrNil(plan.FolderId),
+			PayerAccountId:    stringPointerOrNil(plan.PayerAccountId),
+		}
+
+		createResourceAccountResponse, err := r.client.CreateResourceAccountWithOptions(createResourceAccountRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.Id = types.StringValue(tea.StringValue(createResourceAccountResponse.Body.Account.AccountId))
+		return nil
+	}
+
+	if err := retryAPICall(createResourceAccount); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Resource Account.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The account is created asynchronously, wait until it is provisioned.
+	waitForAccount := func() error {
+		account, err := r.getAccount(plan.Id.ValueString())
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if account == nil {
+			return fmt.Errorf("member account %s is not found yet", plan.Id.ValueString())
+		}
+
+		switch status := tea.StringValue(account.Status); status {
+		case "CreateSuccess":
+			setResourceManagerAccountModel(plan, account)
+			return nil
+		case "CreateFailed", "CreateExpired", "CreateCancelled":
+			return backoff.Permanent(fmt.Errorf("failed to create member account %s: %s",
+				plan.Id.ValueString(), status))
+		default:
+			return fmt.Errorf("member account %s is still %s", plan.Id.ValueString(), status)
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	if err := backoff.Retry(waitForAccount, waitBackoff); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Resource Account to be Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the member account.
+func (r *resourceManagerAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *resourceManagerAccountModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.getAccount(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Account.",
+			err.Error(),
+		)
+		return
+	}
+	if account == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	setResourceManagerAccountModel(state, account)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the display name and the folder of the member account.
+func (r *resourceManagerAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *resourceManagerAccountModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DisplayName.Equal(state.DisplayName) {
+		updateAccount := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateAccountRequest := &alicloudResourceManagerClient.UpdateAccountRequest{
+				AccountId:      tea.String(state.Id.ValueString()),
+				NewDisplayName: tea.String(plan.DisplayName.ValueString()),
+			}
+
+			if _, err := r.client.UpdateAccountWithOptions(updateAccountRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateAccount); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Account.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.FolderId.IsUnknown() && !plan.FolderId.Equal(state.FolderId) {
+		moveAccount := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			moveAccountRequest := &alicloudResourceManagerClient.MoveAccountRequest{
+				AccountId:           tea.String(state.Id.ValueString()),
+				DestinationFolderId: tea.String(plan.FolderId.ValueString()),
+			}
+
+			if _, err := r.client.MoveAccountWithOptions(moveAccountRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(moveAccount); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Move Account.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	account, err := r.getAccount(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Account.",
+			err.Error(),
+		)
+		return
+	}
+	plan.Id = state.Id
+	if account != nil {
+		setResourceManagerAccountModel(plan, account)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the member account and wait until it is deleted. The deletion fails
+// if the account does not pass the deletion check, e.g. it still has
+// resources or unpaid bills.
+func (r *resourceManagerAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resourceManagerAccountModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteAccount := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteAccountRequest := &alicloudResourceManagerClient.DeleteAccountRequest{
+			AccountId: tea.String(state.Id.ValueString()),
+		}
+
+		if _, err := r.client.DeleteAccountWithOptions(deleteAccountRequest, runtime); err != nil {
+			if isResourceManagerAccountNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteAccount); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Account.",
+			err.Error(),
+		)
+		return
+	}
+
+	waitForDeletion := func() error {
+		var getAccountDeletionStatusResponse *alicloudResourceManagerClient.GetAccountDeletionStatusResponse
+		getAccountDeletionStatus := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			getAccountDeletionStatusRequest := &alicloudResourceManagerClient.GetAccountDeletionStatusRequest{
+				AccountId: tea.String(state.Id.ValueString()),
+			}
+
+			var err error
+			getAccountDeletionStatusResponse, err = r.client.GetAccountDeletionStatusWithOptions(getAccountDeletionStatusRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(getAccountDeletionStatus); err != nil {
+			if isResourceManagerAccountNotFound(err) {
+				return nil
+			}
+			return backoff.Permanent(err)
+		}
+
+		status := getAccountDeletionStatusResponse.Body.RdAccountDeletionStatus
+		switch tea.StringValue(status.Status) {
+		case "Success":
+			return nil
+		case "CheckFailed", "DeleteFailed":
+			return backoff.Permanent(fmt.Errorf("failed to delete member account %s: %s",
+				state.Id.ValueString(), tea.StringValue(status.Status)))
+		default:
+			return fmt.Errorf("the deletion of member account %s is %s",
+				state.Id.ValueString(), tea.StringValue(status.Status))
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	if err := backoff.Retry(waitForDeletion, waitBackoff); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Account Deletion.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceManagerAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Function to get the member account, returns nil if the account is not found.
+func (r *resourceManagerAccountResource) getAccount(accountId string) (*alicloudResourceManagerClient.GetAccountResponseBodyAccount, error) {
+	var account *alicloudResourceManagerClient.GetAccountResponseBodyAccount
+	getAccount := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getAccountRequest := &alicloudResourceManagerClient.GetAccountRequest{
+			AccountId: tea.String(accountId),
+		}
+
+		getAccountResponse, err := r.client.GetAccountWithOptions(getAccountRequest, runtime)
+		if err != nil {
+			if isResourceManagerAccountNotFound(err) {
+				account = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		account = getAccountResponse.Body.Account
+		return nil
+	}
+
+	if err := retryAPICall(getAccount); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func setResourceManagerAccountModel(model *resourceManagerAccountModel, account *alicloudResourceManagerClient.GetAccountResponseBodyAccount) {
+	model.DisplayName = types.StringValue(tea.StringValue(account.DisplayName))
+	model.FolderId = types.StringValue(tea.StringValue(account.FolderId))
+	model.AccountName = types.StringValue(tea.StringValue(account.AccountName))
+	model.Status = types.StringValue(tea.StringValue(account.Status))
+}
+
+func isResourceManagerAccountNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return tea.StringValue(_t.Code) == "EntityNotExists.Account"
+	}
+	return false
+}
@@ -0,0 +1,583 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// The maximum length of the document of a control policy.
+const controlPolicyMaxLength = 4096
+
+var (
+	_ resource.Resource              = &resourceManagerControlPolicyAttachmentResource{}
+	_ resource.ResourceWithConfigure = &resourceManagerControlPolicyAttachmentResource{}
+)
+
+func NewResourceManagerControlPolicyAttachmentResource() resource.Resource {
+	return &resourceManagerControlPolicyAttachmentResource{}
+}
+
+type resourceManagerControlPolicyAttachmentResource struct {
+	client     *alicloudResourceManagerClient.Client
+	namePrefix string
+}
+
+type resourceManagerControlPolicyAttachmentModel struct {
+	PolicyName      types.String `tfsdk:"policy_name"`
+	Description     types.String `tfsdk:"description"`
+	TargetId        types.String `tfsdk:"target_id"`
+	PolicyDocuments types.List   `tfsdk:"policy_documents"`
+	Policies        types.List   `tfsdk:"policies"`
+}
+
+var controlPolicyAttrTypes = map[string]attr.Type{
+	"policy_id":       types.StringType,
+	"policy_name":     types.StringType,
+	"policy_document": types.StringType,
+}
+
+// Metadata returns the Resource Manager Control Policy Attachment resource name.
+func (r *resourceManagerControlPolicyAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_control_policy_attachment"
+}
+
+// Schema defines the schema for the Resource Manager Control Policy Attachment resource.
+func (r *resourceManagerControlPolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create the control policies of the resource directory from the policy documents and attach " +
+			"them to a folder or a member account. The statements of the policy documents are combined into as " +
+			"few control policies as possible, and the policy documents exceeding the maximum length of a " +
+			"control policy (4096) are split by the statements, in the same way as st-alicloud_ram_policy.",
+		Attributes: map[string]schema.Attribute{
+			"policy_name": schema.StringAttribute{
+				Description: "The base name of the control policies, the control policies are named " +
+					"`<policy_name>-<index>`.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the control policies.",
+				Optional:    true,
+			},
+			"target_id": schema.StringAttribute{
+				Description: "The ID of the folder or the member account that the control policies are attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_documents": schema.ListAttribute{
+				Description: "The JSON documents of the control policies.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The control policies created and attached to the target.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_id": schema.StringAttribute{
+							Description: "The ID of the control policy.",
+							Computed:    true,
+						},
+						"policy_name": schema.StringAttribute{
+							Description: "The name of the control policy.",
+							Computed:    true,
+						},
+						"policy_document": schema.StringAttribute{
+							Description: "The document of the control policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceManagerControlPolicyAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+	r.namePrefix = req.ProviderData.(alicloudClients).namePrefix
+}
+
+// Create the control policies and attach them to the target.
+func (r *resourceManagerControlPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *resourceManagerControlPolicyAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyPolicies(plan, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Attach Control Policies.",
+			err.Error(),
+		)
+		r.setPartialState(ctx, plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the control policies attached to the target.
+func (r *resourceManagerControlPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *resourceManagerControlPolicyAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachedPolicyIds, err := r.listAttachedPolicyIds(state.TargetId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Control Policy Attachments.",
+			err.Error(),
+		)
+		return
+	}
+
+	drifted := false
+	policies := []attr.Value{}
+	for _, policy := range state.Policies.Elements() {
+		attrs := policy.(types.Object).Attributes()
+		policyId := attrs["policy_id"].(types.String).ValueString()
+
+		document, err := r.getControlPolicyDocument(policyId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Get Control Policy.",
+				err.Error(),
+			)
+			return
+		}
+
+		// The control policies may be deleted, detached or modified outside
+		// of Terraform, which are restored on the next apply.
+		if document == nil {
+			drifted = true
+			continue
+		}
+		if !attachedPolicyIds[policyId] || !isJsonStringEqual(*document, attrs["policy_document"].(types.String).ValueString()) {
+			drifted = true
+		}
+		policies = append(policies, types.ObjectValueMust(controlPolicyAttrTypes, map[string]attr.Value{
+			"policy_id":       types.StringValue(policyId),
+			"policy_name":     attrs["policy_name"],
+			"policy_document": types.StringValue(*document),
+		}))
+	}
+
+	if len(policies) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Policies = types.ListValueMust(types.ObjectType{AttrTypes: controlPolicyAttrTypes}, policies)
+	if drifted {
+		resp.Diagnostics.AddWarning(
+			"Control policies drifted.",
+			"The control policies attached to the target may be deleted, detached or modified outside of Terraform, "+
+				"they will be restored on the next apply.",
+		)
+		state.PolicyDocuments = types.ListNull(types.StringType)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the control policies, the extra control policies are created and
+// attached, and the redundant ones are detached and deleted.
+func (r *resourceManagerControlPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *resourceManagerControlPolicyAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyPolicies(plan, state.Policies.Elements()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Control Policies.",
+			err.Error(),
+		)
+		r.setPartialState(ctx, plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Detach the control policies from the target and delete them.
+func (r *resourceManagerControlPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resourceManagerControlPolicyAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, policy := range state.Policies.Elements() {
+		policyId := policy.(types.Object).Attributes()["policy_id"].(types.String).ValueString()
+		if err := r.removeControlPolicy(policyId, state.TargetId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Control Policy.",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// Function to write the control policies applied before the error to state.
+// The policy documents are cleared, so that the control policies are applied
+// again on the next apply.
+func (r *resourceManagerControlPolicyAttachmentResource) setPartialState(ctx context.Context, plan *resourceManagerControlPolicyAttachmentModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	if len(plan.Policies.Elements()) == 0 {
+		return
+	}
+	plan.PolicyDocuments = types.ListNull(types.StringType)
+
+	setStateDiags := state.Set(ctx, &plan)
+	diags.Append(setStateDiags...)
+}
+
+// Function to combine the policy documents into the control policies and
+// apply them in place of the existing control policies by the index. The
+// control policies applied so far are kept in the plan on error, so that
+// they can be written to state and cleaned up on the next apply.
+func (r *resourceManagerControlPolicyAttachmentResource) applyPolicies(plan *resourceManagerControlPolicyAttachmentModel, existingPolicies []attr.Value) error {
+	documents, err := combineControlPolicyDocuments(convertListValueToStrings(plan.PolicyDocuments))
+	if err != nil {
+		return err
+	}
+
+	attachedPolicyIds, err := r.listAttachedPolicyIds(plan.TargetId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	policies := append([]attr.Value{}, existingPolicies...)
+	defer func() {
+		plan.Policies = types.ListValueMust(types.ObjectType{AttrTypes: controlPolicyAttrTypes}, policies)
+	}()
+
+	for i, document := range documents {
+		policyName := r.namePrefix + plan.PolicyName.ValueString() + "-" + strconv.Itoa(i+1)
+
+		policyId := ""
+		if i < len(existingPolicies) {
+			policyId = existingPolicies[i].(types.Object).Attributes()["policy_id"].(types.String).ValueString()
+			exists, err := r.updateControlPolicy(policyId, policyName, document, plan.Description)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				policyId = ""
+			}
+		}
+		if policyId == "" {
+			if policyId, err = r.createControlPolicy(policyName, document, plan.Description); err != nil {
+				return err
+			}
+		}
+
+		policy := types.ObjectValueMust(controlPolicyAttrTypes, map[string]attr.Value{
+			"policy_id":       types.StringValue(policyId),
+			"policy_name":     types.StringValue(policyName),
+			"policy_document": types.StringValue(document),
+		})
+		if i < len(policies) {
+			policies[i] = policy
+		} else {
+			policies = append(policies, policy)
+		}
+
+		if !attachedPolicyIds[policyId] {
+			if err := r.attachControlPolicy(policyId, plan.TargetId.ValueString()); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(existingPolicies) - 1; i >= len(documents); i-- {
+		policyId := existingPolicies[i].(types.Object).Attributes()["policy_id"].(types.String).ValueString()
+		if err := r.removeControlPolicy(policyId, plan.TargetId.ValueString()); err != nil {
+			return err
+		}
+		policies = policies[:i]
+	}
+	return nil
+}
+
+// Function to combine the policy documents into the control policy documents
+// with combinePolicyDocuments. The documents exceeding the maximum length are
+// split into the documents of a single statement and combined again.
+func combineControlPolicyDocuments(documents []string) ([]string, error) {
+	policies := []simplePolicy{}
+	for i, document := range documents {
+		policies = append(policies, simplePolicy{
+			policyName:     strconv.Itoa(i),
+			policyDocument: document,
+		})
+	}
+
+	combined, excluded, err := combinePolicyDocuments(policies, controlPolicyMaxLength)
+	if err != nil {
+		return nil, err
+	}
+	if len(excluded) == 0 {
+		return combined, nil
+	}
+
+	splitPolicies := []simplePolicy{}
+	for _, policy := range excluded {
+		var data struct {
+			Version   string        `json:"Version"`
+			Statement []interface{} `json:"Statement"`
+		}
+		if err := json.Unmarshal([]byte(policy.policyDocument), &data); err != nil {
+			return nil, err
+		}
+		for _, statement := range data.Statement {
+			document, err := json.Marshal(map[string]interface{}{
+				"Version":   "1",
+				"Statement": []interface{}{statement},
+			})
+			if err != nil {
+				return nil, err
+			}
+			splitPolicies = append(splitPolicies, simplePolicy{
+				policyName:     policy.policyName,
+				policyDocument: string(document),
+			})
+		}
+	}
+
+	splitCombined, oversized, err := combinePolicyDocuments(splitPolicies, controlPolicyMaxLength)
+	if err != nil {
+		return nil, err
+	}
+	if len(oversized) > 0 {
+		return nil, fmt.Errorf("a statement of the policy document %s exceeds the maximum length of a control policy (%d)",
+			oversized[0].policyName, controlPolicyMaxLength)
+	}
+	return append(combined, splitCombined...), nil
+}
+
+func (r *resourceManagerControlPolicyAttachmentResource) createControlPolicy(policyName, document string, description types.String) (string, error) {
+	var policyId string
+	createControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createControlPolicyRequest := &alicloudResourceManagerClient.CreateControlPolicyRequest{
+			PolicyName:     tea.String(policyName),
+			PolicyDocument: tea.String(document),
+			EffectScope:    tea.String("RAM"),
+			Description:    stringPointerOrNil(description),
+		}
+
+		createControlPolicyResponse, err := r.client.CreateControlPolicyWithOptions(createControlPolicyRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		policyId = tea.StringValue(createControlPolicyResponse.Body.ControlPolicy.PolicyId)
+		return nil
+	}
+
+	return policyId, retryAPICall(createControlPolicy)
+}
+
+// Function to update the name and the document of the control policy, returns
+// false if the control policy is not found.
+func (r *resourceManagerControlPolicyAttachmentResource) updateControlPolicy(policyId, policyName, document string, description types.String) (bool, error) {
+	exists := true
+	updateControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateControlPolicyRequest := &alicloudResourceManagerClient.UpdateControlPolicyRequest{
+			PolicyId:          tea.String(policyId),
+			NewPolicyName:     tea.String(policyName),
+			NewPolicyDocument: tea.String(document),
+			NewDescription:    stringPointerOrNil(description),
+		}
+
+		if _, err := r.client.UpdateControlPolicyWithOptions(updateControlPolicyRequest, runtime); err != nil {
+			if isControlPolicyNotFound(err) {
+				exists = false
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return exists, retryAPICall(updateControlPolicy)
+}
+
+// Function to get the document of the control policy, returns nil if the
+// control policy is not found.
+func (r *resourceManagerControlPolicyAttachmentResource) getControlPolicyDocument(policyId string) (*string, error) {
+	var document *string
+	getControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getControlPolicyRequest := &alicloudResourceManagerClient.GetControlPolicyRequest{
+			PolicyId: tea.String(policyId),
+		}
+
+		getControlPolicyResponse, err := r.client.GetControlPolicyWithOptions(getControlPolicyRequest, runtime)
+		if err != nil {
+			if isControlPolicyNotFound(err) {
+				document = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		document = getControlPolicyResponse.Body.ControlPolicy.PolicyDocument
+		return nil
+	}
+
+	return document, retryAPICall(getControlPolicy)
+}
+
+// Function to list the IDs of the control policies attached to the target.
+func (r *resourceManagerControlPolicyAttachmentResource) listAttachedPolicyIds(targetId string) (map[string]bool, error) {
+	policyIds := make(map[string]bool)
+	listControlPolicyAttachmentsForTarget := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listControlPolicyAttachmentsForTargetRequest := &alicloudResourceManagerClient.ListControlPolicyAttachmentsForTargetRequest{
+			TargetId: tea.String(targetId),
+		}
+
+		listControlPolicyAttachmentsForTargetResponse, err := r.client.ListControlPolicyAttachmentsForTargetWithOptions(listControlPolicyAttachmentsForTargetRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		if attachments := listControlPolicyAttachmentsForTargetResponse.Body.ControlPolicyAttachments; attachments != nil {
+			for _, attachment := range attachments.ControlPolicyAttachment {
+				policyIds[tea.StringValue(attachment.PolicyId)] = true
+			}
+		}
+		return nil
+	}
+
+	return policyIds, retryAPICall(listControlPolicyAttachmentsForTarget)
+}
+
+func (r *resourceManagerControlPolicyAttachmentResource) attachControlPolicy(policyId, targetId string) error {
+	attachControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		attachControlPolicyRequest := &alicloudResourceManagerClient.AttachControlPolicyRequest{
+			PolicyId: tea.String(policyId),
+			TargetId: tea.String(targetId),
+		}
+
+		if _, err := r.client.AttachControlPolicyWithOptions(attachControlPolicyRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(attachControlPolicy)
+}
+
+// Function to detach the control policy from the target and delete it, the
+// control policy which is not found is ignored.
+func (r *resourceManagerControlPolicyAttachmentResource) removeControlPolicy(policyId, targetId string) error {
+	detachControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		detachControlPolicyRequest := &alicloudResourceManagerClient.DetachControlPolicyRequest{
+			PolicyId: tea.String(policyId),
+			TargetId: tea.String(targetId),
+		}
+
+		if _, err := r.client.DetachControlPolicyWithOptions(detachControlPolicyRequest, runtime); err != nil {
+			if isControlPolicyNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(detachControlPolicy); err != nil {
+		return err
+	}
+
+	deleteControlPolicy := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteControlPolicyRequest := &alicloudResourceManagerClient.DeleteControlPolicyRequest{
+			PolicyId: tea.String(policyId),
+		}
+
+		if _, err := r.client.DeleteControlPolicyWithOptions(deleteControlPolicyRequest, runtime); err != nil {
+			if isControlPolicyNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteControlPolicy)
+}
+
+func isControlPolicyNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		code := tea.StringValue(_t.Code)
+		return code == "EntityNotExist.ControlPolicy" || code == "EntityNotExists.ControlPolicyAttachment"
+	}
+	return false
+}
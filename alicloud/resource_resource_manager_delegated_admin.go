@@ -0,0 +1,285 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &resourceManagerDelegatedAdminResource{}
+	_ resource.ResourceWithConfigure   = &resourceManagerDelegatedAdminResource{}
+	_ resource.ResourceWithImportState = &resourceManagerDelegatedAdminResource{}
+)
+
+func NewResourceManagerDelegatedAdminResource() resource.Resource {
+	return &resourceManagerDelegatedAdminResource{}
+}
+
+type resourceManagerDelegatedAdminResource struct {
+	client        *alicloudResourceManagerClient.Client
+	adoptExisting bool
+}
+
+type resourceManagerDelegatedAdminModel struct {
+	AccountId             types.String `tfsdk:"account_id"`
+	ServicePrincipal      types.String `tfsdk:"service_principal"`
+	DelegationEnabledTime types.String `tfsdk:"delegation_enabled_time"`
+}
+
+// Metadata returns the Resource Manager Delegated Admin resource name.
+func (r *resourceManagerDelegatedAdminResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_delegated_admin"
+}
+
+// Schema defines the schema for the Resource Manager Delegated Admin resource.
+func (r *resourceManagerDelegatedAdminResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Register a member account of the resource directory as the delegated administrator of a " +
+			"trusted service, e.g. cloudsso.aliyuncs.com, config.aliyuncs.com and actiontrail.aliyuncs.com. The " +
+			"trusted service must be enabled in the resource directory, see the resource " +
+			"st-alicloud_resource_manager_trusted_service.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The ID of the member account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_principal": schema.StringAttribute{
+				Description: "The identifier of the trusted service, e.g. cloudsso.aliyuncs.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delegation_enabled_time": schema.StringAttribute{
+				Description: "The time when the member account was registered as the delegated administrator.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceManagerDelegatedAdminResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Register the member account as the delegated administrator.
+func (r *resourceManagerDelegatedAdminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *resourceManagerDelegatedAdminModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registerDelegatedAdministrator := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		registerDelegatedAdministratorRequest := &alicloudResourceManagerClient.RegisterDelegatedAdministratorRequest{
+			AccountId:        tea.String(plan.AccountId.ValueString()),
+			ServicePrincipal: tea.String(plan.ServicePrincipal.ValueString()),
+		}
+
+		if _, err := r.client.RegisterDelegatedAdministratorWithOptions(registerDelegatedAdministratorRequest, runtime); err != nil {
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(registerDelegatedAdministrator); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Register Delegated Administrator.",
+			err.Error(),
+		)
+		return
+	}
+
+	delegatedAdmin, err := r.findDelegatedAdministrator(plan.ServicePrincipal.ValueString(), plan.AccountId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Delegated Administrators.",
+			err.Error(),
+		)
+		return
+	}
+	plan.DelegationEnabledTime = types.StringValue("")
+	if delegatedAdmin != nil {
+		plan.DelegationEnabledTime = types.StringValue(tea.StringValue(delegatedAdmin.DelegationEnabledTime))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the delegated administrator.
+func (r *resourceManagerDelegatedAdminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *resourceManagerDelegatedAdminModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delegatedAdmin, err := r.findDelegatedAdministrator(state.ServicePrincipal.ValueString(), state.AccountId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Delegated Administrators.",
+			err.Error(),
+		)
+		return
+	}
+	if delegatedAdmin == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.DelegationEnabledTime = types.StringValue(tea.StringValue(delegatedAdmin.DelegationEnabledTime))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing as all the attributes require replacement.
+func (r *resourceManagerDelegatedAdminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *resourceManagerDelegatedAdminModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Deregister the member account from the delegated administrator.
+func (r *resourceManagerDelegatedAdminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resourceManagerDelegatedAdminModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deregisterDelegatedAdministrator := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deregisterDelegatedAdministratorRequest := &alicloudResourceManagerClient.DeregisterDelegatedAdministratorRequest{
+			AccountId:        tea.String(state.AccountId.ValueString()),
+			ServicePrincipal: tea.String(state.ServicePrincipal.ValueString()),
+		}
+
+		if _, err := r.client.DeregisterDelegatedAdministratorWithOptions(deregisterDelegatedAdministratorRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && strings.HasPrefix(tea.StringValue(_t.Code), "EntityNotExists") {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deregisterDelegatedAdministrator); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Deregister Delegated Administrator.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the delegated administrator by the ID in the format of
+// <service_principal>:<account_id>.
+func (r *resourceManagerDelegatedAdminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_principal>:<account_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_principal"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), ids[1])...)
+}
+
+// Function to find the delegated administrator of the trusted service,
+// returns nil if the member account is not the delegated administrator.
+func (r *resourceManagerDelegatedAdminResource) findDelegatedAdministrator(servicePrincipal, accountId string) (*alicloudResourceManagerClient.ListDelegatedAdministratorsResponseBodyAccountsAccount, error) {
+	pageNumber := int32(1)
+	pageSize := int32(100)
+	for {
+		var listDelegatedAdministratorsResponse *alicloudResourceManagerClient.ListDelegatedAdministratorsResponse
+		listDelegatedAdministrators := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listDelegatedAdministratorsRequest := &alicloudResourceManagerClient.ListDelegatedAdministratorsRequest{
+				ServicePrincipal: tea.String(servicePrincipal),
+				PageNumber:       tea.Int64(int64(pageNumber)),
+				PageSize:         tea.Int64(int64(pageSize)),
+			}
+
+			var err error
+			listDelegatedAdministratorsResponse, err = r.client.ListDelegatedAdministratorsWithOptions(listDelegatedAdministratorsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listDelegatedAdministrators); err != nil {
+			return nil, err
+		}
+
+		body := listDelegatedAdministratorsResponse.Body
+		if body.Accounts != nil {
+			for _, account := range body.Accounts.Account {
+				if tea.StringValue(account.AccountId) == accountId {
+					return account, nil
+				}
+			}
+		}
+
+		if int64(pageNumber*pageSize) >= tea.Int64Value(body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return nil, nil
+}
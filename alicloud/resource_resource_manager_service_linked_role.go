@@ -0,0 +1,414 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudRamClient "github.com/alibabacloud-go/ram-20150501/v2/client"
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &resourceManagerServiceLinkedRoleResource{}
+	_ resource.ResourceWithConfigure   = &resourceManagerServiceLinkedRoleResource{}
+	_ resource.ResourceWithImportState = &resourceManagerServiceLinkedRoleResource{}
+)
+
+func NewResourceManagerServiceLinkedRoleResource() resource.Resource {
+	return &resourceManagerServiceLinkedRoleResource{}
+}
+
+type resourceManagerServiceLinkedRoleResource struct {
+	client        *alicloudResourceManagerClient.Client
+	ramClient     *alicloudRamClient.Client
+	adoptExisting bool
+}
+
+type resourceManagerServiceLinkedRoleModel struct {
+	ServiceName  types.String `tfsdk:"service_name"`
+	CustomSuffix types.String `tfsdk:"custom_suffix"`
+	Description  types.String `tfsdk:"description"`
+	RoleName     types.String `tfsdk:"role_name"`
+	RoleId       types.String `tfsdk:"role_id"`
+	Arn          types.String `tfsdk:"arn"`
+}
+
+// Metadata returns the Resource Manager Service Linked Role resource name.
+func (r *resourceManagerServiceLinkedRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_service_linked_role"
+}
+
+// Schema defines the schema for the Resource Manager Service Linked Role resource.
+func (r *resourceManagerServiceLinkedRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a service-linked role of a cloud service, e.g. ess.aliyuncs.com, alb.aliyuncs.com " +
+			"and servicemesh.aliyuncs.com, which is required before the resources of the cloud service are " +
+			"created in a fresh account.",
+		Attributes: map[string]schema.Attribute{
+			"service_name": schema.StringAttribute{
+				Description: "The identifier of the cloud service, e.g. ess.aliyuncs.com.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_suffix": schema.StringAttribute{
+				Description: "The suffix of the role name, only supported by some of the cloud services.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the service-linked role.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Description: "The name of the service-linked role, e.g. AliyunServiceRoleForAutoScaling.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the service-linked role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description: "The ARN of the service-linked role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceManagerServiceLinkedRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+	r.ramClient = req.ProviderData.(alicloudClients).ramClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Create the service-linked role.
+func (r *resourceManagerServiceLinkedRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *resourceManagerServiceLinkedRoleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alreadyExists := false
+	createServiceLinkedRole := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createServiceLinkedRoleRequest := &alicloudResourceManagerClient.CreateServiceLinkedRoleRequest{
+			ServiceName:  tea.String(plan.ServiceName.ValueString()),
+			CustomSuffix: stringPointerOrNil(plan.CustomSuffix),
+			Description:  stringPointerOrNil(plan.Description),
+		}
+
+		createServiceLinkedRoleResponse, err := r.client.CreateServiceLinkedRoleWithOptions(createServiceLinkedRoleRequest, runtime)
+		if err != nil {
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					alreadyExists = true
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+
+		role := createServiceLinkedRoleResponse.Body.Role
+		plan.RoleName = types.StringValue(tea.StringValue(role.RoleName))
+		plan.RoleId = types.StringValue(tea.StringValue(role.RoleId))
+		plan.Arn = types.StringValue(tea.StringValue(role.Arn))
+		return nil
+	}
+
+	if err := retryAPICall(createServiceLinkedRole); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Service Linked Role.",
+			err.Error(),
+		)
+		return
+	}
+
+	if alreadyExists {
+		role, err := r.findServiceLinkedRole(plan.ServiceName.ValueString(), plan.CustomSuffix.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Find Service Linked Role.",
+				err.Error(),
+			)
+			return
+		}
+		if role == nil {
+			resp.Diagnostics.AddError(
+				"[ERROR] Service Linked Role Not Found.",
+				fmt.Sprintf("The service-linked role of %s already exists but it is not found in the RAM roles.",
+					plan.ServiceName.ValueString()),
+			)
+			return
+		}
+		plan.RoleName = types.StringValue(tea.StringValue(role.RoleName))
+		plan.RoleId = types.StringValue(tea.StringValue(role.RoleId))
+		plan.Arn = types.StringValue(tea.StringValue(role.Arn))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the service-linked role.
+func (r *resourceManagerServiceLinkedRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *resourceManagerServiceLinkedRoleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := getRamRole(r.ramClient, state.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Role.",
+			err.Error(),
+		)
+		return
+	}
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.RoleId = types.StringValue(tea.StringValue(role.RoleId))
+	state.Arn = types.StringValue(tea.StringValue(role.Arn))
+	state.Description = stringValueOrNull(state.Description, role.Description)
+
+	// The service name is only known from the trust policy when importing.
+	if state.ServiceName.IsNull() {
+		state.ServiceName = types.StringValue(ramServiceLinkedRoleServiceName(tea.StringValue(role.AssumeRolePolicyDocument)))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the service-linked role.
+func (r *resourceManagerServiceLinkedRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *resourceManagerServiceLinkedRoleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All the attributes require replacement, there is nothing to update.
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the service-linked role, and wait for the deletion task which fails
+// if the role is still used by the resources of the cloud service.
+func (r *resourceManagerServiceLinkedRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resourceManagerServiceLinkedRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deletionTaskId string
+	deleteServiceLinkedRole := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteServiceLinkedRoleRequest := &alicloudResourceManagerClient.DeleteServiceLinkedRoleRequest{
+			RoleName: tea.String(state.RoleName.ValueString()),
+		}
+
+		deleteServiceLinkedRoleResponse, err := r.client.DeleteServiceLinkedRoleWithOptions(deleteServiceLinkedRoleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		deletionTaskId = tea.StringValue(deleteServiceLinkedRoleResponse.Body.DeletionTaskId)
+		return nil
+	}
+
+	if err := retryAPICall(deleteServiceLinkedRole); err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Code), "EntityNotExist") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Service Linked Role.",
+			err.Error(),
+		)
+		return
+	}
+
+	waitForDeletion := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getServiceLinkedRoleDeletionStatusRequest := &alicloudResourceManagerClient.GetServiceLinkedRoleDeletionStatusRequest{
+			DeletionTaskId: tea.String(deletionTaskId),
+		}
+
+		getServiceLinkedRoleDeletionStatusResponse, err := r.client.GetServiceLinkedRoleDeletionStatusWithOptions(getServiceLinkedRoleDeletionStatusRequest, runtime)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		body := getServiceLinkedRoleDeletionStatusResponse.Body
+		switch tea.StringValue(body.Status) {
+		case "SUCCEEDED":
+			return nil
+		case "FAILED":
+			reason := ""
+			if body.Reason != nil {
+				reason = tea.StringValue(body.Reason.Message)
+			}
+			return backoff.Permanent(fmt.Errorf("failed to delete service-linked role %s: %s",
+				state.RoleName.ValueString(), reason))
+		default:
+			return fmt.Errorf("the deletion of service-linked role %s is %s",
+				state.RoleName.ValueString(), tea.StringValue(body.Status))
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxElapsedTime = 10 * time.Minute
+	if err := backoff.Retry(waitForDeletion, waitBackoff); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Service Linked Role Deletion.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceManagerServiceLinkedRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), req, resp)
+}
+
+// Function to find the existing service-linked role of the cloud service. The
+// service-linked roles are named with the prefix AliyunServiceRoleFor and
+// trusted by the cloud service, which is only known from the trust policy.
+func (r *resourceManagerServiceLinkedRoleResource) findServiceLinkedRole(serviceName, customSuffix string) (*alicloudRamClient.GetRoleResponseBodyRole, error) {
+	var roleNames []string
+	marker := ""
+	for {
+		var listRolesResponse *alicloudRamClient.ListRolesResponse
+		listRoles := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listRolesRequest := &alicloudRamClient.ListRolesRequest{
+				MaxItems: tea.Int32(1000),
+			}
+			if marker != "" {
+				listRolesRequest.Marker = tea.String(marker)
+			}
+
+			var err error
+			listRolesResponse, err = r.ramClient.ListRolesWithOptions(listRolesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listRoles); err != nil {
+			return nil, err
+		}
+
+		if listRolesResponse.Body.Roles != nil {
+			for _, role := range listRolesResponse.Body.Roles.Role {
+				roleName := tea.StringValue(role.RoleName)
+				if !strings.HasPrefix(roleName, "AliyunServiceRoleFor") {
+					continue
+				}
+				if customSuffix != "" && !strings.HasSuffix(roleName, "__"+customSuffix) {
+					continue
+				}
+				roleNames = append(roleNames, roleName)
+			}
+		}
+
+		if !tea.BoolValue(listRolesResponse.Body.IsTruncated) {
+			break
+		}
+		marker = tea.StringValue(listRolesResponse.Body.Marker)
+	}
+
+	for _, roleName := range roleNames {
+		role, err := getRamRole(r.ramClient, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if role != nil && ramServiceLinkedRoleServiceName(tea.StringValue(role.AssumeRolePolicyDocument)) == serviceName {
+			return role, nil
+		}
+	}
+	return nil, nil
+}
+
+// Function to get the cloud service which is trusted by the service-linked
+// role from the trust policy, e.g. "Principal": {"Service": ["ess.aliyuncs.com"]}.
+func ramServiceLinkedRoleServiceName(assumeRolePolicyDocument string) string {
+	var document struct {
+		Statement []struct {
+			Principal struct {
+				Service []string `json:"Service"`
+			} `json:"Principal"`
+		} `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(assumeRolePolicyDocument), &document); err != nil {
+		return ""
+	}
+	for _, statement := range document.Statement {
+		if len(statement.Principal.Service) > 0 {
+			return statement.Principal.Service[0]
+		}
+	}
+	return ""
+}
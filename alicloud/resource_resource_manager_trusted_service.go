@@ -0,0 +1,263 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudResourceManagerClient "github.com/alibabacloud-go/resourcemanager-20200331/v3/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &resourceManagerTrustedServiceResource{}
+	_ resource.ResourceWithConfigure   = &resourceManagerTrustedServiceResource{}
+	_ resource.ResourceWithImportState = &resourceManagerTrustedServiceResource{}
+)
+
+func NewResourceManagerTrustedServiceResource() resource.Resource {
+	return &resourceManagerTrustedServiceResource{}
+}
+
+type resourceManagerTrustedServiceResource struct {
+	client        *alicloudResourceManagerClient.Client
+	adoptExisting bool
+}
+
+type resourceManagerTrustedServiceModel struct {
+	ServicePrincipal types.String `tfsdk:"service_principal"`
+	EnableTime       types.String `tfsdk:"enable_time"`
+}
+
+// Metadata returns the Resource Manager Trusted Service resource name.
+func (r *resourceManagerTrustedServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_manager_trusted_service"
+}
+
+// Schema defines the schema for the Resource Manager Trusted Service resource.
+func (r *resourceManagerTrustedServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable the trusted access of a service in the resource directory, e.g. config.aliyuncs.com, " +
+			"actiontrail.aliyuncs.com and cloudsso.aliyuncs.com, which is required before registering the " +
+			"delegated administrator and managing the resources of the service at the resource directory level. " +
+			"The trusted access is disabled when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"service_principal": schema.StringAttribute{
+				Description: "The identifier of the trusted service, e.g. config.aliyuncs.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_time": schema.StringAttribute{
+				Description: "The time when the trusted service was enabled.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceManagerTrustedServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).resourcemanagerClient
+	r.adoptExisting = req.ProviderData.(alicloudClients).adoptExisting
+}
+
+// Enable the trusted access of the service.
+func (r *resourceManagerTrustedServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *resourceManagerTrustedServiceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enableTrustedService := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		enableTrustedServiceRequest := &alicloudResourceManagerClient.EnableTrustedServiceRequest{
+			ServicePrincipal: tea.String(plan.ServicePrincipal.ValueString()),
+		}
+
+		if _, err := r.client.EnableTrustedServiceWithOptions(enableTrustedServiceRequest, runtime); err != nil {
+			if isAlreadyExistsError(err) {
+				if r.adoptExisting {
+					return nil
+				}
+				return newAlreadyExistsError(err)
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(enableTrustedService); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Trusted Service.",
+			err.Error(),
+		)
+		return
+	}
+
+	trustedService, err := r.findTrustedService(plan.ServicePrincipal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Trusted Services.",
+			err.Error(),
+		)
+		return
+	}
+	plan.EnableTime = types.StringValue("")
+	if trustedService != nil {
+		plan.EnableTime = types.StringValue(tea.StringValue(trustedService.EnableTime))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the trusted service, which is removed from the state if the trusted
+// access is disabled.
+func (r *resourceManagerTrustedServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *resourceManagerTrustedServiceModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trustedService, err := r.findTrustedService(state.ServicePrincipal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Trusted Services.",
+			err.Error(),
+		)
+		return
+	}
+	if trustedService == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.EnableTime = types.StringValue(tea.StringValue(trustedService.EnableTime))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update does nothing as all the attributes require replacement.
+func (r *resourceManagerTrustedServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *resourceManagerTrustedServiceModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the trusted access of the service.
+func (r *resourceManagerTrustedServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resourceManagerTrustedServiceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disableTrustedService := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		disableTrustedServiceRequest := &alicloudResourceManagerClient.DisableTrustedServiceRequest{
+			ServicePrincipal: tea.String(state.ServicePrincipal.ValueString()),
+		}
+
+		if _, err := r.client.DisableTrustedServiceWithOptions(disableTrustedServiceRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && strings.HasPrefix(tea.StringValue(_t.Code), "EntityNotExists") {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(disableTrustedService); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Trusted Service.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the trusted service by the service principal.
+func (r *resourceManagerTrustedServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("service_principal"), req, resp)
+}
+
+// Function to find the enabled trusted service, returns nil if the trusted
+// access of the service is not enabled.
+func (r *resourceManagerTrustedServiceResource) findTrustedService(servicePrincipal string) (*alicloudResourceManagerClient.ListTrustedServiceStatusResponseBodyEnabledServicePrincipalsEnabledServicePrincipal, error) {
+	pageNumber := int32(1)
+	pageSize := int32(100)
+	for {
+		var listTrustedServiceStatusResponse *alicloudResourceManagerClient.ListTrustedServiceStatusResponse
+		listTrustedServiceStatus := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listTrustedServiceStatusRequest := &alicloudResourceManagerClient.ListTrustedServiceStatusRequest{
+				PageNumber: tea.Int32(pageNumber),
+				PageSize:   tea.Int32(pageSize),
+			}
+
+			var err error
+			listTrustedServiceStatusResponse, err = r.client.ListTrustedServiceStatusWithOptions(listTrustedServiceStatusRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listTrustedServiceStatus); err != nil {
+			return nil, err
+		}
+
+		body := listTrustedServiceStatusResponse.Body
+		if body.EnabledServicePrincipals != nil {
+			for _, service := range body.EnabledServicePrincipals.EnabledServicePrincipal {
+				if tea.StringValue(service.ServicePrincipal) == servicePrincipal {
+					return service, nil
+				}
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return nil, nil
+}
@@ -0,0 +1,485 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudRosClient "github.com/alibabacloud-go/ros-20190910/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                     = &rosStackResource{}
+	_ resource.ResourceWithConfigure        = &rosStackResource{}
+	_ resource.ResourceWithConfigValidators = &rosStackResource{}
+	_ resource.ResourceWithImportState      = &rosStackResource{}
+)
+
+func NewRosStackResource() resource.Resource {
+	return &rosStackResource{}
+}
+
+type rosStackResource struct {
+	client *alicloudRosClient.Client
+}
+
+type rosStackModel struct {
+	Id               types.String `tfsdk:"id"`
+	StackName        types.String `tfsdk:"stack_name"`
+	TemplateBody     types.String `tfsdk:"template_body"`
+	TemplateUrl      types.String `tfsdk:"template_url"`
+	Parameters       types.Map    `tfsdk:"parameters"`
+	TimeoutInMinutes types.Int64  `tfsdk:"timeout_in_minutes"`
+	DisableRollback  types.Bool   `tfsdk:"disable_rollback"`
+	Status           types.String `tfsdk:"status"`
+	Outputs          types.Map    `tfsdk:"outputs"`
+}
+
+// Metadata returns the ROS Stack resource name.
+func (r *rosStackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ros_stack"
+}
+
+// Schema defines the schema for the ROS Stack resource.
+func (r *rosStackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a Resource Orchestration Service (ROS) stack, so that the legacy ROS templates can " +
+			"be driven by Terraform during the migration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the stack.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name of the stack.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_body": schema.StringAttribute{
+				Description: "The content of the template in JSON or YAML. Conflicts with `template_url`.",
+				Optional:    true,
+			},
+			"template_url": schema.StringAttribute{
+				Description: "The URL of the template, e.g. an OSS object URL. Conflicts with `template_body`.",
+				Optional:    true,
+			},
+			"parameters": schema.MapAttribute{
+				Description: "The parameters of the template.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"timeout_in_minutes": schema.Int64Attribute{
+				Description: "The timeout of creating or updating the stack in minutes. Default to 60.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 1440),
+				},
+			},
+			"disable_rollback": schema.BoolAttribute{
+				Description: "Whether to disable the rollback when the stack fails to be created or updated. " +
+					"Default to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the stack.",
+				Computed:    true,
+			},
+			"outputs": schema.MapAttribute{
+				Description: "The outputs of the stack, the values which are not strings are encoded in JSON.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *rosStackResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("template_body"),
+			path.MatchRoot("template_url"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rosStackResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).rosClient
+}
+
+// Create a new ROS stack and wait until it is created.
+func (r *rosStackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *rosStackModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := map[string]string{}
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createStack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createStackRequest := &alicloudRosClient.CreateStackRequest{
+			RegionId:         r.client.RegionId,
+			StackName:        tea.String(plan.StackName.ValueString()),
+			TimeoutInMinutes: tea.Int64(plan.TimeoutInMinutes.ValueInt64()),
+			DisableRollback:  tea.Bool(plan.DisableRollback.ValueBool()),
+		}
+		if !plan.TemplateBody.IsNull() {
+			createStackRequest.TemplateBody = tea.String(plan.TemplateBody.ValueString())
+		}
+		if !plan.TemplateUrl.IsNull() {
+			createStackRequest.TemplateURL = tea.String(plan.TemplateUrl.ValueString())
+		}
+		for key, value := range parameters {
+			createStackRequest.Parameters = append(createStackRequest.Parameters, &alicloudRosClient.CreateStackRequestParameters{
+				ParameterKey:   tea.String(key),
+				ParameterValue: tea.String(value),
+			})
+		}
+
+		createStackResponse, err := r.client.CreateStackWithOptions(createStackRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		plan.Id = types.StringValue(tea.StringValue(createStackResponse.Body.StackId))
+		return nil
+	}
+
+	if err := retryAPICall(createStack); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create ROS Stack.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the stack ID first, so that the stack is not leaked if it fails to
+	// be created.
+	setStateDiags := resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.waitStack(plan, "CREATE_COMPLETE"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for ROS Stack to be Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the ROS stack.
+func (r *rosStackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *rosStackModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stack, err := r.getStack(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get ROS Stack.",
+			err.Error(),
+		)
+		return
+	}
+	if stack == nil || tea.StringValue(stack.Status) == "DELETE_COMPLETE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only refresh the parameters managed in the state, as the stack
+	// parameters include the pseudo parameters and the parameters with
+	// default values in the template. All the parameters except the pseudo
+	// parameters are refreshed when the stack is imported.
+	stateParameters := map[string]string{}
+	resp.Diagnostics.Append(state.Parameters.ElementsAs(ctx, &stateParameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	imported := state.StackName.IsNull()
+	parameters := map[string]string{}
+	for _, parameter := range stack.Parameters {
+		key := tea.StringValue(parameter.ParameterKey)
+		if _, ok := stateParameters[key]; ok || (imported && !strings.HasPrefix(key, "ALIYUN::")) {
+			parameters[key] = tea.StringValue(parameter.ParameterValue)
+		}
+	}
+	if !state.Parameters.IsNull() || len(parameters) > 0 {
+		state.Parameters = convertStringMapToMapValue(parameters)
+	}
+
+	state.StackName = types.StringValue(tea.StringValue(stack.StackName))
+	state.DisableRollback = types.BoolValue(tea.BoolValue(stack.DisableRollback))
+	if stack.TimeoutInMinutes != nil {
+		state.TimeoutInMinutes = types.Int64Value(int64(tea.Int32Value(stack.TimeoutInMinutes)))
+	}
+	if state.TimeoutInMinutes.IsNull() {
+		state.TimeoutInMinutes = types.Int64Value(60)
+	}
+	setRosStackStatus(state, stack)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the ROS stack and wait until it is updated.
+func (r *rosStackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *rosStackModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	parameters := map[string]string{}
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated := true
+	updateStack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateStackRequest := &alicloudRosClient.UpdateStackRequest{
+			RegionId:         r.client.RegionId,
+			StackId:          tea.String(plan.Id.ValueString()),
+			TimeoutInMinutes: tea.Int64(plan.TimeoutInMinutes.ValueInt64()),
+			DisableRollback:  tea.Bool(plan.DisableRollback.ValueBool()),
+		}
+		if !plan.TemplateBody.IsNull() {
+			updateStackRequest.TemplateBody = tea.String(plan.TemplateBody.ValueString())
+		}
+		if !plan.TemplateUrl.IsNull() {
+			updateStackRequest.TemplateURL = tea.String(plan.TemplateUrl.ValueString())
+		}
+		for key, value := range parameters {
+			updateStackRequest.Parameters = append(updateStackRequest.Parameters, &alicloudRosClient.UpdateStackRequestParameters{
+				ParameterKey:   tea.String(key),
+				ParameterValue: tea.String(value),
+			})
+		}
+
+		if _, err := r.client.UpdateStackWithOptions(updateStackRequest, runtime); err != nil {
+			// ROS rejects the update if neither the template nor the
+			// parameters are changed, e.g. only the timeout is changed.
+			if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Message), "completely same") {
+				updated = false
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateStack); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update ROS Stack.",
+			err.Error(),
+		)
+		return
+	}
+
+	if updated {
+		if err := r.waitStack(plan, "UPDATE_COMPLETE"); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Wait for ROS Stack to be Updated.",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		plan.Status = state.Status
+		plan.Outputs = state.Outputs
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the ROS stack and wait until it is deleted.
+func (r *rosStackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *rosStackModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteStack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteStackRequest := &alicloudRosClient.DeleteStackRequest{
+			RegionId: r.client.RegionId,
+			StackId:  tea.String(state.Id.ValueString()),
+		}
+
+		if _, err := r.client.DeleteStackWithOptions(deleteStackRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "StackNotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteStack); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete ROS Stack.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.waitStack(state, "DELETE_COMPLETE"); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for ROS Stack to be Deleted.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *rosStackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Function to get the ROS stack, returns nil if the stack is not found.
+func (r *rosStackResource) getStack(stackId string) (*alicloudRosClient.GetStackResponseBody, error) {
+	var stack *alicloudRosClient.GetStackResponseBody
+	getStack := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getStackRequest := &alicloudRosClient.GetStackRequest{
+			RegionId: r.client.RegionId,
+			StackId:  tea.String(stackId),
+		}
+
+		getStackResponse, err := r.client.GetStackWithOptions(getStackRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "StackNotFound" {
+				stack = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		stack = getStackResponse.Body
+		return nil
+	}
+
+	if err := retryAPICall(getStack); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// Function to wait until the ROS stack reaches the expected status, the
+// stack failed or rolled back is returned as error with the status reason.
+func (r *rosStackResource) waitStack(model *rosStackModel, expectedStatus string) error {
+	waitStack := func() error {
+		stack, err := r.getStack(model.Id.ValueString())
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if stack == nil {
+			if expectedStatus == "DELETE_COMPLETE" {
+				return nil
+			}
+			return backoff.Permanent(fmt.Errorf("ROS stack %s is not found", model.Id.ValueString()))
+		}
+
+		status := tea.StringValue(stack.Status)
+		switch {
+		case status == expectedStatus:
+			setRosStackStatus(model, stack)
+			return nil
+		case strings.HasSuffix(status, "_FAILED") || strings.Contains(status, "ROLLBACK"):
+			return backoff.Permanent(fmt.Errorf("ROS stack %s is %s: %s",
+				model.Id.ValueString(), status, tea.StringValue(stack.StatusReason)))
+		}
+		return fmt.Errorf("ROS stack %s is still %s", model.Id.ValueString(), status)
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxInterval = 30 * time.Second
+	reconnectBackoff.MaxElapsedTime = time.Duration(model.TimeoutInMinutes.ValueInt64()+5) * time.Minute
+	return backoff.Retry(waitStack, reconnectBackoff)
+}
+
+func setRosStackStatus(model *rosStackModel, stack *alicloudRosClient.GetStackResponseBody) {
+	outputs := map[string]string{}
+	for _, output := range stack.Outputs {
+		key, _ := output["OutputKey"].(string)
+		switch value := output["OutputValue"].(type) {
+		case string:
+			outputs[key] = value
+		default:
+			encoded, _ := json.Marshal(value)
+			outputs[key] = string(encoded)
+		}
+	}
+
+	model.Status = types.StringValue(tea.StringValue(stack.Status))
+	model.Outputs = convertStringMapToMapValue(outputs)
+}
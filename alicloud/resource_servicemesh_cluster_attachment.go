@@ -0,0 +1,308 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudServicemeshClient "github.com/alibabacloud-go/servicemesh-20200111/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &servicemeshClusterAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &servicemeshClusterAttachmentResource{}
+	_ resource.ResourceWithImportState = &servicemeshClusterAttachmentResource{}
+)
+
+func NewServicemeshClusterAttachmentResource() resource.Resource {
+	return &servicemeshClusterAttachmentResource{}
+}
+
+type servicemeshClusterAttachmentResource struct {
+	client *alicloudServicemeshClient.Client
+}
+
+type servicemeshClusterAttachmentModel struct {
+	ServiceMeshId        types.String `tfsdk:"service_mesh_id"`
+	ClusterId            types.String `tfsdk:"cluster_id"`
+	IgnoreNamespaceCheck types.Bool   `tfsdk:"ignore_namespace_check"`
+	ReserveNamespace     types.Bool   `tfsdk:"reserve_namespace"`
+	State                types.String `tfsdk:"state"`
+}
+
+// Metadata returns the Service Mesh Cluster Attachment resource name.
+func (r *servicemeshClusterAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_mesh_cluster_attachment"
+}
+
+// Schema defines the schema for the Service Mesh Cluster Attachment resource.
+func (r *servicemeshClusterAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Add an ACK cluster into an existing service mesh (ASM).",
+		Attributes: map[string]schema.Attribute{
+			"service_mesh_id": schema.StringAttribute{
+				Description: "The ID of the service mesh.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the ACK cluster to be added into the service mesh.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_namespace_check": schema.BoolAttribute{
+				Description: "Whether to ignore the check of the istio-system namespace in the cluster when adding the cluster.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"reserve_namespace": schema.BoolAttribute{
+				Description: "Whether to reserve the istio-system namespace in the cluster when removing the cluster from the service mesh.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the cluster in the service mesh.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *servicemeshClusterAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).servicemeshClient
+}
+
+// Add the cluster into the service mesh and wait until it is running.
+func (r *servicemeshClusterAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *servicemeshClusterAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addClusterIntoServiceMesh := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addClusterIntoServiceMeshRequest := &alicloudServicemeshClient.AddClusterIntoServiceMeshRequest{
+			ServiceMeshId: tea.String(plan.ServiceMeshId.ValueString()),
+			ClusterId:     tea.String(plan.ClusterId.ValueString()),
+		}
+		if !plan.IgnoreNamespaceCheck.IsNull() {
+			addClusterIntoServiceMeshRequest.IgnoreNamespaceCheck = tea.Bool(plan.IgnoreNamespaceCheck.ValueBool())
+		}
+
+		if _, err := r.client.AddClusterIntoServiceMeshWithOptions(addClusterIntoServiceMeshRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(addClusterIntoServiceMesh)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Add Cluster into Service Mesh.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Adding a cluster into the service mesh is asynchronous, wait for the
+	// integration of the cluster to be finished.
+	waitForClusterRunning := func() error {
+		cluster, err := r.describeClusterInServiceMesh(plan.ServiceMeshId.ValueString(), plan.ClusterId.ValueString())
+		if err != nil {
+			return err
+		}
+		if cluster == nil {
+			return fmt.Errorf("cluster %s is not found in service mesh %s", plan.ClusterId.ValueString(), plan.ServiceMeshId.ValueString())
+		}
+
+		switch tea.StringValue(cluster.State) {
+		case "running":
+			plan.State = types.StringValue(tea.StringValue(cluster.State))
+			return nil
+		case "failed":
+			return backoff.Permanent(fmt.Errorf("failed to add cluster into service mesh: %s", tea.StringValue(cluster.ErrorMessage)))
+		default:
+			return fmt.Errorf("cluster is still in %s state", tea.StringValue(cluster.State))
+		}
+	}
+
+	waitBackoff := backoff.NewExponentialBackOff()
+	waitBackoff.MaxInterval = 30 * time.Second
+	waitBackoff.MaxElapsedTime = 15 * time.Minute
+	err = backoff.Retry(waitForClusterRunning, waitBackoff)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait for Cluster to be Added into Service Mesh.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the state of the cluster in the service mesh.
+func (r *servicemeshClusterAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *servicemeshClusterAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.describeClusterInServiceMesh(state.ServiceMeshId.ValueString(), state.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Clusters in Service Mesh.",
+			err.Error(),
+		)
+		return
+	}
+
+	if cluster == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.State = types.StringValue(tea.StringValue(cluster.State))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only saves the reserve_namespace flag, which is used when removing
+// the cluster from the service mesh.
+func (r *servicemeshClusterAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *servicemeshClusterAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.State = state.State
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Remove the cluster from the service mesh.
+func (r *servicemeshClusterAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *servicemeshClusterAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removeClusterFromServiceMesh := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		removeClusterFromServiceMeshRequest := &alicloudServicemeshClient.RemoveClusterFromServiceMeshRequest{
+			ServiceMeshId: tea.String(state.ServiceMeshId.ValueString()),
+			ClusterId:     tea.String(state.ClusterId.ValueString()),
+		}
+		if !state.ReserveNamespace.IsNull() {
+			removeClusterFromServiceMeshRequest.ReserveNamespace = tea.Bool(state.ReserveNamespace.ValueBool())
+		}
+
+		if _, err := r.client.RemoveClusterFromServiceMeshWithOptions(removeClusterFromServiceMeshRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(removeClusterFromServiceMesh)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Remove Cluster from Service Mesh.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the attachment by the ID in the format of
+// <service_mesh_id>:<cluster_id>.
+func (r *servicemeshClusterAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_mesh_id>:<cluster_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_mesh_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), ids[1])...)
+}
+
+// Function to find the cluster in the service mesh, returns nil if the
+// cluster is not in the service mesh.
+func (r *servicemeshClusterAttachmentResource) describeClusterInServiceMesh(serviceMeshId, clusterId string) (*alicloudServicemeshClient.DescribeClustersInServiceMeshResponseBodyClusters, error) {
+	var describeClustersInServiceMeshResponse *alicloudServicemeshClient.DescribeClustersInServiceMeshResponse
+	describeClustersInServiceMesh := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeClustersInServiceMeshRequest := &alicloudServicemeshClient.DescribeClustersInServiceMeshRequest{
+			ServiceMeshId: tea.String(serviceMeshId),
+		}
+
+		var err error
+		describeClustersInServiceMeshResponse, err = r.client.DescribeClustersInServiceMeshWithOptions(describeClustersInServiceMeshRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeClustersInServiceMesh); err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range describeClustersInServiceMeshResponse.Body.Clusters {
+		if tea.StringValue(cluster.ClusterId) == clusterId {
+			return cluster, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,526 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudServicemeshClient "github.com/alibabacloud-go/servicemesh-20200111/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &servicemeshGatewayResource{}
+	_ resource.ResourceWithConfigure   = &servicemeshGatewayResource{}
+	_ resource.ResourceWithImportState = &servicemeshGatewayResource{}
+)
+
+// The gateway is an IstioGateway custom resource in the control plane of the
+// service mesh, which is read and deleted through the Kubernetes API of the
+// control plane as AliCloud API does not support querying and deleting it.
+const (
+	servicemeshGatewayApiVersion = "istio.alibabacloud.com/v1beta1"
+	servicemeshGatewayKind       = "IstioGateway"
+	servicemeshGatewayNamespace  = "istio-system"
+	servicemeshGatewayLbSpecKey  = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec"
+)
+
+func NewServicemeshGatewayResource() resource.Resource {
+	return &servicemeshGatewayResource{}
+}
+
+type servicemeshGatewayResource struct {
+	client *alicloudServicemeshClient.Client
+}
+
+type servicemeshGatewayModel struct {
+	ServiceMeshId       types.String               `tfsdk:"service_mesh_id"`
+	Name                types.String               `tfsdk:"name"`
+	ClusterId           types.String               `tfsdk:"cluster_id"`
+	GatewayType         types.String               `tfsdk:"gateway_type"`
+	ReplicaCount        types.Int64                `tfsdk:"replica_count"`
+	ServiceType         types.String               `tfsdk:"service_type"`
+	LoadBalancerSpec    types.String               `tfsdk:"load_balancer_spec"`
+	AutoScalingEnabled  types.Bool                 `tfsdk:"auto_scaling_enabled"`
+	AutoScalingMinCount types.Int64                `tfsdk:"auto_scaling_min_replicas"`
+	AutoScalingMaxCount types.Int64                `tfsdk:"auto_scaling_max_replicas"`
+	Ports               []*servicemeshGatewayPorts `tfsdk:"ports"`
+}
+
+type servicemeshGatewayPorts struct {
+	Name       types.String `tfsdk:"name"`
+	Port       types.Int64  `tfsdk:"port"`
+	TargetPort types.Int64  `tfsdk:"target_port"`
+	Protocol   types.String `tfsdk:"protocol"`
+}
+
+// Metadata returns the Service Mesh Gateway resource name.
+func (r *servicemeshGatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_mesh_gateway"
+}
+
+// Schema defines the schema for the Service Mesh Gateway resource.
+func (r *servicemeshGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides an ingress or egress gateway of a service mesh (ASM). The gateway is read and " +
+			"deleted as the IstioGateway custom resource in the control plane of the service mesh.",
+		Attributes: map[string]schema.Attribute{
+			"service_mesh_id": schema.StringAttribute{
+				Description: "The ID of the service mesh.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the gateway.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the ACK cluster in the service mesh that the gateway is deployed to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway_type": schema.StringAttribute{
+				Description: "The type of the gateway. Valid values: `ingress`, `egress`. Default to `ingress`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ingress"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ingress", "egress"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replica_count": schema.Int64Attribute{
+				Description: "The number of replicas of the gateway. Default to 2.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2),
+			},
+			"service_type": schema.StringAttribute{
+				Description: "The type of the kubernetes service of the gateway. Valid values: `LoadBalancer`, `NodePort`, `ClusterIP`. Default to `LoadBalancer`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("LoadBalancer"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("LoadBalancer", "NodePort", "ClusterIP"),
+				},
+			},
+			"load_balancer_spec": schema.StringAttribute{
+				Description: "The specification of the CLB created for the gateway, e.g. slb.s1.small. Only applicable when service_type is `LoadBalancer`.",
+				Optional:    true,
+			},
+			"auto_scaling_enabled": schema.BoolAttribute{
+				Description: "Whether to enable the horizontal pod auto scaling of the gateway. Default to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"auto_scaling_min_replicas": schema.Int64Attribute{
+				Description: "The minimum number of replicas when auto scaling is enabled.",
+				Optional:    true,
+			},
+			"auto_scaling_max_replicas": schema.Int64Attribute{
+				Description: "The maximum number of replicas when auto scaling is enabled.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ports": schema.ListNestedBlock{
+				Description: "The ports exposed by the gateway.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the port.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The service port of the gateway.",
+							Required:    true,
+						},
+						"target_port": schema.Int64Attribute{
+							Description: "The container port of the gateway.",
+							Required:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "The protocol of the port. Valid values: `TCP`, `UDP`. Default to `TCP`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("TCP", "UDP"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *servicemeshGatewayResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).servicemeshClient
+}
+
+// Create a new gateway in the service mesh.
+func (r *servicemeshGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *servicemeshGatewayModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := convertServicemeshGatewayToJsonString(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to convert the gateway to a json string.",
+			err.Error(),
+		)
+		return
+	}
+
+	createASMGateway := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createASMGatewayRequest := &alicloudServicemeshClient.CreateASMGatewayRequest{
+			ServiceMeshId:    tea.String(plan.ServiceMeshId.ValueString()),
+			IstioGatewayName: tea.String(plan.Name.ValueString()),
+			Body:             tea.String(body),
+		}
+
+		if _, err := r.client.CreateASMGatewayWithOptions(createASMGatewayRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err = retryAPICall(createASMGateway)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Service Mesh Gateway.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the IstioGateway of the gateway from the control plane, the gateway is
+// removed from state if it is not found.
+func (r *servicemeshGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *servicemeshGatewayModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeClient, err := r.newKubeClient(state.ServiceMeshId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Service Mesh Kubeconfig.",
+			err.Error(),
+		)
+		return
+	}
+
+	object, err := kubeClient.get(servicemeshGatewayKind, servicemeshGatewayNamespace, state.Name.ValueString(), servicemeshGatewayApiVersion)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Service Mesh Gateway.",
+			err.Error(),
+		)
+		return
+	}
+	if object == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	spec, _ := object["spec"].(map[string]interface{})
+	readServicemeshGatewaySpec(state, spec)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the gateway in the service mesh.
+func (r *servicemeshGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *servicemeshGatewayModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := convertServicemeshGatewayToJsonString(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to convert the gateway to a json string.",
+			err.Error(),
+		)
+		return
+	}
+
+	updateASMGateway := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateASMGatewayRequest := &alicloudServicemeshClient.UpdateASMGatewayRequest{
+			ServiceMeshId:    tea.String(plan.ServiceMeshId.ValueString()),
+			IstioGatewayName: tea.String(plan.Name.ValueString()),
+			Body:             tea.String(body),
+		}
+
+		if _, err := r.client.UpdateASMGatewayWithOptions(updateASMGatewayRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err = retryAPICall(updateASMGateway)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Service Mesh Gateway.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the IstioGateway of the gateway from the control plane, which
+// deletes the gateway and its load balancer.
+func (r *servicemeshGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *servicemeshGatewayModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeClient, err := r.newKubeClient(state.ServiceMeshId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Service Mesh Kubeconfig.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := kubeClient.delete(convertServicemeshGatewayToManifest(state)); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Service Mesh Gateway.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the gateway by the ID in the format of
+// <service_mesh_id>:<name>.
+func (r *servicemeshGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_mesh_id>:<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_mesh_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), ids[1])...)
+}
+
+// Function to create the client of the control plane of the service mesh
+// from its kubeconfig.
+func (r *servicemeshGatewayResource) newKubeClient(serviceMeshId string) (*servicemeshKubeClient, error) {
+	var kubeconfig string
+	describeServiceMeshKubeconfig := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeServiceMeshKubeconfigRequest := &alicloudServicemeshClient.DescribeServiceMeshKubeconfigRequest{
+			ServiceMeshId:    tea.String(serviceMeshId),
+			PrivateIpAddress: tea.Bool(false),
+		}
+
+		describeServiceMeshKubeconfigResponse, err := r.client.DescribeServiceMeshKubeconfigWithOptions(describeServiceMeshKubeconfigRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		kubeconfig = tea.StringValue(describeServiceMeshKubeconfigResponse.Body.Kubeconfig)
+		return nil
+	}
+
+	if err := retryAPICall(describeServiceMeshKubeconfig); err != nil {
+		return nil, err
+	}
+	return newServicemeshKubeClient(kubeconfig)
+}
+
+// Function to read the spec of the IstioGateway into the model, so that the
+// drift of the gateway is detected. The optional attributes which are not
+// set in the spec are kept as null.
+func readServicemeshGatewaySpec(model *servicemeshGatewayModel, spec map[string]interface{}) {
+	if clusterIds, _ := spec["clusterIds"].([]interface{}); len(clusterIds) > 0 {
+		model.ClusterId = types.StringValue(fmt.Sprint(clusterIds[0]))
+	}
+	if gatewayType, ok := spec["gatewayType"].(string); ok {
+		model.GatewayType = types.StringValue(gatewayType)
+	}
+	if replicaCount, ok := spec["replicaCount"].(float64); ok {
+		model.ReplicaCount = types.Int64Value(int64(replicaCount))
+	}
+	if serviceType, ok := spec["serviceType"].(string); ok {
+		model.ServiceType = types.StringValue(serviceType)
+	}
+
+	serviceAnnotations, _ := spec["serviceAnnotations"].(map[string]interface{})
+	if loadBalancerSpec, ok := serviceAnnotations[servicemeshGatewayLbSpecKey].(string); ok {
+		model.LoadBalancerSpec = types.StringValue(loadBalancerSpec)
+	} else {
+		model.LoadBalancerSpec = types.StringNull()
+	}
+
+	autoScaling, _ := spec["autoScalingConfig"].(map[string]interface{})
+	enabled, _ := autoScaling["enabled"].(bool)
+	model.AutoScalingEnabled = types.BoolValue(enabled)
+	model.AutoScalingMinCount = types.Int64Null()
+	model.AutoScalingMaxCount = types.Int64Null()
+	if enabled {
+		if minReplicas, ok := autoScaling["minReplicas"].(float64); ok {
+			model.AutoScalingMinCount = types.Int64Value(int64(minReplicas))
+		}
+		if maxReplicas, ok := autoScaling["maxReplicas"].(float64); ok {
+			model.AutoScalingMaxCount = types.Int64Value(int64(maxReplicas))
+		}
+	}
+
+	// The protocol is kept as null if it is the default TCP and not set.
+	prevProtocols := map[string]types.String{}
+	for _, port := range model.Ports {
+		prevProtocols[port.Name.ValueString()] = port.Protocol
+	}
+	specPorts, _ := spec["ports"].([]interface{})
+	ports := []*servicemeshGatewayPorts{}
+	for _, specPort := range specPorts {
+		portMap, _ := specPort.(map[string]interface{})
+		name, _ := portMap["name"].(string)
+		port, _ := portMap["port"].(float64)
+		targetPort, _ := portMap["targetPort"].(float64)
+		protocol, _ := portMap["protocol"].(string)
+
+		gatewayPort := &servicemeshGatewayPorts{
+			Name:       types.StringValue(name),
+			Port:       types.Int64Value(int64(port)),
+			TargetPort: types.Int64Value(int64(targetPort)),
+			Protocol:   types.StringValue(protocol),
+		}
+		if prevProtocol, ok := prevProtocols[name]; (!ok || prevProtocol.IsNull()) && (protocol == "" || protocol == "TCP") {
+			gatewayPort.Protocol = types.StringNull()
+		}
+		ports = append(ports, gatewayPort)
+	}
+	model.Ports = ports
+}
+
+// Convert the gateway to the IstioGateway JSON body accepted by AliCloud API.
+func convertServicemeshGatewayToJsonString(model *servicemeshGatewayModel) (string, error) {
+	bodyJson, err := json.Marshal(convertServicemeshGatewayToManifest(model))
+	if err != nil {
+		return "", err
+	}
+	return string(bodyJson), nil
+}
+
+// Convert the gateway to the IstioGateway custom resource.
+func convertServicemeshGatewayToManifest(model *servicemeshGatewayModel) map[string]interface{} {
+	ports := []map[string]interface{}{}
+	for _, port := range model.Ports {
+		protocol := "TCP"
+		if !port.Protocol.IsNull() {
+			protocol = port.Protocol.ValueString()
+		}
+		ports = append(ports, map[string]interface{}{
+			"name":       port.Name.ValueString(),
+			"port":       port.Port.ValueInt64(),
+			"targetPort": port.TargetPort.ValueInt64(),
+			"protocol":   protocol,
+		})
+	}
+
+	spec := map[string]interface{}{
+		"clusterIds":   []string{model.ClusterId.ValueString()},
+		"gatewayType":  model.GatewayType.ValueString(),
+		"replicaCount": model.ReplicaCount.ValueInt64(),
+		"serviceType":  model.ServiceType.ValueString(),
+		"ports":        ports,
+	}
+
+	if !model.LoadBalancerSpec.IsNull() {
+		spec["serviceAnnotations"] = map[string]string{
+			servicemeshGatewayLbSpecKey: model.LoadBalancerSpec.ValueString(),
+		}
+	}
+
+	if model.AutoScalingEnabled.ValueBool() {
+		autoScaling := map[string]interface{}{
+			"enabled": true,
+		}
+		if !model.AutoScalingMinCount.IsNull() {
+			autoScaling["minReplicas"] = model.AutoScalingMinCount.ValueInt64()
+		}
+		if !model.AutoScalingMaxCount.IsNull() {
+			autoScaling["maxReplicas"] = model.AutoScalingMaxCount.ValueInt64()
+		}
+		spec["autoScalingConfig"] = autoScaling
+	}
+
+	return map[string]interface{}{
+		"apiVersion": servicemeshGatewayApiVersion,
+		"kind":       servicemeshGatewayKind,
+		"metadata": map[string]interface{}{
+			"name":      model.Name.ValueString(),
+			"namespace": servicemeshGatewayNamespace,
+		},
+		"spec": spec,
+	}
+}
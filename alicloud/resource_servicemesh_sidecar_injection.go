@@ -0,0 +1,254 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudServicemeshClient "github.com/alibabacloud-go/servicemesh-20200111/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                   = &servicemeshSidecarInjectionResource{}
+	_ resource.ResourceWithConfigure      = &servicemeshSidecarInjectionResource{}
+	_ resource.ResourceWithValidateConfig = &servicemeshSidecarInjectionResource{}
+)
+
+func NewServicemeshSidecarInjectionResource() resource.Resource {
+	return &servicemeshSidecarInjectionResource{}
+}
+
+type servicemeshSidecarInjectionResource struct {
+	client *alicloudServicemeshClient.Client
+}
+
+type servicemeshSidecarInjectionModel struct {
+	ServiceMeshId      types.String `tfsdk:"service_mesh_id"`
+	EnabledNamespaces  types.List   `tfsdk:"enabled_namespaces"`
+	DisabledNamespaces types.List   `tfsdk:"disabled_namespaces"`
+}
+
+// Metadata returns the Service Mesh Sidecar Injection resource name.
+func (r *servicemeshSidecarInjectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_mesh_sidecar_injection"
+}
+
+// Schema defines the schema for the Service Mesh Sidecar Injection resource.
+func (r *servicemeshSidecarInjectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable or disable the automatic sidecar injection of the namespaces in a service mesh (ASM), " +
+			"so that onboarding a namespace into the service mesh is declarative. The namespaces removed from " +
+			"`enabled_namespaces` and all the enabled namespaces when the resource is destroyed are disabled. " +
+			"AliCloud API does not support querying the sidecar injection of the namespaces, the changes made " +
+			"outside of Terraform are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"service_mesh_id": schema.StringAttribute{
+				Description: "The ID of the service mesh.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled_namespaces": schema.ListAttribute{
+				Description: "The namespaces that the sidecar injection is enabled.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"disabled_namespaces": schema.ListAttribute{
+				Description: "The namespaces that the sidecar injection is disabled.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that a namespace is not both enabled and disabled.
+func (r *servicemeshSidecarInjectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *servicemeshSidecarInjectionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.EnabledNamespaces.IsUnknown() || config.DisabledNamespaces.IsUnknown() {
+		return
+	}
+
+	enabledNamespaces := convertListValueToStrings(config.EnabledNamespaces)
+	disabledNamespaces := convertListValueToStrings(config.DisabledNamespaces)
+	for _, namespace := range convertStringsDifference(enabledNamespaces, convertStringsDifference(enabledNamespaces, disabledNamespaces)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disabled_namespaces"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("Namespace %s can not be both enabled and disabled.", namespace),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *servicemeshSidecarInjectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).servicemeshClient
+}
+
+// Enable or disable the sidecar injection of the namespaces.
+func (r *servicemeshSidecarInjectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *servicemeshSidecarInjectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateNamespaces(plan.ServiceMeshId.ValueString(), convertListValueToStrings(plan.EnabledNamespaces), true); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Sidecar Injection.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updateNamespaces(plan.ServiceMeshId.ValueString(), convertListValueToStrings(plan.DisabledNamespaces), false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Sidecar Injection.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does nothing as AliCloud API does not support querying the sidecar
+// injection of the namespaces.
+func (r *servicemeshSidecarInjectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *servicemeshSidecarInjectionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the sidecar injection of the changed namespaces only.
+func (r *servicemeshSidecarInjectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *servicemeshSidecarInjectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planEnabled := convertListValueToStrings(plan.EnabledNamespaces)
+	planDisabled := convertListValueToStrings(plan.DisabledNamespaces)
+	stateEnabled := convertListValueToStrings(state.EnabledNamespaces)
+	stateDisabled := convertListValueToStrings(state.DisabledNamespaces)
+
+	// The namespaces removed from enabled_namespaces are disabled, unless
+	// they are already added into disabled_namespaces.
+	toEnable := convertStringsDifference(planEnabled, stateEnabled)
+	toDisable := convertStringsDifference(planDisabled, stateDisabled)
+	toDisable = append(toDisable, convertStringsDifference(convertStringsDifference(stateEnabled, planEnabled), toDisable)...)
+
+	if err := r.updateNamespaces(plan.ServiceMeshId.ValueString(), toEnable, true); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Enable Sidecar Injection.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.updateNamespaces(plan.ServiceMeshId.ValueString(), toDisable, false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Sidecar Injection.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the sidecar injection of the enabled namespaces. The sidecars
+// injected into the running pods are removed after the pods are restarted.
+func (r *servicemeshSidecarInjectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *servicemeshSidecarInjectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateNamespaces(state.ServiceMeshId.ValueString(), convertListValueToStrings(state.EnabledNamespaces), false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Disable Sidecar Injection.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Function to enable or disable the sidecar injection of the namespaces one by
+// one.
+func (r *servicemeshSidecarInjectionResource) updateNamespaces(serviceMeshId string, namespaces []string, enabled bool) error {
+	for _, namespace := range namespaces {
+		updateIstioInjectionConfig := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateIstioInjectionConfigRequest := &alicloudServicemeshClient.UpdateIstioInjectionConfigRequest{
+				ServiceMeshId:        tea.String(serviceMeshId),
+				Namespace:            tea.String(namespace),
+				EnableIstioInjection: tea.Bool(enabled),
+			}
+
+			if _, err := r.client.UpdateIstioInjectionConfigWithOptions(updateIstioInjectionConfigRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateIstioInjectionConfig); err != nil {
+			return fmt.Errorf("namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
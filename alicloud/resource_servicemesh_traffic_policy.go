@@ -0,0 +1,619 @@
+package alicloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudServicemeshClient "github.com/alibabacloud-go/servicemesh-20200111/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &servicemeshTrafficPolicyResource{}
+	_ resource.ResourceWithConfigure   = &servicemeshTrafficPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &servicemeshTrafficPolicyResource{}
+	_ resource.ResourceWithImportState = &servicemeshTrafficPolicyResource{}
+)
+
+// The field manager of the server-side apply, so that the fields applied by
+// Terraform are distinguished from the fields changed by the others.
+const servicemeshFieldManager = "terraform-provider-st-alicloud"
+
+func NewServicemeshTrafficPolicyResource() resource.Resource {
+	return &servicemeshTrafficPolicyResource{}
+}
+
+type servicemeshTrafficPolicyResource struct {
+	client *alicloudServicemeshClient.Client
+}
+
+type servicemeshTrafficPolicyModel struct {
+	ServiceMeshId types.String `tfsdk:"service_mesh_id"`
+	Manifest      types.String `tfsdk:"manifest"`
+	Kind          types.String `tfsdk:"kind"`
+	Namespace     types.String `tfsdk:"namespace"`
+	Name          types.String `tfsdk:"name"`
+}
+
+// Metadata returns the Service Mesh Traffic Policy resource name.
+func (r *servicemeshTrafficPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_mesh_traffic_policy"
+}
+
+// Schema defines the schema for the Service Mesh Traffic Policy resource.
+func (r *servicemeshTrafficPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Apply an Istio custom resource, e.g. VirtualService and DestinationRule, to the control " +
+			"plane of a service mesh (ASM), so that the routing of the mesh is versioned with the mesh itself. " +
+			"The manifest is validated by the control plane with a dry run when planning, and the changes of " +
+			"the spec made outside of Terraform are detected.",
+		Attributes: map[string]schema.Attribute{
+			"service_mesh_id": schema.StringAttribute{
+				Description: "The ID of the service mesh.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				Description: "The JSON manifest of the Istio custom resource, the apiVersion must be in the " +
+					"`*.istio.io` groups. The namespace is default to `default`. Changing the kind, namespace or " +
+					"name creates a new resource.",
+				Required: true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of the custom resource.",
+				Computed:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the custom resource.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the custom resource.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *servicemeshTrafficPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).servicemeshClient
+}
+
+// Apply the custom resource to the control plane.
+func (r *servicemeshTrafficPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *servicemeshTrafficPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(plan, false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Apply Istio Custom Resource.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the custom resource and detect the drift of the spec.
+func (r *servicemeshTrafficPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *servicemeshTrafficPolicyModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeClient, err := r.newKubeClient(state.ServiceMeshId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Service Mesh Kubeconfig.",
+			err.Error(),
+		)
+		return
+	}
+
+	// The manifest is only known from the control plane when importing.
+	manifest := map[string]interface{}{}
+	if !state.Manifest.IsNull() {
+		if manifest, err = parseServicemeshManifest(state.Manifest.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[ERROR] Invalid Istio Manifest.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	object, err := kubeClient.get(state.Kind.ValueString(), state.Namespace.ValueString(), state.Name.ValueString(), manifest["apiVersion"])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get Istio Custom Resource.",
+			err.Error(),
+		)
+		return
+	}
+	if object == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Manifest.IsNull() {
+		manifest = map[string]interface{}{
+			"apiVersion": object["apiVersion"],
+			"kind":       object["kind"],
+			"metadata": map[string]interface{}{
+				"name":      state.Name.ValueString(),
+				"namespace": state.Namespace.ValueString(),
+			},
+		}
+	}
+
+	// Only the spec is compared, as the metadata is filled with the
+	// generated fields by the control plane.
+	configuredSpec, _ := json.Marshal(manifest["spec"])
+	remoteSpec, _ := json.Marshal(object["spec"])
+	if state.Manifest.IsNull() || !isJsonStringEqual(string(configuredSpec), string(remoteSpec)) {
+		manifest["spec"] = object["spec"]
+		encoded, err := json.Marshal(manifest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[ERROR] Failed to Encode Istio Manifest.",
+				err.Error(),
+			)
+			return
+		}
+		state.Manifest = types.StringValue(string(encoded))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Apply the changed manifest to the control plane.
+func (r *servicemeshTrafficPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *servicemeshTrafficPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(plan, false); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Apply Istio Custom Resource.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the custom resource from the control plane.
+func (r *servicemeshTrafficPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *servicemeshTrafficPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := parseServicemeshManifest(state.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Invalid Istio Manifest.",
+			err.Error(),
+		)
+		return
+	}
+
+	kubeClient, err := r.newKubeClient(state.ServiceMeshId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Service Mesh Kubeconfig.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := kubeClient.delete(manifest); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Istio Custom Resource.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the custom resource by the ID in the format of
+// <service_mesh_id>:<kind>:<namespace>:<name>.
+func (r *servicemeshTrafficPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 4 || ids[0] == "" || ids[1] == "" || ids[2] == "" || ids[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_mesh_id>:<kind>:<namespace>:<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_mesh_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), ids[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), ids[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), ids[3])...)
+}
+
+// ModifyPlan sets the identity of the custom resource from the manifest,
+// requires replacement when the identity is changed, and validates the
+// changed manifest with a dry run on the control plane.
+func (r *servicemeshTrafficPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *servicemeshTrafficPolicyModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if !req.State.Raw.IsNull() {
+		getStateDiags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(getStateDiags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Manifest.IsUnknown() {
+		plan.Kind = types.StringUnknown()
+		plan.Namespace = types.StringUnknown()
+		plan.Name = types.StringUnknown()
+		setPlanDiags := resp.Plan.Set(ctx, &plan)
+		resp.Diagnostics.Append(setPlanDiags...)
+		return
+	}
+
+	manifest, err := parseServicemeshManifest(plan.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("manifest"),
+			"Invalid Istio Manifest",
+			err.Error(),
+		)
+		return
+	}
+	kind, namespace, name := servicemeshManifestIdentity(manifest)
+	plan.Kind = types.StringValue(kind)
+	plan.Namespace = types.StringValue(namespace)
+	plan.Name = types.StringValue(name)
+
+	if state != nil && (!plan.Kind.Equal(state.Kind) || !plan.Namespace.Equal(state.Namespace) || !plan.Name.Equal(state.Name)) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("manifest"))
+	}
+
+	setPlanDiags := resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(setPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ServiceMeshId.IsUnknown() || r.client == nil || (state != nil && plan.Manifest.Equal(state.Manifest)) {
+		return
+	}
+
+	if err := r.apply(plan, true); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("manifest"),
+			"Invalid Istio Manifest",
+			"The manifest is rejected by the control plane of the service mesh: "+err.Error(),
+		)
+	}
+}
+
+// Function to apply the manifest with the server-side apply, the manifest is
+// only validated by the control plane without being persisted if dryRun is
+// true.
+func (r *servicemeshTrafficPolicyResource) apply(model *servicemeshTrafficPolicyModel, dryRun bool) error {
+	manifest, err := parseServicemeshManifest(model.Manifest.ValueString())
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := r.newKubeClient(model.ServiceMeshId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if err := kubeClient.apply(manifest, dryRun); err != nil {
+		return err
+	}
+
+	kind, namespace, name := servicemeshManifestIdentity(manifest)
+	model.Kind = types.StringValue(kind)
+	model.Namespace = types.StringValue(namespace)
+	model.Name = types.StringValue(name)
+	return nil
+}
+
+// Function to create the client of the control plane of the service mesh
+// from its kubeconfig.
+func (r *servicemeshTrafficPolicyResource) newKubeClient(serviceMeshId string) (*servicemeshKubeClient, error) {
+	var kubeconfig string
+	describeServiceMeshKubeconfig := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeServiceMeshKubeconfigRequest := &alicloudServicemeshClient.DescribeServiceMeshKubeconfigRequest{
+			ServiceMeshId:    tea.String(serviceMeshId),
+			PrivateIpAddress: tea.Bool(false),
+		}
+
+		describeServiceMeshKubeconfigResponse, err := r.client.DescribeServiceMeshKubeconfigWithOptions(describeServiceMeshKubeconfigRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		kubeconfig = tea.StringValue(describeServiceMeshKubeconfigResponse.Body.Kubeconfig)
+		return nil
+	}
+
+	if err := retryAPICall(describeServiceMeshKubeconfig); err != nil {
+		return nil, err
+	}
+	return newServicemeshKubeClient(kubeconfig)
+}
+
+// Function to parse the JSON manifest of the Istio custom resource, the
+// namespace is default to "default".
+func parseServicemeshManifest(manifest string) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(manifest), &object); err != nil {
+		return nil, fmt.Errorf("the manifest is not a valid JSON object: %w", err)
+	}
+
+	apiVersion, _ := object["apiVersion"].(string)
+	if group := strings.Split(apiVersion, "/")[0]; !strings.HasSuffix(group, ".istio.io") || !strings.Contains(apiVersion, "/") {
+		return nil, fmt.Errorf("the apiVersion %q is not in the *.istio.io groups", apiVersion)
+	}
+	if kind, _ := object["kind"].(string); kind == "" {
+		return nil, fmt.Errorf("the kind is not specified")
+	}
+
+	metadata, _ := object["metadata"].(map[string]interface{})
+	if name, _ := metadata["name"].(string); name == "" {
+		return nil, fmt.Errorf("the metadata.name is not specified")
+	}
+	if namespace, _ := metadata["namespace"].(string); namespace == "" {
+		metadata["namespace"] = "default"
+	}
+	return object, nil
+}
+
+func servicemeshManifestIdentity(manifest map[string]interface{}) (kind, namespace, name string) {
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	kind, _ = manifest["kind"].(string)
+	namespace, _ = metadata["namespace"].(string)
+	name, _ = metadata["name"].(string)
+	return kind, namespace, name
+}
+
+// Client of the Kubernetes API of the control plane of the service mesh, only
+// the requests of the Istio custom resources are supported.
+type servicemeshKubeClient struct {
+	server     string
+	token      string
+	httpClient *http.Client
+}
+
+// Function to create the client from the kubeconfig returned by ASM, which
+// only has a single cluster and user. The kubeconfig is in YAML, the fields
+// are read line by line instead of parsing the whole YAML document.
+func newServicemeshKubeClient(kubeconfig string) (*servicemeshKubeClient, error) {
+	fields := map[string]string{}
+	for _, line := range strings.Split(kubeconfig, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), ":")
+		if !found {
+			continue
+		}
+		key = strings.TrimPrefix(key, "- ")
+		if _, ok := fields[key]; !ok {
+			fields[key] = strings.Trim(strings.TrimSpace(value), `"'`)
+		}
+	}
+
+	if fields["server"] == "" {
+		return nil, fmt.Errorf("the server is not found in the kubeconfig of the service mesh")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caData := fields["certificate-authority-data"]; caData != "" {
+		ca, err := base64.StdEncoding.DecodeString(caData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the certificate authority data: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		tlsConfig.RootCAs.AppendCertsFromPEM(ca)
+	}
+	if certData, keyData := fields["client-certificate-data"], fields["client-key-data"]; certData != "" && keyData != "" {
+		cert, err := base64.StdEncoding.DecodeString(certData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the client certificate data: %w", err)
+		}
+		key, err := base64.StdEncoding.DecodeString(keyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the client key data: %w", err)
+		}
+		keyPair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return &servicemeshKubeClient{
+		server: strings.TrimSuffix(fields["server"], "/"),
+		token:  fields["token"],
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// Function to apply the manifest with the server-side apply, the conflicts
+// with the other field managers are overridden.
+func (c *servicemeshKubeClient) apply(manifest map[string]interface{}, dryRun bool) error {
+	kind, namespace, name := servicemeshManifestIdentity(manifest)
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	query := "?fieldManager=" + servicemeshFieldManager + "&force=true"
+	if dryRun {
+		query += "&dryRun=All"
+	}
+
+	_, err = c.do(http.MethodPatch, servicemeshObjectPath(manifest["apiVersion"], kind, namespace, name)+query, body)
+	return err
+}
+
+// Function to get the custom resource, returns nil if it is not found. The
+// apiVersion is discovered from the Istio groups if it is unknown.
+func (c *servicemeshKubeClient) get(kind, namespace, name string, apiVersion interface{}) (map[string]interface{}, error) {
+	apiVersions := []interface{}{apiVersion}
+	if apiVersion == nil {
+		apiVersions = []interface{}{
+			"networking.istio.io/v1beta1",
+			"security.istio.io/v1beta1",
+			"telemetry.istio.io/v1alpha1",
+		}
+	}
+
+	for _, version := range apiVersions {
+		object, err := c.do(http.MethodGet, servicemeshObjectPath(version, kind, namespace, name), nil)
+		if err != nil {
+			return nil, err
+		}
+		if object != nil {
+			return object, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *servicemeshKubeClient) delete(manifest map[string]interface{}) error {
+	kind, namespace, name := servicemeshManifestIdentity(manifest)
+	_, err := c.do(http.MethodDelete, servicemeshObjectPath(manifest["apiVersion"], kind, namespace, name), nil)
+	return err
+}
+
+// Function to send the request to the control plane, returns nil object if
+// the custom resource is not found.
+func (c *servicemeshKubeClient) do(method, requestPath string, body []byte) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	request := func() error {
+		httpRequest, err := http.NewRequest(method, c.server+requestPath, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		httpRequest.Header.Set("Accept", "application/json")
+		if method == http.MethodPatch {
+			httpRequest.Header.Set("Content-Type", "application/apply-patch+yaml")
+		}
+		if c.token != "" {
+			httpRequest.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		httpResponse, err := c.httpClient.Do(httpRequest)
+		if err != nil {
+			return err
+		}
+		defer httpResponse.Body.Close()
+
+		responseBody, err := io.ReadAll(httpResponse.Body)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case httpResponse.StatusCode == http.StatusNotFound:
+			object = nil
+			return nil
+		case httpResponse.StatusCode >= 500 || httpResponse.StatusCode == http.StatusTooManyRequests:
+			return fmt.Errorf("%s %s: %s", method, requestPath, servicemeshKubeStatusMessage(responseBody))
+		case httpResponse.StatusCode >= 400:
+			return backoff.Permanent(fmt.Errorf("%s %s: %s", method, requestPath, servicemeshKubeStatusMessage(responseBody)))
+		}
+
+		object = map[string]interface{}{}
+		return json.Unmarshal(responseBody, &object)
+	}
+
+	if err := retryAPICall(request); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// Function to get the path of the custom resource, the plural resource name
+// is derived from the kind, e.g. VirtualService to virtualservices and
+// ServiceEntry to serviceentries.
+func servicemeshObjectPath(apiVersion interface{}, kind, namespace, name string) string {
+	plural := strings.ToLower(kind)
+	if strings.HasSuffix(plural, "y") {
+		plural = strings.TrimSuffix(plural, "y") + "ies"
+	} else {
+		plural += "s"
+	}
+	return fmt.Sprintf("/apis/%v/namespaces/%s/%s/%s", apiVersion, namespace, plural, name)
+}
+
+// Function to get the message from the Kubernetes Status response.
+func servicemeshKubeStatusMessage(body []byte) string {
+	var status struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &status); err != nil || status.Message == "" {
+		return string(body)
+	}
+	return status.Message
+}
@@ -2,16 +2,17 @@ package alicloud
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	// "strconv"
 	"encoding/json"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,8 +22,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &servicemeshUserPermissionResource{}
-	_ resource.ResourceWithConfigure = &servicemeshUserPermissionResource{}
+	_ resource.Resource                = &servicemeshUserPermissionResource{}
+	_ resource.ResourceWithConfigure   = &servicemeshUserPermissionResource{}
+	_ resource.ResourceWithImportState = &servicemeshUserPermissionResource{}
 )
 
 func NewServicemeshUserPermissionResource() resource.Resource {
@@ -308,6 +310,43 @@ func (r *servicemeshUserPermissionResource) Delete(ctx context.Context, req reso
 	}
 }
 
+// ImportState imports the existing permissions of a RAM user by the ID in
+// the format of <sub_account_user_id> or
+// <sub_account_user_id>:<service_mesh_id>[,<service_mesh_id>...], the latter
+// only imports the permissions of the given service meshes.
+func (r *servicemeshUserPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	uid, serviceMeshes, err := parsePermissionImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <sub_account_user_id> or "+
+				"<sub_account_user_id>:<service_mesh_id>[,<service_mesh_id>...]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	existingPerms, err := r.describeUserPermissions(uid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to query user's existing permission.",
+			err.Error(),
+		)
+		return
+	}
+
+	importedPerms := []*serviceMeshUserPermissions{}
+	for _, perm := range existingPerms {
+		if len(serviceMeshes) > 0 && !serviceMeshes[perm.ServiceMeshId.ValueString()] {
+			continue
+		}
+		perm.IsRamRole = types.BoolNull()
+		importedPerms = append(importedPerms, perm)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sub_account_user_id"), uid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permissions"), importedPerms)...)
+}
+
 func isAllFalse(list []bool) bool {
 	for _, value := range list {
 		if value == true {
@@ -363,9 +402,7 @@ func (r *servicemeshUserPermissionResource) describeUserPermissions(uid string)
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(describeUserPermissions, reconnectBackoff)
+	err = retryAPICall(describeUserPermissions)
 	if err != nil {
 		return permissions, err
 	}
@@ -425,9 +462,7 @@ func (r *servicemeshUserPermissionResource) grantPermissions(uid string, permStr
 	}
 
 	// Retry backoff
-	reconnectBackoff := backoff.NewExponentialBackOff()
-	reconnectBackoff.MaxElapsedTime = 30 * time.Second
-	err = backoff.Retry(grantPermissions, reconnectBackoff)
+	err = retryAPICall(grantPermissions)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,707 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// The maximum number of entries to be added or removed in a single
+	// AddAccessControlListEntry or RemoveAccessControlListEntry request.
+	slbAclEntryBatchSize = 50
+)
+
+var (
+	_ resource.Resource                = &slbAclAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &slbAclAttachmentResource{}
+	_ resource.ResourceWithImportState = &slbAclAttachmentResource{}
+)
+
+func NewSlbAclAttachmentResource() resource.Resource {
+	return &slbAclAttachmentResource{}
+}
+
+type slbAclAttachmentResource struct {
+	client *alicloudSlbClient.Client
+}
+
+type slbAclAttachmentModel struct {
+	LoadBalancerId   types.String `tfsdk:"load_balancer_id"`
+	ListenerPort     types.Int64  `tfsdk:"listener_port"`
+	ListenerProtocol types.String `tfsdk:"listener_protocol"`
+	AclId            types.String `tfsdk:"acl_id"`
+	AclType          types.String `tfsdk:"acl_type"`
+	AclEntries       types.List   `tfsdk:"acl_entries"`
+}
+
+// slbAclEntry is the JSON format of ACL entry accepted by the AliCloud SLB
+// access control list APIs.
+type slbAclEntry struct {
+	Entry string `json:"entry"`
+}
+
+// Metadata returns the SLB ACL Attachment resource name.
+func (r *slbAclAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slb_acl_attachment"
+}
+
+// Schema defines the schema for the SLB ACL Attachment resource.
+func (r *slbAclAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach an access control list (ACL) to a classic Server Load Balancer (CLB) listener as a " +
+			"whitelist or blacklist, and optionally manage the full set of entries of the ACL.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Description: "ID of the load balancer that the listener belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"listener_port": schema.Int64Attribute{
+				Description: "The frontend port of the listener.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"listener_protocol": schema.StringAttribute{
+				Description: "The frontend protocol of the listener. Valid values: `tcp`, `udp`, `http`, `https`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp", "http", "https"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"acl_id": schema.StringAttribute{
+				Description: "ID of the access control list to be attached to the listener.",
+				Required:    true,
+			},
+			"acl_type": schema.StringAttribute{
+				Description: "The type of the access control. Valid values: `white`, `black`. Only the requests " +
+					"from the IP addresses in the ACL are forwarded if `white`, and the requests from the IP " +
+					"addresses in the ACL are rejected if `black`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("white", "black"),
+				},
+			},
+			"acl_entries": schema.ListAttribute{
+				Description: "List of IP addresses or CIDR blocks in the ACL. If set, the full set of entries of " +
+					"the ACL is managed and the entries added outside of this resource are removed. The " +
+					"entries are kept in the ACL when the resource is destroyed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *slbAclAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).slbClient
+}
+
+// Update the entries of the ACL before attaching the ACL to the listener,
+// so that the requests are not rejected by an incomplete whitelist.
+func (r *slbAclAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *slbAclAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AclEntries.IsNull() {
+		err := r.syncAclEntries(plan.AclId.ValueString(), convertListValueToStrings(plan.AclEntries))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Access Control List Entries.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.setListenerAccessControl(plan, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Attach Access Control List to Listener.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the access control of the listener. The resource is removed from
+// state when the access control of the listener is disabled. The entries
+// in state are kept in the same order, and the entries added outside of
+// Terraform are appended, so that they are removed in the next apply.
+func (r *slbAclAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *slbAclAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listener, err := r.describeListener(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Load Balancer Listeners.",
+			err.Error(),
+		)
+		return
+	}
+
+	if listener == nil || tea.StringValue(listener.AclStatus) != "on" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.AclId = types.StringValue(tea.StringValue(listener.AclId))
+	state.AclType = types.StringValue(tea.StringValue(listener.AclType))
+
+	if !state.AclEntries.IsNull() {
+		aclEntries, err := r.describeAclEntries(state.AclId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Describe Access Control List Attribute.",
+				err.Error(),
+			)
+			return
+		}
+
+		exists := make(map[string]bool)
+		for _, aclEntry := range aclEntries {
+			exists[aclEntry] = true
+		}
+
+		entries := []attr.Value{}
+		for _, aclEntry := range convertListValueToStrings(state.AclEntries) {
+			if exists[aclEntry] {
+				entries = append(entries, types.StringValue(aclEntry))
+				delete(exists, aclEntry)
+			}
+		}
+		for _, aclEntry := range aclEntries {
+			if exists[aclEntry] {
+				entries = append(entries, types.StringValue(aclEntry))
+			}
+		}
+		state.AclEntries = types.ListValueMust(types.StringType, entries)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the entries of the ACL, then attach the ACL with the new ID or type
+// to the listener and sync the legacy whitelist of the listener.
+func (r *slbAclAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *slbAclAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AclEntries.IsNull() {
+		err := r.syncAclEntries(plan.AclId.ValueString(), convertListValueToStrings(plan.AclEntries))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Access Control List Entries.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.AclId.Equal(state.AclId) || !plan.AclType.Equal(state.AclType) {
+		err := r.setListenerAccessControl(plan, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Attach Access Control List to Listener.",
+				err.Error(),
+			)
+			return
+		}
+	} else if !plan.AclEntries.Equal(state.AclEntries) {
+		err := r.syncListenerWhiteList(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Listener Whitelist.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Disable the access control and close the legacy whitelist of the listener.
+func (r *slbAclAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *slbAclAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listener, err := r.describeListener(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Load Balancer Listeners.",
+			err.Error(),
+		)
+		return
+	}
+	if listener == nil {
+		return
+	}
+
+	err = r.setListenerAccessControl(state, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Detach Access Control List from Listener.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the attachment by the ID in the format of
+// <load_balancer_id>:<listener_protocol>:<listener_port>.
+func (r *slbAclAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 3 || ids[0] == "" || ids[1] == "" || ids[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <load_balancer_id>:<listener_protocol>:<listener_port>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	listenerPort, err := strconv.ParseInt(ids[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected listener port to be a number. Got: %q", ids[2]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("listener_protocol"), ids[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("listener_port"), listenerPort)...)
+}
+
+// Function to enable or disable the access control of the listener with the
+// API of the listener's protocol, then sync the legacy whitelist of the
+// listener with the ACL.
+func (r *slbAclAttachmentResource) setListenerAccessControl(model *slbAclAttachmentModel, enabled bool) error {
+	loadBalancerId := tea.String(model.LoadBalancerId.ValueString())
+	listenerPort := tea.Int32(int32(model.ListenerPort.ValueInt64()))
+
+	var aclStatus, aclId, aclType *string
+	if enabled {
+		aclStatus = tea.String("on")
+		aclId = tea.String(model.AclId.ValueString())
+		aclType = tea.String(model.AclType.ValueString())
+	} else {
+		aclStatus = tea.String("off")
+	}
+
+	setListenerAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		var err error
+		switch model.ListenerProtocol.ValueString() {
+		case "tcp":
+			_, err = r.client.SetLoadBalancerTCPListenerAttributeWithOptions(&alicloudSlbClient.SetLoadBalancerTCPListenerAttributeRequest{
+				RegionId:       r.client.RegionId,
+				LoadBalancerId: loadBalancerId,
+				ListenerPort:   listenerPort,
+				AclStatus:      aclStatus,
+				AclId:          aclId,
+				AclType:        aclType,
+			}, runtime)
+		case "udp":
+			_, err = r.client.SetLoadBalancerUDPListenerAttributeWithOptions(&alicloudSlbClient.SetLoadBalancerUDPListenerAttributeRequest{
+				RegionId:       r.client.RegionId,
+				LoadBalancerId: loadBalancerId,
+				ListenerPort:   listenerPort,
+				AclStatus:      aclStatus,
+				AclId:          aclId,
+				AclType:        aclType,
+			}, runtime)
+		case "http":
+			_, err = r.client.SetLoadBalancerHTTPListenerAttributeWithOptions(&alicloudSlbClient.SetLoadBalancerHTTPListenerAttributeRequest{
+				RegionId:       r.client.RegionId,
+				LoadBalancerId: loadBalancerId,
+				ListenerPort:   listenerPort,
+				AclStatus:      aclStatus,
+				AclId:          aclId,
+				AclType:        aclType,
+			}, runtime)
+		case "https":
+			_, err = r.client.SetLoadBalancerHTTPSListenerAttributeWithOptions(&alicloudSlbClient.SetLoadBalancerHTTPSListenerAttributeRequest{
+				RegionId:       r.client.RegionId,
+				LoadBalancerId: loadBalancerId,
+				ListenerPort:   listenerPort,
+				AclStatus:      aclStatus,
+				AclId:          aclId,
+				AclType:        aclType,
+			}, runtime)
+		}
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(setListenerAttribute); err != nil {
+		return err
+	}
+
+	if !enabled {
+		return r.setListenerWhiteListStatus(model, "close")
+	}
+	return r.syncListenerWhiteList(model)
+}
+
+// Function to sync the legacy whitelist of the listener. The entries of the
+// ACL are added to the whitelist and the whitelist is opened in the white
+// mode, while the whitelist is closed in the black mode, as the legacy
+// whitelist does not support the blacklist.
+func (r *slbAclAttachmentResource) syncListenerWhiteList(model *slbAclAttachmentModel) error {
+	if model.AclType.ValueString() != "white" {
+		return r.setListenerWhiteListStatus(model, "close")
+	}
+
+	aclEntries, err := r.describeAclEntries(model.AclId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var describeListenerAccessControlAttributeResponse *alicloudSlbClient.DescribeListenerAccessControlAttributeResponse
+	describeListenerAccessControlAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeListenerAccessControlAttributeRequest := &alicloudSlbClient.DescribeListenerAccessControlAttributeRequest{
+			RegionId:         r.client.RegionId,
+			LoadBalancerId:   tea.String(model.LoadBalancerId.ValueString()),
+			ListenerPort:     tea.Int32(int32(model.ListenerPort.ValueInt64())),
+			ListenerProtocol: tea.String(model.ListenerProtocol.ValueString()),
+		}
+
+		var err error
+		describeListenerAccessControlAttributeResponse, err = r.client.DescribeListenerAccessControlAttributeWithOptions(describeListenerAccessControlAttributeRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeListenerAccessControlAttribute); err != nil {
+		return err
+	}
+
+	whiteListItems := []string{}
+	for _, item := range strings.Split(tea.StringValue(describeListenerAccessControlAttributeResponse.Body.SourceItems), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			whiteListItems = append(whiteListItems, item)
+		}
+	}
+
+	// Add the missing items before opening the whitelist, so that the
+	// requests are not rejected by an incomplete whitelist.
+	if addItems := convertStringsDifference(aclEntries, whiteListItems); len(addItems) > 0 {
+		addListenerWhiteListItem := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			addListenerWhiteListItemRequest := &alicloudSlbClient.AddListenerWhiteListItemRequest{
+				RegionId:         r.client.RegionId,
+				LoadBalancerId:   tea.String(model.LoadBalancerId.ValueString()),
+				ListenerPort:     tea.Int32(int32(model.ListenerPort.ValueInt64())),
+				ListenerProtocol: tea.String(model.ListenerProtocol.ValueString()),
+				SourceItems:      tea.String(strings.Join(addItems, ",")),
+			}
+
+			if _, err := r.client.AddListenerWhiteListItemWithOptions(addListenerWhiteListItemRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(addListenerWhiteListItem); err != nil {
+			return err
+		}
+	}
+
+	if removeItems := convertStringsDifference(whiteListItems, aclEntries); len(removeItems) > 0 {
+		removeListenerWhiteListItem := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			removeListenerWhiteListItemRequest := &alicloudSlbClient.RemoveListenerWhiteListItemRequest{
+				RegionId:         r.client.RegionId,
+				LoadBalancerId:   tea.String(model.LoadBalancerId.ValueString()),
+				ListenerPort:     tea.Int32(int32(model.ListenerPort.ValueInt64())),
+				ListenerProtocol: tea.String(model.ListenerProtocol.ValueString()),
+				SourceItems:      tea.String(strings.Join(removeItems, ",")),
+			}
+
+			if _, err := r.client.RemoveListenerWhiteListItemWithOptions(removeListenerWhiteListItemRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(removeListenerWhiteListItem); err != nil {
+			return err
+		}
+	}
+
+	return r.setListenerWhiteListStatus(model, "open_white_list")
+}
+
+// Function to open or close the legacy whitelist of the listener.
+func (r *slbAclAttachmentResource) setListenerWhiteListStatus(model *slbAclAttachmentModel, accessControlStatus string) error {
+	setListenerAccessControlStatus := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setListenerAccessControlStatusRequest := &alicloudSlbClient.SetListenerAccessControlStatusRequest{
+			RegionId:            r.client.RegionId,
+			LoadBalancerId:      tea.String(model.LoadBalancerId.ValueString()),
+			ListenerPort:        tea.Int32(int32(model.ListenerPort.ValueInt64())),
+			ListenerProtocol:    tea.String(model.ListenerProtocol.ValueString()),
+			AccessControlStatus: tea.String(accessControlStatus),
+		}
+
+		if _, err := r.client.SetListenerAccessControlStatusWithOptions(setListenerAccessControlStatusRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(setListenerAccessControlStatus)
+}
+
+// Function to read the listener of the load balancer, nil is returned if
+// the listener does not exist.
+func (r *slbAclAttachmentResource) describeListener(model *slbAclAttachmentModel) (*alicloudSlbClient.DescribeLoadBalancerListenersResponseBodyListeners, error) {
+	var describeLoadBalancerListenersResponse *alicloudSlbClient.DescribeLoadBalancerListenersResponse
+	describeLoadBalancerListeners := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeLoadBalancerListenersRequest := &alicloudSlbClient.DescribeLoadBalancerListenersRequest{
+			RegionId:         r.client.RegionId,
+			LoadBalancerId:   []*string{tea.String(model.LoadBalancerId.ValueString())},
+			ListenerPort:     tea.Int32(int32(model.ListenerPort.ValueInt64())),
+			ListenerProtocol: tea.String(model.ListenerProtocol.ValueString()),
+		}
+
+		var err error
+		describeLoadBalancerListenersResponse, err = r.client.DescribeLoadBalancerListenersWithOptions(describeLoadBalancerListenersRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeLoadBalancerListeners); err != nil {
+		return nil, err
+	}
+
+	for _, listener := range describeLoadBalancerListenersResponse.Body.Listeners {
+		if int64(tea.Int32Value(listener.ListenerPort)) == model.ListenerPort.ValueInt64() &&
+			tea.StringValue(listener.ListenerProtocol) == model.ListenerProtocol.ValueString() {
+			return listener, nil
+		}
+	}
+	return nil, nil
+}
+
+// Function to read all the entries of the ACL.
+func (r *slbAclAttachmentResource) describeAclEntries(aclId string) ([]string, error) {
+	aclEntries := []string{}
+	seen := make(map[string]bool)
+	page := int32(1)
+
+	for {
+		var describeAccessControlListAttributeResponse *alicloudSlbClient.DescribeAccessControlListAttributeResponse
+		describeAccessControlListAttribute := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeAccessControlListAttributeRequest := &alicloudSlbClient.DescribeAccessControlListAttributeRequest{
+				RegionId: r.client.RegionId,
+				AclId:    tea.String(aclId),
+				Page:     tea.Int32(page),
+			}
+
+			var err error
+			describeAccessControlListAttributeResponse, err = r.client.DescribeAccessControlListAttributeWithOptions(describeAccessControlListAttributeRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeAccessControlListAttribute); err != nil {
+			return nil, err
+		}
+
+		// Stop when a page does not return any new entry, as the last page
+		// is returned again for the page number out of range.
+		added := 0
+		if body := describeAccessControlListAttributeResponse.Body; body.AclEntrys != nil {
+			for _, aclEntry := range body.AclEntrys.AclEntry {
+				entry := tea.StringValue(aclEntry.AclEntryIP)
+				if !seen[entry] {
+					seen[entry] = true
+					aclEntries = append(aclEntries, entry)
+					added++
+				}
+			}
+		}
+		if added == 0 {
+			break
+		}
+		page++
+	}
+	return aclEntries, nil
+}
+
+// Function to add the missing entries to the ACL and remove the entries
+// which are not in the list.
+func (r *slbAclAttachmentResource) syncAclEntries(aclId string, entries []string) error {
+	aclEntries, err := r.describeAclEntries(aclId)
+	if err != nil {
+		return err
+	}
+
+	addEntries := convertStringsDifference(entries, aclEntries)
+	for start := 0; start < len(addEntries); start += slbAclEntryBatchSize {
+		end := start + slbAclEntryBatchSize
+		if end > len(addEntries) {
+			end = len(addEntries)
+		}
+
+		aclEntrysJson, err := convertSlbAclEntriesToJsonString(addEntries[start:end])
+		if err != nil {
+			return err
+		}
+
+		addAccessControlListEntry := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			addAccessControlListEntryRequest := &alicloudSlbClient.AddAccessControlListEntryRequest{
+				RegionId:  r.client.RegionId,
+				AclId:     tea.String(aclId),
+				AclEntrys: tea.String(aclEntrysJson),
+			}
+
+			if _, err := r.client.AddAccessControlListEntryWithOptions(addAccessControlListEntryRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(addAccessControlListEntry); err != nil {
+			return err
+		}
+	}
+
+	removeEntries := convertStringsDifference(aclEntries, entries)
+	for start := 0; start < len(removeEntries); start += slbAclEntryBatchSize {
+		end := start + slbAclEntryBatchSize
+		if end > len(removeEntries) {
+			end = len(removeEntries)
+		}
+
+		aclEntrysJson, err := convertSlbAclEntriesToJsonString(removeEntries[start:end])
+		if err != nil {
+			return err
+		}
+
+		removeAccessControlListEntry := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			removeAccessControlListEntryRequest := &alicloudSlbClient.RemoveAccessControlListEntryRequest{
+				RegionId:  r.client.RegionId,
+				AclId:     tea.String(aclId),
+				AclEntrys: tea.String(aclEntrysJson),
+			}
+
+			if _, err := r.client.RemoveAccessControlListEntryWithOptions(removeAccessControlListEntryRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(removeAccessControlListEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert the ACL entries to the JSON string format accepted by AliCloud API.
+func convertSlbAclEntriesToJsonString(entries []string) (string, error) {
+	aclEntries := []slbAclEntry{}
+	for _, entry := range entries {
+		aclEntries = append(aclEntries, slbAclEntry{Entry: entry})
+	}
+
+	aclEntriesJson, err := json.Marshal(aclEntries)
+	if err != nil {
+		return "", err
+	}
+	return string(aclEntriesJson), nil
+}
@@ -0,0 +1,353 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &slbListenerRuleResource{}
+	_ resource.ResourceWithConfigure   = &slbListenerRuleResource{}
+	_ resource.ResourceWithImportState = &slbListenerRuleResource{}
+)
+
+func NewSlbListenerRuleResource() resource.Resource {
+	return &slbListenerRuleResource{}
+}
+
+type slbListenerRuleResource struct {
+	client *alicloudSlbClient.Client
+}
+
+type slbListenerRuleModel struct {
+	Id               types.String `tfsdk:"id"`
+	LoadBalancerId   types.String `tfsdk:"load_balancer_id"`
+	ListenerPort     types.Int64  `tfsdk:"listener_port"`
+	ListenerProtocol types.String `tfsdk:"listener_protocol"`
+	Name             types.String `tfsdk:"name"`
+	Domain           types.String `tfsdk:"domain"`
+	Url              types.String `tfsdk:"url"`
+	VServerGroupId   types.String `tfsdk:"vserver_group_id"`
+}
+
+// Metadata returns the SLB Listener Rule resource name.
+func (r *slbListenerRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slb_listener_rule"
+}
+
+// Schema defines the schema for the SLB Listener Rule resource.
+func (r *slbListenerRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a classic Server Load Balancer (CLB) listener forwarding rule based on domain and URL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the forwarding rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"load_balancer_id": schema.StringAttribute{
+				Description: "ID of the load balancer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"listener_port": schema.Int64Attribute{
+				Description: "The frontend port of the listener.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"listener_protocol": schema.StringAttribute{
+				Description: "The frontend protocol of the listener. Valid values: `http`, `https`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the forwarding rule.",
+				Required:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "The domain name of the forwarding rule. At least one of `domain` and `url` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL path of the forwarding rule. At least one of `domain` and `url` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vserver_group_id": schema.StringAttribute{
+				Description: "ID of the VServer group that the requests are forwarded to.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *slbListenerRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).slbClient
+}
+
+// Create a new forwarding rule for the listener.
+func (r *slbListenerRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *slbListenerRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Domain.ValueString() == "" && plan.Url.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"[INPUT ERROR] Invalid Listener Rule.",
+			"At least one of domain and url must be set.",
+		)
+		return
+	}
+
+	rule := map[string]string{
+		"RuleName":       plan.Name.ValueString(),
+		"VServerGroupId": plan.VServerGroupId.ValueString(),
+	}
+	if plan.Domain.ValueString() != "" {
+		rule["Domain"] = plan.Domain.ValueString()
+	}
+	if plan.Url.ValueString() != "" {
+		rule["Url"] = plan.Url.ValueString()
+	}
+	ruleList, err := json.Marshal([]map[string]string{rule})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to convert the listener rule to a json string.",
+			err.Error(),
+		)
+		return
+	}
+
+	var ruleId string
+	createRules := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createRulesRequest := &alicloudSlbClient.CreateRulesRequest{
+			RegionId:         r.client.RegionId,
+			LoadBalancerId:   tea.String(plan.LoadBalancerId.ValueString()),
+			ListenerPort:     tea.Int32(int32(plan.ListenerPort.ValueInt64())),
+			ListenerProtocol: tea.String(plan.ListenerProtocol.ValueString()),
+			RuleList:         tea.String(string(ruleList)),
+		}
+
+		createRulesResponse, err := r.client.CreateRulesWithOptions(createRulesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		if createRulesResponse.Body.Rules == nil || len(createRulesResponse.Body.Rules.Rule) == 0 {
+			return backoff.Permanent(fmt.Errorf("no rule is returned after creating the listener rule"))
+		}
+		ruleId = tea.StringValue(createRulesResponse.Body.Rules.Rule[0].RuleId)
+		return nil
+	}
+
+	err = retryAPICall(createRules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Listener Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(ruleId)
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the forwarding rule.
+func (r *slbListenerRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *slbListenerRuleModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeRuleAttributeResponse *alicloudSlbClient.DescribeRuleAttributeResponse
+	describeRuleAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeRuleAttributeRequest := &alicloudSlbClient.DescribeRuleAttributeRequest{
+			RegionId: r.client.RegionId,
+			RuleId:   tea.String(state.Id.ValueString()),
+		}
+
+		var err error
+		describeRuleAttributeResponse, err = r.client.DescribeRuleAttributeWithOptions(describeRuleAttributeRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(describeRuleAttribute)
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidRuleId.NotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Listener Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	body := describeRuleAttributeResponse.Body
+	state.LoadBalancerId = types.StringValue(tea.StringValue(body.LoadBalancerId))
+	state.Name = types.StringValue(tea.StringValue(body.RuleName))
+	state.VServerGroupId = types.StringValue(tea.StringValue(body.VServerGroupId))
+	if listenerPort, err := strconv.ParseInt(tea.StringValue(body.ListenerPort), 10, 64); err == nil {
+		state.ListenerPort = types.Int64Value(listenerPort)
+	}
+	if tea.StringValue(body.Domain) != "" {
+		state.Domain = types.StringValue(tea.StringValue(body.Domain))
+	}
+	if tea.StringValue(body.Url) != "" {
+		state.Url = types.StringValue(tea.StringValue(body.Url))
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name and VServer group of the forwarding rule.
+func (r *slbListenerRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *slbListenerRuleModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		setRuleRequest := &alicloudSlbClient.SetRuleRequest{
+			RegionId:       r.client.RegionId,
+			RuleId:         tea.String(state.Id.ValueString()),
+			RuleName:       tea.String(plan.Name.ValueString()),
+			VServerGroupId: tea.String(plan.VServerGroupId.ValueString()),
+		}
+
+		if _, err := r.client.SetRuleWithOptions(setRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(setRule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Listener Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = state.Id
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the forwarding rule.
+func (r *slbListenerRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *slbListenerRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteRules := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		ruleIds, err := json.Marshal([]string{state.Id.ValueString()})
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		deleteRulesRequest := &alicloudSlbClient.DeleteRulesRequest{
+			RegionId: r.client.RegionId,
+			RuleIds:  tea.String(string(ruleIds)),
+		}
+
+		if _, err := r.client.DeleteRulesWithOptions(deleteRulesRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidRuleId.NotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(deleteRules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Listener Rule.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *slbListenerRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,491 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudSlbClient "github.com/alibabacloud-go/slb-20140515/v4/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+var (
+	_ resource.Resource                = &slbVServerGroupResource{}
+	_ resource.ResourceWithConfigure   = &slbVServerGroupResource{}
+	_ resource.ResourceWithImportState = &slbVServerGroupResource{}
+)
+
+func NewSlbVServerGroupResource() resource.Resource {
+	return &slbVServerGroupResource{}
+}
+
+type slbVServerGroupResource struct {
+	client *alicloudSlbClient.Client
+}
+
+type slbVServerGroupModel struct {
+	Id             types.String                    `tfsdk:"id"`
+	LoadBalancerId types.String                    `tfsdk:"load_balancer_id"`
+	Name           types.String                    `tfsdk:"name"`
+	BackendServers []*slbVServerGroupBackendServer `tfsdk:"backend_servers"`
+}
+
+type slbVServerGroupBackendServer struct {
+	ServerId    types.String `tfsdk:"server_id"`
+	Port        types.Int64  `tfsdk:"port"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+// slbBackendServer is the JSON format of backend server accepted by the
+// AliCloud SLB VServer group APIs.
+type slbBackendServer struct {
+	ServerId    string `json:"ServerId"`
+	Port        int64  `json:"Port"`
+	Weight      int64  `json:"Weight"`
+	Type        string `json:"Type,omitempty"`
+	Description string `json:"Description,omitempty"`
+}
+
+// Metadata returns the SLB VServer Group resource name.
+func (r *slbVServerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slb_vserver_group"
+}
+
+// Schema defines the schema for the SLB VServer Group resource.
+func (r *slbVServerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a classic Server Load Balancer (CLB) VServer group resource with its backend servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the VServer group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"load_balancer_id": schema.StringAttribute{
+				Description: "ID of the load balancer that the VServer group belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the VServer group.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"backend_servers": schema.ListNestedBlock{
+				Description: "Backend servers of the VServer group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Description: "ID of the ECS instance or ENI.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port used by the backend server.",
+							Required:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The weight of the backend server.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the backend server. Valid values: `ecs`, `eni`. Default to `ecs`.",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("ecs", "eni"),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the backend server.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *slbVServerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).slbClient
+}
+
+// Create a new VServer group with its backend servers.
+func (r *slbVServerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *slbVServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendServers, err := convertSlbBackendServersToJsonString(plan.BackendServers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[ERROR] Failed to convert the backend servers to a json string.",
+			err.Error(),
+		)
+		return
+	}
+
+	var vServerGroupId string
+	createVServerGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createVServerGroupRequest := &alicloudSlbClient.CreateVServerGroupRequest{
+			RegionId:         r.client.RegionId,
+			LoadBalancerId:   tea.String(plan.LoadBalancerId.ValueString()),
+			VServerGroupName: tea.String(plan.Name.ValueString()),
+			BackendServers:   tea.String(backendServers),
+		}
+
+		createVServerGroupResponse, err := r.client.CreateVServerGroupWithOptions(createVServerGroupRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		vServerGroupId = tea.StringValue(createVServerGroupResponse.Body.VServerGroupId)
+		return nil
+	}
+
+	err = retryAPICall(createVServerGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(vServerGroupId)
+	if err := r.readVServerGroup(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Read VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the VServer group and its backend servers.
+func (r *slbVServerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *slbVServerGroupModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readVServerGroup(state); err != nil {
+		if isSlbVServerGroupNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Read VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the VServer group name and reconcile its backend servers.
+func (r *slbVServerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *slbVServerGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Servers are identified by server ID and port, as the same ECS instance
+	// may serve multiple ports in one VServer group.
+	serverKey := func(server *slbVServerGroupBackendServer) string {
+		return fmt.Sprintf("%s:%d", server.ServerId.ValueString(), server.Port.ValueInt64())
+	}
+
+	planServers := make(map[string]*slbVServerGroupBackendServer)
+	for _, server := range plan.BackendServers {
+		planServers[serverKey(server)] = server
+	}
+	stateServers := make(map[string]*slbVServerGroupBackendServer)
+	for _, server := range state.BackendServers {
+		stateServers[serverKey(server)] = server
+	}
+
+	var addServers, removeServers, modifyServers []*slbVServerGroupBackendServer
+	for key, server := range planServers {
+		if stateServer, exists := stateServers[key]; !exists {
+			addServers = append(addServers, server)
+		} else if !server.Weight.Equal(stateServer.Weight) || !server.Description.Equal(stateServer.Description) {
+			modifyServers = append(modifyServers, server)
+		}
+	}
+	for key, server := range stateServers {
+		if _, exists := planServers[key]; !exists {
+			removeServers = append(removeServers, server)
+		}
+	}
+
+	updateVServerGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		if len(removeServers) > 0 {
+			backendServers, err := convertSlbBackendServersToJsonString(removeServers)
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+
+			removeVServerGroupBackendServersRequest := &alicloudSlbClient.RemoveVServerGroupBackendServersRequest{
+				RegionId:       r.client.RegionId,
+				VServerGroupId: tea.String(state.Id.ValueString()),
+				BackendServers: tea.String(backendServers),
+			}
+
+			if _, err := r.client.RemoveVServerGroupBackendServersWithOptions(removeVServerGroupBackendServersRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			removeServers = nil
+		}
+
+		if len(addServers) > 0 {
+			backendServers, err := convertSlbBackendServersToJsonString(addServers)
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+
+			addVServerGroupBackendServersRequest := &alicloudSlbClient.AddVServerGroupBackendServersRequest{
+				RegionId:       r.client.RegionId,
+				VServerGroupId: tea.String(state.Id.ValueString()),
+				BackendServers: tea.String(backendServers),
+			}
+
+			if _, err := r.client.AddVServerGroupBackendServersWithOptions(addVServerGroupBackendServersRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			addServers = nil
+		}
+
+		setVServerGroupAttributeRequest := &alicloudSlbClient.SetVServerGroupAttributeRequest{
+			RegionId:         r.client.RegionId,
+			VServerGroupId:   tea.String(state.Id.ValueString()),
+			VServerGroupName: tea.String(plan.Name.ValueString()),
+		}
+
+		if len(modifyServers) > 0 {
+			backendServers, err := convertSlbBackendServersToJsonString(modifyServers)
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+			setVServerGroupAttributeRequest.BackendServers = tea.String(backendServers)
+		}
+
+		if _, err := r.client.SetVServerGroupAttributeWithOptions(setVServerGroupAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(updateVServerGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = state.Id
+	if err := r.readVServerGroup(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Read VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the VServer group.
+func (r *slbVServerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *slbVServerGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteVServerGroup := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteVServerGroupRequest := &alicloudSlbClient.DeleteVServerGroupRequest{
+			RegionId:       r.client.RegionId,
+			VServerGroupId: tea.String(state.Id.ValueString()),
+		}
+
+		if _, err := r.client.DeleteVServerGroupWithOptions(deleteVServerGroupRequest, runtime); err != nil {
+			if isSlbVServerGroupNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	err := retryAPICall(deleteVServerGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete VServer Group.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *slbVServerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Function to read the VServer group attributes into the model.
+func (r *slbVServerGroupResource) readVServerGroup(model *slbVServerGroupModel) error {
+	var describeVServerGroupAttributeResponse *alicloudSlbClient.DescribeVServerGroupAttributeResponse
+	describeVServerGroupAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeVServerGroupAttributeRequest := &alicloudSlbClient.DescribeVServerGroupAttributeRequest{
+			RegionId:       r.client.RegionId,
+			VServerGroupId: tea.String(model.Id.ValueString()),
+		}
+
+		var err error
+		describeVServerGroupAttributeResponse, err = r.client.DescribeVServerGroupAttributeWithOptions(describeVServerGroupAttributeRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeVServerGroupAttribute); err != nil {
+		return err
+	}
+
+	body := describeVServerGroupAttributeResponse.Body
+	model.LoadBalancerId = types.StringValue(tea.StringValue(body.LoadBalancerId))
+	model.Name = types.StringValue(tea.StringValue(body.VServerGroupName))
+
+	backendServers := []*slbVServerGroupBackendServer{}
+	if body.BackendServers != nil {
+		for _, server := range body.BackendServers.BackendServer {
+			// AliCloud returns an empty string for backend servers without
+			// description, keep it as null to match the configuration.
+			description := types.StringNull()
+			if tea.StringValue(server.Description) != "" {
+				description = types.StringValue(tea.StringValue(server.Description))
+			}
+
+			backendServers = append(backendServers, &slbVServerGroupBackendServer{
+				ServerId:    types.StringValue(tea.StringValue(server.ServerId)),
+				Port:        types.Int64Value(int64(tea.Int32Value(server.Port))),
+				Weight:      types.Int64Value(int64(tea.Int32Value(server.Weight))),
+				Type:        types.StringValue(strings.ToLower(tea.StringValue(server.Type))),
+				Description: description,
+			})
+		}
+	}
+
+	// Keep the order of the backend servers in the prior state or plan, as
+	// AliCloud returns the backend servers in its own order.
+	ordered := []*slbVServerGroupBackendServer{}
+	for _, prevServer := range model.BackendServers {
+		for i, server := range backendServers {
+			if server != nil && server.ServerId.Equal(prevServer.ServerId) && server.Port.Equal(prevServer.Port) {
+				ordered = append(ordered, server)
+				backendServers[i] = nil
+				break
+			}
+		}
+	}
+	for _, server := range backendServers {
+		if server != nil {
+			ordered = append(ordered, server)
+		}
+	}
+	model.BackendServers = ordered
+	return nil
+}
+
+// Convert the backend servers to the JSON string format accepted by AliCloud API.
+func convertSlbBackendServersToJsonString(servers []*slbVServerGroupBackendServer) (string, error) {
+	backendServers := []slbBackendServer{}
+	for _, server := range servers {
+		backendServer := slbBackendServer{
+			ServerId:    server.ServerId.ValueString(),
+			Port:        server.Port.ValueInt64(),
+			Weight:      server.Weight.ValueInt64(),
+			Type:        server.Type.ValueString(),
+			Description: server.Description.ValueString(),
+		}
+		backendServers = append(backendServers, backendServer)
+	}
+
+	backendServersJson, err := json.Marshal(backendServers)
+	if err != nil {
+		return "", err
+	}
+	return string(backendServersJson), nil
+}
+
+// Function to check whether the error is returned as the VServer group does
+// not exist.
+func isSlbVServerGroupNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return tea.StringValue(_t.Code) == "InvalidParameter.VServerGroupId" ||
+			strings.Contains(tea.StringValue(_t.Message), "The specified VServerGroupId does not exist")
+	}
+	return false
+}
@@ -0,0 +1,365 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                = &vpcDhcpOptionsSetResource{}
+	_ resource.ResourceWithConfigure   = &vpcDhcpOptionsSetResource{}
+	_ resource.ResourceWithImportState = &vpcDhcpOptionsSetResource{}
+)
+
+func NewVpcDhcpOptionsSetResource() resource.Resource {
+	return &vpcDhcpOptionsSetResource{}
+}
+
+type vpcDhcpOptionsSetResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcDhcpOptionsSetModel struct {
+	DhcpOptionsSetId  types.String `tfsdk:"dhcp_options_set_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	DomainName        types.String `tfsdk:"domain_name"`
+	DomainNameServers types.List   `tfsdk:"domain_name_servers"`
+}
+
+// Metadata returns the VPC DHCP options set resource name.
+func (r *vpcDhcpOptionsSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_dhcp_options_set"
+}
+
+// Schema defines the schema for the VPC DHCP options set resource.
+func (r *vpcDhcpOptionsSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a DHCP options set, which configures the domain name and the DNS servers of the " +
+			"instances in the associated VPCs.",
+		Attributes: map[string]schema.Attribute{
+			"dhcp_options_set_id": schema.StringAttribute{
+				Description: "The ID of the DHCP options set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the DHCP options set.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the DHCP options set.",
+				Optional:    true,
+			},
+			"domain_name": schema.StringAttribute{
+				Description: "The domain name assigned to the instances, e.g. corp.example.com.",
+				Optional:    true,
+			},
+			"domain_name_servers": schema.ListAttribute{
+				Description: "The IP addresses of the DNS servers, at most 4 DNS servers. Default to the AliCloud DNS servers.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 4),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcDhcpOptionsSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create the DHCP options set and wait until it is available.
+func (r *vpcDhcpOptionsSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcDhcpOptionsSetModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createDhcpOptionsSetResponse *alicloudVpcClient.CreateDhcpOptionsSetResponse
+	createDhcpOptionsSet := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createDhcpOptionsSetRequest := &alicloudVpcClient.CreateDhcpOptionsSetRequest{
+			RegionId:                  r.client.RegionId,
+			DhcpOptionsSetName:        ecsStringPointer(plan.Name),
+			DhcpOptionsSetDescription: ecsStringPointer(plan.Description),
+			DomainName:                ecsStringPointer(plan.DomainName),
+		}
+		if servers := convertListValueToStrings(plan.DomainNameServers); len(servers) > 0 {
+			createDhcpOptionsSetRequest.DomainNameServers = tea.String(strings.Join(servers, ","))
+		}
+
+		var err error
+		createDhcpOptionsSetResponse, err = r.client.CreateDhcpOptionsSetWithOptions(createDhcpOptionsSetRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createDhcpOptionsSet); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create DHCP Options Set.",
+			err.Error(),
+		)
+		return
+	}
+	plan.DhcpOptionsSetId = types.StringValue(tea.StringValue(createDhcpOptionsSetResponse.Body.DhcpOptionsSetId))
+
+	// Save the DHCP options set into state before waiting, so that it is not
+	// leaked if it fails or times out.
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitVpcDhcpOptionsSet(r.client, plan.DhcpOptionsSetId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait DHCP Options Set Available.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read the DHCP options set.
+func (r *vpcDhcpOptionsSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcDhcpOptionsSetModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dhcpOptionsSet, err := describeVpcDhcpOptionsSet(r.client, state.DhcpOptionsSetId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get DHCP Options Set.",
+			err.Error(),
+		)
+		return
+	}
+	if dhcpOptionsSet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = ecsStringValue(state.Name, dhcpOptionsSet.DhcpOptionsSetName)
+	state.Description = ecsStringValue(state.Description, dhcpOptionsSet.DhcpOptionsSetDescription)
+	if dhcpOptionsSet.DhcpOptions != nil {
+		state.DomainName = ecsStringValue(state.DomainName, dhcpOptionsSet.DhcpOptions.DomainName)
+
+		// The AliCloud DNS servers are returned when the DNS servers are not
+		// configured, keep them null in state.
+		if !state.DomainNameServers.IsNull() {
+			servers := []attr.Value{}
+			for _, server := range strings.Split(tea.StringValue(dhcpOptionsSet.DhcpOptions.DomainNameServers), ",") {
+				if server = strings.TrimSpace(server); server != "" {
+					servers = append(servers, types.StringValue(server))
+				}
+			}
+			state.DomainNameServers = types.ListValueMust(types.StringType, servers)
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the DHCP options set and wait until the change is applied to the
+// associated VPCs.
+func (r *vpcDhcpOptionsSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcDhcpOptionsSetModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dhcpOptionsSetId := state.DhcpOptionsSetId.ValueString()
+	updateDhcpOptionsSetAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateDhcpOptionsSetAttributeRequest := &alicloudVpcClient.UpdateDhcpOptionsSetAttributeRequest{
+			RegionId:                  r.client.RegionId,
+			DhcpOptionsSetId:          tea.String(dhcpOptionsSetId),
+			DhcpOptionsSetName:        tea.String(plan.Name.ValueString()),
+			DhcpOptionsSetDescription: tea.String(plan.Description.ValueString()),
+			DomainName:                tea.String(plan.DomainName.ValueString()),
+		}
+		if servers := convertListValueToStrings(plan.DomainNameServers); len(servers) > 0 {
+			updateDhcpOptionsSetAttributeRequest.DomainNameServers = tea.String(strings.Join(servers, ","))
+		}
+
+		if _, err := r.client.UpdateDhcpOptionsSetAttributeWithOptions(updateDhcpOptionsSetAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateDhcpOptionsSetAttribute); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update DHCP Options Set Attribute.",
+			err.Error(),
+		)
+		return
+	}
+	plan.DhcpOptionsSetId = state.DhcpOptionsSetId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitVpcDhcpOptionsSet(r.client, dhcpOptionsSetId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait DHCP Options Set Available.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Delete the DHCP options set, it must be detached from all the VPCs.
+func (r *vpcDhcpOptionsSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcDhcpOptionsSetModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteDhcpOptionsSet := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDhcpOptionsSetRequest := &alicloudVpcClient.DeleteDhcpOptionsSetRequest{
+			RegionId:         r.client.RegionId,
+			DhcpOptionsSetId: tea.String(state.DhcpOptionsSetId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteDhcpOptionsSetWithOptions(deleteDhcpOptionsSetRequest, runtime); err != nil {
+			if isVpcDhcpOptionsSetNotFoundError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteDhcpOptionsSet); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete DHCP Options Set.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcDhcpOptionsSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("dhcp_options_set_id"), req, resp)
+}
+
+// Function to read the DHCP options set, returns nil if the DHCP options set
+// is not found.
+func describeVpcDhcpOptionsSet(client *alicloudVpcClient.Client, dhcpOptionsSetId string) (*alicloudVpcClient.GetDhcpOptionsSetResponseBody, error) {
+	var dhcpOptionsSet *alicloudVpcClient.GetDhcpOptionsSetResponseBody
+	getDhcpOptionsSet := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getDhcpOptionsSetRequest := &alicloudVpcClient.GetDhcpOptionsSetRequest{
+			RegionId:         client.RegionId,
+			DhcpOptionsSetId: tea.String(dhcpOptionsSetId),
+		}
+
+		getDhcpOptionsSetResponse, err := client.GetDhcpOptionsSetWithOptions(getDhcpOptionsSetRequest, runtime)
+		if err != nil {
+			if isVpcDhcpOptionsSetNotFoundError(err) {
+				dhcpOptionsSet = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		dhcpOptionsSet = getDhcpOptionsSetResponse.Body
+		return nil
+	}
+
+	if err := retryAPICall(getDhcpOptionsSet); err != nil {
+		return nil, err
+	}
+	if dhcpOptionsSet != nil && tea.StringValue(dhcpOptionsSet.Status) == "Deleted" {
+		return nil, nil
+	}
+	return dhcpOptionsSet, nil
+}
+
+// Function to wait until the DHCP options set and all its VPC associations
+// are no longer pending.
+func waitVpcDhcpOptionsSet(client *alicloudVpcClient.Client, dhcpOptionsSetId string) error {
+	waitDhcpOptionsSet := func() error {
+		dhcpOptionsSet, err := describeVpcDhcpOptionsSet(client, dhcpOptionsSetId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if dhcpOptionsSet == nil {
+			return backoff.Permanent(fmt.Errorf("DHCP options set %s is not found", dhcpOptionsSetId))
+		}
+		if status := tea.StringValue(dhcpOptionsSet.Status); status == "Pending" {
+			return fmt.Errorf("DHCP options set %s is %s", dhcpOptionsSetId, status)
+		}
+		for _, vpc := range dhcpOptionsSet.AssociateVpcs {
+			if status := tea.StringValue(vpc.AssociateStatus); status == "Pending" {
+				return fmt.Errorf("association of DHCP options set %s with VPC %s is %s", dhcpOptionsSetId, tea.StringValue(vpc.VpcId), status)
+			}
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitDhcpOptionsSet, reconnectBackoff)
+}
+
+// Function to check whether the error is caused by the DHCP options set
+// which does not exist.
+func isVpcDhcpOptionsSetNotFoundError(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(_t.Code), "DhcpOptionsSet") && strings.Contains(tea.StringValue(_t.Code), "NotFound")
+	}
+	return false
+}
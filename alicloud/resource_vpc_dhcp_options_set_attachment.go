@@ -0,0 +1,235 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                = &vpcDhcpOptionsSetAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &vpcDhcpOptionsSetAttachmentResource{}
+	_ resource.ResourceWithImportState = &vpcDhcpOptionsSetAttachmentResource{}
+)
+
+func NewVpcDhcpOptionsSetAttachmentResource() resource.Resource {
+	return &vpcDhcpOptionsSetAttachmentResource{}
+}
+
+type vpcDhcpOptionsSetAttachmentResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcDhcpOptionsSetAttachmentModel struct {
+	DhcpOptionsSetId types.String `tfsdk:"dhcp_options_set_id"`
+	VpcId            types.String `tfsdk:"vpc_id"`
+}
+
+// Metadata returns the VPC DHCP options set attachment resource name.
+func (r *vpcDhcpOptionsSetAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_dhcp_options_set_attachment"
+}
+
+// Schema defines the schema for the VPC DHCP options set attachment resource.
+func (r *vpcDhcpOptionsSetAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Associate a DHCP options set with a VPC. A VPC can only be associated with one DHCP options set.",
+		Attributes: map[string]schema.Attribute{
+			"dhcp_options_set_id": schema.StringAttribute{
+				Description: "The ID of the DHCP options set.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the VPC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcDhcpOptionsSetAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Attach the DHCP options set to the VPC and wait until it is in use.
+func (r *vpcDhcpOptionsSetAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcDhcpOptionsSetAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachDhcpOptionsSetToVpc := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		attachDhcpOptionsSetToVpcRequest := &alicloudVpcClient.AttachDhcpOptionsSetToVpcRequest{
+			RegionId:         r.client.RegionId,
+			DhcpOptionsSetId: tea.String(plan.DhcpOptionsSetId.ValueString()),
+			VpcId:            tea.String(plan.VpcId.ValueString()),
+		}
+
+		if _, err := r.client.AttachDhcpOptionsSetToVpcWithOptions(attachDhcpOptionsSetToVpcRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(attachDhcpOptionsSetToVpc); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Attach DHCP Options Set To VPC.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitVpcDhcpOptionsSet(r.client, plan.DhcpOptionsSetId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait DHCP Options Set In Use.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Read whether the DHCP options set is still associated with the VPC.
+func (r *vpcDhcpOptionsSetAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcDhcpOptionsSetAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dhcpOptionsSet, err := describeVpcDhcpOptionsSet(r.client, state.DhcpOptionsSetId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get DHCP Options Set.",
+			err.Error(),
+		)
+		return
+	}
+
+	associated := false
+	if dhcpOptionsSet != nil {
+		for _, vpc := range dhcpOptionsSet.AssociateVpcs {
+			if tea.StringValue(vpc.VpcId) == state.VpcId.ValueString() {
+				associated = true
+				break
+			}
+		}
+	}
+	if !associated {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// All the attributes require replacement, there is nothing to update.
+func (r *vpcDhcpOptionsSetAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *vpcDhcpOptionsSetAttachmentModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Detach the DHCP options set from the VPC and wait until it is detached.
+func (r *vpcDhcpOptionsSetAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcDhcpOptionsSetAttachmentModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detachDhcpOptionsSetFromVpc := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		detachDhcpOptionsSetFromVpcRequest := &alicloudVpcClient.DetachDhcpOptionsSetFromVpcRequest{
+			RegionId:         r.client.RegionId,
+			DhcpOptionsSetId: tea.String(state.DhcpOptionsSetId.ValueString()),
+			VpcId:            tea.String(state.VpcId.ValueString()),
+		}
+
+		if _, err := r.client.DetachDhcpOptionsSetFromVpcWithOptions(detachDhcpOptionsSetFromVpcRequest, runtime); err != nil {
+			if isVpcDhcpOptionsSetNotFoundError(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(detachDhcpOptionsSetFromVpc); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Detach DHCP Options Set From VPC.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := waitVpcDhcpOptionsSet(r.client, state.DhcpOptionsSetId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait DHCP Options Set Detached.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// Import the attachment with the ID in the format of
+// <dhcp_options_set_id>:<vpc_id>.
+func (r *vpcDhcpOptionsSetAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <dhcp_options_set_id>:<vpc_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dhcp_options_set_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_id"), idParts[1])...)
+}
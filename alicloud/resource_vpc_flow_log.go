@@ -0,0 +1,451 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                = &vpcFlowLogResource{}
+	_ resource.ResourceWithConfigure   = &vpcFlowLogResource{}
+	_ resource.ResourceWithImportState = &vpcFlowLogResource{}
+)
+
+func NewVpcFlowLogResource() resource.Resource {
+	return &vpcFlowLogResource{}
+}
+
+type vpcFlowLogResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcFlowLogModel struct {
+	FlowLogId           types.String `tfsdk:"flow_log_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceId          types.String `tfsdk:"resource_id"`
+	TrafficType         types.String `tfsdk:"traffic_type"`
+	ProjectName         types.String `tfsdk:"project_name"`
+	LogStoreName        types.String `tfsdk:"log_store_name"`
+	AggregationInterval types.Int64  `tfsdk:"aggregation_interval"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+}
+
+// Metadata returns the VPC flow log resource name.
+func (r *vpcFlowLogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_flow_log"
+}
+
+// Schema defines the schema for the VPC flow log resource.
+func (r *vpcFlowLogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a flow log, which captures the traffic of a VPC, vSwitch or ENI and delivers the " +
+			"records to a logstore of SLS.",
+		Attributes: map[string]schema.Attribute{
+			"flow_log_id": schema.StringAttribute{
+				Description: "The ID of the flow log.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the flow log.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the flow log.",
+				Optional:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The type of the resource whose traffic is captured. Valid values: VPC, VSwitch, " +
+					"NetworkInterface.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("VPC", "VSwitch", "NetworkInterface"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "The ID of the resource whose traffic is captured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"traffic_type": schema.StringAttribute{
+				Description: "The type of the traffic captured. Valid values: All, Allow, Drop. Default to All.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("All"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("All", "Allow", "Drop"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Description: "The name of the SLS project which stores the flow log records.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_store_name": schema.StringAttribute{
+				Description: "The name of the SLS logstore which stores the flow log records.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"aggregation_interval": schema.Int64Attribute{
+				Description: "The interval in minutes to aggregate the traffic into the records. Valid values: " +
+					"1, 5, 10. Default to 10.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 5, 10),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to enable the flow log. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcFlowLogResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create the flow log and wait until it is active. The flow log is
+// deactivated after it is created if it is not enabled.
+func (r *vpcFlowLogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcFlowLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createFlowLog := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createFlowLogRequest := &alicloudVpcClient.CreateFlowLogRequest{
+			RegionId:            r.client.RegionId,
+			FlowLogName:         ecsStringPointer(plan.Name),
+			Description:         ecsStringPointer(plan.Description),
+			ResourceType:        tea.String(plan.ResourceType.ValueString()),
+			ResourceId:          tea.String(plan.ResourceId.ValueString()),
+			TrafficType:         tea.String(plan.TrafficType.ValueString()),
+			ProjectName:         tea.String(plan.ProjectName.ValueString()),
+			LogStoreName:        tea.String(plan.LogStoreName.ValueString()),
+			AggregationInterval: tea.Int32(int32(plan.AggregationInterval.ValueInt64())),
+		}
+
+		createFlowLogResponse, err := r.client.CreateFlowLogWithOptions(createFlowLogRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.FlowLogId = types.StringValue(tea.StringValue(createFlowLogResponse.Body.FlowLogId))
+		return nil
+	}
+
+	if err := retryAPICall(createFlowLog); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Flow Log.",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitVpcFlowLog(r.client, plan.FlowLogId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Flow Log Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Enabled.ValueBool() {
+		if err := r.setFlowLogStatus(plan.FlowLogId.ValueString(), false); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Deactive Flow Log.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the flow log.
+func (r *vpcFlowLogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcFlowLogModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flowLog, err := describeVpcFlowLog(r.client, state.FlowLogId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Flow Logs.",
+			err.Error(),
+		)
+		return
+	}
+	if flowLog == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = ecsStringValue(state.Name, flowLog.FlowLogName)
+	state.Description = ecsStringValue(state.Description, flowLog.Description)
+	state.ResourceType = types.StringValue(tea.StringValue(flowLog.ResourceType))
+	state.ResourceId = types.StringValue(tea.StringValue(flowLog.ResourceId))
+	state.TrafficType = types.StringValue(tea.StringValue(flowLog.TrafficType))
+	state.ProjectName = types.StringValue(tea.StringValue(flowLog.ProjectName))
+	state.LogStoreName = types.StringValue(tea.StringValue(flowLog.LogStoreName))
+	state.AggregationInterval = types.Int64Value(int64(tea.Int32Value(flowLog.AggregationInterval)))
+	state.Enabled = types.BoolValue(tea.StringValue(flowLog.Status) == "Active")
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the attributes and the status of the flow log.
+func (r *vpcFlowLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcFlowLogModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flowLogId := state.FlowLogId.ValueString()
+	plan.FlowLogId = state.FlowLogId
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.AggregationInterval.Equal(state.AggregationInterval) {
+		modifyFlowLogAttribute := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyFlowLogAttributeRequest := &alicloudVpcClient.ModifyFlowLogAttributeRequest{
+				RegionId:            r.client.RegionId,
+				FlowLogId:           tea.String(flowLogId),
+				FlowLogName:         tea.String(plan.Name.ValueString()),
+				Description:         tea.String(plan.Description.ValueString()),
+				AggregationInterval: tea.Int32(int32(plan.AggregationInterval.ValueInt64())),
+			}
+
+			if _, err := r.client.ModifyFlowLogAttributeWithOptions(modifyFlowLogAttributeRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyFlowLogAttribute); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify Flow Log Attribute.",
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitVpcFlowLog(r.client, flowLogId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Wait Flow Log Modified.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := r.setFlowLogStatus(flowLogId, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Flow Log Status.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the flow log.
+func (r *vpcFlowLogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcFlowLogModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteFlowLog := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteFlowLogRequest := &alicloudVpcClient.DeleteFlowLogRequest{
+			RegionId:  r.client.RegionId,
+			FlowLogId: tea.String(state.FlowLogId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteFlowLogWithOptions(deleteFlowLogRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteFlowLog); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Flow Log.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcFlowLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("flow_log_id"), req, resp)
+}
+
+// Function to activate or deactivate the flow log, and wait until the status
+// is changed.
+func (r *vpcFlowLogResource) setFlowLogStatus(flowLogId string, enabled bool) error {
+	setFlowLogStatus := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		if enabled {
+			activeFlowLogRequest := &alicloudVpcClient.ActiveFlowLogRequest{
+				RegionId:  r.client.RegionId,
+				FlowLogId: tea.String(flowLogId),
+			}
+
+			if _, err := r.client.ActiveFlowLogWithOptions(activeFlowLogRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		deactiveFlowLogRequest := &alicloudVpcClient.DeactiveFlowLogRequest{
+			RegionId:  r.client.RegionId,
+			FlowLogId: tea.String(flowLogId),
+		}
+
+		if _, err := r.client.DeactiveFlowLogWithOptions(deactiveFlowLogRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(setFlowLogStatus); err != nil {
+		return err
+	}
+
+	_, err := waitVpcFlowLog(r.client, flowLogId)
+	return err
+}
+
+// Function to read the flow log, returns nil if the flow log is not found.
+func describeVpcFlowLog(client *alicloudVpcClient.Client, flowLogId string) (*alicloudVpcClient.DescribeFlowLogsResponseBodyFlowLogsFlowLog, error) {
+	var describeFlowLogsResponse *alicloudVpcClient.DescribeFlowLogsResponse
+	describeFlowLogs := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeFlowLogsRequest := &alicloudVpcClient.DescribeFlowLogsRequest{
+			RegionId:  client.RegionId,
+			FlowLogId: tea.String(flowLogId),
+		}
+
+		var err error
+		describeFlowLogsResponse, err = client.DescribeFlowLogsWithOptions(describeFlowLogsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeFlowLogs); err != nil {
+		return nil, err
+	}
+
+	if describeFlowLogsResponse.Body.FlowLogs == nil || len(describeFlowLogsResponse.Body.FlowLogs.FlowLog) == 0 {
+		return nil, nil
+	}
+	return describeFlowLogsResponse.Body.FlowLogs.FlowLog[0], nil
+}
+
+// Function to wait until the flow log is not in a transitional status, e.g.
+// Activating, returns the flow log.
+func waitVpcFlowLog(client *alicloudVpcClient.Client, flowLogId string) (*alicloudVpcClient.DescribeFlowLogsResponseBodyFlowLogsFlowLog, error) {
+	var flowLog *alicloudVpcClient.DescribeFlowLogsResponseBodyFlowLogsFlowLog
+	waitFlowLog := func() error {
+		var err error
+		flowLog, err = describeVpcFlowLog(client, flowLogId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if flowLog == nil {
+			return backoff.Permanent(fmt.Errorf("flow log %s is not found", flowLogId))
+		}
+		if status := tea.StringValue(flowLog.Status); strings.HasSuffix(status, "ing") {
+			return fmt.Errorf("flow log %s is %s", flowLogId, status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitFlowLog, reconnectBackoff)
+	return flowLog, err
+}
@@ -0,0 +1,624 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcipamClient "github.com/alibabacloud-go/vpcipam-20230228/client"
+)
+
+var (
+	_ resource.Resource                = &vpcIpamPoolResource{}
+	_ resource.ResourceWithConfigure   = &vpcIpamPoolResource{}
+	_ resource.ResourceWithImportState = &vpcIpamPoolResource{}
+)
+
+func NewVpcIpamPoolResource() resource.Resource {
+	return &vpcIpamPoolResource{}
+}
+
+type vpcIpamPoolResource struct {
+	client *alicloudVpcipamClient.Client
+}
+
+type vpcIpamPoolModel struct {
+	IpamPoolId                types.String `tfsdk:"ipam_pool_id"`
+	IpamScopeId               types.String `tfsdk:"ipam_scope_id"`
+	IpamPoolName              types.String `tfsdk:"ipam_pool_name"`
+	Description               types.String `tfsdk:"description"`
+	IpVersion                 types.String `tfsdk:"ip_version"`
+	PoolRegionId              types.String `tfsdk:"pool_region_id"`
+	SourceIpamPoolId          types.String `tfsdk:"source_ipam_pool_id"`
+	AllocationDefaultCidrMask types.Int64  `tfsdk:"allocation_default_cidr_mask"`
+	AllocationMinCidrMask     types.Int64  `tfsdk:"allocation_min_cidr_mask"`
+	AllocationMaxCidrMask     types.Int64  `tfsdk:"allocation_max_cidr_mask"`
+	Cidrs                     types.List   `tfsdk:"cidrs"`
+}
+
+// Metadata returns the VPC IPAM Pool resource name.
+func (r *vpcIpamPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_ipam_pool"
+}
+
+// Schema defines the schema for the VPC IPAM Pool resource.
+func (r *vpcIpamPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an address pool of the VPC IP address manager (IPAM) and the CIDR blocks provisioned " +
+			"into the pool.",
+		Attributes: map[string]schema.Attribute{
+			"ipam_pool_id": schema.StringAttribute{
+				Description: "The ID of the IPAM pool.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipam_scope_id": schema.StringAttribute{
+				Description: "The ID of the IPAM scope to create the pool in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipam_pool_name": schema.StringAttribute{
+				Description: "The name of the IPAM pool.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the IPAM pool.",
+				Optional:    true,
+			},
+			"ip_version": schema.StringAttribute{
+				Description: "The IP version of the IPAM pool. Accepted values: \"IPv4\", \"IPv6\". Default to \"IPv4\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("IPv4"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("IPv4", "IPv6"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pool_region_id": schema.StringAttribute{
+				Description: "The region where the CIDR blocks of the IPAM pool can be used. Leave empty for a pool " +
+					"which is only used to allocate CIDR blocks to its sub pools.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_ipam_pool_id": schema.StringAttribute{
+				Description: "The ID of the parent IPAM pool to allocate the CIDR blocks of this pool from.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_default_cidr_mask": schema.Int64Attribute{
+				Description: "The default mask of the CIDR blocks allocated from the IPAM pool.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
+			},
+			"allocation_min_cidr_mask": schema.Int64Attribute{
+				Description: "The minimum mask of the CIDR blocks allocated from the IPAM pool.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allocation_max_cidr_mask": schema.Int64Attribute{
+				Description: "The maximum mask of the CIDR blocks allocated from the IPAM pool.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cidrs": schema.ListAttribute{
+				Description: "List of CIDR blocks provisioned into the IPAM pool. The CIDR blocks are allocated from " +
+					"the parent pool if source_ipam_pool_id is set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcIpamPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcipamClient
+}
+
+// Create the IPAM pool and provision the CIDR blocks into the pool.
+func (r *vpcIpamPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcIpamPoolModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createIpamPoolResponse *alicloudVpcipamClient.CreateIpamPoolResponse
+	createIpamPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createIpamPoolRequest := &alicloudVpcipamClient.CreateIpamPoolRequest{
+			RegionId:                  r.client.RegionId,
+			IpamScopeId:               tea.String(plan.IpamScopeId.ValueString()),
+			IpamPoolName:              vpcIpamStringPointer(plan.IpamPoolName),
+			IpamPoolDescription:       vpcIpamStringPointer(plan.Description),
+			IpVersion:                 tea.String(plan.IpVersion.ValueString()),
+			PoolRegionId:              vpcIpamStringPointer(plan.PoolRegionId),
+			SourceIpamPoolId:          vpcIpamStringPointer(plan.SourceIpamPoolId),
+			AllocationDefaultCidrMask: vpcIpamInt32Pointer(plan.AllocationDefaultCidrMask),
+			AllocationMinCidrMask:     vpcIpamInt32Pointer(plan.AllocationMinCidrMask),
+			AllocationMaxCidrMask:     vpcIpamInt32Pointer(plan.AllocationMaxCidrMask),
+		}
+
+		var err error
+		createIpamPoolResponse, err = r.client.CreateIpamPoolWithOptions(createIpamPoolRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createIpamPool); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create IPAM Pool.",
+			err.Error(),
+		)
+		return
+	}
+
+	ipamPoolId := tea.StringValue(createIpamPoolResponse.Body.IpamPoolId)
+	ipamPool, err := r.waitIpamPoolCreated(ipamPoolId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait IPAM Pool Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the created IPAM pool into state before provisioning the CIDR
+	// blocks, so that the pool is not leaked when provisioning fails.
+	state := &vpcIpamPoolModel{
+		IpamPoolId:                types.StringValue(ipamPoolId),
+		IpamScopeId:               plan.IpamScopeId,
+		IpamPoolName:              plan.IpamPoolName,
+		Description:               plan.Description,
+		IpVersion:                 plan.IpVersion,
+		PoolRegionId:              plan.PoolRegionId,
+		SourceIpamPoolId:          plan.SourceIpamPoolId,
+		AllocationDefaultCidrMask: plan.AllocationDefaultCidrMask,
+		AllocationMinCidrMask:     types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMinCidrMask))),
+		AllocationMaxCidrMask:     types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMaxCidrMask))),
+		Cidrs:                     types.ListNull(types.StringType),
+	}
+
+	var provisionedCidrs []attr.Value
+	for _, cidr := range convertListValueToStrings(plan.Cidrs) {
+		if err := r.addIpamPoolCidr(ipamPoolId, cidr); err != nil {
+			if len(provisionedCidrs) > 0 {
+				state.Cidrs = types.ListValueMust(types.StringType, provisionedCidrs)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Add IPAM Pool CIDR.",
+				err.Error(),
+			)
+			return
+		}
+		provisionedCidrs = append(provisionedCidrs, types.StringValue(cidr))
+	}
+	state.Cidrs = plan.Cidrs
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the IPAM pool and the CIDR blocks provisioned into the pool.
+func (r *vpcIpamPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcIpamPoolModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipamPool, err := r.describeIpamPool(state.IpamPoolId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List IPAM Pools.",
+			err.Error(),
+		)
+		return
+	}
+	if ipamPool == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.IpamScopeId = types.StringValue(tea.StringValue(ipamPool.IpamScopeId))
+	state.IpamPoolName = vpcIpamStringValue(state.IpamPoolName, ipamPool.IpamPoolName)
+	state.Description = vpcIpamStringValue(state.Description, ipamPool.IpamPoolDescription)
+	state.IpVersion = types.StringValue(tea.StringValue(ipamPool.IpVersion))
+	state.PoolRegionId = vpcIpamStringValue(state.PoolRegionId, ipamPool.PoolRegionId)
+	state.SourceIpamPoolId = vpcIpamStringValue(state.SourceIpamPoolId, ipamPool.SourceIpamPoolId)
+	if tea.Int32Value(ipamPool.AllocationDefaultCidrMask) == 0 && state.AllocationDefaultCidrMask.IsNull() {
+		state.AllocationDefaultCidrMask = types.Int64Null()
+	} else {
+		state.AllocationDefaultCidrMask = types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationDefaultCidrMask)))
+	}
+	state.AllocationMinCidrMask = types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMinCidrMask)))
+	state.AllocationMaxCidrMask = types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMaxCidrMask)))
+
+	cidrs, err := r.listIpamPoolCidrs(state.IpamPoolId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List IPAM Pool CIDRs.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Keep the order of the CIDR blocks in state, and append the CIDR blocks
+	// provisioned outside of Terraform.
+	provisioned := make(map[string]bool)
+	for _, cidr := range cidrs {
+		provisioned[cidr] = true
+	}
+	orderedCidrs := []attr.Value{}
+	for _, cidr := range convertListValueToStrings(state.Cidrs) {
+		if provisioned[cidr] {
+			orderedCidrs = append(orderedCidrs, types.StringValue(cidr))
+			delete(provisioned, cidr)
+		}
+	}
+	for _, cidr := range cidrs {
+		if provisioned[cidr] {
+			orderedCidrs = append(orderedCidrs, types.StringValue(cidr))
+		}
+	}
+	if len(orderedCidrs) > 0 || !state.Cidrs.IsNull() {
+		state.Cidrs = types.ListValueMust(types.StringType, orderedCidrs)
+	}
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the IPAM pool, and provision or deprovision the changed CIDR blocks.
+func (r *vpcIpamPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcIpamPoolModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipamPoolId := state.IpamPoolId.ValueString()
+	updateIpamPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateIpamPoolRequest := &alicloudVpcipamClient.UpdateIpamPoolRequest{
+			RegionId:                  r.client.RegionId,
+			IpamPoolId:                tea.String(ipamPoolId),
+			IpamPoolName:              tea.String(plan.IpamPoolName.ValueString()),
+			IpamPoolDescription:       tea.String(plan.Description.ValueString()),
+			AllocationDefaultCidrMask: vpcIpamInt32Pointer(plan.AllocationDefaultCidrMask),
+			AllocationMinCidrMask:     vpcIpamInt32Pointer(plan.AllocationMinCidrMask),
+			AllocationMaxCidrMask:     vpcIpamInt32Pointer(plan.AllocationMaxCidrMask),
+		}
+		if plan.AllocationDefaultCidrMask.IsNull() && !state.AllocationDefaultCidrMask.IsNull() {
+			updateIpamPoolRequest.ClearAllocationDefaultCidrMask = tea.Bool(true)
+		}
+
+		if _, err := r.client.UpdateIpamPoolWithOptions(updateIpamPoolRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateIpamPool); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update IPAM Pool.",
+			err.Error(),
+		)
+		return
+	}
+
+	planCidrs := convertListValueToStrings(plan.Cidrs)
+	stateCidrs := convertListValueToStrings(state.Cidrs)
+	for _, cidr := range convertStringsDifference(stateCidrs, planCidrs) {
+		if err := r.deleteIpamPoolCidr(ipamPoolId, cidr); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete IPAM Pool CIDR.",
+				err.Error(),
+			)
+			return
+		}
+	}
+	for _, cidr := range convertStringsDifference(planCidrs, stateCidrs) {
+		if err := r.addIpamPoolCidr(ipamPoolId, cidr); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Add IPAM Pool CIDR.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	ipamPool, err := r.describeIpamPool(ipamPoolId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List IPAM Pools.",
+			err.Error(),
+		)
+		return
+	}
+	if ipamPool != nil {
+		plan.AllocationMinCidrMask = types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMinCidrMask)))
+		plan.AllocationMaxCidrMask = types.Int64Value(int64(tea.Int32Value(ipamPool.AllocationMaxCidrMask)))
+	}
+	plan.IpamPoolId = state.IpamPoolId
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Deprovision the CIDR blocks and delete the IPAM pool.
+func (r *vpcIpamPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcIpamPoolModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipamPoolId := state.IpamPoolId.ValueString()
+	for _, cidr := range convertListValueToStrings(state.Cidrs) {
+		if err := r.deleteIpamPoolCidr(ipamPoolId, cidr); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete IPAM Pool CIDR.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	deleteIpamPool := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteIpamPoolRequest := &alicloudVpcipamClient.DeleteIpamPoolRequest{
+			RegionId:   r.client.RegionId,
+			IpamPoolId: tea.String(ipamPoolId),
+		}
+
+		if _, err := r.client.DeleteIpamPoolWithOptions(deleteIpamPoolRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteIpamPool); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete IPAM Pool.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcIpamPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ipam_pool_id"), req, resp)
+}
+
+// Function to read the IPAM pool, returns nil if the pool is not found.
+func (r *vpcIpamPoolResource) describeIpamPool(ipamPoolId string) (*alicloudVpcipamClient.ListIpamPoolsResponseBodyIpamPools, error) {
+	var listIpamPoolsResponse *alicloudVpcipamClient.ListIpamPoolsResponse
+	listIpamPools := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listIpamPoolsRequest := &alicloudVpcipamClient.ListIpamPoolsRequest{
+			RegionId:    r.client.RegionId,
+			IpamPoolIds: []*string{tea.String(ipamPoolId)},
+		}
+
+		var err error
+		listIpamPoolsResponse, err = r.client.ListIpamPoolsWithOptions(listIpamPoolsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listIpamPools); err != nil {
+		return nil, err
+	}
+
+	if len(listIpamPoolsResponse.Body.IpamPools) == 0 {
+		return nil, nil
+	}
+	return listIpamPoolsResponse.Body.IpamPools[0], nil
+}
+
+// Function to wait until the IPAM pool is created.
+func (r *vpcIpamPoolResource) waitIpamPoolCreated(ipamPoolId string) (*alicloudVpcipamClient.ListIpamPoolsResponseBodyIpamPools, error) {
+	var ipamPool *alicloudVpcipamClient.ListIpamPoolsResponseBodyIpamPools
+	waitIpamPoolCreated := func() error {
+		var err error
+		ipamPool, err = r.describeIpamPool(ipamPoolId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if ipamPool == nil {
+			return fmt.Errorf("IPAM pool %s is not found", ipamPoolId)
+		}
+		if status := tea.StringValue(ipamPool.Status); status != "Created" {
+			return fmt.Errorf("IPAM pool %s is %s", ipamPoolId, status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	if err := backoff.Retry(waitIpamPoolCreated, reconnectBackoff); err != nil {
+		return nil, err
+	}
+	return ipamPool, nil
+}
+
+// Function to list all the CIDR blocks provisioned into the IPAM pool.
+func (r *vpcIpamPoolResource) listIpamPoolCidrs(ipamPoolId string) ([]string, error) {
+	cidrs := []string{}
+	var nextToken *string
+
+	for {
+		var listIpamPoolCidrsResponse *alicloudVpcipamClient.ListIpamPoolCidrsResponse
+		listIpamPoolCidrs := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			listIpamPoolCidrsRequest := &alicloudVpcipamClient.ListIpamPoolCidrsRequest{
+				RegionId:   r.client.RegionId,
+				IpamPoolId: tea.String(ipamPoolId),
+				MaxResults: tea.Int32(100),
+				NextToken:  nextToken,
+			}
+
+			var err error
+			listIpamPoolCidrsResponse, err = r.client.ListIpamPoolCidrsWithOptions(listIpamPoolCidrsRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(listIpamPoolCidrs); err != nil {
+			return nil, err
+		}
+
+		for _, ipamPoolCidr := range listIpamPoolCidrsResponse.Body.IpamPoolCidrs {
+			cidrs = append(cidrs, tea.StringValue(ipamPoolCidr.Cidr))
+		}
+
+		nextToken = listIpamPoolCidrsResponse.Body.NextToken
+		if tea.StringValue(nextToken) == "" {
+			break
+		}
+	}
+	return cidrs, nil
+}
+
+// Function to provision the CIDR block into the IPAM pool.
+func (r *vpcIpamPoolResource) addIpamPoolCidr(ipamPoolId, cidr string) error {
+	addIpamPoolCidr := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		addIpamPoolCidrRequest := &alicloudVpcipamClient.AddIpamPoolCidrRequest{
+			RegionId:   r.client.RegionId,
+			IpamPoolId: tea.String(ipamPoolId),
+			Cidr:       tea.String(cidr),
+		}
+
+		if _, err := r.client.AddIpamPoolCidrWithOptions(addIpamPoolCidrRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(addIpamPoolCidr)
+}
+
+// Function to deprovision the CIDR block from the IPAM pool.
+func (r *vpcIpamPoolResource) deleteIpamPoolCidr(ipamPoolId, cidr string) error {
+	deleteIpamPoolCidr := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteIpamPoolCidrRequest := &alicloudVpcipamClient.DeleteIpamPoolCidrRequest{
+			RegionId:   r.client.RegionId,
+			IpamPoolId: tea.String(ipamPoolId),
+			Cidr:       tea.String(cidr),
+		}
+
+		if _, err := r.client.DeleteIpamPoolCidrWithOptions(deleteIpamPoolCidrRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	return retryAPICall(deleteIpamPoolCidr)
+}
+
+func vpcIpamStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.String(value.ValueString())
+}
+
+func vpcIpamInt32Pointer(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return tea.Int32(int32(value.ValueInt64()))
+}
+
+// Keep the optional value null if it is not configured and AliCloud API
+// returns an empty value.
+func vpcIpamStringValue(prev types.String, value *string) types.String {
+	if prev.IsNull() && tea.StringValue(value) == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(tea.StringValue(value))
+}
@@ -0,0 +1,279 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcipamClient "github.com/alibabacloud-go/vpcipam-20230228/client"
+)
+
+var (
+	_ resource.Resource                = &vpcIpamPoolAllocationResource{}
+	_ resource.ResourceWithConfigure   = &vpcIpamPoolAllocationResource{}
+	_ resource.ResourceWithImportState = &vpcIpamPoolAllocationResource{}
+)
+
+func NewVpcIpamPoolAllocationResource() resource.Resource {
+	return &vpcIpamPoolAllocationResource{}
+}
+
+type vpcIpamPoolAllocationResource struct {
+	client *alicloudVpcipamClient.Client
+}
+
+type vpcIpamPoolAllocationModel struct {
+	IpamPoolAllocationId types.String `tfsdk:"ipam_pool_allocation_id"`
+	IpamPoolId           types.String `tfsdk:"ipam_pool_id"`
+	Cidr                 types.String `tfsdk:"cidr"`
+	CidrMask             types.Int64  `tfsdk:"cidr_mask"`
+	SourceCidr           types.String `tfsdk:"source_cidr"`
+}
+
+// Metadata returns the VPC IPAM Pool Allocation resource name.
+func (r *vpcIpamPoolAllocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_ipam_pool_allocation"
+}
+
+// Schema defines the schema for the VPC IPAM Pool Allocation resource.
+func (r *vpcIpamPoolAllocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Allocate a CIDR block from an address pool of the VPC IP address manager (IPAM), the allocated " +
+			"CIDR block is reserved and can be used by a VPC or VSwitch.",
+		Attributes: map[string]schema.Attribute{
+			"ipam_pool_allocation_id": schema.StringAttribute{
+				Description: "The ID of the allocation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipam_pool_id": schema.StringAttribute{
+				Description: "The ID of the IPAM pool to allocate the CIDR block from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The CIDR block to allocate. Conflicts with cidr_mask, the CIDR block is allocated " +
+					"automatically if cidr_mask is set.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cidr_mask")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"cidr_mask": schema.Int64Attribute{
+				Description: "The mask of the CIDR block to allocate automatically from the IPAM pool.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source_cidr": schema.StringAttribute{
+				Description: "The CIDR block of the IPAM pool which the allocated CIDR block belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcIpamPoolAllocationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcipamClient
+}
+
+// Allocate the CIDR block from the IPAM pool.
+func (r *vpcIpamPoolAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcIpamPoolAllocationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createIpamPoolAllocationResponse *alicloudVpcipamClient.CreateIpamPoolAllocationResponse
+	createIpamPoolAllocation := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createIpamPoolAllocationRequest := &alicloudVpcipamClient.CreateIpamPoolAllocationRequest{
+			RegionId:   r.client.RegionId,
+			IpamPoolId: tea.String(plan.IpamPoolId.ValueString()),
+			Cidr:       vpcIpamStringPointer(plan.Cidr),
+			CidrMask:   vpcIpamInt32Pointer(plan.CidrMask),
+		}
+
+		var err error
+		createIpamPoolAllocationResponse, err = r.client.CreateIpamPoolAllocationWithOptions(createIpamPoolAllocationRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createIpamPoolAllocation); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create IPAM Pool Allocation.",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.IpamPoolAllocationId = types.StringValue(tea.StringValue(createIpamPoolAllocationResponse.Body.IpamPoolAllocationId))
+	plan.Cidr = types.StringValue(tea.StringValue(createIpamPoolAllocationResponse.Body.Cidr))
+	plan.SourceCidr = types.StringValue(tea.StringValue(createIpamPoolAllocationResponse.Body.SourceCidr))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the allocation of the IPAM pool.
+func (r *vpcIpamPoolAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcIpamPoolAllocationModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var listIpamPoolAllocationsResponse *alicloudVpcipamClient.ListIpamPoolAllocationsResponse
+	listIpamPoolAllocations := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listIpamPoolAllocationsRequest := &alicloudVpcipamClient.ListIpamPoolAllocationsRequest{
+			RegionId:              r.client.RegionId,
+			IpamPoolId:            tea.String(state.IpamPoolId.ValueString()),
+			IpamPoolAllocationIds: []*string{tea.String(state.IpamPoolAllocationId.ValueString())},
+		}
+
+		var err error
+		listIpamPoolAllocationsResponse, err = r.client.ListIpamPoolAllocationsWithOptions(listIpamPoolAllocationsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listIpamPoolAllocations); err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && strings.HasSuffix(tea.StringValue(_t.Code), ".NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List IPAM Pool Allocations.",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(listIpamPoolAllocationsResponse.Body.IpamPoolAllocations) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	allocation := listIpamPoolAllocationsResponse.Body.IpamPoolAllocations[0]
+	state.IpamPoolId = types.StringValue(tea.StringValue(allocation.IpamPoolId))
+	state.Cidr = types.StringValue(tea.StringValue(allocation.Cidr))
+	state.SourceCidr = types.StringValue(tea.StringValue(allocation.SourceCidr))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// All the attributes require replacement, there is nothing to update.
+func (r *vpcIpamPoolAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *vpcIpamPoolAllocationModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Release the allocated CIDR block back to the IPAM pool.
+func (r *vpcIpamPoolAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcIpamPoolAllocationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteIpamPoolAllocation := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteIpamPoolAllocationRequest := &alicloudVpcipamClient.DeleteIpamPoolAllocationRequest{
+			RegionId:             r.client.RegionId,
+			IpamPoolId:           tea.String(state.IpamPoolId.ValueString()),
+			IpamPoolAllocationId: tea.String(state.IpamPoolAllocationId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteIpamPoolAllocationWithOptions(deleteIpamPoolAllocationRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteIpamPoolAllocation); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete IPAM Pool Allocation.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the allocation by the ID in the format of
+// <ipam_pool_id>:<ipam_pool_allocation_id>.
+func (r *vpcIpamPoolAllocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <ipam_pool_id>:<ipam_pool_allocation_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipam_pool_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipam_pool_allocation_id"), ids[1])...)
+}
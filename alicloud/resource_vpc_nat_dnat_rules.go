@@ -0,0 +1,616 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                   = &vpcNatDnatRulesResource{}
+	_ resource.ResourceWithConfigure      = &vpcNatDnatRulesResource{}
+	_ resource.ResourceWithImportState    = &vpcNatDnatRulesResource{}
+	_ resource.ResourceWithValidateConfig = &vpcNatDnatRulesResource{}
+)
+
+func NewVpcNatDnatRulesResource() resource.Resource {
+	return &vpcNatDnatRulesResource{}
+}
+
+type vpcNatDnatRulesResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcNatDnatRulesModel struct {
+	ForwardTableId types.String   `tfsdk:"forward_table_id"`
+	DnatRules      []*vpcDnatRule `tfsdk:"dnat_rules"`
+}
+
+type vpcDnatRule struct {
+	Name           types.String `tfsdk:"name"`
+	ExternalIp     types.String `tfsdk:"external_ip"`
+	ExternalPort   types.String `tfsdk:"external_port"`
+	IpProtocol     types.String `tfsdk:"ip_protocol"`
+	InternalIp     types.String `tfsdk:"internal_ip"`
+	InternalPort   types.String `tfsdk:"internal_port"`
+	PortBreak      types.Bool   `tfsdk:"port_break"`
+	ForwardEntryId types.String `tfsdk:"forward_entry_id"`
+}
+
+// A DNAT rule is identified by the external IP, external port and protocol,
+// which must be unique in the DNAT table.
+func (rule *vpcDnatRule) key() string {
+	return fmt.Sprintf("%s:%s/%s",
+		rule.ExternalIp.ValueString(),
+		strings.ToLower(rule.ExternalPort.ValueString()),
+		strings.ToLower(rule.IpProtocol.ValueString()),
+	)
+}
+
+// Metadata returns the VPC NAT DNAT Rules resource name.
+func (r *vpcNatDnatRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_nat_dnat_rules"
+}
+
+// Schema defines the schema for the VPC NAT DNAT Rules resource.
+func (r *vpcNatDnatRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the full DNAT table of a NAT gateway. The DNAT rules created outside of this resource " +
+			"are removed from the DNAT table.",
+		Attributes: map[string]schema.Attribute{
+			"forward_table_id": schema.StringAttribute{
+				Description: "The ID of the DNAT table of the NAT gateway.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dnat_rules": schema.ListNestedBlock{
+				Description: "List of DNAT rules in the DNAT table. The DNAT rules are identified by the external IP, " +
+					"external port and protocol.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the DNAT rule.",
+							Optional:    true,
+						},
+						"external_ip": schema.StringAttribute{
+							Description: "The EIP of the NAT gateway to receive the traffic.",
+							Required:    true,
+						},
+						"external_port": schema.StringAttribute{
+							Description: "The external port or port range in the format of A/B, e.g. 80 or 1024/1030. " +
+								"Set to \"Any\" to map the EIP to the internal IP.",
+							Required: true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							Description: "The protocol of the DNAT rule. Accepted values: \"TCP\", \"UDP\", \"Any\".",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("TCP", "UDP", "Any"),
+							},
+						},
+						"internal_ip": schema.StringAttribute{
+							Description: "The private IP of the ECS instance or ENI to forward the traffic.",
+							Required:    true,
+						},
+						"internal_port": schema.StringAttribute{
+							Description: "The internal port or port range in the format of A/B, must match the external port.",
+							Required:    true,
+						},
+						"port_break": schema.BoolAttribute{
+							Description: "Whether to remove the port mapping restriction. Only used when creating or modifying the DNAT rule.",
+							Optional:    true,
+						},
+						"forward_entry_id": schema.StringAttribute{
+							Description: "The ID of the DNAT rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig detects the conflicting DNAT rules during plan.
+func (r *vpcNatDnatRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *vpcNatDnatRulesModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the DNAT rules with known external IP, port and protocol are
+	// compared.
+	var rules []*vpcDnatRule
+	for _, rule := range config.DnatRules {
+		if rule.ExternalIp.IsUnknown() || rule.ExternalPort.IsUnknown() || rule.IpProtocol.IsUnknown() {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	for i, rule := range rules {
+		for _, other := range rules[i+1:] {
+			if err := checkVpcDnatRuleConflict(rule, other); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("dnat_rules"),
+					"Conflicting DNAT Rules",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcNatDnatRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create all the DNAT rules in the DNAT table, the existing DNAT rules are
+// removed.
+func (r *vpcNatDnatRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcNatDnatRulesModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existingRules, err := r.describeForwardTableEntries(plan.ForwardTableId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Forward Table Entries.",
+			err.Error(),
+		)
+		return
+	}
+
+	state := &vpcNatDnatRulesModel{
+		ForwardTableId: plan.ForwardTableId,
+		DnatRules:      existingRules,
+	}
+	r.reconcile(ctx, state, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read all the DNAT rules in the DNAT table. The DNAT rules in state are kept
+// in the same order, and the DNAT rules created outside of Terraform are
+// appended, so that they are removed in the next apply.
+func (r *vpcNatDnatRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcNatDnatRulesModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.describeForwardTableEntries(state.ForwardTableId.ValueString())
+	if err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidForwardTableId.NotFound" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Forward Table Entries.",
+			err.Error(),
+		)
+		return
+	}
+
+	rulesByKey := make(map[string]*vpcDnatRule)
+	for _, rule := range rules {
+		rulesByKey[rule.key()] = rule
+	}
+
+	ordered := []*vpcDnatRule{}
+	for _, stateRule := range state.DnatRules {
+		rule, ok := rulesByKey[stateRule.key()]
+		if !ok {
+			continue
+		}
+		// PortBreak is not returned by AliCloud API.
+		rule.PortBreak = stateRule.PortBreak
+		if stateRule.Name.IsNull() && rule.Name.ValueString() == "" {
+			rule.Name = types.StringNull()
+		}
+		ordered = append(ordered, rule)
+		delete(rulesByKey, stateRule.key())
+	}
+	for _, rule := range rules {
+		if _, ok := rulesByKey[rule.key()]; ok {
+			ordered = append(ordered, rule)
+		}
+	}
+	state.DnatRules = ordered
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Reconcile the DNAT table with the planned DNAT rules.
+func (r *vpcNatDnatRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcNatDnatRulesModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, state, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete all the DNAT rules in the DNAT table.
+func (r *vpcNatDnatRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcNatDnatRulesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range state.DnatRules {
+		if err := r.deleteForwardEntry(state.ForwardTableId.ValueString(), rule.ForwardEntryId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Forward Entry.",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *vpcNatDnatRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("forward_table_id"), req, resp)
+}
+
+// Function to reconcile the DNAT rules from the current state to the plan.
+// The removed DNAT rules are deleted first to release the external ports,
+// then the changed DNAT rules are modified and the added DNAT rules are
+// created. The state is saved with the finished changes on error.
+func (r *vpcNatDnatRulesResource) reconcile(ctx context.Context, state, plan *vpcNatDnatRulesModel, tfState *tfsdk.State, diagnostics *diag.Diagnostics) {
+	forwardTableId := plan.ForwardTableId.ValueString()
+
+	planRules := make(map[string]*vpcDnatRule)
+	for _, rule := range plan.DnatRules {
+		planRules[rule.key()] = rule
+	}
+	stateRules := make(map[string]*vpcDnatRule)
+	for _, rule := range state.DnatRules {
+		stateRules[rule.key()] = rule
+	}
+
+	// Save the finished changes into state when any of the changes fails.
+	saveState := func(err error, summary string) {
+		diagnostics.AddError(summary, err.Error())
+		current := &vpcNatDnatRulesModel{ForwardTableId: plan.ForwardTableId, DnatRules: []*vpcDnatRule{}}
+		for _, rule := range plan.DnatRules {
+			if !rule.ForwardEntryId.IsUnknown() {
+				current.DnatRules = append(current.DnatRules, rule)
+			} else if stateRule, ok := stateRules[rule.key()]; ok {
+				current.DnatRules = append(current.DnatRules, stateRule)
+			}
+		}
+		diagnostics.Append(tfState.Set(ctx, &current)...)
+	}
+
+	for _, stateRule := range state.DnatRules {
+		if _, ok := planRules[stateRule.key()]; ok {
+			continue
+		}
+		if err := r.deleteForwardEntry(forwardTableId, stateRule.ForwardEntryId.ValueString()); err != nil {
+			saveState(err, "[API ERROR] Failed to Delete Forward Entry.")
+			return
+		}
+		delete(stateRules, stateRule.key())
+	}
+
+	for _, planRule := range plan.DnatRules {
+		stateRule, ok := stateRules[planRule.key()]
+		if !ok {
+			continue
+		}
+		planRule.ForwardEntryId = stateRule.ForwardEntryId
+		if planRule.Name.Equal(stateRule.Name) &&
+			planRule.InternalIp.Equal(stateRule.InternalIp) &&
+			planRule.InternalPort.Equal(stateRule.InternalPort) {
+			continue
+		}
+		if err := r.modifyForwardEntry(forwardTableId, planRule); err != nil {
+			planRule.ForwardEntryId = types.StringUnknown()
+			saveState(err, "[API ERROR] Failed to Modify Forward Entry.")
+			return
+		}
+	}
+
+	for _, planRule := range plan.DnatRules {
+		if _, ok := stateRules[planRule.key()]; ok {
+			continue
+		}
+		if err := r.createForwardEntry(forwardTableId, planRule); err != nil {
+			saveState(err, "[API ERROR] Failed to Create Forward Entry.")
+			return
+		}
+	}
+
+	diagnostics.Append(tfState.Set(ctx, &plan)...)
+}
+
+// Function to read all the DNAT rules in the DNAT table.
+func (r *vpcNatDnatRulesResource) describeForwardTableEntries(forwardTableId string) ([]*vpcDnatRule, error) {
+	rules := []*vpcDnatRule{}
+	pageNumber := int32(1)
+	pageSize := int32(50)
+
+	for {
+		var describeForwardTableEntriesResponse *alicloudVpcClient.DescribeForwardTableEntriesResponse
+		describeForwardTableEntries := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeForwardTableEntriesRequest := &alicloudVpcClient.DescribeForwardTableEntriesRequest{
+				RegionId:       r.client.RegionId,
+				ForwardTableId: tea.String(forwardTableId),
+				PageNumber:     tea.Int32(pageNumber),
+				PageSize:       tea.Int32(pageSize),
+			}
+
+			var err error
+			describeForwardTableEntriesResponse, err = r.client.DescribeForwardTableEntriesWithOptions(describeForwardTableEntriesRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeForwardTableEntries); err != nil {
+			return nil, err
+		}
+
+		if describeForwardTableEntriesResponse.Body.ForwardTableEntries != nil {
+			for _, entry := range describeForwardTableEntriesResponse.Body.ForwardTableEntries.ForwardTableEntry {
+				rules = append(rules, &vpcDnatRule{
+					Name:           types.StringValue(tea.StringValue(entry.ForwardEntryName)),
+					ExternalIp:     types.StringValue(tea.StringValue(entry.ExternalIp)),
+					ExternalPort:   types.StringValue(tea.StringValue(entry.ExternalPort)),
+					IpProtocol:     types.StringValue(tea.StringValue(entry.IpProtocol)),
+					InternalIp:     types.StringValue(tea.StringValue(entry.InternalIp)),
+					InternalPort:   types.StringValue(tea.StringValue(entry.InternalPort)),
+					PortBreak:      types.BoolNull(),
+					ForwardEntryId: types.StringValue(tea.StringValue(entry.ForwardEntryId)),
+				})
+			}
+		}
+
+		if pageNumber*pageSize >= tea.Int32Value(describeForwardTableEntriesResponse.Body.TotalCount) {
+			break
+		}
+		pageNumber++
+	}
+	return rules, nil
+}
+
+// Function to create the DNAT rule and wait until it is available, the ID
+// of the created DNAT rule is set into the model.
+func (r *vpcNatDnatRulesResource) createForwardEntry(forwardTableId string, rule *vpcDnatRule) error {
+	var createForwardEntryResponse *alicloudVpcClient.CreateForwardEntryResponse
+	createForwardEntry := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createForwardEntryRequest := &alicloudVpcClient.CreateForwardEntryRequest{
+			RegionId:       r.client.RegionId,
+			ForwardTableId: tea.String(forwardTableId),
+			ExternalIp:     tea.String(rule.ExternalIp.ValueString()),
+			ExternalPort:   tea.String(rule.ExternalPort.ValueString()),
+			IpProtocol:     tea.String(rule.IpProtocol.ValueString()),
+			InternalIp:     tea.String(rule.InternalIp.ValueString()),
+			InternalPort:   tea.String(rule.InternalPort.ValueString()),
+		}
+		if !rule.Name.IsNull() {
+			createForwardEntryRequest.ForwardEntryName = tea.String(rule.Name.ValueString())
+		}
+		if !rule.PortBreak.IsNull() {
+			createForwardEntryRequest.PortBreak = tea.Bool(rule.PortBreak.ValueBool())
+		}
+
+		var err error
+		createForwardEntryResponse, err = r.client.CreateForwardEntryWithOptions(createForwardEntryRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createForwardEntry); err != nil {
+		return err
+	}
+
+	forwardEntryId := tea.StringValue(createForwardEntryResponse.Body.ForwardEntryId)
+	if err := r.waitForwardEntry(forwardTableId, forwardEntryId, false); err != nil {
+		return err
+	}
+	rule.ForwardEntryId = types.StringValue(forwardEntryId)
+	return nil
+}
+
+// Function to modify the DNAT rule and wait until it is available.
+func (r *vpcNatDnatRulesResource) modifyForwardEntry(forwardTableId string, rule *vpcDnatRule) error {
+	modifyForwardEntry := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyForwardEntryRequest := &alicloudVpcClient.ModifyForwardEntryRequest{
+			RegionId:         r.client.RegionId,
+			ForwardTableId:   tea.String(forwardTableId),
+			ForwardEntryId:   tea.String(rule.ForwardEntryId.ValueString()),
+			ForwardEntryName: tea.String(rule.Name.ValueString()),
+			InternalIp:       tea.String(rule.InternalIp.ValueString()),
+			InternalPort:     tea.String(rule.InternalPort.ValueString()),
+		}
+		if !rule.PortBreak.IsNull() {
+			modifyForwardEntryRequest.PortBreak = tea.Bool(rule.PortBreak.ValueBool())
+		}
+
+		if _, err := r.client.ModifyForwardEntryWithOptions(modifyForwardEntryRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyForwardEntry); err != nil {
+		return err
+	}
+	return r.waitForwardEntry(forwardTableId, rule.ForwardEntryId.ValueString(), false)
+}
+
+// Function to delete the DNAT rule and wait until it is deleted.
+func (r *vpcNatDnatRulesResource) deleteForwardEntry(forwardTableId, forwardEntryId string) error {
+	deleteForwardEntry := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteForwardEntryRequest := &alicloudVpcClient.DeleteForwardEntryRequest{
+			RegionId:       r.client.RegionId,
+			ForwardTableId: tea.String(forwardTableId),
+			ForwardEntryId: tea.String(forwardEntryId),
+		}
+
+		if _, err := r.client.DeleteForwardEntryWithOptions(deleteForwardEntryRequest, runtime); err != nil {
+			if _t, ok := err.(*tea.SDKError); ok && tea.StringValue(_t.Code) == "InvalidForwardEntryId.NotFound" {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteForwardEntry); err != nil {
+		return err
+	}
+	return r.waitForwardEntry(forwardTableId, forwardEntryId, true)
+}
+
+// Function to wait until the DNAT rule is available, or is deleted if
+// deleted is true. The DNAT rules of a NAT gateway are changed
+// asynchronously, and the DNAT table can not be changed while any DNAT rule
+// is pending.
+func (r *vpcNatDnatRulesResource) waitForwardEntry(forwardTableId, forwardEntryId string, deleted bool) error {
+	waitForwardEntry := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeForwardTableEntriesRequest := &alicloudVpcClient.DescribeForwardTableEntriesRequest{
+			RegionId:       r.client.RegionId,
+			ForwardTableId: tea.String(forwardTableId),
+			ForwardEntryId: tea.String(forwardEntryId),
+		}
+
+		describeForwardTableEntriesResponse, err := r.client.DescribeForwardTableEntriesWithOptions(describeForwardTableEntriesRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		var entries []*alicloudVpcClient.DescribeForwardTableEntriesResponseBodyForwardTableEntriesForwardTableEntry
+		if describeForwardTableEntriesResponse.Body.ForwardTableEntries != nil {
+			entries = describeForwardTableEntriesResponse.Body.ForwardTableEntries.ForwardTableEntry
+		}
+		if deleted {
+			if len(entries) == 0 {
+				return nil
+			}
+			return fmt.Errorf("forward entry %s is still being deleted", forwardEntryId)
+		}
+		if len(entries) == 0 {
+			return backoff.Permanent(fmt.Errorf("forward entry %s is not found", forwardEntryId))
+		}
+		if status := tea.StringValue(entries[0].Status); status != "Available" {
+			return fmt.Errorf("forward entry %s is %s", forwardEntryId, status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 5 * time.Minute
+	return backoff.Retry(waitForwardEntry, reconnectBackoff)
+}
+
+// Check whether two DNAT rules conflict with each other in the same DNAT
+// table.
+func checkVpcDnatRuleConflict(rule, other *vpcDnatRule) error {
+	if !rule.ExternalIp.Equal(other.ExternalIp) {
+		return nil
+	}
+
+	externalIp := rule.ExternalIp.ValueString()
+	if strings.EqualFold(rule.ExternalPort.ValueString(), "any") || strings.EqualFold(other.ExternalPort.ValueString(), "any") {
+		return fmt.Errorf("the external IP %s is mapped with port \"Any\", no other DNAT rule can use the same external IP", externalIp)
+	}
+	if !vpcDnatPortRangesOverlap(rule.ExternalPort.ValueString(), other.ExternalPort.ValueString()) {
+		return nil
+	}
+	if strings.EqualFold(rule.IpProtocol.ValueString(), "any") ||
+		strings.EqualFold(other.IpProtocol.ValueString(), "any") ||
+		strings.EqualFold(rule.IpProtocol.ValueString(), other.IpProtocol.ValueString()) {
+		return fmt.Errorf("the external port %s and %s of the external IP %s overlap with the same protocol",
+			rule.ExternalPort.ValueString(), other.ExternalPort.ValueString(), externalIp)
+	}
+	return nil
+}
+
+// Check whether two ports or port ranges in the format of A/B overlap. The
+// ports that can not be parsed are compared as string.
+func vpcDnatPortRangesOverlap(port, other string) bool {
+	start, end, err := parseVpcDnatPortRange(port)
+	if err != nil {
+		return port == other
+	}
+	otherStart, otherEnd, err := parseVpcDnatPortRange(other)
+	if err != nil {
+		return port == other
+	}
+	return start <= otherEnd && otherStart <= end
+}
+
+func parseVpcDnatPortRange(port string) (int, int, error) {
+	startPort, endPort, isRange := strings.Cut(port, "/")
+	var start, end int
+	if _, err := fmt.Sscanf(startPort, "%d", &start); err != nil {
+		return 0, 0, err
+	}
+	end = start
+	if isRange {
+		if _, err := fmt.Sscanf(endPort, "%d", &end); err != nil {
+			return 0, 0, err
+		}
+	}
+	return start, end, nil
+}
@@ -0,0 +1,844 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	alicloudOpenapiClient "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	alicloudStsClient "github.com/alibabacloud-go/sts-20150401/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+	alicloudVpcpeerClient "github.com/alibabacloud-go/vpcpeer-20220101/v2/client"
+)
+
+var (
+	_ resource.Resource                = &vpcPeeringConnectionResource{}
+	_ resource.ResourceWithConfigure   = &vpcPeeringConnectionResource{}
+	_ resource.ResourceWithImportState = &vpcPeeringConnectionResource{}
+)
+
+func NewVpcPeeringConnectionResource() resource.Resource {
+	return &vpcPeeringConnectionResource{}
+}
+
+type vpcPeeringConnectionResource struct {
+	client    *alicloudVpcpeerClient.Client
+	vpcClient *alicloudVpcClient.Client
+}
+
+type vpcPeeringConnectionModel struct {
+	PeeringConnectionId types.String                  `tfsdk:"peering_connection_id"`
+	Name                types.String                  `tfsdk:"name"`
+	Description         types.String                  `tfsdk:"description"`
+	VpcId               types.String                  `tfsdk:"vpc_id"`
+	AcceptingAliUid     types.Int64                   `tfsdk:"accepting_ali_uid"`
+	AcceptingRegionId   types.String                  `tfsdk:"accepting_region_id"`
+	AcceptingVpcId      types.String                  `tfsdk:"accepting_vpc_id"`
+	Bandwidth           types.Int64                   `tfsdk:"bandwidth"`
+	Status              types.String                  `tfsdk:"status"`
+	Accepter            *vpcPeeringConnectionAccepter `tfsdk:"accepter"`
+	RequesterRoutes     []*vpcPeeringConnectionRoute  `tfsdk:"requester_routes"`
+	AccepterRoutes      []*vpcPeeringConnectionRoute  `tfsdk:"accepter_routes"`
+}
+
+type vpcPeeringConnectionAccepter struct {
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretKey       types.String `tfsdk:"secret_key"`
+	RoleArn         types.String `tfsdk:"role_arn"`
+	RoleSessionName types.String `tfsdk:"role_session_name"`
+}
+
+type vpcPeeringConnectionRoute struct {
+	RouteTableId         types.String `tfsdk:"route_table_id"`
+	DestinationCidrBlock types.String `tfsdk:"destination_cidr_block"`
+	RouteEntryId         types.String `tfsdk:"route_entry_id"`
+}
+
+// Metadata returns the VPC peering connection resource name.
+func (r *vpcPeeringConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering_connection"
+}
+
+// Schema defines the schema for the VPC peering connection resource.
+func (r *vpcPeeringConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	routeBlock := func(side string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Description: fmt.Sprintf("The route entries in the route tables of the %s VPC, which route the "+
+				"destination CIDR blocks to the peering connection.", side),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"route_table_id": schema.StringAttribute{
+						Description: fmt.Sprintf("The ID of the route table of the %s VPC.", side),
+						Required:    true,
+					},
+					"destination_cidr_block": schema.StringAttribute{
+						Description: "The destination CIDR block, e.g. the CIDR block of the peer VPC.",
+						Required:    true,
+					},
+					"route_entry_id": schema.StringAttribute{
+						Description: "The ID of the route entry.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a VPC peering connection, including the acceptance of the peering connection and " +
+			"the route entries of both VPCs. The accepter side in another account is managed with the access " +
+			"keys or the role of the accepter block.",
+		Attributes: map[string]schema.Attribute{
+			"peering_connection_id": schema.StringAttribute{
+				Description: "The ID of the peering connection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the peering connection.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the peering connection.",
+				Optional:    true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Description: "The ID of the requester VPC in the region of the provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accepting_ali_uid": schema.Int64Attribute{
+				Description: "The ID of the account of the accepter VPC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"accepting_region_id": schema.StringAttribute{
+				Description: "The region of the accepter VPC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accepting_vpc_id": schema.StringAttribute{
+				Description: "The ID of the accepter VPC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bandwidth": schema.Int64Attribute{
+				Description: "The bandwidth of the peering connection in Mbps. Default to be assigned by AliCloud.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the peering connection, e.g. Accepting, Activated.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"accepter": schema.SingleNestedBlock{
+				Description: "The credentials of the accepter account to accept the peering connection and manage " +
+					"the accepter routes. The peering connection is accepted only when this block is set. The " +
+					"role is assumed with the access keys of this block, or with the credentials of the provider " +
+					"if the access keys are not set.",
+				Attributes: map[string]schema.Attribute{
+					"access_key": schema.StringAttribute{
+						Description: "The access key of the accepter account. Default to use access key " +
+							"configured in the provider.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+						},
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key of the accepter account. Default to use secret key " +
+							"configured in the provider.",
+						Optional:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						Description: "The ARN of the role in the accepter account to be assumed, e.g. " +
+							"acs:ram::1234567890123456:role/vpc-peering.",
+						Optional: true,
+					},
+					"role_session_name": schema.StringAttribute{
+						Description: "The session name of the assumed role. Default to terraform.",
+						Optional:    true,
+					},
+				},
+			},
+			"requester_routes": routeBlock("requester"),
+			"accepter_routes":  routeBlock("accepter"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcPeeringConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcpeerClient
+	r.vpcClient = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create the peering connection, accept it with the accepter credentials and
+// create the route entries of both VPCs.
+func (r *vpcPeeringConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcPeeringConnectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createVpcPeerConnection := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createVpcPeerConnectionRequest := &alicloudVpcpeerClient.CreateVpcPeerConnectionRequest{
+			RegionId:          r.vpcClient.RegionId,
+			VpcId:             tea.String(plan.VpcId.ValueString()),
+			AcceptingAliUid:   tea.Int64(plan.AcceptingAliUid.ValueInt64()),
+			AcceptingRegionId: tea.String(plan.AcceptingRegionId.ValueString()),
+			AcceptingVpcId:    tea.String(plan.AcceptingVpcId.ValueString()),
+			Name:              ecsStringPointer(plan.Name),
+			Description:       ecsStringPointer(plan.Description),
+			Bandwidth:         ecsInt32Pointer(plan.Bandwidth),
+		}
+
+		createVpcPeerConnectionResponse, err := r.client.CreateVpcPeerConnectionWithOptions(createVpcPeerConnectionRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.PeeringConnectionId = types.StringValue(tea.StringValue(createVpcPeerConnectionResponse.Body.InstanceId))
+		return nil
+	}
+
+	if err := retryAPICall(createVpcPeerConnection); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create VPC Peer Connection.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the peering connection into state before accepting it, so that it
+	// is not leaked if the acceptance fails.
+	plan.Status = types.StringUnknown()
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("peering_connection_id"), plan.PeeringConnectionId)...)
+
+	connection, err := waitVpcPeerConnection(r.client, plan.PeeringConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait VPC Peer Connection Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	var accepterVpcClient *alicloudVpcClient.Client
+	if plan.Accepter != nil || len(plan.AccepterRoutes) > 0 {
+		var accepterClient *alicloudVpcpeerClient.Client
+		var accepterDiags diag.Diagnostics
+		accepterClient, accepterVpcClient, accepterDiags = r.newAccepterClients(plan)
+		resp.Diagnostics.Append(accepterDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Accepter != nil && tea.StringValue(connection.Status) == "Accepting" {
+			connection, err = r.acceptVpcPeerConnection(accepterClient, plan.PeeringConnectionId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Accept VPC Peer Connection.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+	plan.Status = types.StringValue(tea.StringValue(connection.Status))
+	plan.Bandwidth = types.Int64Value(int64(tea.Int32Value(connection.Bandwidth)))
+
+	for _, route := range plan.RequesterRoutes {
+		if err := createVpcPeerRouteEntry(r.vpcClient, route, plan.PeeringConnectionId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Create Route Entry.",
+				err.Error(),
+			)
+			return
+		}
+	}
+	for _, route := range plan.AccepterRoutes {
+		if err := createVpcPeerRouteEntry(accepterVpcClient, route, plan.PeeringConnectionId.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Create Route Entry.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the peering connection. The route entries are kept as in state.
+func (r *vpcPeeringConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcPeeringConnectionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := describeVpcPeerConnection(r.client, state.PeeringConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Get VPC Peer Connection Attribute.",
+			err.Error(),
+		)
+		return
+	}
+	if connection == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = ecsStringValue(state.Name, connection.Name)
+	state.Description = ecsStringValue(state.Description, connection.Description)
+	if connection.Vpc != nil {
+		state.VpcId = types.StringValue(tea.StringValue(connection.Vpc.VpcId))
+	}
+	state.AcceptingAliUid = types.Int64Value(tea.Int64Value(connection.AcceptingOwnerUid))
+	state.AcceptingRegionId = types.StringValue(tea.StringValue(connection.AcceptingRegionId))
+	if connection.AcceptingVpc != nil {
+		state.AcceptingVpcId = types.StringValue(tea.StringValue(connection.AcceptingVpc.VpcId))
+	}
+	state.Bandwidth = types.Int64Value(int64(tea.Int32Value(connection.Bandwidth)))
+	state.Status = types.StringValue(tea.StringValue(connection.Status))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the attributes of the peering connection, accept it if the accepter
+// block is added, and replace the changed route entries.
+func (r *vpcPeeringConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcPeeringConnectionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionId := state.PeeringConnectionId.ValueString()
+	plan.PeeringConnectionId = state.PeeringConnectionId
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		(!plan.Bandwidth.IsUnknown() && !plan.Bandwidth.Equal(state.Bandwidth)) {
+		modifyVpcPeerConnection := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			modifyVpcPeerConnectionRequest := &alicloudVpcpeerClient.ModifyVpcPeerConnectionRequest{
+				InstanceId:  tea.String(connectionId),
+				Name:        tea.String(plan.Name.ValueString()),
+				Description: tea.String(plan.Description.ValueString()),
+				Bandwidth:   ecsInt32Pointer(plan.Bandwidth),
+			}
+
+			if _, err := r.client.ModifyVpcPeerConnectionWithOptions(modifyVpcPeerConnectionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(modifyVpcPeerConnection); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Modify VPC Peer Connection.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	connection, err := waitVpcPeerConnection(r.client, connectionId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait VPC Peer Connection Updated.",
+			err.Error(),
+		)
+		return
+	}
+
+	var accepterVpcClient *alicloudVpcClient.Client
+	if plan.Accepter != nil || len(plan.AccepterRoutes) > 0 || len(state.AccepterRoutes) > 0 {
+		var accepterClient *alicloudVpcpeerClient.Client
+		var accepterDiags diag.Diagnostics
+		accepterClient, accepterVpcClient, accepterDiags = r.newAccepterClients(plan)
+		resp.Diagnostics.Append(accepterDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Accepter != nil && tea.StringValue(connection.Status) == "Accepting" {
+			connection, err = r.acceptVpcPeerConnection(accepterClient, connectionId)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"[API ERROR] Failed to Accept VPC Peer Connection.",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+	plan.Status = types.StringValue(tea.StringValue(connection.Status))
+	plan.Bandwidth = types.Int64Value(int64(tea.Int32Value(connection.Bandwidth)))
+
+	if err := updateVpcPeerRouteEntries(r.vpcClient, state.RequesterRoutes, plan.RequesterRoutes, connectionId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Requester Route Entries.",
+			err.Error(),
+		)
+		return
+	}
+	if err := updateVpcPeerRouteEntries(accepterVpcClient, state.AccepterRoutes, plan.AccepterRoutes, connectionId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Accepter Route Entries.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the route entries and the peering connection.
+func (r *vpcPeeringConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcPeeringConnectionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionId := state.PeeringConnectionId.ValueString()
+
+	if err := updateVpcPeerRouteEntries(r.vpcClient, state.RequesterRoutes, nil, connectionId); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Requester Route Entries.",
+			err.Error(),
+		)
+		return
+	}
+	if len(state.AccepterRoutes) > 0 {
+		_, accepterVpcClient, accepterDiags := r.newAccepterClients(state)
+		resp.Diagnostics.Append(accepterDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateVpcPeerRouteEntries(accepterVpcClient, state.AccepterRoutes, nil, connectionId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Accepter Route Entries.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	deleteVpcPeerConnection := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteVpcPeerConnectionRequest := &alicloudVpcpeerClient.DeleteVpcPeerConnectionRequest{
+			InstanceId: tea.String(connectionId),
+		}
+
+		if _, err := r.client.DeleteVpcPeerConnectionWithOptions(deleteVpcPeerConnectionRequest, runtime); err != nil {
+			if isVpcPeerNotFound(err) {
+				return nil
+			}
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteVpcPeerConnection); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete VPC Peer Connection.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcPeeringConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("peering_connection_id"), req, resp)
+}
+
+// Function to create the VPC Peer and VPC clients of the accepter account in
+// the accepting region. The role is assumed if the role ARN is set.
+func (r *vpcPeeringConnectionResource) newAccepterClients(model *vpcPeeringConnectionModel) (*alicloudVpcpeerClient.Client, *alicloudVpcClient.Client, diag.Diagnostics) {
+	accepter := model.Accepter
+	if accepter == nil {
+		accepter = &vpcPeeringConnectionAccepter{}
+	}
+	region := model.AcceptingRegionId.ValueString()
+
+	// The region is always set, so that a new client config is always
+	// returned with the credentials of the accepter or the provider.
+	_, credentialsConfig, diags := initNewClient(&r.client.Client, &clientConfig{
+		Region:    model.AcceptingRegionId,
+		AccessKey: accepter.AccessKey,
+		SecretKey: accepter.SecretKey,
+	})
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	if roleArn := accepter.RoleArn.ValueString(); roleArn != "" {
+		roleSessionName := accepter.RoleSessionName.ValueString()
+		if roleSessionName == "" {
+			roleSessionName = "terraform"
+		}
+
+		stsClientConfig := *credentialsConfig
+		stsClientConfig.Endpoint = tea.String(fmt.Sprintf("sts.%s.aliyuncs.com", region))
+		stsClient, err := alicloudStsClient.NewClient(&stsClientConfig)
+		if err != nil {
+			diags.AddError(
+				"Unable to Create AliCloud STS API Client for Accepter",
+				"An unexpected error occurred when creating the AliCloud STS API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"AliCloud STS Client Error: "+err.Error(),
+			)
+			return nil, nil, diags
+		}
+
+		var assumeRoleResponse *alicloudStsClient.AssumeRoleResponse
+		assumeRole := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			assumeRoleRequest := &alicloudStsClient.AssumeRoleRequest{
+				RoleArn:         tea.String(roleArn),
+				RoleSessionName: tea.String(roleSessionName),
+			}
+
+			assumeRoleResponse, err = stsClient.AssumeRoleWithOptions(assumeRoleRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(assumeRole); err != nil {
+			diags.AddError(
+				"[API ERROR] Failed to Assume Role of Accepter.",
+				err.Error(),
+			)
+			return nil, nil, diags
+		}
+
+		credentials := assumeRoleResponse.Body.Credentials
+		credentialsConfig = &alicloudOpenapiClient.Config{
+			RegionId:        tea.String(region),
+			AccessKeyId:     credentials.AccessKeyId,
+			AccessKeySecret: credentials.AccessKeySecret,
+			SecurityToken:   credentials.SecurityToken,
+		}
+	}
+
+	vpcpeerClientConfig := *credentialsConfig
+	vpcpeerClientConfig.Endpoint = tea.String("vpcpeer.aliyuncs.com")
+	vpcpeerClient, err := alicloudVpcpeerClient.NewClient(&vpcpeerClientConfig)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create AliCloud VPC Peer API Client for Accepter",
+			"An unexpected error occurred when creating the AliCloud VPC Peer API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud VPC Peer Client Error: "+err.Error(),
+		)
+		return nil, nil, diags
+	}
+
+	vpcClientConfig := *credentialsConfig
+	vpcClientConfig.Endpoint = tea.String(fmt.Sprintf("vpc.%s.aliyuncs.com", region))
+	vpcClient, err := alicloudVpcClient.NewClient(&vpcClientConfig)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create AliCloud VPC API Client for Accepter",
+			"An unexpected error occurred when creating the AliCloud VPC API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"AliCloud VPC Client Error: "+err.Error(),
+		)
+		return nil, nil, diags
+	}
+
+	return vpcpeerClient, vpcClient, diags
+}
+
+// Function to accept the peering connection with the accepter client, and
+// wait until it is activated.
+func (r *vpcPeeringConnectionResource) acceptVpcPeerConnection(accepterClient *alicloudVpcpeerClient.Client, connectionId string) (*alicloudVpcpeerClient.GetVpcPeerConnectionAttributeResponseBody, error) {
+	acceptVpcPeerConnection := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		acceptVpcPeerConnectionRequest := &alicloudVpcpeerClient.AcceptVpcPeerConnectionRequest{
+			InstanceId: tea.String(connectionId),
+		}
+
+		if _, err := accepterClient.AcceptVpcPeerConnectionWithOptions(acceptVpcPeerConnectionRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(acceptVpcPeerConnection); err != nil {
+		return nil, err
+	}
+	return waitVpcPeerConnection(r.client, connectionId)
+}
+
+// Function to read the peering connection, returns nil if the peering
+// connection is not found.
+func describeVpcPeerConnection(client *alicloudVpcpeerClient.Client, connectionId string) (*alicloudVpcpeerClient.GetVpcPeerConnectionAttributeResponseBody, error) {
+	var connection *alicloudVpcpeerClient.GetVpcPeerConnectionAttributeResponseBody
+	getVpcPeerConnectionAttribute := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		getVpcPeerConnectionAttributeRequest := &alicloudVpcpeerClient.GetVpcPeerConnectionAttributeRequest{
+			InstanceId: tea.String(connectionId),
+		}
+
+		getVpcPeerConnectionAttributeResponse, err := client.GetVpcPeerConnectionAttributeWithOptions(getVpcPeerConnectionAttributeRequest, runtime)
+		if err != nil {
+			if isVpcPeerNotFound(err) {
+				connection = nil
+				return nil
+			}
+			return handleAPIError(err)
+		}
+
+		connection = getVpcPeerConnectionAttributeResponse.Body
+		return nil
+	}
+
+	err := retryAPICall(getVpcPeerConnectionAttribute)
+	return connection, err
+}
+
+// Function to wait until the peering connection is not in a transitional
+// status, e.g. Creating, returns the peering connection.
+func waitVpcPeerConnection(client *alicloudVpcpeerClient.Client, connectionId string) (*alicloudVpcpeerClient.GetVpcPeerConnectionAttributeResponseBody, error) {
+	var connection *alicloudVpcpeerClient.GetVpcPeerConnectionAttributeResponseBody
+	waitConnection := func() error {
+		var err error
+		connection, err = describeVpcPeerConnection(client, connectionId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if connection == nil {
+			return backoff.Permanent(fmt.Errorf("VPC peer connection %s is not found", connectionId))
+		}
+
+		switch status := tea.StringValue(connection.Status); status {
+		case "Creating", "Activating", "Updating", "Deleting":
+			return fmt.Errorf("VPC peer connection %s is %s", connectionId, status)
+		case "Rejected", "Expired":
+			return backoff.Permanent(fmt.Errorf("VPC peer connection %s is %s", connectionId, status))
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitConnection, reconnectBackoff)
+	return connection, err
+}
+
+// Function to delete the route entries removed from the plan and create the
+// route entries added to the plan. The route entries are identified by the
+// route table and the destination CIDR block.
+func updateVpcPeerRouteEntries(client *alicloudVpcClient.Client, stateRoutes, planRoutes []*vpcPeeringConnectionRoute, connectionId string) error {
+	routeKey := func(route *vpcPeeringConnectionRoute) string {
+		return route.RouteTableId.ValueString() + "/" + route.DestinationCidrBlock.ValueString()
+	}
+
+	stateRouteEntryIds := make(map[string]string)
+	for _, route := range stateRoutes {
+		stateRouteEntryIds[routeKey(route)] = route.RouteEntryId.ValueString()
+	}
+
+	for _, route := range planRoutes {
+		if routeEntryId, ok := stateRouteEntryIds[routeKey(route)]; ok {
+			route.RouteEntryId = types.StringValue(routeEntryId)
+			delete(stateRouteEntryIds, routeKey(route))
+		}
+	}
+
+	for _, routeEntryId := range stateRouteEntryIds {
+		deleteRouteEntry := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			deleteRouteEntryRequest := &alicloudVpcClient.DeleteRouteEntryRequest{
+				RegionId:     client.RegionId,
+				RouteEntryId: tea.String(routeEntryId),
+			}
+
+			if _, err := client.DeleteRouteEntryWithOptions(deleteRouteEntryRequest, runtime); err != nil {
+				if _t, ok := err.(*tea.SDKError); ok {
+					code := tea.StringValue(_t.Code)
+					if strings.HasPrefix(code, "InvalidRouteEntry.NotFound") {
+						return nil
+					}
+					if strings.HasPrefix(code, "IncorrectStatus") || code == "OperationConflict" {
+						return err
+					}
+				}
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(deleteRouteEntry); err != nil {
+			return err
+		}
+	}
+
+	for _, route := range planRoutes {
+		if !route.RouteEntryId.IsUnknown() && !route.RouteEntryId.IsNull() {
+			continue
+		}
+		if err := createVpcPeerRouteEntry(client, route, connectionId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Function to create the route entry to the peering connection, and wait
+// until it is available, as the route table can not be changed while a route
+// entry is pending.
+func createVpcPeerRouteEntry(client *alicloudVpcClient.Client, route *vpcPeeringConnectionRoute, connectionId string) error {
+	createRouteEntry := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createRouteEntryRequest := &alicloudVpcClient.CreateRouteEntryRequest{
+			RegionId:             client.RegionId,
+			RouteTableId:         tea.String(route.RouteTableId.ValueString()),
+			DestinationCidrBlock: tea.String(route.DestinationCidrBlock.ValueString()),
+			NextHopType:          tea.String("VpcPeer"),
+			NextHopId:            tea.String(connectionId),
+		}
+
+		createRouteEntryResponse, err := client.CreateRouteEntryWithOptions(createRouteEntryRequest, runtime)
+		if err != nil {
+			if _t, ok := err.(*tea.SDKError); ok {
+				code := tea.StringValue(_t.Code)
+				if strings.HasPrefix(code, "IncorrectStatus") || code == "OperationConflict" {
+					return err
+				}
+			}
+			return handleAPIError(err)
+		}
+		route.RouteEntryId = types.StringValue(tea.StringValue(createRouteEntryResponse.Body.RouteEntryId))
+		return nil
+	}
+
+	if err := retryAPICall(createRouteEntry); err != nil {
+		return err
+	}
+
+	waitRouteEntry := func() error {
+		var describeRouteEntryListResponse *alicloudVpcClient.DescribeRouteEntryListResponse
+		describeRouteEntryList := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			describeRouteEntryListRequest := &alicloudVpcClient.DescribeRouteEntryListRequest{
+				RegionId:     client.RegionId,
+				RouteTableId: tea.String(route.RouteTableId.ValueString()),
+				RouteEntryId: tea.String(route.RouteEntryId.ValueString()),
+			}
+
+			var err error
+			describeRouteEntryListResponse, err = client.DescribeRouteEntryListWithOptions(describeRouteEntryListRequest, runtime)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(describeRouteEntryList); err != nil {
+			return backoff.Permanent(err)
+		}
+
+		routeEntrys := describeRouteEntryListResponse.Body.RouteEntrys
+		if routeEntrys == nil || len(routeEntrys.RouteEntry) == 0 {
+			return fmt.Errorf("route entry %s is not found", route.RouteEntryId.ValueString())
+		}
+		if status := tea.StringValue(routeEntrys.RouteEntry[0].Status); status != "Available" {
+			return fmt.Errorf("route entry %s is %s", route.RouteEntryId.ValueString(), status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitRouteEntry, reconnectBackoff)
+}
+
+// Returns true if the peering connection is not found.
+func isVpcPeerNotFound(err error) bool {
+	if _t, ok := err.(*tea.SDKError); ok {
+		return strings.HasPrefix(tea.StringValue(_t.Code), "ResourceNotFound")
+	}
+	return false
+}
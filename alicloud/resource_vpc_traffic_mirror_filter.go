@@ -0,0 +1,641 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                = &vpcTrafficMirrorFilterResource{}
+	_ resource.ResourceWithConfigure   = &vpcTrafficMirrorFilterResource{}
+	_ resource.ResourceWithImportState = &vpcTrafficMirrorFilterResource{}
+)
+
+func NewVpcTrafficMirrorFilterResource() resource.Resource {
+	return &vpcTrafficMirrorFilterResource{}
+}
+
+type vpcTrafficMirrorFilterResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcTrafficMirrorFilterModel struct {
+	TrafficMirrorFilterId types.String                  `tfsdk:"traffic_mirror_filter_id"`
+	Name                  types.String                  `tfsdk:"name"`
+	Description           types.String                  `tfsdk:"description"`
+	IngressRules          []*vpcTrafficMirrorFilterRule `tfsdk:"ingress_rules"`
+	EgressRules           []*vpcTrafficMirrorFilterRule `tfsdk:"egress_rules"`
+}
+
+type vpcTrafficMirrorFilterRule struct {
+	Priority             types.Int64  `tfsdk:"priority"`
+	Action               types.String `tfsdk:"action"`
+	Protocol             types.String `tfsdk:"protocol"`
+	SourceCidrBlock      types.String `tfsdk:"source_cidr_block"`
+	DestinationCidrBlock types.String `tfsdk:"destination_cidr_block"`
+	SourcePortRange      types.String `tfsdk:"source_port_range"`
+	DestinationPortRange types.String `tfsdk:"destination_port_range"`
+	RuleId               types.String `tfsdk:"rule_id"`
+}
+
+// A filter rule is identified by all of its attributes, as any change of the
+// rule is applied by replacing it.
+func (rule *vpcTrafficMirrorFilterRule) key() string {
+	return fmt.Sprintf("%d:%s:%s:%s:%s:%s:%s",
+		rule.Priority.ValueInt64(),
+		strings.ToLower(rule.Action.ValueString()),
+		strings.ToUpper(rule.Protocol.ValueString()),
+		rule.SourceCidrBlock.ValueString(),
+		rule.DestinationCidrBlock.ValueString(),
+		vpcTrafficMirrorPortRange(rule.SourcePortRange),
+		vpcTrafficMirrorPortRange(rule.DestinationPortRange),
+	)
+}
+
+// Metadata returns the VPC traffic mirror filter resource name.
+func (r *vpcTrafficMirrorFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_traffic_mirror_filter"
+}
+
+// Schema defines the schema for the VPC traffic mirror filter resource.
+func (r *vpcTrafficMirrorFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleAttributes := map[string]schema.Attribute{
+		"priority": schema.Int64Attribute{
+			Description: "The priority of the rule, a smaller value has a higher priority. Valid values: 1 to 10.",
+			Required:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 10),
+			},
+		},
+		"action": schema.StringAttribute{
+			Description: "The action of the rule. Valid values: accept, drop.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("accept", "drop"),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			Description: "The protocol of the traffic. Valid values: ALL, ICMP, TCP, UDP.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("ALL", "ICMP", "TCP", "UDP"),
+			},
+		},
+		"source_cidr_block": schema.StringAttribute{
+			Description: "The source CIDR block of the traffic.",
+			Required:    true,
+		},
+		"destination_cidr_block": schema.StringAttribute{
+			Description: "The destination CIDR block of the traffic.",
+			Required:    true,
+		},
+		"source_port_range": schema.StringAttribute{
+			Description: "The source port range in the format of A/B, e.g. 1/65535. Only used for TCP and UDP.",
+			Optional:    true,
+		},
+		"destination_port_range": schema.StringAttribute{
+			Description: "The destination port range in the format of A/B, e.g. 80/80. Only used for TCP and UDP.",
+			Optional:    true,
+		},
+		"rule_id": schema.StringAttribute{
+			Description: "The ID of the rule.",
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a traffic mirror filter, which selects the traffic to be mirrored by the traffic " +
+			"mirror sessions.",
+		Attributes: map[string]schema.Attribute{
+			"traffic_mirror_filter_id": schema.StringAttribute{
+				Description: "The ID of the traffic mirror filter.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the traffic mirror filter.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the traffic mirror filter.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ingress_rules": schema.ListNestedBlock{
+				Description: "The rules of the inbound traffic to be mirrored.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: ruleAttributes,
+				},
+			},
+			"egress_rules": schema.ListNestedBlock{
+				Description: "The rules of the outbound traffic to be mirrored.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: ruleAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcTrafficMirrorFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create the traffic mirror filter with the rules and wait until it is
+// created.
+func (r *vpcTrafficMirrorFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcTrafficMirrorFilterModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTrafficMirrorFilter := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createTrafficMirrorFilterRequest := &alicloudVpcClient.CreateTrafficMirrorFilterRequest{
+			RegionId:                       r.client.RegionId,
+			TrafficMirrorFilterName:        ecsStringPointer(plan.Name),
+			TrafficMirrorFilterDescription: ecsStringPointer(plan.Description),
+		}
+		for _, rule := range plan.IngressRules {
+			createTrafficMirrorFilterRequest.IngressRules = append(createTrafficMirrorFilterRequest.IngressRules,
+				&alicloudVpcClient.CreateTrafficMirrorFilterRequestIngressRules{
+					Priority:             tea.Int32(int32(rule.Priority.ValueInt64())),
+					Action:               tea.String(rule.Action.ValueString()),
+					Protocol:             tea.String(rule.Protocol.ValueString()),
+					SourceCidrBlock:      tea.String(rule.SourceCidrBlock.ValueString()),
+					DestinationCidrBlock: tea.String(rule.DestinationCidrBlock.ValueString()),
+					SourcePortRange:      tea.String(vpcTrafficMirrorPortRange(rule.SourcePortRange)),
+					DestinationPortRange: tea.String(vpcTrafficMirrorPortRange(rule.DestinationPortRange)),
+				})
+		}
+		for _, rule := range plan.EgressRules {
+			createTrafficMirrorFilterRequest.EgressRules = append(createTrafficMirrorFilterRequest.EgressRules,
+				&alicloudVpcClient.CreateTrafficMirrorFilterRequestEgressRules{
+					Priority:             tea.Int32(int32(rule.Priority.ValueInt64())),
+					Action:               tea.String(rule.Action.ValueString()),
+					Protocol:             tea.String(rule.Protocol.ValueString()),
+					SourceCidrBlock:      tea.String(rule.SourceCidrBlock.ValueString()),
+					DestinationCidrBlock: tea.String(rule.DestinationCidrBlock.ValueString()),
+					SourcePortRange:      tea.String(vpcTrafficMirrorPortRange(rule.SourcePortRange)),
+					DestinationPortRange: tea.String(vpcTrafficMirrorPortRange(rule.DestinationPortRange)),
+				})
+		}
+
+		createTrafficMirrorFilterResponse, err := r.client.CreateTrafficMirrorFilterWithOptions(createTrafficMirrorFilterRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.TrafficMirrorFilterId = types.StringValue(tea.StringValue(createTrafficMirrorFilterResponse.Body.TrafficMirrorFilterId))
+		return nil
+	}
+
+	if err := retryAPICall(createTrafficMirrorFilter); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Traffic Mirror Filter.",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.waitAndReadRuleIds(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Traffic Mirror Filter Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the traffic mirror filter and its rules. The rules in state are kept in
+// the same order, and the rules created outside of Terraform are appended, so
+// that they are removed in the next apply.
+func (r *vpcTrafficMirrorFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcTrafficMirrorFilterModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := describeVpcTrafficMirrorFilter(r.client, state.TrafficMirrorFilterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Traffic Mirror Filters.",
+			err.Error(),
+		)
+		return
+	}
+	if filter == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ingressRules, egressRules := vpcTrafficMirrorFilterRules(filter)
+	state.Name = ecsStringValue(state.Name, filter.TrafficMirrorFilterName)
+	state.Description = ecsStringValue(state.Description, filter.TrafficMirrorFilterDescription)
+	state.IngressRules = vpcTrafficMirrorOrderRules(state.IngressRules, ingressRules)
+	state.EgressRules = vpcTrafficMirrorOrderRules(state.EgressRules, egressRules)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the attributes of the traffic mirror filter, the changed rules are
+// deleted and created again.
+func (r *vpcTrafficMirrorFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcTrafficMirrorFilterModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterId := state.TrafficMirrorFilterId.ValueString()
+	plan.TrafficMirrorFilterId = state.TrafficMirrorFilterId
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		updateTrafficMirrorFilterAttribute := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			updateTrafficMirrorFilterAttributeRequest := &alicloudVpcClient.UpdateTrafficMirrorFilterAttributeRequest{
+				RegionId:                       r.client.RegionId,
+				TrafficMirrorFilterId:          tea.String(filterId),
+				TrafficMirrorFilterName:        tea.String(plan.Name.ValueString()),
+				TrafficMirrorFilterDescription: tea.String(plan.Description.ValueString()),
+			}
+
+			if _, err := r.client.UpdateTrafficMirrorFilterAttributeWithOptions(updateTrafficMirrorFilterAttributeRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(updateTrafficMirrorFilterAttribute); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Update Traffic Mirror Filter Attribute.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Delete the removed rules first, so that the priorities are released
+	// for the new rules.
+	var removedRuleIds []string
+	for _, rules := range [][2][]*vpcTrafficMirrorFilterRule{{state.IngressRules, plan.IngressRules}, {state.EgressRules, plan.EgressRules}} {
+		planKeys := make(map[string]bool)
+		for _, rule := range rules[1] {
+			planKeys[rule.key()] = true
+		}
+		for _, rule := range rules[0] {
+			if !planKeys[rule.key()] {
+				removedRuleIds = append(removedRuleIds, rule.RuleId.ValueString())
+			}
+		}
+	}
+	if len(removedRuleIds) > 0 {
+		if err := waitVpcTrafficMirrorFilter(r.client, filterId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Wait Traffic Mirror Filter Created.",
+				err.Error(),
+			)
+			return
+		}
+
+		deleteTrafficMirrorFilterRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			deleteTrafficMirrorFilterRulesRequest := &alicloudVpcClient.DeleteTrafficMirrorFilterRulesRequest{
+				RegionId:                   r.client.RegionId,
+				TrafficMirrorFilterId:      tea.String(filterId),
+				TrafficMirrorFilterRuleIds: tea.StringSlice(removedRuleIds),
+			}
+
+			if _, err := r.client.DeleteTrafficMirrorFilterRulesWithOptions(deleteTrafficMirrorFilterRulesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(deleteTrafficMirrorFilterRules); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Delete Traffic Mirror Filter Rules.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	stateIngressKeys := make(map[string]bool)
+	for _, rule := range state.IngressRules {
+		stateIngressKeys[rule.key()] = true
+	}
+	stateEgressKeys := make(map[string]bool)
+	for _, rule := range state.EgressRules {
+		stateEgressKeys[rule.key()] = true
+	}
+
+	createTrafficMirrorFilterRulesRequest := &alicloudVpcClient.CreateTrafficMirrorFilterRulesRequest{
+		RegionId:              r.client.RegionId,
+		TrafficMirrorFilterId: tea.String(filterId),
+	}
+	for _, rule := range plan.IngressRules {
+		if stateIngressKeys[rule.key()] {
+			continue
+		}
+		createTrafficMirrorFilterRulesRequest.IngressRules = append(createTrafficMirrorFilterRulesRequest.IngressRules,
+			&alicloudVpcClient.CreateTrafficMirrorFilterRulesRequestIngressRules{
+				Priority:             tea.Int32(int32(rule.Priority.ValueInt64())),
+				Action:               tea.String(rule.Action.ValueString()),
+				Protocol:             tea.String(rule.Protocol.ValueString()),
+				SourceCidrBlock:      tea.String(rule.SourceCidrBlock.ValueString()),
+				DestinationCidrBlock: tea.String(rule.DestinationCidrBlock.ValueString()),
+				SourcePortRange:      tea.String(vpcTrafficMirrorPortRange(rule.SourcePortRange)),
+				DestinationPortRange: tea.String(vpcTrafficMirrorPortRange(rule.DestinationPortRange)),
+			})
+	}
+	for _, rule := range plan.EgressRules {
+		if stateEgressKeys[rule.key()] {
+			continue
+		}
+		createTrafficMirrorFilterRulesRequest.EgressRules = append(createTrafficMirrorFilterRulesRequest.EgressRules,
+			&alicloudVpcClient.CreateTrafficMirrorFilterRulesRequestEgressRules{
+				Priority:             tea.Int32(int32(rule.Priority.ValueInt64())),
+				Action:               tea.String(rule.Action.ValueString()),
+				Protocol:             tea.String(rule.Protocol.ValueString()),
+				SourceCidrBlock:      tea.String(rule.SourceCidrBlock.ValueString()),
+				DestinationCidrBlock: tea.String(rule.DestinationCidrBlock.ValueString()),
+				SourcePortRange:      tea.String(vpcTrafficMirrorPortRange(rule.SourcePortRange)),
+				DestinationPortRange: tea.String(vpcTrafficMirrorPortRange(rule.DestinationPortRange)),
+			})
+	}
+	if len(createTrafficMirrorFilterRulesRequest.IngressRules) > 0 || len(createTrafficMirrorFilterRulesRequest.EgressRules) > 0 {
+		if err := waitVpcTrafficMirrorFilter(r.client, filterId); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Wait Traffic Mirror Filter Created.",
+				err.Error(),
+			)
+			return
+		}
+
+		createTrafficMirrorFilterRules := func() error {
+			runtime := &util.RuntimeOptions{}
+
+			if _, err := r.client.CreateTrafficMirrorFilterRulesWithOptions(createTrafficMirrorFilterRulesRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			return nil
+		}
+
+		if err := retryAPICall(createTrafficMirrorFilterRules); err != nil {
+			resp.Diagnostics.AddError(
+				"[API ERROR] Failed to Create Traffic Mirror Filter Rules.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if err := r.waitAndReadRuleIds(plan); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Traffic Mirror Filter Created.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the traffic mirror filter.
+func (r *vpcTrafficMirrorFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcTrafficMirrorFilterModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTrafficMirrorFilter := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteTrafficMirrorFilterRequest := &alicloudVpcClient.DeleteTrafficMirrorFilterRequest{
+			RegionId:              r.client.RegionId,
+			TrafficMirrorFilterId: tea.String(state.TrafficMirrorFilterId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteTrafficMirrorFilterWithOptions(deleteTrafficMirrorFilterRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteTrafficMirrorFilter); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Traffic Mirror Filter.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcTrafficMirrorFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("traffic_mirror_filter_id"), req, resp)
+}
+
+// Function to wait until the traffic mirror filter is created, and set the
+// IDs of the rules into the model.
+func (r *vpcTrafficMirrorFilterResource) waitAndReadRuleIds(plan *vpcTrafficMirrorFilterModel) error {
+	filterId := plan.TrafficMirrorFilterId.ValueString()
+	if err := waitVpcTrafficMirrorFilter(r.client, filterId); err != nil {
+		return err
+	}
+
+	filter, err := describeVpcTrafficMirrorFilter(r.client, filterId)
+	if err != nil {
+		return err
+	}
+	if filter == nil {
+		return fmt.Errorf("traffic mirror filter %s is not found", filterId)
+	}
+
+	ingressRules, egressRules := vpcTrafficMirrorFilterRules(filter)
+	for _, rules := range [][2][]*vpcTrafficMirrorFilterRule{{plan.IngressRules, ingressRules}, {plan.EgressRules, egressRules}} {
+		ruleIds := make(map[string]types.String)
+		for _, rule := range rules[1] {
+			ruleIds[rule.key()] = rule.RuleId
+		}
+		for _, rule := range rules[0] {
+			ruleId, ok := ruleIds[rule.key()]
+			if !ok {
+				return fmt.Errorf("rule with priority %d of traffic mirror filter %s is not found", rule.Priority.ValueInt64(), filterId)
+			}
+			rule.RuleId = ruleId
+		}
+	}
+	return nil
+}
+
+// Function to read the traffic mirror filter, returns nil if the traffic
+// mirror filter is not found.
+func describeVpcTrafficMirrorFilter(client *alicloudVpcClient.Client, filterId string) (*alicloudVpcClient.ListTrafficMirrorFiltersResponseBodyTrafficMirrorFilters, error) {
+	var listTrafficMirrorFiltersResponse *alicloudVpcClient.ListTrafficMirrorFiltersResponse
+	listTrafficMirrorFilters := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listTrafficMirrorFiltersRequest := &alicloudVpcClient.ListTrafficMirrorFiltersRequest{
+			RegionId:               client.RegionId,
+			TrafficMirrorFilterIds: []*string{tea.String(filterId)},
+		}
+
+		var err error
+		listTrafficMirrorFiltersResponse, err = client.ListTrafficMirrorFiltersWithOptions(listTrafficMirrorFiltersRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listTrafficMirrorFilters); err != nil {
+		return nil, err
+	}
+
+	if len(listTrafficMirrorFiltersResponse.Body.TrafficMirrorFilters) == 0 {
+		return nil, nil
+	}
+	return listTrafficMirrorFiltersResponse.Body.TrafficMirrorFilters[0], nil
+}
+
+// Function to wait until the traffic mirror filter is created, the traffic
+// mirror filter can not be changed while it is being modified.
+func waitVpcTrafficMirrorFilter(client *alicloudVpcClient.Client, filterId string) error {
+	waitTrafficMirrorFilter := func() error {
+		filter, err := describeVpcTrafficMirrorFilter(client, filterId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if filter == nil {
+			return backoff.Permanent(fmt.Errorf("traffic mirror filter %s is not found", filterId))
+		}
+		if status := tea.StringValue(filter.TrafficMirrorFilterStatus); status != "Created" {
+			return fmt.Errorf("traffic mirror filter %s is %s", filterId, status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	return backoff.Retry(waitTrafficMirrorFilter, reconnectBackoff)
+}
+
+// Function to convert the rules of the traffic mirror filter returned by
+// AliCloud API.
+func vpcTrafficMirrorFilterRules(filter *alicloudVpcClient.ListTrafficMirrorFiltersResponseBodyTrafficMirrorFilters) (ingressRules, egressRules []*vpcTrafficMirrorFilterRule) {
+	for _, rule := range filter.IngressRules {
+		ingressRules = append(ingressRules, &vpcTrafficMirrorFilterRule{
+			Priority:             types.Int64Value(int64(tea.Int32Value(rule.Priority))),
+			Action:               types.StringValue(tea.StringValue(rule.Action)),
+			Protocol:             types.StringValue(tea.StringValue(rule.Protocol)),
+			SourceCidrBlock:      types.StringValue(tea.StringValue(rule.SourceCidrBlock)),
+			DestinationCidrBlock: types.StringValue(tea.StringValue(rule.DestinationCidrBlock)),
+			SourcePortRange:      types.StringValue(tea.StringValue(rule.SourcePortRange)),
+			DestinationPortRange: types.StringValue(tea.StringValue(rule.DestinationPortRange)),
+			RuleId:               types.StringValue(tea.StringValue(rule.TrafficMirrorFilterRuleId)),
+		})
+	}
+	for _, rule := range filter.EgressRules {
+		egressRules = append(egressRules, &vpcTrafficMirrorFilterRule{
+			Priority:             types.Int64Value(int64(tea.Int32Value(rule.Priority))),
+			Action:               types.StringValue(tea.StringValue(rule.Action)),
+			Protocol:             types.StringValue(tea.StringValue(rule.Protocol)),
+			SourceCidrBlock:      types.StringValue(tea.StringValue(rule.SourceCidrBlock)),
+			DestinationCidrBlock: types.StringValue(tea.StringValue(rule.DestinationCidrBlock)),
+			SourcePortRange:      types.StringValue(tea.StringValue(rule.SourcePortRange)),
+			DestinationPortRange: types.StringValue(tea.StringValue(rule.DestinationPortRange)),
+			RuleId:               types.StringValue(tea.StringValue(rule.TrafficMirrorFilterRuleId)),
+		})
+	}
+	return ingressRules, egressRules
+}
+
+// Function to keep the rules in the same order as the state, the port ranges
+// which are not configured are kept null. The new rules are appended.
+func vpcTrafficMirrorOrderRules(stateRules, rules []*vpcTrafficMirrorFilterRule) []*vpcTrafficMirrorFilterRule {
+	rulesByKey := make(map[string]*vpcTrafficMirrorFilterRule)
+	for _, rule := range rules {
+		rulesByKey[rule.key()] = rule
+	}
+
+	ordered := []*vpcTrafficMirrorFilterRule{}
+	for _, stateRule := range stateRules {
+		rule, ok := rulesByKey[stateRule.key()]
+		if !ok {
+			continue
+		}
+		if stateRule.SourcePortRange.IsNull() {
+			rule.SourcePortRange = types.StringNull()
+		}
+		if stateRule.DestinationPortRange.IsNull() {
+			rule.DestinationPortRange = types.StringNull()
+		}
+		ordered = append(ordered, rule)
+		delete(rulesByKey, stateRule.key())
+	}
+	for _, rule := range rules {
+		if _, ok := rulesByKey[rule.key()]; ok {
+			ordered = append(ordered, rule)
+		}
+	}
+	return ordered
+}
+
+// The port range of ALL and ICMP is -1/-1, which is also used when the port
+// range is not configured.
+func vpcTrafficMirrorPortRange(portRange types.String) string {
+	if portRange.IsNull() || portRange.IsUnknown() || portRange.ValueString() == "" {
+		return "-1/-1"
+	}
+	return portRange.ValueString()
+}
@@ -0,0 +1,451 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudVpcClient "github.com/alibabacloud-go/vpc-20160428/v6/client"
+)
+
+var (
+	_ resource.Resource                = &vpcTrafficMirrorSessionResource{}
+	_ resource.ResourceWithConfigure   = &vpcTrafficMirrorSessionResource{}
+	_ resource.ResourceWithImportState = &vpcTrafficMirrorSessionResource{}
+)
+
+func NewVpcTrafficMirrorSessionResource() resource.Resource {
+	return &vpcTrafficMirrorSessionResource{}
+}
+
+type vpcTrafficMirrorSessionResource struct {
+	client *alicloudVpcClient.Client
+}
+
+type vpcTrafficMirrorSessionModel struct {
+	TrafficMirrorSessionId types.String `tfsdk:"traffic_mirror_session_id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	TrafficMirrorFilterId  types.String `tfsdk:"traffic_mirror_filter_id"`
+	SourceIds              types.List   `tfsdk:"source_ids"`
+	TargetId               types.String `tfsdk:"target_id"`
+	TargetType             types.String `tfsdk:"target_type"`
+	Priority               types.Int64  `tfsdk:"priority"`
+	VirtualNetworkId       types.Int64  `tfsdk:"virtual_network_id"`
+	PacketLength           types.Int64  `tfsdk:"packet_length"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+}
+
+// Metadata returns the VPC traffic mirror session resource name.
+func (r *vpcTrafficMirrorSessionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_traffic_mirror_session"
+}
+
+// Schema defines the schema for the VPC traffic mirror session resource.
+func (r *vpcTrafficMirrorSessionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a traffic mirror session, which mirrors the traffic of the source ENIs selected by " +
+			"the traffic mirror filter to the target ENI or SLB instance for packet capture.",
+		Attributes: map[string]schema.Attribute{
+			"traffic_mirror_session_id": schema.StringAttribute{
+				Description: "The ID of the traffic mirror session.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the traffic mirror session.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the traffic mirror session.",
+				Optional:    true,
+			},
+			"traffic_mirror_filter_id": schema.StringAttribute{
+				Description: "The ID of the traffic mirror filter.",
+				Required:    true,
+			},
+			"source_ids": schema.ListAttribute{
+				Description: "The IDs of the ENIs whose traffic is mirrored.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Description: "The ID of the ENI or the SLB instance which receives the mirrored traffic.",
+				Required:    true,
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of the target. Valid values: NetworkInterface, SLB. Default to NetworkInterface.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("NetworkInterface"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("NetworkInterface", "SLB"),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "The priority of the session, a smaller value has a higher priority when a source is in " +
+					"multiple sessions. Valid values: 1 to 32766.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32766),
+				},
+			},
+			"virtual_network_id": schema.Int64Attribute{
+				Description: "The VXLAN network identifier (VNI) of the mirrored traffic. Default to be assigned " +
+					"by AliCloud.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 16777215),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"packet_length": schema.Int64Attribute{
+				Description: "The maximum transmission unit of the mirrored packets. Valid values: 64 to 9600. " +
+					"Default to 1500.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1500),
+				Validators: []validator.Int64{
+					int64validator.Between(64, 9600),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to enable the session. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vpcTrafficMirrorSessionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).vpcClient
+}
+
+// Create the traffic mirror session and wait until it is created.
+func (r *vpcTrafficMirrorSessionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *vpcTrafficMirrorSessionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTrafficMirrorSession := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createTrafficMirrorSessionRequest := &alicloudVpcClient.CreateTrafficMirrorSessionRequest{
+			RegionId:                        r.client.RegionId,
+			TrafficMirrorSessionName:        ecsStringPointer(plan.Name),
+			TrafficMirrorSessionDescription: ecsStringPointer(plan.Description),
+			TrafficMirrorFilterId:           tea.String(plan.TrafficMirrorFilterId.ValueString()),
+			TrafficMirrorSourceIds:          tea.StringSlice(convertListValueToStrings(plan.SourceIds)),
+			TrafficMirrorTargetId:           tea.String(plan.TargetId.ValueString()),
+			TrafficMirrorTargetType:         tea.String(plan.TargetType.ValueString()),
+			Priority:                        tea.Int32(int32(plan.Priority.ValueInt64())),
+			VirtualNetworkId:                ecsInt32Pointer(plan.VirtualNetworkId),
+			PacketLength:                    tea.Int32(int32(plan.PacketLength.ValueInt64())),
+			Enabled:                         tea.Bool(plan.Enabled.ValueBool()),
+		}
+
+		createTrafficMirrorSessionResponse, err := r.client.CreateTrafficMirrorSessionWithOptions(createTrafficMirrorSessionRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		plan.TrafficMirrorSessionId = types.StringValue(tea.StringValue(createTrafficMirrorSessionResponse.Body.TrafficMirrorSessionId))
+		return nil
+	}
+
+	if err := retryAPICall(createTrafficMirrorSession); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Traffic Mirror Session.",
+			err.Error(),
+		)
+		return
+	}
+
+	session, err := waitVpcTrafficMirrorSession(r.client, plan.TrafficMirrorSessionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Traffic Mirror Session Created.",
+			err.Error(),
+		)
+		return
+	}
+	plan.VirtualNetworkId = types.Int64Value(int64(tea.Int32Value(session.VirtualNetworkId)))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the traffic mirror session.
+func (r *vpcTrafficMirrorSessionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *vpcTrafficMirrorSessionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := describeVpcTrafficMirrorSession(r.client, state.TrafficMirrorSessionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to List Traffic Mirror Sessions.",
+			err.Error(),
+		)
+		return
+	}
+	if session == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = ecsStringValue(state.Name, session.TrafficMirrorSessionName)
+	state.Description = ecsStringValue(state.Description, session.TrafficMirrorSessionDescription)
+	state.TrafficMirrorFilterId = types.StringValue(tea.StringValue(session.TrafficMirrorFilterId))
+	state.TargetId = types.StringValue(tea.StringValue(session.TrafficMirrorTargetId))
+	state.TargetType = types.StringValue(tea.StringValue(session.TrafficMirrorTargetType))
+	state.Priority = types.Int64Value(int64(tea.Int32Value(session.Priority)))
+	state.VirtualNetworkId = types.Int64Value(int64(tea.Int32Value(session.VirtualNetworkId)))
+	state.PacketLength = types.Int64Value(int64(tea.Int32Value(session.PacketLength)))
+	state.Enabled = types.BoolValue(tea.BoolValue(session.Enabled))
+
+	// Keep the sources in the same order as the state.
+	sourceIds := tea.StringSliceValue(session.TrafficMirrorSourceIds)
+	stateSourceIds := convertListValueToStrings(state.SourceIds)
+	orderedSourceIds := convertStringsDifference(stateSourceIds, convertStringsDifference(stateSourceIds, sourceIds))
+	orderedSourceIds = append(orderedSourceIds, convertStringsDifference(sourceIds, stateSourceIds)...)
+	state.SourceIds = types.ListValueMust(types.StringType, convertStringPointersToAttrValues(tea.StringSlice(orderedSourceIds)))
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the attributes and the sources of the traffic mirror session.
+func (r *vpcTrafficMirrorSessionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *vpcTrafficMirrorSessionModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessionId := state.TrafficMirrorSessionId.ValueString()
+	plan.TrafficMirrorSessionId = state.TrafficMirrorSessionId
+	oldSourceIds := convertListValueToStrings(state.SourceIds)
+	newSourceIds := convertListValueToStrings(plan.SourceIds)
+
+	// The session can not be changed while it is being modified, wait before
+	// every call.
+	updateTrafficMirrorSession := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		updateTrafficMirrorSessionAttributeRequest := &alicloudVpcClient.UpdateTrafficMirrorSessionAttributeRequest{
+			RegionId:                        r.client.RegionId,
+			TrafficMirrorSessionId:          tea.String(sessionId),
+			TrafficMirrorSessionName:        tea.String(plan.Name.ValueString()),
+			TrafficMirrorSessionDescription: tea.String(plan.Description.ValueString()),
+			TrafficMirrorFilterId:           tea.String(plan.TrafficMirrorFilterId.ValueString()),
+			TrafficMirrorTargetId:           tea.String(plan.TargetId.ValueString()),
+			TrafficMirrorTargetType:         tea.String(plan.TargetType.ValueString()),
+			Priority:                        tea.Int32(int32(plan.Priority.ValueInt64())),
+			VirtualNetworkId:                ecsInt32Pointer(plan.VirtualNetworkId),
+			PacketLength:                    tea.Int32(int32(plan.PacketLength.ValueInt64())),
+			Enabled:                         tea.Bool(plan.Enabled.ValueBool()),
+		}
+
+		if _, err := r.client.UpdateTrafficMirrorSessionAttributeWithOptions(updateTrafficMirrorSessionAttributeRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+
+		if sourceIds := convertStringsDifference(oldSourceIds, newSourceIds); len(sourceIds) > 0 {
+			if _, err := waitVpcTrafficMirrorSession(r.client, sessionId); err != nil {
+				return backoff.Permanent(err)
+			}
+
+			removeSourcesFromTrafficMirrorSessionRequest := &alicloudVpcClient.RemoveSourcesFromTrafficMirrorSessionRequest{
+				RegionId:               r.client.RegionId,
+				TrafficMirrorSessionId: tea.String(sessionId),
+				TrafficMirrorSourceIds: tea.StringSlice(sourceIds),
+			}
+
+			if _, err := r.client.RemoveSourcesFromTrafficMirrorSessionWithOptions(removeSourcesFromTrafficMirrorSessionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			oldSourceIds = convertStringsDifference(oldSourceIds, sourceIds)
+		}
+
+		if sourceIds := convertStringsDifference(newSourceIds, oldSourceIds); len(sourceIds) > 0 {
+			if _, err := waitVpcTrafficMirrorSession(r.client, sessionId); err != nil {
+				return backoff.Permanent(err)
+			}
+
+			addSourcesToTrafficMirrorSessionRequest := &alicloudVpcClient.AddSourcesToTrafficMirrorSessionRequest{
+				RegionId:               r.client.RegionId,
+				TrafficMirrorSessionId: tea.String(sessionId),
+				TrafficMirrorSourceIds: tea.StringSlice(sourceIds),
+			}
+
+			if _, err := r.client.AddSourcesToTrafficMirrorSessionWithOptions(addSourcesToTrafficMirrorSessionRequest, runtime); err != nil {
+				return handleAPIError(err)
+			}
+			oldSourceIds = append(oldSourceIds, sourceIds...)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(updateTrafficMirrorSession); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Update Traffic Mirror Session.",
+			err.Error(),
+		)
+		return
+	}
+
+	session, err := waitVpcTrafficMirrorSession(r.client, sessionId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Wait Traffic Mirror Session Created.",
+			err.Error(),
+		)
+		return
+	}
+	plan.VirtualNetworkId = types.Int64Value(int64(tea.Int32Value(session.VirtualNetworkId)))
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the traffic mirror session.
+func (r *vpcTrafficMirrorSessionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *vpcTrafficMirrorSessionModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTrafficMirrorSession := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteTrafficMirrorSessionRequest := &alicloudVpcClient.DeleteTrafficMirrorSessionRequest{
+			RegionId:               r.client.RegionId,
+			TrafficMirrorSessionId: tea.String(state.TrafficMirrorSessionId.ValueString()),
+		}
+
+		if _, err := r.client.DeleteTrafficMirrorSessionWithOptions(deleteTrafficMirrorSessionRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteTrafficMirrorSession); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Traffic Mirror Session.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *vpcTrafficMirrorSessionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("traffic_mirror_session_id"), req, resp)
+}
+
+// Function to read the traffic mirror session, returns nil if the traffic
+// mirror session is not found.
+func describeVpcTrafficMirrorSession(client *alicloudVpcClient.Client, sessionId string) (*alicloudVpcClient.ListTrafficMirrorSessionsResponseBodyTrafficMirrorSessions, error) {
+	var listTrafficMirrorSessionsResponse *alicloudVpcClient.ListTrafficMirrorSessionsResponse
+	listTrafficMirrorSessions := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		listTrafficMirrorSessionsRequest := &alicloudVpcClient.ListTrafficMirrorSessionsRequest{
+			RegionId:                client.RegionId,
+			TrafficMirrorSessionIds: []*string{tea.String(sessionId)},
+		}
+
+		var err error
+		listTrafficMirrorSessionsResponse, err = client.ListTrafficMirrorSessionsWithOptions(listTrafficMirrorSessionsRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(listTrafficMirrorSessions); err != nil {
+		return nil, err
+	}
+
+	if len(listTrafficMirrorSessionsResponse.Body.TrafficMirrorSessions) == 0 {
+		return nil, nil
+	}
+	return listTrafficMirrorSessionsResponse.Body.TrafficMirrorSessions[0], nil
+}
+
+// Function to wait until the traffic mirror session is created, returns the
+// created session.
+func waitVpcTrafficMirrorSession(client *alicloudVpcClient.Client, sessionId string) (*alicloudVpcClient.ListTrafficMirrorSessionsResponseBodyTrafficMirrorSessions, error) {
+	var session *alicloudVpcClient.ListTrafficMirrorSessionsResponseBodyTrafficMirrorSessions
+	waitTrafficMirrorSession := func() error {
+		var err error
+		session, err = describeVpcTrafficMirrorSession(client, sessionId)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if session == nil {
+			return backoff.Permanent(fmt.Errorf("traffic mirror session %s is not found", sessionId))
+		}
+		if status := tea.StringValue(session.TrafficMirrorSessionStatus); status != "Created" {
+			return fmt.Errorf("traffic mirror session %s is %s", sessionId, status)
+		}
+		return nil
+	}
+
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 10 * time.Minute
+	err := backoff.Retry(waitTrafficMirrorSession, reconnectBackoff)
+	return session, err
+}
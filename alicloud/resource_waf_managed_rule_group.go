@@ -0,0 +1,439 @@
+package alicloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	alicloudWafClient "github.com/alibabacloud-go/waf-openapi-20211001/v4/client"
+)
+
+const (
+	// The defense scene of the WAF protection templates of the rule groups.
+	wafRuleGroupDefenseScene = "waf_group"
+
+	wafRuleGroupActionMonitor = "monitor"
+	wafRuleGroupActionBlock   = "block"
+)
+
+var (
+	_ resource.Resource                = &wafManagedRuleGroupResource{}
+	_ resource.ResourceWithConfigure   = &wafManagedRuleGroupResource{}
+	_ resource.ResourceWithImportState = &wafManagedRuleGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &wafManagedRuleGroupResource{}
+)
+
+func NewWafManagedRuleGroupResource() resource.Resource {
+	return &wafManagedRuleGroupResource{}
+}
+
+type wafManagedRuleGroupResource struct {
+	client *alicloudWafClient.Client
+}
+
+type wafManagedRuleGroupModel struct {
+	InstanceId         types.String `tfsdk:"instance_id"`
+	TemplateId         types.Int64  `tfsdk:"template_id"`
+	RuleName           types.String `tfsdk:"rule_name"`
+	RuleGroupId        types.Int64  `tfsdk:"rule_group_id"`
+	Action             types.String `tfsdk:"action"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	AllowDirectUpgrade types.Bool   `tfsdk:"allow_direct_upgrade"`
+	RuleId             types.Int64  `tfsdk:"rule_id"`
+}
+
+// The config of the protection rule of the waf_group defense scene.
+type wafRuleGroupRuleConfig struct {
+	Id       int64  `json:"id,omitempty"`
+	Name     string `json:"name"`
+	PolicyId int64  `json:"policyId"`
+	Action   string `json:"action"`
+	Status   int64  `json:"status"`
+}
+
+// Metadata returns the WAF Managed Rule Group resource name.
+func (r *wafManagedRuleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_waf_managed_rule_group"
+}
+
+// Schema defines the schema for the WAF Managed Rule Group resource.
+func (r *wafManagedRuleGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pin the rule group of the basic protection rules of a WAF 3.0 protection template, so that " +
+			"the rules are only upgraded by switching to another rule group, which is rolled out in report-only " +
+			"mode first.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the WAF instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.Int64Attribute{
+				Description: "The ID of the protection template of the waf_group defense scene.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rule_name": schema.StringAttribute{
+				Description: "The name of the protection rule.",
+				Required:    true,
+			},
+			"rule_group_id": schema.Int64Attribute{
+				Description: "The ID of the rule group to pin. Use a custom rule group with automatic update " +
+					"disabled to pin the version of the rules, as the built-in rule groups are updated by " +
+					"AliCloud automatically.",
+				Required: true,
+			},
+			"action": schema.StringAttribute{
+				Description: "The action of the protection rule. Valid values: monitor, block. Default to monitor, " +
+					"which only reports the requests matching the rules.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(wafRuleGroupActionMonitor),
+				Validators: []validator.String{
+					stringvalidator.OneOf(wafRuleGroupActionMonitor, wafRuleGroupActionBlock),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the protection rule is enabled. Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"allow_direct_upgrade": schema.BoolAttribute{
+				Description: "Whether to allow changing the rule group while the action is block. By default, " +
+					"the rule group can only be changed in monitor action, so that the new rules are verified " +
+					"in report-only mode before blocking the requests. Default to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"rule_id": schema.Int64Attribute{
+				Description: "The ID of the protection rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *wafManagedRuleGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(alicloudClients).wafClient
+}
+
+// Create the protection rule of the rule group.
+func (r *wafManagedRuleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *wafManagedRuleGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := json.Marshal([]*wafRuleGroupRuleConfig{newWafRuleGroupRuleConfig(plan)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal Protection Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	var createDefenseRuleResponse *alicloudWafClient.CreateDefenseRuleResponse
+	createDefenseRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		createDefenseRuleRequest := &alicloudWafClient.CreateDefenseRuleRequest{
+			InstanceId:   tea.String(plan.InstanceId.ValueString()),
+			TemplateId:   tea.Int64(plan.TemplateId.ValueInt64()),
+			DefenseScene: tea.String(wafRuleGroupDefenseScene),
+			Rules:        tea.String(string(rules)),
+		}
+
+		var err error
+		createDefenseRuleResponse, err = r.client.CreateDefenseRuleWithOptions(createDefenseRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(createDefenseRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Defense Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	ruleIds := strings.Split(tea.StringValue(createDefenseRuleResponse.Body.RuleIds), ",")
+	ruleId, err := strconv.ParseInt(strings.TrimSpace(ruleIds[0]), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Create Defense Rule.",
+			fmt.Sprintf("Unexpected rule ID returned: %q", tea.StringValue(createDefenseRuleResponse.Body.RuleIds)),
+		)
+		return
+	}
+	plan.RuleId = types.Int64Value(ruleId)
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the protection rule of the rule group.
+func (r *wafManagedRuleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *wafManagedRuleGroupModel
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var describeDefenseRuleResponse *alicloudWafClient.DescribeDefenseRuleResponse
+	describeDefenseRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		describeDefenseRuleRequest := &alicloudWafClient.DescribeDefenseRuleRequest{
+			InstanceId: tea.String(state.InstanceId.ValueString()),
+			TemplateId: tea.Int64(state.TemplateId.ValueInt64()),
+			RuleId:     tea.Int64(state.RuleId.ValueInt64()),
+		}
+
+		var err error
+		describeDefenseRuleResponse, err = r.client.DescribeDefenseRuleWithOptions(describeDefenseRuleRequest, runtime)
+		if err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(describeDefenseRule); err != nil {
+		if _t, ok := err.(*tea.SDKError); ok && strings.Contains(tea.StringValue(_t.Code), "NotExist") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Describe Defense Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	rule := describeDefenseRuleResponse.Body.Rule
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	config := &wafRuleGroupRuleConfig{}
+	if err := json.Unmarshal([]byte(tea.StringValue(rule.Config)), config); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Unmarshal Protection Rule Config.",
+			err.Error(),
+		)
+		return
+	}
+	state.RuleName = types.StringValue(tea.StringValue(rule.RuleName))
+	state.RuleGroupId = types.Int64Value(config.PolicyId)
+	state.Action = types.StringValue(config.Action)
+	state.Enabled = types.BoolValue(tea.Int32Value(rule.Status) == 1)
+
+	setStateDiags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the rule group and the action of the protection rule.
+func (r *wafManagedRuleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *wafManagedRuleGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.RuleId = state.RuleId
+
+	config := newWafRuleGroupRuleConfig(plan)
+	config.Id = plan.RuleId.ValueInt64()
+	rules, err := json.Marshal([]*wafRuleGroupRuleConfig{config})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Marshal Protection Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	modifyDefenseRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		modifyDefenseRuleRequest := &alicloudWafClient.ModifyDefenseRuleRequest{
+			InstanceId:   tea.String(plan.InstanceId.ValueString()),
+			TemplateId:   tea.Int64(plan.TemplateId.ValueInt64()),
+			DefenseScene: tea.String(wafRuleGroupDefenseScene),
+			Rules:        tea.String(string(rules)),
+		}
+
+		if _, err := r.client.ModifyDefenseRuleWithOptions(modifyDefenseRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(modifyDefenseRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Modify Defense Rule.",
+			err.Error(),
+		)
+		return
+	}
+
+	setStateDiags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(setStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the protection rule of the rule group.
+func (r *wafManagedRuleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *wafManagedRuleGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteDefenseRule := func() error {
+		runtime := &util.RuntimeOptions{}
+
+		deleteDefenseRuleRequest := &alicloudWafClient.DeleteDefenseRuleRequest{
+			InstanceId: tea.String(state.InstanceId.ValueString()),
+			TemplateId: tea.Int64(state.TemplateId.ValueInt64()),
+			RuleIds:    tea.String(strconv.FormatInt(state.RuleId.ValueInt64(), 10)),
+		}
+
+		if _, err := r.client.DeleteDefenseRuleWithOptions(deleteDefenseRuleRequest, runtime); err != nil {
+			return handleAPIError(err)
+		}
+		return nil
+	}
+
+	if err := retryAPICall(deleteDefenseRule); err != nil {
+		resp.Diagnostics.AddError(
+			"[API ERROR] Failed to Delete Defense Rule.",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *wafManagedRuleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ":")
+	if len(ids) != 3 || ids[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <instance_id>:<template_id>:<rule_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	templateId, templateErr := strconv.ParseInt(ids[1], 10, 64)
+	ruleId, ruleErr := strconv.ParseInt(ids[2], 10, 64)
+	if templateErr != nil || ruleErr != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected numeric template ID and rule ID. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), templateId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_id"), ruleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_direct_upgrade"), false)...)
+}
+
+// ModifyPlan rejects changing the rule group while blocking the requests,
+// unless allow_direct_upgrade is set, so that the new rules are always
+// verified in report-only mode first.
+func (r *wafManagedRuleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *wafManagedRuleGroupModel
+	getPlanDiags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(getPlanDiags...)
+	getStateDiags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(getStateDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RuleGroupId.IsUnknown() || plan.RuleGroupId.Equal(state.RuleGroupId) {
+		return
+	}
+	if plan.Action.ValueString() == wafRuleGroupActionBlock && !plan.AllowDirectUpgrade.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule_group_id"),
+			"Rule Group Upgrade Must Be Report-Only First",
+			fmt.Sprintf("The rule group is changed from %d to %d while the action is block. Change the rule "+
+				"group with action monitor first, and switch the action to block after the new rules are "+
+				"verified, or set allow_direct_upgrade to true.",
+				state.RuleGroupId.ValueInt64(), plan.RuleGroupId.ValueInt64()),
+		)
+	}
+}
+
+// Function to convert the model into the config of the protection rule.
+func newWafRuleGroupRuleConfig(model *wafManagedRuleGroupModel) *wafRuleGroupRuleConfig {
+	status := int64(0)
+	if model.Enabled.ValueBool() {
+		status = 1
+	}
+	return &wafRuleGroupRuleConfig{
+		Name:     model.RuleName.ValueString(),
+		PolicyId: model.RuleGroupId.ValueInt64(),
+		Action:   model.Action.ValueString(),
+		Status:   status,
+	}
+}
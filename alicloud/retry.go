@@ -0,0 +1,138 @@
+package alicloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// Settings of the retry of the AliCloud API calls, configured by the retry
+// block of the provider.
+type retrySettings struct {
+	maxElapsedTime      time.Duration
+	maxAttempts         uint64
+	jitter              float64
+	throttlingThreshold int
+	throttlingCooldown  time.Duration
+}
+
+var defaultRetrySettings = retrySettings{
+	maxElapsedTime: 30 * time.Second,
+	jitter:         backoff.DefaultRandomizationFactor,
+}
+
+// The retrier shared by all the resources and data sources, so that the
+// circuit breaker counts the throttling failures of the whole apply. It is
+// replaced when the provider is configured.
+var apiRetrier = newRetrier(defaultRetrySettings)
+
+// Retrier of the AliCloud API calls with a circuit breaker, which aborts all
+// the following calls after the configured number of consecutive throttling
+// failures, instead of every resource keeps retrying against the throttled
+// API until the maximum elapsed time. The circuit breaker is closed again
+// after the cooldown, or stays open for the rest of the apply without one.
+type retrier struct {
+	settings retrySettings
+
+	mutex                 sync.Mutex
+	consecutiveThrottling int
+	open                  bool
+	openedAt              time.Time
+}
+
+func newRetrier(settings retrySettings) *retrier {
+	return &retrier{settings: settings}
+}
+
+// Retry the AliCloud API call with the provider retry settings, the call
+// should return the error from handleAPIError or handleOssAPIError.
+func retryAPICall(operation backoff.Operation) error {
+	return apiRetrier.retry(operation)
+}
+
+func (r *retrier) retry(operation backoff.Operation) error {
+	guardedOperation := func() error {
+		if r.isOpen() {
+			return backoff.Permanent(fmt.Errorf("circuit breaker is open after %d consecutive throttling "+
+				"failures, aborting the remaining API calls", r.settings.throttlingThreshold))
+		}
+
+		err := operation()
+		if r.record(err) {
+			return backoff.Permanent(fmt.Errorf("circuit breaker is opened after %d consecutive throttling "+
+				"failures: %w", r.settings.throttlingThreshold, err))
+		}
+		return err
+	}
+
+	return backoff.Retry(guardedOperation, r.newBackOff())
+}
+
+func (r *retrier) newBackOff() backoff.BackOff {
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = r.settings.maxElapsedTime
+	reconnectBackoff.RandomizationFactor = r.settings.jitter
+
+	if r.settings.maxAttempts > 0 {
+		return backoff.WithMaxRetries(reconnectBackoff, r.settings.maxAttempts-1)
+	}
+	return reconnectBackoff
+}
+
+func (r *retrier) isOpen() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.open && r.settings.throttlingCooldown > 0 && time.Since(r.openedAt) >= r.settings.throttlingCooldown {
+		r.open = false
+		r.consecutiveThrottling = 0
+	}
+	return r.open
+}
+
+// Function to record the result of an API call, returns true if the circuit
+// breaker is opened by this call. Any result other than throttling resets the
+// consecutive count.
+func (r *retrier) record(err error) bool {
+	if r.settings.throttlingThreshold <= 0 {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err == nil || !isThrottlingError(err) {
+		r.consecutiveThrottling = 0
+		return false
+	}
+
+	r.consecutiveThrottling++
+	if !r.open && r.consecutiveThrottling >= r.settings.throttlingThreshold {
+		r.open = true
+		r.openedAt = time.Now()
+		return true
+	}
+	return false
+}
+
+// Function to check whether the error is caused by the throttling of
+// AliCloud API, e.g. Throttling.User.
+func isThrottlingError(err error) bool {
+	var sdkError *tea.SDKError
+	if errors.As(err, &sdkError) {
+		return strings.HasPrefix(tea.StringValue(sdkError.Code), ERR_THROTTLING)
+	}
+
+	var ossError oss.ServiceError
+	if errors.As(err, &ossError) {
+		return strings.HasPrefix(ossError.Code, ERR_THROTTLING)
+	}
+	return false
+}
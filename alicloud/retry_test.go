@@ -0,0 +1,215 @@
+package alicloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+func newTestSDKError(code string) error {
+	return tea.NewSDKError(map[string]interface{}{
+		"code":    code,
+		"message": "test error",
+	})
+}
+
+func TestRetrierJitter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		jitter float64
+	}{
+		{name: "no jitter", jitter: 0},
+		{name: "default jitter", jitter: backoff.DefaultRandomizationFactor},
+		{name: "full jitter", jitter: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newRetrier(retrySettings{maxElapsedTime: time.Minute, jitter: testCase.jitter})
+			delta := time.Duration(testCase.jitter * float64(backoff.DefaultInitialInterval))
+			minInterval := backoff.DefaultInitialInterval - delta
+			maxInterval := backoff.DefaultInitialInterval + delta
+
+			for i := 0; i < 100; i++ {
+				interval := r.newBackOff().NextBackOff()
+				if interval < minInterval || interval > maxInterval {
+					t.Fatalf("expected the first interval between %s and %s, got %s", minInterval, maxInterval, interval)
+				}
+			}
+		})
+	}
+}
+
+func TestRetrierMaxAttempts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		maxAttempts   uint64
+		expectedCalls int
+	}{
+		{name: "single attempt", maxAttempts: 1, expectedCalls: 1},
+		{name: "three attempts", maxAttempts: 3, expectedCalls: 3},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newRetrier(retrySettings{maxElapsedTime: time.Minute, maxAttempts: testCase.maxAttempts})
+
+			calls := 0
+			err := r.retry(func() error {
+				calls++
+				return handleAPIError(newTestSDKError(ERR_SERVICE_UNAVAILABLE))
+			})
+			if err == nil {
+				t.Fatal("expected the error of the last attempt, got nil")
+			}
+			if calls != testCase.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetrierCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name               string
+		throttlingCooldown time.Duration
+		openedAgo          time.Duration
+		expectedOpen       bool
+	}{
+		{name: "open without cooldown", openedAgo: time.Hour, expectedOpen: true},
+		{name: "open within cooldown", throttlingCooldown: time.Minute, openedAgo: time.Second, expectedOpen: true},
+		{name: "reset after cooldown", throttlingCooldown: time.Minute, openedAgo: 2 * time.Minute, expectedOpen: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newRetrier(retrySettings{
+				maxElapsedTime:      time.Minute,
+				throttlingThreshold: 2,
+				throttlingCooldown:  testCase.throttlingCooldown,
+			})
+
+			calls := 0
+			err := r.retry(func() error {
+				calls++
+				return handleAPIError(newTestSDKError(ERR_THROTTLING_USER))
+			})
+			if err == nil || !strings.Contains(err.Error(), "circuit breaker is opened") {
+				t.Fatalf("expected the circuit breaker to be opened, got %v", err)
+			}
+			if calls != 2 {
+				t.Fatalf("expected 2 calls before the circuit breaker is opened, got %d", calls)
+			}
+
+			r.openedAt = time.Now().Add(-testCase.openedAgo)
+
+			calls = 0
+			err = r.retry(func() error {
+				calls++
+				return nil
+			})
+			if testCase.expectedOpen {
+				if err == nil || calls != 0 {
+					t.Fatalf("expected the call to be aborted by the circuit breaker, got %d calls and %v", calls, err)
+				}
+				return
+			}
+			if err != nil || calls != 1 {
+				t.Fatalf("expected the call to succeed after the cooldown, got %d calls and %v", calls, err)
+			}
+			if r.consecutiveThrottling != 0 {
+				t.Fatalf("expected the consecutive throttling count to be reset, got %d", r.consecutiveThrottling)
+			}
+		})
+	}
+}
+
+func TestRetrierCircuitBreakerResetByOtherErrors(t *testing.T) {
+	r := newRetrier(retrySettings{maxElapsedTime: time.Minute, throttlingThreshold: 2})
+
+	results := []error{
+		newTestSDKError(ERR_THROTTLING_USER),
+		newTestSDKError(ERR_SERVICE_UNAVAILABLE),
+		newTestSDKError(ERR_THROTTLING_USER),
+		nil,
+	}
+	calls := 0
+	err := r.retry(func() error {
+		result := results[calls]
+		calls++
+		return handleAPIError(result)
+	})
+	if err != nil {
+		t.Fatalf("expected the call to succeed, got %v", err)
+	}
+	if calls != len(results) {
+		t.Fatalf("expected %d calls, got %d", len(results), calls)
+	}
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "throttling", err: newTestSDKError(ERR_THROTTLING), expected: true},
+		{name: "user throttling", err: newTestSDKError(ERR_THROTTLING_USER), expected: true},
+		{name: "api throttling", err: newTestSDKError(ERR_THROTTLING_API), expected: true},
+		{name: "wrapped throttling", err: fmt.Errorf("wrapped: %w", newTestSDKError(ERR_THROTTLING_USER)), expected: true},
+		{name: "permanent throttling", err: backoff.Permanent(newTestSDKError(ERR_THROTTLING_USER)), expected: true},
+		{name: "oss throttling", err: oss.ServiceError{Code: ERR_THROTTLING}, expected: true},
+		{name: "service unavailable", err: newTestSDKError(ERR_SERVICE_UNAVAILABLE), expected: false},
+		{name: "oss no such key", err: oss.ServiceError{Code: "NoSuchKey"}, expected: false},
+		{name: "plain error", err: errors.New("Throttling"), expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := isThrottlingError(testCase.err); actual != testCase.expected {
+				t.Fatalf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRetrierPermanentErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		err           error
+		expectedCalls int
+	}{
+		{name: "non-retryable sdk error", err: newTestSDKError("InvalidParameter"), expectedCalls: 1},
+		{name: "retryable sdk error", err: newTestSDKError(ERR_INTERNAL_ERROR), expectedCalls: 2},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newRetrier(retrySettings{maxElapsedTime: time.Minute, maxAttempts: 2})
+
+			calls := 0
+			err := r.retry(func() error {
+				calls++
+				return handleAPIError(testCase.err)
+			})
+			if err == nil {
+				t.Fatal("expected the error, got nil")
+			}
+			if calls != testCase.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+
+			var sdkError *tea.SDKError
+			if !errors.As(err, &sdkError) || tea.StringValue(sdkError.Code) != tea.StringValue(testCase.err.(*tea.SDKError).Code) {
+				t.Fatalf("expected the sdk error to be returned, got %v", err)
+			}
+		})
+	}
+}
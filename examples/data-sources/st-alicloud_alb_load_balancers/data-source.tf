@@ -0,0 +1,22 @@
+provider "st-alicloud" {
+  alias  = "alb"
+  region = "cn-hongkong"
+}
+
+data "st-alicloud_alb_load_balancers" "albs" {
+  provider = st-alicloud.alb
+
+  vpc_id = "vpc-xxxxxxxxxxxxxxxxxxxxx"
+  tags = {
+    "app" = "web-server"
+    "env" = "basic"
+  }
+
+  client_config {
+    zone = "cn-hongkong-b"
+  }
+}
+
+output "alb_load_balancers" {
+  value = data.st-alicloud_alb_load_balancers.albs
+}
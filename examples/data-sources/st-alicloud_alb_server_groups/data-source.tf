@@ -0,0 +1,32 @@
+provider "st-alicloud" {
+  alias  = "alb"
+  region = "cn-hongkong"
+}
+
+data "st-alicloud_alb_server_groups" "server_groups" {
+  provider = st-alicloud.alb
+
+  vpc_id = "vpc-xxxxxxxxxxxxxxxxxxxxx"
+  tags = {
+    "app" = "web-server"
+  }
+}
+
+resource "st-alicloud_ess_attach_alb_server_group" "web" {
+  provider = st-alicloud.alb
+
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+
+  dynamic "alb_server_groups" {
+    for_each = data.st-alicloud_alb_server_groups.server_groups.server_groups
+    content {
+      alb_server_group_id = alb_server_groups.value.id
+      port                = 80
+      weight              = 100
+    }
+  }
+}
+
+output "alb_server_groups" {
+  value = data.st-alicloud_alb_server_groups.server_groups
+}
@@ -0,0 +1,16 @@
+data "st-alicloud_alidns_records" "api" {
+  domain_name = "example.com"
+  rr_regex    = "^api(-[a-z0-9]+)?$"
+  type        = "A"
+}
+
+data "st-alicloud_alidns_records" "production" {
+  domain_tags = {
+    env = "production"
+  }
+  line = "default"
+}
+
+output "api_record_ids" {
+  value = data.st-alicloud_alidns_records.api.ids
+}
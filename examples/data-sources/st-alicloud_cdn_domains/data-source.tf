@@ -0,0 +1,13 @@
+data "st-alicloud_cdn_domains" "online" {
+  status = "online"
+
+  tags = {
+    env = "production"
+  }
+}
+
+output "cdn_cnames" {
+  value = {
+    for domain in data.st-alicloud_cdn_domains.online.domains : domain.domain_name => domain.cname
+  }
+}
@@ -0,0 +1,12 @@
+data "st-alicloud_cdn_quota_usage" "cdn" {
+  domain_names   = ["static.example.com", "img.example.com"]
+  window_minutes = 30
+}
+
+output "cdn_url_refresh_remain" {
+  value = data.st-alicloud_cdn_quota_usage.cdn.refresh_quota.url_remain
+}
+
+output "cdn_peak_bps" {
+  value = data.st-alicloud_cdn_quota_usage.cdn.usage.peak_bps
+}
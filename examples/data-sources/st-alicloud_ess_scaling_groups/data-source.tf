@@ -0,0 +1,12 @@
+data "st-alicloud_ess_scaling_groups" "web" {
+  name_regex = "^web-"
+  vpc_id     = "vpc-xxxxxxxxxxxxxxxxxxxx"
+
+  tags = {
+    env = "prod"
+  }
+}
+
+output "web_alb_server_group_ids" {
+  value = flatten(data.st-alicloud_ess_scaling_groups.web.scaling_groups[*].alb_server_group_ids)
+}
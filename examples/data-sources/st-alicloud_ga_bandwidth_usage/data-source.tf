@@ -0,0 +1,13 @@
+data "st-alicloud_ga_bandwidth_usage" "usage" {
+  accelerator_id     = "ga-bp1odcab8tmno0hdq****"
+  listener_ids       = ["lsr-bp1bpn0kn908w4nbw****"]
+  endpoint_group_ids = ["epg-bp1dmlohjjz4kqaun****", "epg-bp14sz7ftcwwjgrdm****"]
+  window_minutes     = 10
+}
+
+output "ga_endpoint_group_bandwidth" {
+  value = {
+    for group in data.st-alicloud_ga_bandwidth_usage.usage.endpoint_groups :
+    group.id => group.out_bandwidth_maximum
+  }
+}
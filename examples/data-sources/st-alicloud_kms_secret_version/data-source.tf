@@ -0,0 +1,9 @@
+data "st-alicloud_kms_secret_version" "app_db" {
+  secret_name   = "app/db-password"
+  version_stage = "ACSCurrent"
+}
+
+output "app_db_password" {
+  value     = data.st-alicloud_kms_secret_version.app_db.secret_data
+  sensitive = true
+}
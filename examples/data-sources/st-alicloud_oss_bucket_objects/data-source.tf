@@ -0,0 +1,19 @@
+data "st-alicloud_oss_bucket_objects" "releases" {
+  bucket = "example-artifacts"
+  prefix = "releases/api/"
+  suffix = ".zip"
+}
+
+output "latest_release" {
+  value = element(
+    data.st-alicloud_oss_bucket_objects.releases.objects,
+    length(data.st-alicloud_oss_bucket_objects.releases.objects) - 1
+  )
+}
+
+output "release_etags" {
+  value = {
+    for object in data.st-alicloud_oss_bucket_objects.releases.objects :
+    object.key => object.etag
+  }
+}
@@ -0,0 +1,13 @@
+data "st-alicloud_ram_conditional_policy_document" "guard_rails" {
+  source_ip_allowlist = ["203.0.113.0/24", "198.51.100.10"]
+
+  require_mfa          = true
+  mfa_required_actions = ["ram:*", "ecs:Delete*"]
+}
+
+# Create a custom policy with the policy document, e.g. by the resource
+# alicloud_ram_policy of the official provider, and attach the custom policy
+# together with the other policies by st-alicloud_ram_policy.
+output "policy_document" {
+  value = data.st-alicloud_ram_conditional_policy_document.guard_rails.policy_document
+}
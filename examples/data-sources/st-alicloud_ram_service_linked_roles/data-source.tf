@@ -0,0 +1,18 @@
+data "st-alicloud_ram_service_linked_roles" "required" {
+  role_names = [
+    "AliyunServiceRoleForAutoScaling",
+    "AliyunServiceRoleForAlb",
+    "AliyunServiceRoleForServiceMesh",
+  ]
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.missing_role_names) == 0
+      error_message = "The service-linked roles ${join(", ", self.missing_role_names)} must be created first."
+    }
+  }
+}
+
+output "service_linked_roles" {
+  value = data.st-alicloud_ram_service_linked_roles.required.roles
+}
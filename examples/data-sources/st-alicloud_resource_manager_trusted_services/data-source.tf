@@ -0,0 +1,15 @@
+data "st-alicloud_resource_manager_trusted_services" "trusted_services" {
+  lifecycle {
+    postcondition {
+      condition = alltrue([
+        for service in ["config.aliyuncs.com", "actiontrail.aliyuncs.com", "cloudsso.aliyuncs.com"] :
+        contains(self.trusted_services[*].service_principal, service)
+      ])
+      error_message = "Config, ActionTrail and CloudSSO must be enabled as trusted services of the resource directory."
+    }
+  }
+}
+
+output "trusted_services" {
+  value = data.st-alicloud_resource_manager_trusted_services.trusted_services.trusted_services[*].service_principal
+}
@@ -0,0 +1,15 @@
+provider "st-alicloud" {
+  alias  = "slb"
+  region = "cn-hongkong"
+}
+
+data "st-alicloud_slb_listeners" "listeners" {
+  provider = st-alicloud.slb
+
+  load_balancer_id  = "lb-j6c7snkd7agh0pynd7b0x"
+  listener_protocol = "https"
+}
+
+output "slb_listeners" {
+  value = data.st-alicloud_slb_listeners.listeners.listeners
+}
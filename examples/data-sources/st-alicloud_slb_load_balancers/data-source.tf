@@ -10,6 +10,10 @@ data "st-alicloud_slb_load_balancers" "slbs" {
     "app" = "web-server"
     "env" = "basic"
   }
+  tag_match_mode = "all"
+
+  page_size   = 50
+  max_results = 200
 }
 
 output "slb_load_balancers" {
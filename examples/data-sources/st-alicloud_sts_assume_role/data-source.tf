@@ -0,0 +1,16 @@
+data "st-alicloud_sts_assume_role" "deploy" {
+  role_arn          = "acs:ram::123456789012****:role/deploy"
+  role_session_name = "terraform-deploy"
+  duration_seconds  = 900
+
+  policy = jsonencode({
+    Version = "1"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["oss:PutObject"]
+        Resource = ["acs:oss:*:*:example-bucket/*"]
+      }
+    ]
+  })
+}
@@ -0,0 +1,18 @@
+data "st-alicloud_tag_compliance" "cost_allocation" {
+  resource_arns = [
+    "acs:ecs:cn-hongkong:1234567890123456:instance/i-j6c0f3xxxxxxxxxxxxxx",
+    "acs:oss:cn-hongkong:1234567890123456:bucket/example-bucket",
+  ]
+
+  required_tag_keys = [
+    "CostCenter",
+    "Project",
+  ]
+
+  lifecycle {
+    postcondition {
+      condition     = self.compliant
+      error_message = "Resources missing the cost allocation tags: ${join(", ", self.non_compliant_resources[*].resource_arn)}."
+    }
+  }
+}
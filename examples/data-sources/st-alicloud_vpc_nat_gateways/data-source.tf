@@ -0,0 +1,7 @@
+data "st-alicloud_vpc_nat_gateways" "def" {
+  vpc_id = "vpc-xxxxxxxxxxxxxxxxxxxx"
+
+  tags = {
+    "env" = "prod"
+  }
+}
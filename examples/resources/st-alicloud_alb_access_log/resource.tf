@@ -0,0 +1,11 @@
+resource "st-alicloud_alb_access_log" "web" {
+  load_balancer_id = "alb-xxxxxxxxxxxxxxxxxx"
+  log_project      = "alb-access-log"
+  log_store        = "web"
+}
+
+resource "st-alicloud_alb_access_log" "tcp" {
+  load_balancer_id = "nlb-xxxxxxxxxxxxxxxxxx"
+  log_project      = "nlb-access-log"
+  log_store        = "tcp"
+}
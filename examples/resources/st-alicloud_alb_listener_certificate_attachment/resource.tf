@@ -0,0 +1,7 @@
+resource "st-alicloud_alb_listener_certificate_attachment" "https" {
+  listener_id = "lsn-xxxxxxxxxxxxxxxxxxxx"
+  certificate_ids = [
+    "12345678-cn-hongkong",
+    "12345679-cn-hongkong",
+  ]
+}
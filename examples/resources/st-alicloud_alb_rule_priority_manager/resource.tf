@@ -0,0 +1,10 @@
+resource "st-alicloud_alb_rule_priority_manager" "https" {
+  listener_id = "lsn-xxxxxxxxxxxxxxxxxxxx"
+  rule_ids = [
+    "rule-xxxxxxxxxxxxxxxxxxx1",
+    "rule-xxxxxxxxxxxxxxxxxxx2",
+    "rule-xxxxxxxxxxxxxxxxxxx3",
+  ]
+  priority_start = 10
+  priority_step  = 10
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_alidns_domain_resolution_line.office 1234567
@@ -0,0 +1,22 @@
+resource "st-alicloud_alidns_domain_resolution_line" "office" {
+  domain_name = "example.com"
+  name        = "office"
+
+  ip_segments {
+    start_ip = "203.0.113.0"
+    end_ip   = "203.0.113.255"
+  }
+
+  ip_segments {
+    start_ip = "198.51.100.0"
+    end_ip   = "198.51.100.127"
+  }
+}
+
+resource "alicloud_alidns_record" "internal" {
+  domain_name = "example.com"
+  rr          = "portal"
+  type        = "A"
+  value       = "10.0.0.10"
+  line        = st-alicloud_alidns_domain_resolution_line.office.line_code
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_alidns_gtm_instance_config.example gtm-cn-wwo3a3hbz01
@@ -0,0 +1,46 @@
+resource "st-alicloud_alidns_gtm_instance_config" "example" {
+  instance_id = "gtm-cn-wwo3a3hbz01"
+
+  address_pool {
+    name = "primary"
+    type = "IPV4"
+
+    address {
+      address    = "47.100.10.1"
+      line_codes = ["default"]
+    }
+
+    health_check {
+      protocol            = "HTTP"
+      interval            = 60
+      timeout             = 5000
+      evaluation_count    = 2
+      monitor_extend_info = jsonencode({ port = 80, host = "www.example.com", path = "/health", code = 400, failureRate = 50 })
+
+      isp_city_node {
+        city_code = "503"
+        isp_code  = "465"
+      }
+    }
+  }
+
+  address_pool {
+    name = "secondary"
+    type = "IPV4"
+
+    address {
+      address    = "8.210.10.1"
+      line_codes = ["default"]
+    }
+  }
+
+  access_strategy {
+    name                   = "global"
+    lines                  = ["default"]
+    default_addr_pool_type = "IPV4"
+    default_addr_pools     = ["primary"]
+
+    failover_addr_pool_type = "IPV4"
+    failover_addr_pools     = ["secondary"]
+  }
+}
@@ -0,0 +1,16 @@
+resource "st-alicloud_alidns_record_failover" "api" {
+  domain_name       = "example.com"
+  rr                = "api"
+  type              = "A"
+  ttl               = 60
+  primary_address   = "203.0.113.10"
+  secondary_address = "198.51.100.10"
+
+  monitor {
+    protocol         = "HTTP"
+    port             = 80
+    path             = "/healthz"
+    interval         = 1
+    min_availability = 50
+  }
+}
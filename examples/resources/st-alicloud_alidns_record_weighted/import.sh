@@ -0,0 +1 @@
+terraform import st-alicloud_alidns_record_weighted.api example.com:api:A
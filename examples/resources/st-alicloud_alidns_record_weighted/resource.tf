@@ -0,0 +1,16 @@
+resource "st-alicloud_alidns_record_weighted" "api" {
+  domain_name = "example.com"
+  rr          = "api"
+  type        = "A"
+  ttl         = 600
+
+  records {
+    value  = "47.100.10.1"
+    weight = 80
+  }
+
+  records {
+    value  = "47.100.10.2"
+    weight = 20
+  }
+}
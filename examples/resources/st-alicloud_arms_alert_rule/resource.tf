@@ -0,0 +1,18 @@
+resource "st-alicloud_arms_alert_rule" "pod_restart" {
+  alert_rule_name = "pod-restart"
+  cluster_id      = st-alicloud_arms_prometheus_ack_integration.prod.cluster_id
+  expression      = "increase(kube_pod_container_status_restarts_total[5m]) > 3"
+  duration        = 5
+  severity        = "P2"
+  message         = "Pod {{$labels.namespace}}/{{$labels.pod}} restarted more than 3 times in 5 minutes."
+
+  labels = {
+    team = "sre"
+  }
+
+  annotations = {
+    runbook = "https://wiki.example.com/runbooks/pod-restart"
+  }
+
+  notification_policy_id = st-alicloud_arms_notification_policy.sre.notification_policy_id
+}
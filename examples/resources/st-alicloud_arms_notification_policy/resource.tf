@@ -0,0 +1,20 @@
+resource "st-alicloud_arms_notification_policy" "sre" {
+  name            = "sre"
+  notify_channels = ["email", "sms"]
+  repeat_interval = 1800
+  grouping_fields = ["alertname", "namespace"]
+
+  matching_rules {
+    conditions {
+      key      = "team"
+      operator = "eq"
+      value    = "sre"
+    }
+  }
+
+  notify_objects {
+    type = "CONTACT_GROUP"
+    id   = "12345"
+    name = "sre-oncall"
+  }
+}
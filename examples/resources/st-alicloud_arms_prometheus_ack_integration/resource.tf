@@ -0,0 +1,4 @@
+resource "st-alicloud_arms_prometheus_ack_integration" "prod" {
+  cluster_id       = "c0bad479465464e1d8c1e641b0afbxxxx"
+  storage_duration = 30
+}
@@ -0,0 +1,4 @@
+resource "st-alicloud_arms_prometheus_instance" "idc" {
+  instance_name    = "idc-prometheus"
+  storage_duration = 90
+}
@@ -0,0 +1,11 @@
+resource "st-alicloud_arms_prometheus_remote_write" "central" {
+  instance_id       = st-alicloud_arms_prometheus_ack_integration.prod.cluster_id
+  remote_write_yaml = <<-EOT
+    remote_write:
+      - name: central
+        url: ${st-alicloud_arms_prometheus_instance.idc.remote_write_intranet_url}
+        basic_auth:
+          username: AccessKeyId
+          password: AccessKeySecret
+  EOT
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_arms_webhook_contact.pagerduty 12345
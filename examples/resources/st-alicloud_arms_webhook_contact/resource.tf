@@ -0,0 +1,37 @@
+resource "st-alicloud_arms_webhook_contact" "pagerduty" {
+  name = "pagerduty-sre"
+  url  = "https://events.pagerduty.com/v2/enqueue"
+
+  headers = {
+    "Content-Type" = "application/json"
+  }
+
+  body = jsonencode({
+    routing_key  = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+    event_action = "trigger"
+    dedup_key    = "{{ .groupKey }}"
+    payload = {
+      summary  = "{{ .commonLabels.alertname }}"
+      source   = "alicloud-cms"
+      severity = "critical"
+    }
+  })
+
+  recover_body = jsonencode({
+    routing_key  = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+    event_action = "resolve"
+    dedup_key    = "{{ .groupKey }}"
+  })
+}
+
+resource "st-alicloud_arms_notification_policy" "sre" {
+  name            = "sre"
+  notify_channels = ["webhook"]
+  repeat_interval = 1800
+
+  notify_objects {
+    type = "WEBHOOK"
+    id   = st-alicloud_arms_webhook_contact.pagerduty.webhook_id
+    name = st-alicloud_arms_webhook_contact.pagerduty.name
+  }
+}
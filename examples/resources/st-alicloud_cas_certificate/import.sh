@@ -0,0 +1 @@
+terraform import st-alicloud_cas_certificate.example 12345678
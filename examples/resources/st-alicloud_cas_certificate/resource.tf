@@ -0,0 +1,20 @@
+resource "st-alicloud_cas_certificate" "example" {
+  name = "example-com"
+  cert = file("${path.module}/example.com.crt")
+  key  = file("${path.module}/example.com.key")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "st-alicloud_ddoscoo_webconfig_ssl_attachment" "example" {
+  domain  = "example.com"
+  cert_id = st-alicloud_cas_certificate.example.cert_id
+
+  lifecycle {
+    replace_triggered_by = [
+      st-alicloud_cas_certificate.example.fingerprint,
+    ]
+  }
+}
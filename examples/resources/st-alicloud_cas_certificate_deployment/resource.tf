@@ -0,0 +1,25 @@
+resource "st-alicloud_cas_certificate" "example" {
+  name = "example-com"
+  cert = file("${path.module}/example.com.crt")
+  key  = file("${path.module}/example.com.key")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "st-alicloud_cas_certificate_deployment" "example" {
+  name    = "example-com"
+  cert_id = st-alicloud_cas_certificate.example.cert_id
+
+  resources {
+    cloud_product = "ALB"
+    instance_id   = "alb-xxxxxxxxxxxxxxxxxx"
+    listener_port = 443
+  }
+
+  resources {
+    cloud_product = "CDN"
+    domain        = "static.example.com"
+  }
+}
@@ -0,0 +1,18 @@
+resource "st-alicloud_cdn_cache_refresh" "release" {
+  triggers = {
+    release = var.release_version
+  }
+
+  refresh_urls = [
+    "https://static.example.com/index.html",
+  ]
+
+  refresh_directories = [
+    "https://static.example.com/assets/",
+  ]
+
+  preload_urls = [
+    "https://static.example.com/index.html",
+    "https://static.example.com/assets/app.js",
+  ]
+}
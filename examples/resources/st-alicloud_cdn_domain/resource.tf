@@ -0,0 +1,43 @@
+resource "st-alicloud_cdn_domain" "static" {
+  domain_name = "static.example.com"
+  cdn_type    = "web"
+  scope       = "overseas"
+
+  sources {
+    type    = "domain"
+    content = "origin.example.com"
+    port    = 443
+  }
+
+  cache_ttl_rules {
+    type  = "suffix"
+    value = "jpg,png,css,js"
+    ttl   = 86400
+  }
+
+  cache_ttl_rules {
+    type  = "path"
+    value = "/api"
+    ttl   = 0
+  }
+
+  https {
+    force_https  = true
+    http2        = true
+    hsts_max_age = 31536000
+  }
+
+  referer {
+    type        = "whitelist"
+    domains     = ["example.com", "*.example.com"]
+    allow_empty = true
+  }
+
+  ip_acl {
+    type = "deny"
+    ips  = ["192.0.2.0/24"]
+  }
+
+  range_origin        = "on"
+  back_to_origin_host = "origin.example.com"
+}
@@ -0,0 +1,12 @@
+# Bind a certificate of Certificate Management Service.
+resource "st-alicloud_cdn_domain_ssl_certificate" "static" {
+  domain_name   = "static.example.com"
+  cas_cert_name = "static.example.com-2026"
+}
+
+# Upload the certificate content.
+resource "st-alicloud_cdn_domain_ssl_certificate" "assets" {
+  domain_name  = "assets.example.com"
+  cert_content = file("${path.module}/assets.example.com.crt")
+  private_key  = file("${path.module}/assets.example.com.key")
+}
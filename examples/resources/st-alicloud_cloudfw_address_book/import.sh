@@ -0,0 +1 @@
+terraform import st-alicloud_cloudfw_address_book.allowed_domains <group_uuid>
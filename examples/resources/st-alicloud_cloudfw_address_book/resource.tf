@@ -0,0 +1,9 @@
+resource "st-alicloud_cloudfw_address_book" "allowed_domains" {
+  name        = "egress-allowed-domains"
+  description = "Domains allowed for the egress traffic."
+  type        = "domain"
+  addresses = [
+    "*.example.com",
+    "api.example.net",
+  ]
+}
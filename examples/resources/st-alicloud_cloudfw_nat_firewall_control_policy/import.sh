@@ -0,0 +1 @@
+terraform import st-alicloud_cloudfw_nat_firewall_control_policy.allow_domains <nat_gateway_id>:<acl_uuid>
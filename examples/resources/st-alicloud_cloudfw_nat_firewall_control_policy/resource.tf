@@ -0,0 +1,38 @@
+resource "st-alicloud_cloudfw_address_book" "allowed_domains" {
+  name        = "egress-allowed-domains"
+  description = "Domains allowed for the egress traffic."
+  type        = "domain"
+  addresses = [
+    "*.example.com",
+    "api.example.net",
+  ]
+}
+
+resource "st-alicloud_cloudfw_nat_firewall_control_policy" "allow_domains" {
+  nat_gateway_id        = "ngw-xxxxxxxxxxxxxxxxxxxxx"
+  description           = "Allow HTTPS to the allowed domains."
+  acl_action            = "accept"
+  source                = "10.0.0.0/8"
+  source_type           = "net"
+  destination           = st-alicloud_cloudfw_address_book.allowed_domains.name
+  destination_type      = "group"
+  proto                 = "TCP"
+  dest_port             = "443/443"
+  application_name_list = ["HTTPS"]
+  order                 = 1
+}
+
+resource "st-alicloud_cloudfw_nat_firewall_control_policy" "deny_all" {
+  nat_gateway_id   = "ngw-xxxxxxxxxxxxxxxxxxxxx"
+  description      = "Deny the other egress traffic."
+  acl_action       = "drop"
+  source           = "10.0.0.0/8"
+  source_type      = "net"
+  destination      = "0.0.0.0/0"
+  destination_type = "net"
+  dest_port        = "0/0"
+
+  depends_on = [
+    st-alicloud_cloudfw_nat_firewall_control_policy.allow_domains,
+  ]
+}
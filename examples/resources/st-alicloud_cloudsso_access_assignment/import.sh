@@ -0,0 +1 @@
+terraform import st-alicloud_cloudsso_access_assignment.developers_read_only d-00fc2p61****:ac-00jhtfl8thteu6uj****:RD-Account:1234567890123456:Group:g-00jqzghi2n3o5hkh****
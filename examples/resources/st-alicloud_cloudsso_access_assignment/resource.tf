@@ -0,0 +1,7 @@
+resource "st-alicloud_cloudsso_access_assignment" "developers_read_only" {
+  directory_id            = st-alicloud_cloudsso_directory.this.id
+  access_configuration_id = st-alicloud_cloudsso_access_configuration.read_only.access_configuration_id
+  target_id               = "1234567890123456"
+  principal_type          = "Group"
+  principal_id            = st-alicloud_cloudsso_group.developers.group_id
+}
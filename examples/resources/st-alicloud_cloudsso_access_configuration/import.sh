@@ -0,0 +1 @@
+terraform import st-alicloud_cloudsso_access_configuration.read_only d-00fc2p61****:ac-00jhtfl8thteu6uj****
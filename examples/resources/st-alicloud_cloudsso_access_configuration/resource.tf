@@ -0,0 +1,22 @@
+resource "st-alicloud_cloudsso_access_configuration" "read_only" {
+  directory_id              = st-alicloud_cloudsso_directory.this.id
+  access_configuration_name = "ReadOnly"
+  description               = "Read only access to ECS and OSS."
+  session_duration          = 7200
+
+  system_policies = [
+    "AliyunECSReadOnlyAccess",
+    "AliyunOSSReadOnlyAccess",
+  ]
+
+  inline_policy_document = jsonencode({
+    Version = "1"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["log:Get*", "log:List*"]
+        Resource = "*"
+      }
+    ]
+  })
+}
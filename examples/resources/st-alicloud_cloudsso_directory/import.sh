@@ -0,0 +1 @@
+terraform import st-alicloud_cloudsso_directory.this d-00fc2p61****
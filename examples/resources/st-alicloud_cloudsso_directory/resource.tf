@@ -0,0 +1,3 @@
+resource "st-alicloud_cloudsso_directory" "this" {
+  directory_name = "example-sso"
+}
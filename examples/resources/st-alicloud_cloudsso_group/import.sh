@@ -0,0 +1 @@
+terraform import st-alicloud_cloudsso_group.developers d-00fc2p61****:g-00jqzghi2n3o5hkh****
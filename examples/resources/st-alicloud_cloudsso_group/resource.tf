@@ -0,0 +1,9 @@
+resource "st-alicloud_cloudsso_group" "developers" {
+  directory_id = st-alicloud_cloudsso_directory.this.id
+  group_name   = "developers"
+  description  = "The developers of the example project."
+
+  user_ids = [
+    st-alicloud_cloudsso_user.alice.user_id,
+  ]
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_cloudsso_user.alice d-00fc2p61****:u-00q8wbq42wiltcrk****
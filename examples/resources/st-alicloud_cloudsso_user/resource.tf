@@ -0,0 +1,6 @@
+resource "st-alicloud_cloudsso_user" "alice" {
+  directory_id = st-alicloud_cloudsso_directory.this.id
+  user_name    = "alice"
+  display_name = "Alice"
+  email        = "alice@example.com"
+}
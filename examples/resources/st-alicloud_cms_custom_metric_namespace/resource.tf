@@ -0,0 +1,21 @@
+resource "st-alicloud_cms_custom_metric_namespace" "payment" {
+  namespace   = "payment-service"
+  description = "Custom metrics of the payment service."
+  spec        = "cms.s1.xlarge"
+
+  initial_metrics {
+    name = "payment_requests_total"
+    labels = {
+      env = "prod"
+    }
+  }
+
+  initial_metrics {
+    name  = "payment_queue_depth"
+    value = "0"
+    labels = {
+      env   = "prod"
+      queue = "settlement"
+    }
+  }
+}
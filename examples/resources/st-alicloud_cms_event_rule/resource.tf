@@ -0,0 +1,19 @@
+resource "st-alicloud_cms_event_rule" "ecs" {
+  rule_name    = "ecs-critical-events"
+  description  = "Critical system events of ECS instances"
+  silence_time = 86400
+
+  event_pattern {
+    product     = "ecs"
+    event_types = ["StatusNotification", "Exception"]
+    event_names = ["Instance:StateChange", "Instance:SystemFailure.Reboot:Executing"]
+    levels      = ["CRITICAL", "WARN"]
+    statuses    = ["Executing", "Failed"]
+  }
+}
+
+resource "st-alicloud_cms_system_event_contact_group_attachment" "ecs" {
+  rule_name          = st-alicloud_cms_event_rule.ecs.rule_name
+  contact_group_name = "ops"
+  level              = "3"
+}
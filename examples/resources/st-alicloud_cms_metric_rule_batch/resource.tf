@@ -0,0 +1,39 @@
+resource "st-alicloud_cms_metric_rule_batch" "ecs" {
+  namespace          = "acs_ecs_dashboard"
+  contact_groups     = ["ops"]
+  webhook            = "https://alert.example.com/cms"
+  effective_interval = "00:00-23:59"
+
+  rules {
+    rule_name    = "ecs-cpu-utilization"
+    metric_name  = "CPUUtilization"
+    period       = 60
+    silence_time = 3600
+
+    critical {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "95"
+      times               = 3
+    }
+
+    warn {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "85"
+      times               = 3
+    }
+  }
+
+  rules {
+    rule_name   = "ecs-memory-utilization"
+    metric_name = "memory_usedutilization"
+
+    critical {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "90"
+      times               = 3
+    }
+  }
+}
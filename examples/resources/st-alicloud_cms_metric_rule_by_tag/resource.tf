@@ -0,0 +1,44 @@
+resource "st-alicloud_cms_metric_rule_by_tag" "ecs" {
+  namespace      = "acs_ecs_dashboard"
+  resource_type  = "ALIYUN::ECS::INSTANCE"
+  contact_groups = ["ops"]
+  webhook        = "https://alert.example.com/cms"
+
+  tags = {
+    Environment = "production"
+    Monitoring  = "enabled"
+  }
+
+  rules {
+    rule_name    = "ecs-cpu-utilization"
+    metric_name  = "CPUUtilization"
+    period       = 60
+    silence_time = 3600
+
+    critical {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "95"
+      times               = 3
+    }
+
+    warn {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "85"
+      times               = 3
+    }
+  }
+
+  rules {
+    rule_name   = "ecs-disk-utilization"
+    metric_name = "diskusage_utilization"
+
+    critical {
+      statistics          = "Average"
+      comparison_operator = "GreaterThanOrEqualToThreshold"
+      threshold           = "90"
+      times               = 3
+    }
+  }
+}
@@ -1,5 +1,30 @@
 resource "st-alicloud_cms_system_event_contact_group_attachment" "contact_group_attachment" {
-  rule_name          = "test-rule-name"
-  contact_group_name = "test-contact-group-name"
-  level              = "3"
+  rule_name = "test-rule-name"
+
+  contact_parameters {
+    contact_group_name = "test-contact-group-name"
+    level              = "3"
+  }
+
+  contact_parameters {
+    contact_group_name = "test-oncall-contact-group-name"
+    level              = "2"
+  }
+
+  webhook_parameters {
+    url      = "https://alert.example.com/cms/events"
+    protocol = "http"
+    method   = "post"
+  }
+
+  mns_parameters {
+    region = "cn-hongkong"
+    queue  = "system-events"
+  }
+
+  fc_parameters {
+    region        = "cn-hongkong"
+    service_name  = "alerting"
+    function_name = "handle-system-event"
+  }
 }
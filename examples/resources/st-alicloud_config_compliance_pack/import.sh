@@ -0,0 +1 @@
+terraform import st-alicloud_config_compliance_pack.baseline cp-fdc8626622af00f9****
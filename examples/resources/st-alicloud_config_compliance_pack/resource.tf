@@ -0,0 +1,10 @@
+resource "st-alicloud_config_compliance_pack" "baseline" {
+  compliance_pack_name = "baseline"
+  description          = "The compliance baseline of the production accounts."
+  risk_level           = 1
+
+  config_rule_ids = [
+    st-alicloud_config_rule.required_tags.config_rule_id,
+    st-alicloud_config_rule.ram_password_policy.config_rule_id,
+  ]
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_config_rule.required_tags cr-5772ba41209e007b****
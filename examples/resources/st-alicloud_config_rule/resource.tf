@@ -0,0 +1,24 @@
+resource "st-alicloud_config_rule" "required_tags" {
+  rule_name                 = "ecs-instance-required-tags"
+  description               = "ECS instances must carry the cost allocation tags."
+  source_owner              = "ALIYUN"
+  source_identifier         = "required-tags"
+  config_rule_trigger_types = "ConfigurationItemChangeNotification"
+  resource_types_scope      = ["ACS::ECS::Instance"]
+  risk_level                = 1
+
+  input_parameters = {
+    tag1Key = "CostCenter"
+    tag2Key = "Project"
+  }
+}
+
+resource "st-alicloud_config_rule" "ram_password_policy" {
+  rule_name                   = "ram-password-policy-check"
+  source_owner                = "ALIYUN"
+  source_identifier           = "ram-password-policy-check"
+  config_rule_trigger_types   = "ScheduledNotification"
+  maximum_execution_frequency = "TwentyFour_Hours"
+  resource_types_scope        = ["ACS::RAM::Account"]
+  risk_level                  = 2
+}
@@ -0,0 +1,5 @@
+resource "st-alicloud_cs_cluster_audit_log" "audit" {
+  cluster_id       = "cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  enabled          = true
+  sls_project_name = "k8s-audit-log-project"
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_cs_cluster_rrsa.rrsa cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
@@ -0,0 +1,24 @@
+resource "st-alicloud_cs_cluster_rrsa" "rrsa" {
+  cluster_id = "cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+resource "alicloud_ram_role" "app" {
+  name = "app-pod-role"
+  document = jsonencode({
+    Version = "1"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Federated = [st-alicloud_cs_cluster_rrsa.rrsa.oidc_provider_arn]
+      }
+      Condition = {
+        StringEquals = {
+          "oidc:iss" = st-alicloud_cs_cluster_rrsa.rrsa.issuer_url
+          "oidc:aud" = st-alicloud_cs_cluster_rrsa.rrsa.audience
+          "oidc:sub" = "system:serviceaccount:app:app"
+        }
+      }
+    }]
+  })
+}
@@ -0,0 +1,5 @@
+# Import all the kubernetes permissions of a RAM user.
+terraform import st-alicloud_cs_kubernetes_permissions.example 20xxxxxxxxxxxxxxx
+
+# Import only the kubernetes permissions of the given clusters.
+terraform import st-alicloud_cs_kubernetes_permissions.example 20xxxxxxxxxxxxxxx:cxxxxxxxxxxxx1,cxxxxxxxxxxxx2
@@ -0,0 +1 @@
+terraform import st-alicloud_dataworks_project_member.analyst 123456:263612345678901234
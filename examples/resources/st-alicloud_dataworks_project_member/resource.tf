@@ -0,0 +1,8 @@
+resource "st-alicloud_dataworks_project_member" "analyst" {
+  project_id = 123456
+  user_id    = "263612345678901234"
+  role_codes = [
+    "role_project_dev",
+    "role_project_guest",
+  ]
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_dcdn_domain.api api.example.com
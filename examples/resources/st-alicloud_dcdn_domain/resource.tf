@@ -0,0 +1,23 @@
+resource "st-alicloud_dcdn_domain" "api" {
+  domain_name = "api.example.com"
+  scope       = "global"
+  scene       = "api"
+
+  sources {
+    type    = "domain"
+    content = "origin.example.com"
+    port    = 443
+  }
+
+  websocket {
+    enabled = true
+    timeout = 60
+  }
+
+  https {
+    force_https = true
+    http2       = true
+  }
+
+  back_to_origin_host = "origin.example.com"
+}
@@ -0,0 +1,21 @@
+resource "st-alicloud_ddoscoo_scheduler_rule" "web" {
+  rule_name = "web-tiered-protection"
+  rule_type = 6
+
+  # Serve the traffic from the ECS instance normally.
+  rules {
+    type       = "A"
+    value      = "47.xx.xx.11"
+    value_type = 6
+    priority   = 100
+    region_id  = "cn-hongkong"
+  }
+
+  # Switch to the Anti-DDoS Pro instance when an attack occurs.
+  rules {
+    type       = "A"
+    value      = "203.xx.xx.25"
+    value_type = 1
+    priority   = 50
+  }
+}
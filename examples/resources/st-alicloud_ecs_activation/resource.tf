@@ -0,0 +1,12 @@
+resource "st-alicloud_ecs_activation" "on_premises" {
+  description           = "Register on-premises servers of the data center"
+  instance_name         = "idc-server"
+  instance_count        = 50
+  time_to_live_in_hours = 24
+  ip_address_range      = "203.0.113.0/24"
+}
+
+output "activation_code" {
+  value     = st-alicloud_ecs_activation.on_premises.activation_code
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+resource "st-alicloud_ecs_image" "golden" {
+  snapshot_id  = st-alicloud_ecs_snapshot.golden.snapshot_id
+  image_name   = "golden-20240101"
+  description  = "Golden image built from the builder instance."
+  image_family = "golden"
+  architecture = "x86_64"
+  platform     = "Ubuntu"
+}
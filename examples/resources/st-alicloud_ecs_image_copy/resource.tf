@@ -0,0 +1,6 @@
+resource "st-alicloud_ecs_image_copy" "golden_singapore" {
+  source_image_id       = st-alicloud_ecs_image.golden.image_id
+  destination_region_id = "ap-southeast-1"
+  image_name            = "golden-20240101"
+  description           = "Golden image copied from cn-hongkong."
+}
@@ -0,0 +1,7 @@
+resource "st-alicloud_ecs_image_share_permission" "golden" {
+  image_id = "m-xxxxxxxxxxxxxxxxxxxx"
+  account_ids = [
+    "1234567890123456",
+    "1234567890123457",
+  ]
+}
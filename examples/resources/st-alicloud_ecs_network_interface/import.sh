@@ -0,0 +1 @@
+terraform import st-alicloud_ecs_network_interface.static eni-j6c8bqmzxgm5e6xxxxx
@@ -0,0 +1,12 @@
+resource "st-alicloud_ecs_network_interface" "static" {
+  vswitch_id         = "vsw-j6c1iuppbq9sk4e2xxxxx"
+  security_group_ids = ["sg-j6c8ov6nbj0vqrqxxxxx"]
+  name               = "static-ip"
+  description        = "The static IP addresses of the worker."
+  primary_ip_address = "10.0.1.10"
+
+  secondary_private_ip_addresses = [
+    "10.0.1.11",
+    "10.0.1.12",
+  ]
+}
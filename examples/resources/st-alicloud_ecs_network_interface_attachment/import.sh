@@ -0,0 +1 @@
+terraform import st-alicloud_ecs_network_interface_attachment.static eni-j6c8bqmzxgm5e6xxxxx:i-j6c5kq1f2vmw4uxxxxxx
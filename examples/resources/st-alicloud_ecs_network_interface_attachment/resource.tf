@@ -0,0 +1,4 @@
+resource "st-alicloud_ecs_network_interface_attachment" "static" {
+  network_interface_id = "eni-j6c8bqmzxgm5e6xxxxx"
+  instance_id          = "i-j6c5kq1f2vmw4uxxxxxx"
+}
@@ -0,0 +1,6 @@
+resource "st-alicloud_ecs_snapshot" "golden" {
+  disk_id        = "d-xxxxxxxxxxxxxxxxxxxx"
+  snapshot_name  = "golden-system-disk"
+  description    = "System disk of the golden image builder."
+  retention_days = 30
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_eip_bandwidth_schedule.double_eleven eip-double-eleven-bandwidth
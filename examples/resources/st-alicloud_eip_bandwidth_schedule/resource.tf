@@ -0,0 +1,12 @@
+resource "st-alicloud_eip_bandwidth_schedule" "double_eleven" {
+  name          = "eip-double-eleven-bandwidth"
+  instance_type = "EIP"
+  instance_id   = "eip-bp1mfcsdgdke5avnpckf3"
+
+  upgrade_bandwidth = 200
+  upgrade_cron      = "0 0 20 ? * * *"
+  restore_bandwidth = 50
+  restore_cron      = "0 0 2 ? * * *"
+
+  end_date = "2024-11-12T02:30:00+08:00"
+}
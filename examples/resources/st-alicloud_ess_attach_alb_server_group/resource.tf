@@ -0,0 +1,15 @@
+resource "st-alicloud_ess_attach_alb_server_group" "web" {
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+
+  alb_server_groups {
+    alb_server_group_id = "sgp-xxxxxxxxxxxxxxxxxx"
+    port                = 80
+    weight              = 100
+  }
+
+  alb_server_groups {
+    alb_server_group_id = "sgp-yyyyyyyyyyyyyyyyyy"
+    port                = 8080
+    weight              = 50
+  }
+}
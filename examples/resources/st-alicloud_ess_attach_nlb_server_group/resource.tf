@@ -0,0 +1,9 @@
+resource "st-alicloud_ess_attach_nlb_server_group" "tcp" {
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+
+  nlb_server_groups {
+    nlb_server_group_id = "sgp-xxxxxxxxxxxxxxxxxx"
+    port                = 443
+    weight              = 100
+  }
+}
@@ -0,0 +1,7 @@
+resource "st-alicloud_ess_instance_protection" "critical" {
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+  instance_ids = [
+    "i-xxxxxxxxxxxxxxxxxxxx",
+    "i-yyyyyyyyyyyyyyyyyyyy",
+  ]
+}
@@ -0,0 +1,13 @@
+resource "st-alicloud_ess_lifecycle_hook" "drain" {
+  scaling_group_id      = "asg-xxxxxxxxxxxxxxxxxxxx"
+  lifecycle_hook_name   = "graceful-drain"
+  lifecycle_transition  = "SCALE_IN"
+  heartbeat_timeout     = 900
+  default_result        = "CONTINUE"
+  notification_metadata = "{\"cluster\":\"web\"}"
+
+  notification_target {
+    type = "queue"
+    name = "ess-drain-queue"
+  }
+}
@@ -0,0 +1,27 @@
+resource "st-alicloud_ess_scaling_group_mixed_instances_policy" "web" {
+  scaling_group_id = "asg-j6cxxxxxxxxxxxxxxxxxx"
+  instance_types   = ["ecs.c7.large", "ecs.c6.large", "ecs.g7.large"]
+  spot_strategy    = "SpotWithPriceLimit"
+
+  spot_price_limits {
+    instance_type = "ecs.c7.large"
+    price_limit   = 0.5
+  }
+
+  spot_price_limits {
+    instance_type = "ecs.c6.large"
+    price_limit   = 0.45
+  }
+
+  spot_price_limits {
+    instance_type = "ecs.g7.large"
+    price_limit   = 0.6
+  }
+
+  multi_az_policy                          = "COST_OPTIMIZED"
+  on_demand_base_capacity                  = 2
+  on_demand_percentage_above_base_capacity = 20
+  spot_instance_pools                      = 2
+  spot_instance_remedy                     = true
+  compensate_with_on_demand                = true
+}
@@ -0,0 +1,8 @@
+resource "st-alicloud_ess_scaling_group_tags" "cost_allocation" {
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+  tags = {
+    "cost-center" = "platform"
+    "project"     = "web-server"
+  }
+  propagate_to_instances = true
+}
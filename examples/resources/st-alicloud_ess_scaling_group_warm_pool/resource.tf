@@ -0,0 +1,6 @@
+resource "st-alicloud_ess_scaling_group_warm_pool" "web" {
+  scaling_group_id      = "asg-xxxxxxxxxxxxxxxxxxxx"
+  min_size              = 5
+  instance_reuse_policy = "forceRecycle"
+  pool_state            = "Stopped"
+}
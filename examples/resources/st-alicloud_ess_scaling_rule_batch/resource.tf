@@ -0,0 +1,35 @@
+resource "st-alicloud_ess_scaling_rule_batch" "web" {
+  scaling_group_id = "asg-xxxxxxxxxxxxxxxxxxxx"
+
+  scaling_rules {
+    scaling_rule_name = "cpu-target-tracking"
+    scaling_rule_type = "TargetTrackingScalingRule"
+    metric_name       = "CpuUtilization"
+    target_value      = 60
+  }
+
+  scaling_rules {
+    scaling_rule_name = "scale-out-2"
+    scaling_rule_type = "SimpleScalingRule"
+    adjustment_type   = "QuantityChangeInCapacity"
+    adjustment_value  = 2
+    cooldown          = 300
+  }
+
+  scaling_rules {
+    scaling_rule_name = "step-scale-out"
+    scaling_rule_type = "StepScalingRule"
+    adjustment_type   = "QuantityChangeInCapacity"
+
+    step_adjustments {
+      metric_interval_lower_bound = 0
+      metric_interval_upper_bound = 20
+      scaling_adjustment          = 1
+    }
+
+    step_adjustments {
+      metric_interval_lower_bound = 20
+      scaling_adjustment          = 3
+    }
+  }
+}
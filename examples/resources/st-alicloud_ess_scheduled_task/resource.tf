@@ -0,0 +1,11 @@
+resource "st-alicloud_ess_scheduled_task" "weekday_peak" {
+  scaling_group_id    = "asg-xxxxxxxxxxxxxxxxxxxx"
+  scheduled_task_name = "weekday-peak"
+  description         = "Scale up before the weekday traffic peak."
+  launch_time         = "2024-01-01T00:30Z"
+  recurrence_type     = "Cron"
+  recurrence_value    = "30 0 * * 1-5"
+  min_value           = 4
+  max_value           = 20
+  desired_capacity    = 8
+}
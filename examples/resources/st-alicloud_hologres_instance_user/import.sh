@@ -0,0 +1 @@
+terraform import st-alicloud_hologres_instance_user.analyst hgprecn-cn-i7m2v08uu00a:p4_263612345678901234
@@ -0,0 +1,5 @@
+resource "st-alicloud_hologres_instance_user" "analyst" {
+  instance_id      = "hgprecn-cn-i7m2v08uu00a"
+  ram_account_type = "ram_user"
+  ram_account_id   = "263612345678901234"
+}
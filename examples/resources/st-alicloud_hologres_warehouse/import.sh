@@ -0,0 +1 @@
+terraform import st-alicloud_hologres_warehouse.analytics hgprecn-cn-i7m2v08uu00a:analytics
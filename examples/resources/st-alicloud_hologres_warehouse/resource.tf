@@ -0,0 +1,6 @@
+resource "st-alicloud_hologres_warehouse" "analytics" {
+  instance_id   = "hgprecn-cn-i7m2v08uu00a"
+  name          = "analytics"
+  cpu           = 32
+  cluster_count = 2
+}
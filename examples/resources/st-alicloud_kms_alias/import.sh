@@ -0,0 +1 @@
+terraform import st-alicloud_kms_alias.app alias/app
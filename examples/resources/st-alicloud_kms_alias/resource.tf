@@ -0,0 +1,4 @@
+resource "st-alicloud_kms_alias" "app" {
+  alias_name = "alias/app"
+  key_id     = st-alicloud_kms_key.app.key_id
+}
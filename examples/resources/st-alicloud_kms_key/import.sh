@@ -0,0 +1 @@
+terraform import st-alicloud_kms_key.app key-hzz6xxxxxxxxxxxxxxxxx
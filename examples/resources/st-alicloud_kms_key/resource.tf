@@ -0,0 +1,7 @@
+resource "st-alicloud_kms_key" "app" {
+  description            = "Envelope encryption key of the app."
+  protection_level       = "HSM"
+  automatic_rotation     = true
+  rotation_interval      = "365d"
+  pending_window_in_days = 7
+}
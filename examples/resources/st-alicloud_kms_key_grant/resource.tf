@@ -0,0 +1,12 @@
+resource "st-alicloud_kms_key_grant" "app" {
+  key_id = "key-hzz6xxxxxxxxxxxxxxxxx"
+  name   = "app-envelope-encryption"
+  principals = [
+    "acs:ram::123456789012****:role/app",
+  ]
+  operations = [
+    "kms:Encrypt",
+    "kms:Decrypt",
+    "kms:GenerateDataKey",
+  ]
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_kms_secret.app_db app/db-password
@@ -0,0 +1,11 @@
+resource "st-alicloud_kms_secret" "app_db" {
+  secret_name       = "app/db-password"
+  description       = "Database password of the app."
+  encryption_key_id = st-alicloud_kms_key.app.key_id
+  secret_data       = var.app_db_password
+  version_stages    = ["blue"]
+
+  tags = {
+    app = "example"
+  }
+}
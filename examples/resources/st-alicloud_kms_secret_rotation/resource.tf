@@ -0,0 +1,4 @@
+resource "st-alicloud_kms_secret_rotation" "rds" {
+  secret_name       = "rds-app-credential"
+  rotation_interval = "7d"
+}
@@ -0,0 +1,16 @@
+data "st-alicloud_alb_load_balancers" "production" {
+  tags = {
+    "env" = "production"
+  }
+}
+
+resource "st-alicloud_load_balancer_protection" "production" {
+  for_each = { for alb in data.st-alicloud_alb_load_balancers.production.load_balancers : alb.id => alb }
+
+  load_balancer_id   = each.key
+  load_balancer_type = "alb"
+
+  deletion_protection            = true
+  modification_protection        = true
+  modification_protection_reason = "Managed by Terraform"
+}
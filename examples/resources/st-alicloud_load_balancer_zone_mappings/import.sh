@@ -0,0 +1 @@
+terraform import st-alicloud_load_balancer_zone_mappings.nlb nlb:nlb-xxxxxxxxxxxxxxxxxx
@@ -0,0 +1,19 @@
+resource "st-alicloud_load_balancer_zone_mappings" "nlb" {
+  load_balancer_id   = "nlb-xxxxxxxxxxxxxxxxxx"
+  load_balancer_type = "nlb"
+
+  zone_mappings {
+    zone_id    = "cn-hongkong-b"
+    vswitch_id = "vsw-xxxxxxxxxxxxxxxxxxxxx"
+  }
+
+  zone_mappings {
+    zone_id    = "cn-hongkong-c"
+    vswitch_id = "vsw-yyyyyyyyyyyyyyyyyyyyy"
+  }
+
+  zone_mappings {
+    zone_id    = "cn-hongkong-d"
+    vswitch_id = "vsw-zzzzzzzzzzzzzzzzzzzzz"
+  }
+}
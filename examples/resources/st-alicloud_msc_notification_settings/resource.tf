@@ -0,0 +1,27 @@
+resource "st-alicloud_msc_notification_settings" "account" {
+  contacts {
+    name     = "security-team"
+    email    = "security@example.com"
+    mobile   = "85200000000"
+    position = "Technical Director"
+  }
+
+  contacts {
+    name     = "finance-team"
+    email    = "finance@example.com"
+    mobile   = "85200000001"
+    position = "Finance Director"
+  }
+
+  subscriptions {
+    item_name     = "Security Notifications"
+    channels      = ["sms", "mail"]
+    contact_names = ["security-team"]
+  }
+
+  subscriptions {
+    item_name     = "Account Balance Alerts"
+    channels      = ["mail"]
+    contact_names = ["finance-team"]
+  }
+}
@@ -0,0 +1,19 @@
+resource "st-alicloud_nlb_server_group_server_attachment" "web" {
+  server_group_id = "sgp-xxxxxxxxxxxxxxxxxx"
+
+  servers {
+    server_type = "Ecs"
+    server_id   = "i-xxxxxxxxxxxxxxxxxxxx"
+    port        = 80
+    weight      = 100
+  }
+
+  servers {
+    server_type = "Ip"
+    server_id   = "10.0.0.10"
+    server_ip   = "10.0.0.10"
+    port        = 8080
+    weight      = 50
+    description = "on-premises"
+  }
+}
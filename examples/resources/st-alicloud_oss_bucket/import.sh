@@ -0,0 +1 @@
+terraform import st-alicloud_oss_bucket.logs example-logs
@@ -0,0 +1,47 @@
+resource "st-alicloud_oss_bucket" "logs" {
+  bucket                = "example-logs"
+  acl                   = "private"
+  versioning            = "Enabled"
+  transfer_acceleration = false
+
+  policy = jsonencode({
+    Version = "1"
+    Statement = [
+      {
+        Effect    = "Deny"
+        Principal = ["*"]
+        Action    = ["oss:*"]
+        Resource  = ["acs:oss:*:*:example-logs", "acs:oss:*:*:example-logs/*"]
+        Condition = {
+          Bool = {
+            "acs:SecureTransport" = ["false"]
+          }
+        }
+      }
+    ]
+  })
+
+  server_side_encryption {
+    sse_algorithm     = "KMS"
+    kms_master_key_id = "key-hzz6xxxxxxxxxxxxxxxxx"
+  }
+
+  lifecycle_rules {
+    id                                 = "archive-logs"
+    prefix                             = "logs/"
+    enabled                            = true
+    expiration_days                    = 365
+    abort_multipart_upload_days        = 7
+    noncurrent_version_expiration_days = 30
+
+    transitions {
+      days          = 30
+      storage_class = "IA"
+    }
+
+    transitions {
+      days          = 90
+      storage_class = "Archive"
+    }
+  }
+}
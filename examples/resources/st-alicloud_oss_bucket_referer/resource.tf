@@ -0,0 +1,8 @@
+resource "st-alicloud_oss_bucket_referer" "cdn_origin" {
+  bucket              = "example-static-site"
+  allow_empty_referer = false
+  referer_list = [
+    "https://www.example.com",
+    "https://*.example.com",
+  ]
+}
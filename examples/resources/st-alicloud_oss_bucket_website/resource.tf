@@ -0,0 +1,21 @@
+resource "st-alicloud_oss_bucket_website" "static_site" {
+  bucket         = "example-static-site"
+  index_document = "index.html"
+  error_document = "error.html"
+
+  routing_rules {
+    rule_number        = 1
+    key_prefix_equals  = "docs/"
+    redirect_type      = "External"
+    protocol           = "https"
+    host_name          = "docs.example.com"
+    http_redirect_code = 301
+  }
+
+  routing_rules {
+    rule_number                     = 2
+    http_error_code_returned_equals = 404
+    redirect_type                   = "Internal"
+    replace_key_with                = "index.html"
+  }
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_oss_object.bootstrap example-artifacts:scripts/bootstrap.sh
@@ -0,0 +1,18 @@
+resource "st-alicloud_oss_object" "bootstrap" {
+  bucket       = "example-artifacts"
+  key          = "scripts/bootstrap.sh"
+  source       = "${path.module}/files/bootstrap.sh"
+  content_type = "text/x-sh"
+  acl          = "private"
+}
+
+resource "st-alicloud_oss_object" "app_config" {
+  bucket = "example-artifacts"
+  key    = "config/app.json"
+  content = jsonencode({
+    log_level = "info"
+  })
+  content_type           = "application/json"
+  server_side_encryption = "KMS"
+  kms_key_id             = "key-xxxxxxxxxxxxxxxxxxxxx"
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_pai_workspace_member.developer 12345:263612345678901234
@@ -0,0 +1,8 @@
+resource "st-alicloud_pai_workspace_member" "developer" {
+  workspace_id = "12345"
+  user_id      = "263612345678901234"
+  roles = [
+    "PAI.AlgoDeveloper",
+    "PAI.LabelManager",
+  ]
+}
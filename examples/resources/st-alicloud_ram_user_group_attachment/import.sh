@@ -0,0 +1,2 @@
+# The import ID is in the format of <group_name>:<user_name>.
+terraform import st-alicloud_ram_user_group_attachment.ram_group test-group:test-user
@@ -0,0 +1 @@
+terraform import st-alicloud_resource_manager_account.production 1234567890123456
@@ -0,0 +1,5 @@
+resource "st-alicloud_resource_manager_account" "production" {
+  display_name        = "production"
+  account_name_prefix = "production"
+  folder_id           = "fd-xxxxxxxxxx"
+}
@@ -0,0 +1,24 @@
+resource "st-alicloud_resource_manager_control_policy_attachment" "production" {
+  policy_name = "production-guardrails"
+  description = "Guardrails of the production folder."
+  target_id   = "fd-xxxxxxxxxx"
+
+  policy_documents = [
+    jsonencode({
+      Version = "1"
+      Statement = [{
+        Effect   = "Deny"
+        Action   = ["ram:CreateAccessKey"]
+        Resource = "*"
+      }]
+    }),
+    jsonencode({
+      Version = "1"
+      Statement = [{
+        Effect   = "Deny"
+        Action   = ["actiontrail:DeleteTrail", "actiontrail:StopLogging"]
+        Resource = "*"
+      }]
+    }),
+  ]
+}
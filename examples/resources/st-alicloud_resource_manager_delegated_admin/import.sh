@@ -0,0 +1 @@
+terraform import st-alicloud_resource_manager_delegated_admin.cloudsso cloudsso.aliyuncs.com:1234567890123456
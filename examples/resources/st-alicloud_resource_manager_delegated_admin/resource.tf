@@ -0,0 +1,4 @@
+resource "st-alicloud_resource_manager_delegated_admin" "cloudsso" {
+  account_id        = "1234567890123456"
+  service_principal = "cloudsso.aliyuncs.com"
+}
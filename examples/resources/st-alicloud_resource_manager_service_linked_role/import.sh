@@ -0,0 +1 @@
+terraform import st-alicloud_resource_manager_service_linked_role.ess AliyunServiceRoleForAutoScaling
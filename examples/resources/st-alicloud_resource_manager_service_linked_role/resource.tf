@@ -0,0 +1,11 @@
+resource "st-alicloud_resource_manager_service_linked_role" "ess" {
+  service_name = "ess.aliyuncs.com"
+}
+
+resource "st-alicloud_resource_manager_service_linked_role" "alb" {
+  service_name = "alb.aliyuncs.com"
+}
+
+resource "st-alicloud_resource_manager_service_linked_role" "asm" {
+  service_name = "servicemesh.aliyuncs.com"
+}
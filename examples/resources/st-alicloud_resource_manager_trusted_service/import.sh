@@ -0,0 +1 @@
+terraform import st-alicloud_resource_manager_trusted_service.config config.aliyuncs.com
@@ -0,0 +1,3 @@
+resource "st-alicloud_resource_manager_trusted_service" "config" {
+  service_principal = "config.aliyuncs.com"
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_ros_stack.legacy xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
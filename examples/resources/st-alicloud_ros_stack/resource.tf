@@ -0,0 +1,33 @@
+resource "st-alicloud_ros_stack" "legacy" {
+  stack_name = "legacy-vpc"
+  template_body = jsonencode({
+    ROSTemplateFormatVersion = "2015-09-01"
+    Parameters = {
+      CidrBlock = {
+        Type    = "String"
+        Default = "192.168.0.0/16"
+      }
+    }
+    Resources = {
+      Vpc = {
+        Type = "ALIYUN::ECS::VPC"
+        Properties = {
+          VpcName   = "legacy-vpc"
+          CidrBlock = { Ref = "CidrBlock" }
+        }
+      }
+    }
+    Outputs = {
+      VpcId = {
+        Value = { "Fn::GetAtt" = ["Vpc", "VpcId"] }
+      }
+    }
+  })
+
+  parameters = {
+    CidrBlock = "10.0.0.0/16"
+  }
+
+  timeout_in_minutes = 30
+  disable_rollback   = false
+}
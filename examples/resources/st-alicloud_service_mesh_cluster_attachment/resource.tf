@@ -0,0 +1,5 @@
+resource "st-alicloud_service_mesh_cluster_attachment" "cluster" {
+  service_mesh_id   = "cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  cluster_id        = "cyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyy"
+  reserve_namespace = false
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_service_mesh_gateway.ingress cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx:ingressgateway
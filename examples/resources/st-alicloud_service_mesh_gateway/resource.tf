@@ -0,0 +1,25 @@
+resource "st-alicloud_service_mesh_gateway" "ingress" {
+  service_mesh_id    = "cxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  name               = "ingressgateway"
+  cluster_id         = "cyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyyy"
+  gateway_type       = "ingress"
+  replica_count      = 2
+  service_type       = "LoadBalancer"
+  load_balancer_spec = "slb.s1.small"
+
+  auto_scaling_enabled      = true
+  auto_scaling_min_replicas = 2
+  auto_scaling_max_replicas = 5
+
+  ports {
+    name        = "http"
+    port        = 80
+    target_port = 80
+  }
+
+  ports {
+    name        = "https"
+    port        = 443
+    target_port = 443
+  }
+}
@@ -0,0 +1,12 @@
+resource "st-alicloud_service_mesh_sidecar_injection" "mesh" {
+  service_mesh_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+
+  enabled_namespaces = [
+    "frontend",
+    "backend",
+  ]
+
+  disabled_namespaces = [
+    "monitoring",
+  ]
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_service_mesh_traffic_policy.reviews_route xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx:VirtualService:bookinfo:reviews
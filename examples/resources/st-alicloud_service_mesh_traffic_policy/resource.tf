@@ -0,0 +1,45 @@
+resource "st-alicloud_service_mesh_traffic_policy" "reviews_route" {
+  service_mesh_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  manifest = jsonencode({
+    apiVersion = "networking.istio.io/v1beta1"
+    kind       = "VirtualService"
+    metadata = {
+      name      = "reviews"
+      namespace = "bookinfo"
+    }
+    spec = {
+      hosts = ["reviews"]
+      http = [{
+        route = [
+          {
+            destination = { host = "reviews", subset = "v1" }
+            weight      = 90
+          },
+          {
+            destination = { host = "reviews", subset = "v2" }
+            weight      = 10
+          },
+        ]
+      }]
+    }
+  })
+}
+
+resource "st-alicloud_service_mesh_traffic_policy" "reviews_subsets" {
+  service_mesh_id = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  manifest = jsonencode({
+    apiVersion = "networking.istio.io/v1beta1"
+    kind       = "DestinationRule"
+    metadata = {
+      name      = "reviews"
+      namespace = "bookinfo"
+    }
+    spec = {
+      host = "reviews"
+      subsets = [
+        { name = "v1", labels = { version = "v1" } },
+        { name = "v2", labels = { version = "v2" } },
+      ]
+    }
+  })
+}
@@ -0,0 +1,5 @@
+# Import all the service mesh permissions of a RAM user.
+terraform import st-alicloud_service_mesh_user_permission.example 20xxxxxxxxxxxxxxx
+
+# Import only the permissions of the given service meshes.
+terraform import st-alicloud_service_mesh_user_permission.example 20xxxxxxxxxxxxxxx:cxxxxxxxxxxxx1,cxxxxxxxxxxxx2
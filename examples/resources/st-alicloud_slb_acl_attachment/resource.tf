@@ -0,0 +1,12 @@
+resource "st-alicloud_slb_acl_attachment" "office" {
+  load_balancer_id  = "lb-xxxxxxxxxxxxxxxxxxxxx"
+  listener_port     = 443
+  listener_protocol = "https"
+
+  acl_id   = "acl-xxxxxxxxxxxxxxxxxxxxx"
+  acl_type = "white"
+  acl_entries = [
+    "203.0.113.0/24",
+    "198.51.100.10/32",
+  ]
+}
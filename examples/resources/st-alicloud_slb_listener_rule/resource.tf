@@ -0,0 +1,9 @@
+resource "st-alicloud_slb_listener_rule" "api" {
+  load_balancer_id  = "lb-xxxxxxxxxxxxxxxxxxxxx"
+  listener_port     = 443
+  listener_protocol = "https"
+  name              = "api"
+  domain            = "api.example.com"
+  url               = "/v1"
+  vserver_group_id  = st-alicloud_slb_vserver_group.web.id
+}
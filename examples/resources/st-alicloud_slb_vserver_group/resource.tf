@@ -0,0 +1,18 @@
+resource "st-alicloud_slb_vserver_group" "web" {
+  load_balancer_id = "lb-xxxxxxxxxxxxxxxxxxxxx"
+  name             = "web"
+
+  backend_servers {
+    server_id = "i-xxxxxxxxxxxxxxxxxxxx"
+    port      = 8080
+    weight    = 100
+  }
+
+  backend_servers {
+    server_id   = "i-yyyyyyyyyyyyyyyyyyyy"
+    port        = 8080
+    weight      = 50
+    type        = "ecs"
+    description = "canary"
+  }
+}
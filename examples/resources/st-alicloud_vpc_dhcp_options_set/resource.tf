@@ -0,0 +1,6 @@
+resource "st-alicloud_vpc_dhcp_options_set" "corp" {
+  name                = "corp"
+  description         = "Resolve the on-premises domain with the corporate DNS servers."
+  domain_name         = "corp.example.com"
+  domain_name_servers = ["10.0.0.2", "10.0.0.3"]
+}
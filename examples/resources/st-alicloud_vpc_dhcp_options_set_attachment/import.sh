@@ -0,0 +1 @@
+terraform import st-alicloud_vpc_dhcp_options_set_attachment.corp dopt-j6c4t8wm1v6zexxxxxx:vpc-j6c1mm4bbk5l3kxxxxxx
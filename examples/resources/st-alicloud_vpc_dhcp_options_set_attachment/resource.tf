@@ -0,0 +1,4 @@
+resource "st-alicloud_vpc_dhcp_options_set_attachment" "corp" {
+  dhcp_options_set_id = st-alicloud_vpc_dhcp_options_set.corp.dhcp_options_set_id
+  vpc_id              = "vpc-j6c1mm4bbk5l3kxxxxxx"
+}
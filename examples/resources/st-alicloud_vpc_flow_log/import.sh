@@ -0,0 +1 @@
+terraform import st-alicloud_vpc_flow_log.production fl-j6cxxxxxxxxxxxxxxxxxx
@@ -0,0 +1,11 @@
+resource "st-alicloud_vpc_flow_log" "production" {
+  name                 = "production-vpc"
+  description          = "Flow log of the production VPC."
+  resource_type        = "VPC"
+  resource_id          = "vpc-j6cxxxxxxxxxxxxxxxxxx"
+  traffic_type         = "All"
+  project_name         = "vpc-flow-log"
+  log_store_name       = "production"
+  aggregation_interval = 10
+  enabled              = true
+}
@@ -0,0 +1,15 @@
+resource "st-alicloud_vpc_ipam_pool" "global" {
+  ipam_scope_id  = "ipam-scope-xxxxxxxxxxxxxxxxxxxx"
+  ipam_pool_name = "global"
+  description    = "Top level pool of the private address space."
+  cidrs          = ["10.0.0.0/8"]
+}
+
+resource "st-alicloud_vpc_ipam_pool" "hongkong" {
+  ipam_scope_id                = "ipam-scope-xxxxxxxxxxxxxxxxxxxx"
+  ipam_pool_name               = "cn-hongkong"
+  pool_region_id               = "cn-hongkong"
+  source_ipam_pool_id          = st-alicloud_vpc_ipam_pool.global.ipam_pool_id
+  allocation_default_cidr_mask = 16
+  cidrs                        = ["10.16.0.0/12"]
+}
@@ -0,0 +1,4 @@
+resource "st-alicloud_vpc_ipam_pool_allocation" "web" {
+  ipam_pool_id = "ipam-pool-xxxxxxxxxxxxxxxxxxxx"
+  cidr_mask    = 16
+}
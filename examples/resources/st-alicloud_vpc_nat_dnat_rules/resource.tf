@@ -0,0 +1,21 @@
+resource "st-alicloud_vpc_nat_dnat_rules" "web" {
+  forward_table_id = "ftb-xxxxxxxxxxxxxxxxxxxx"
+
+  dnat_rules {
+    name          = "web-http"
+    external_ip   = "47.0.0.1"
+    external_port = "80"
+    ip_protocol   = "TCP"
+    internal_ip   = "192.168.0.10"
+    internal_port = "8080"
+  }
+
+  dnat_rules {
+    name          = "web-https"
+    external_ip   = "47.0.0.1"
+    external_port = "443"
+    ip_protocol   = "TCP"
+    internal_ip   = "192.168.0.10"
+    internal_port = "8443"
+  }
+}
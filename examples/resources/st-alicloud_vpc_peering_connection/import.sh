@@ -0,0 +1 @@
+terraform import st-alicloud_vpc_peering_connection.shared_services pcc-j6cxxxxxxxxxxxxxxxxxx
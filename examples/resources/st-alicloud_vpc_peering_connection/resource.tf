@@ -0,0 +1,24 @@
+resource "st-alicloud_vpc_peering_connection" "shared_services" {
+  name                = "production-to-shared-services"
+  description         = "Peering connection from the production VPC to the shared services VPC."
+  vpc_id              = "vpc-j6cxxxxxxxxxxxxxxxxxx"
+  accepting_ali_uid   = 1234567890123456
+  accepting_region_id = "cn-hongkong"
+  accepting_vpc_id    = "vpc-j6cyyyyyyyyyyyyyyyyyy"
+  bandwidth           = 1024
+
+  accepter {
+    role_arn          = "acs:ram::1234567890123456:role/vpc-peering"
+    role_session_name = "terraform-vpc-peering"
+  }
+
+  requester_routes {
+    route_table_id         = "vtb-j6cxxxxxxxxxxxxxxxxxx"
+    destination_cidr_block = "172.16.0.0/16"
+  }
+
+  accepter_routes {
+    route_table_id         = "vtb-j6cyyyyyyyyyyyyyyyyyy"
+    destination_cidr_block = "10.0.0.0/16"
+  }
+}
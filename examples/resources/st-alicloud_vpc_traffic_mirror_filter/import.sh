@@ -0,0 +1 @@
+terraform import st-alicloud_vpc_traffic_mirror_filter.incident tmf-j6cmls82xnc86vxxxxxx
@@ -0,0 +1,22 @@
+resource "st-alicloud_vpc_traffic_mirror_filter" "incident" {
+  name        = "incident-capture"
+  description = "Mirror the HTTPS traffic for the security investigation."
+
+  ingress_rules {
+    priority               = 1
+    action                 = "accept"
+    protocol               = "TCP"
+    source_cidr_block      = "0.0.0.0/0"
+    destination_cidr_block = "10.0.0.0/8"
+    source_port_range      = "1/65535"
+    destination_port_range = "443/443"
+  }
+
+  egress_rules {
+    priority               = 1
+    action                 = "accept"
+    protocol               = "ALL"
+    source_cidr_block      = "10.0.0.0/8"
+    destination_cidr_block = "0.0.0.0/0"
+  }
+}
@@ -0,0 +1 @@
+terraform import st-alicloud_vpc_traffic_mirror_session.incident tms-j6cla50buc44ap8xxxxxx
@@ -0,0 +1,8 @@
+resource "st-alicloud_vpc_traffic_mirror_session" "incident" {
+  name                     = "incident-capture"
+  traffic_mirror_filter_id = st-alicloud_vpc_traffic_mirror_filter.incident.traffic_mirror_filter_id
+  source_ids               = ["eni-j6c8bqmzxgm5e6xxxxx"]
+  target_id                = st-alicloud_ecs_network_interface.capture.network_interface_id
+  target_type              = "NetworkInterface"
+  priority                 = 1
+}
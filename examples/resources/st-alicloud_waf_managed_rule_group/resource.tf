@@ -0,0 +1,9 @@
+resource "st-alicloud_waf_managed_rule_group" "web" {
+  instance_id   = "waf_v2_public_cn-xxxxxxxxxxx"
+  template_id   = 12345
+  rule_name     = "web-basic-protection"
+  rule_group_id = 1012
+
+  # Verify the new rule group in report-only mode before switching to block.
+  action = "monitor"
+}
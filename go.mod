@@ -5,19 +5,45 @@ go 1.19
 require (
 	github.com/alibabacloud-go/alidns-20150109/v4 v4.0.1
 	github.com/alibabacloud-go/cdn-20180510/v2 v2.0.9
-	github.com/alibabacloud-go/darabonba-openapi/v2 v2.0.4
+	github.com/alibabacloud-go/darabonba-openapi/v2 v2.0.9
 	github.com/alibabacloud-go/ddoscoo-20200101/v2 v2.0.0
-	github.com/alibabacloud-go/tea v1.2.1
-	github.com/alibabacloud-go/tea-utils/v2 v2.0.4
+	github.com/alibabacloud-go/tea v1.2.2
+	github.com/alibabacloud-go/tea-utils/v2 v2.0.6
 	github.com/hashicorp/terraform-plugin-framework v1.3.2
 	github.com/hashicorp/terraform-plugin-framework-validators v0.10.0
 )
 
 require (
 	github.com/alibabacloud-go/adb-20190315/v2 v2.1.2
+	github.com/alibabacloud-go/aiworkspace-20210204/v3 v3.0.1
+	github.com/alibabacloud-go/alb-20200616/v2 v2.0.5
+	github.com/alibabacloud-go/arms-20190808/v6 v6.0.0
 	github.com/alibabacloud-go/bssopenapi-20171214/v3 v3.0.2
+	github.com/alibabacloud-go/cas-20200407/v3 v3.0.1
+	github.com/alibabacloud-go/cloudfw-20171207/v7 v7.0.1
+	github.com/alibabacloud-go/cloudsso-20210515/v2 v2.1.0
+	github.com/alibabacloud-go/config-20200907/v3 v3.0.3
+	github.com/alibabacloud-go/cs-20151215/v5 v5.7.2
+	github.com/alibabacloud-go/dataworks-public-20200518/v5 v5.6.0
+	github.com/alibabacloud-go/dcdn-20180115/v3 v3.3.0
+	github.com/alibabacloud-go/ecs-20140526/v4 v4.0.1
 	github.com/alibabacloud-go/ess-20220222/v2 v2.0.10
+	github.com/alibabacloud-go/hologram-20220601 v1.0.4
+	github.com/alibabacloud-go/kms-20160120/v3 v3.2.3
+	github.com/alibabacloud-go/mscopensubscription-20210713 v1.0.4
+	github.com/alibabacloud-go/nlb-20220430/v2 v2.0.3
+	github.com/alibabacloud-go/oos-20190601/v3 v3.0.5
+	github.com/alibabacloud-go/resourcemanager-20200331/v3 v3.0.1
+	github.com/alibabacloud-go/ros-20190910/v4 v4.3.0
 	github.com/alibabacloud-go/slb-20140515/v4 v4.0.1
+	github.com/alibabacloud-go/sls-20201230/v5 v5.0.0
+	github.com/alibabacloud-go/sts-20150401/v2 v2.0.1
+	github.com/alibabacloud-go/tag-20180828/v2 v2.0.2
+	github.com/alibabacloud-go/vpc-20160428/v6 v6.1.0
+	github.com/alibabacloud-go/vpcipam-20230228 v1.0.1
+	github.com/alibabacloud-go/vpcpeer-20220101/v2 v2.0.2
+	github.com/alibabacloud-go/waf-openapi-20211001/v4 v4.1.0
+	github.com/aliyun/aliyun-oss-go-sdk v2.2.7+incompatible
 	github.com/cenkalti/backoff v2.2.1+incompatible
 	github.com/google/uuid v1.3.0
 	github.com/hashicorp/terraform-plugin-docs v0.14.1
@@ -27,6 +53,14 @@ require (
 	github.com/Masterminds/goutils v1.1.1 // indirect
 	github.com/Masterminds/semver/v3 v3.1.1 // indirect
 	github.com/Masterminds/sprig/v3 v3.2.2 // indirect
+	github.com/alibabacloud-go/alibabacloud-gateway-pop v0.0.6 // indirect
+	github.com/alibabacloud-go/alibabacloud-gateway-sls v0.0.6 // indirect
+	github.com/alibabacloud-go/alibabacloud-gateway-sls-util v0.0.1 // indirect
+	github.com/alibabacloud-go/darabonba-array v0.1.0 // indirect
+	github.com/alibabacloud-go/darabonba-encode-util v0.0.2 // indirect
+	github.com/alibabacloud-go/darabonba-map v0.0.2 // indirect
+	github.com/alibabacloud-go/darabonba-signature-util v0.0.7 // indirect
+	github.com/alibabacloud-go/darabonba-string v1.0.2 // indirect
 	github.com/alibabacloud-go/openapi-util v0.1.0 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
@@ -44,6 +78,7 @@ require (
 	github.com/mitchellh/cli v1.1.5 // indirect
 	github.com/mitchellh/copystructure v1.2.0 // indirect
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
+	github.com/pierrec/lz4 v2.6.1+incompatible // indirect
 	github.com/posener/complete v1.2.3 // indirect
 	github.com/russross/blackfriday v1.6.0 // indirect
 	github.com/shopspring/decimal v1.3.1 // indirect
@@ -52,22 +87,22 @@ require (
 	github.com/vmihailenco/msgpack/v5 v5.3.5 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/zclconf/go-cty v1.13.1 // indirect
-	golang.org/x/crypto v0.10.0 // indirect
+	golang.org/x/crypto v0.21.0 // indirect
 	golang.org/x/mod v0.8.0 // indirect
+	golang.org/x/time v0.3.0 // indirect
 )
 
 require (
 	github.com/alibabacloud-go/alibabacloud-gateway-spi v0.0.4 // indirect
 	github.com/alibabacloud-go/cms-20190101/v8 v8.0.1
-	github.com/alibabacloud-go/cs-20151215/v4 v4.0.5
-	github.com/alibabacloud-go/debug v0.0.0-20190504072949-9472017b5c68 // indirect
+	github.com/alibabacloud-go/debug v1.0.0 // indirect
 	github.com/alibabacloud-go/emr-20210320 v1.1.0
 	github.com/alibabacloud-go/endpoint-util v1.1.1 // indirect
 	github.com/alibabacloud-go/ram-20150501/v2 v2.0.0
 	github.com/alibabacloud-go/servicemesh-20200111/v4 v4.3.1
 	github.com/alibabacloud-go/tea-utils v1.4.5 // indirect
-	github.com/alibabacloud-go/tea-xml v1.1.2 // indirect
-	github.com/aliyun/credentials-go v1.2.6 // indirect
+	github.com/alibabacloud-go/tea-xml v1.1.3 // indirect
+	github.com/aliyun/credentials-go v1.3.1 // indirect
 	github.com/cenkalti/backoff/v4 v4.2.0
 	github.com/clbanning/mxj/v2 v2.5.7 // indirect
 	github.com/fatih/color v1.14.1 // indirect
@@ -88,9 +123,9 @@ require (
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/oklog/run v1.1.0 // indirect
 	github.com/tjfoc/gmsm v1.4.1 // indirect
-	golang.org/x/net v0.11.0 // indirect
-	golang.org/x/sys v0.9.0 // indirect
-	golang.org/x/text v0.10.0 // indirect
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
 	google.golang.org/grpc v1.56.1 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect